@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/plugin/v1alpha1/step_runner.proto
+
+package pluginv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StepRunnerServiceName is the fully-qualified name of the StepRunnerService service.
+	StepRunnerServiceName = "akuity.io.kargo.plugin.v1alpha1.StepRunnerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StepRunnerServiceGetInfoProcedure is the fully-qualified name of the StepRunnerService's GetInfo
+	// RPC.
+	StepRunnerServiceGetInfoProcedure = "/akuity.io.kargo.plugin.v1alpha1.StepRunnerService/GetInfo"
+	// StepRunnerServiceRunStepProcedure is the fully-qualified name of the StepRunnerService's RunStep
+	// RPC.
+	StepRunnerServiceRunStepProcedure = "/akuity.io.kargo.plugin.v1alpha1.StepRunnerService/RunStep"
+	// StepRunnerServiceRunStreamedStepProcedure is the fully-qualified name of the StepRunnerService's
+	// RunStreamedStep RPC.
+	StepRunnerServiceRunStreamedStepProcedure = "/akuity.io.kargo.plugin.v1alpha1.StepRunnerService/RunStreamedStep"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	stepRunnerServiceServiceDescriptor               = v1alpha1.File_api_plugin_v1alpha1_step_runner_proto.Services().ByName("StepRunnerService")
+	stepRunnerServiceGetInfoMethodDescriptor         = stepRunnerServiceServiceDescriptor.Methods().ByName("GetInfo")
+	stepRunnerServiceRunStepMethodDescriptor         = stepRunnerServiceServiceDescriptor.Methods().ByName("RunStep")
+	stepRunnerServiceRunStreamedStepMethodDescriptor = stepRunnerServiceServiceDescriptor.Methods().ByName("RunStreamedStep")
+)
+
+// StepRunnerServiceClient is a client for the akuity.io.kargo.plugin.v1alpha1.StepRunnerService
+// service.
+type StepRunnerServiceClient interface {
+	// GetInfo returns information about the plugin, including the protocol
+	// version it implements and the steps it provides.
+	GetInfo(context.Context, *connect.Request[v1alpha1.GetInfoRequest]) (*connect.Response[v1alpha1.GetInfoResponse], error)
+	// RunStep runs a single promotion step. It is used for plugins that have
+	// the working directory of the promotion mounted.
+	RunStep(context.Context, *connect.Request[v1alpha1.RunStepRequest]) (*connect.Response[v1alpha1.RunStepResponse], error)
+	// RunStreamedStep runs a single promotion step. It is used for plugins that
+	// stream the working directory of the promotion. The caller first sends the
+	// request, followed by the chunks of a gzipped tarball of the working
+	// directory, and then closes its side of the stream. The plugin responds
+	// with the response, followed by the chunks of a gzipped tarball of the
+	// working directory after the step has run.
+	RunStreamedStep(context.Context) *connect.BidiStreamForClient[v1alpha1.RunStreamedStepRequest, v1alpha1.RunStreamedStepResponse]
+}
+
+// NewStepRunnerServiceClient constructs a client for the
+// akuity.io.kargo.plugin.v1alpha1.StepRunnerService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStepRunnerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StepRunnerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &stepRunnerServiceClient{
+		getInfo: connect.NewClient[v1alpha1.GetInfoRequest, v1alpha1.GetInfoResponse](
+			httpClient,
+			baseURL+StepRunnerServiceGetInfoProcedure,
+			connect.WithSchema(stepRunnerServiceGetInfoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		runStep: connect.NewClient[v1alpha1.RunStepRequest, v1alpha1.RunStepResponse](
+			httpClient,
+			baseURL+StepRunnerServiceRunStepProcedure,
+			connect.WithSchema(stepRunnerServiceRunStepMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		runStreamedStep: connect.NewClient[v1alpha1.RunStreamedStepRequest, v1alpha1.RunStreamedStepResponse](
+			httpClient,
+			baseURL+StepRunnerServiceRunStreamedStepProcedure,
+			connect.WithSchema(stepRunnerServiceRunStreamedStepMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// stepRunnerServiceClient implements StepRunnerServiceClient.
+type stepRunnerServiceClient struct {
+	getInfo         *connect.Client[v1alpha1.GetInfoRequest, v1alpha1.GetInfoResponse]
+	runStep         *connect.Client[v1alpha1.RunStepRequest, v1alpha1.RunStepResponse]
+	runStreamedStep *connect.Client[v1alpha1.RunStreamedStepRequest, v1alpha1.RunStreamedStepResponse]
+}
+
+// GetInfo calls akuity.io.kargo.plugin.v1alpha1.StepRunnerService.GetInfo.
+func (c *stepRunnerServiceClient) GetInfo(ctx context.Context, req *connect.Request[v1alpha1.GetInfoRequest]) (*connect.Response[v1alpha1.GetInfoResponse], error) {
+	return c.getInfo.CallUnary(ctx, req)
+}
+
+// RunStep calls akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStep.
+func (c *stepRunnerServiceClient) RunStep(ctx context.Context, req *connect.Request[v1alpha1.RunStepRequest]) (*connect.Response[v1alpha1.RunStepResponse], error) {
+	return c.runStep.CallUnary(ctx, req)
+}
+
+// RunStreamedStep calls akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStreamedStep.
+func (c *stepRunnerServiceClient) RunStreamedStep(ctx context.Context) *connect.BidiStreamForClient[v1alpha1.RunStreamedStepRequest, v1alpha1.RunStreamedStepResponse] {
+	return c.runStreamedStep.CallBidiStream(ctx)
+}
+
+// StepRunnerServiceHandler is an implementation of the
+// akuity.io.kargo.plugin.v1alpha1.StepRunnerService service.
+type StepRunnerServiceHandler interface {
+	// GetInfo returns information about the plugin, including the protocol
+	// version it implements and the steps it provides.
+	GetInfo(context.Context, *connect.Request[v1alpha1.GetInfoRequest]) (*connect.Response[v1alpha1.GetInfoResponse], error)
+	// RunStep runs a single promotion step. It is used for plugins that have
+	// the working directory of the promotion mounted.
+	RunStep(context.Context, *connect.Request[v1alpha1.RunStepRequest]) (*connect.Response[v1alpha1.RunStepResponse], error)
+	// RunStreamedStep runs a single promotion step. It is used for plugins that
+	// stream the working directory of the promotion. The caller first sends the
+	// request, followed by the chunks of a gzipped tarball of the working
+	// directory, and then closes its side of the stream. The plugin responds
+	// with the response, followed by the chunks of a gzipped tarball of the
+	// working directory after the step has run.
+	RunStreamedStep(context.Context, *connect.BidiStream[v1alpha1.RunStreamedStepRequest, v1alpha1.RunStreamedStepResponse]) error
+}
+
+// NewStepRunnerServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStepRunnerServiceHandler(svc StepRunnerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	stepRunnerServiceGetInfoHandler := connect.NewUnaryHandler(
+		StepRunnerServiceGetInfoProcedure,
+		svc.GetInfo,
+		connect.WithSchema(stepRunnerServiceGetInfoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	stepRunnerServiceRunStepHandler := connect.NewUnaryHandler(
+		StepRunnerServiceRunStepProcedure,
+		svc.RunStep,
+		connect.WithSchema(stepRunnerServiceRunStepMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	stepRunnerServiceRunStreamedStepHandler := connect.NewBidiStreamHandler(
+		StepRunnerServiceRunStreamedStepProcedure,
+		svc.RunStreamedStep,
+		connect.WithSchema(stepRunnerServiceRunStreamedStepMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/akuity.io.kargo.plugin.v1alpha1.StepRunnerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StepRunnerServiceGetInfoProcedure:
+			stepRunnerServiceGetInfoHandler.ServeHTTP(w, r)
+		case StepRunnerServiceRunStepProcedure:
+			stepRunnerServiceRunStepHandler.ServeHTTP(w, r)
+		case StepRunnerServiceRunStreamedStepProcedure:
+			stepRunnerServiceRunStreamedStepHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStepRunnerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStepRunnerServiceHandler struct{}
+
+func (UnimplementedStepRunnerServiceHandler) GetInfo(context.Context, *connect.Request[v1alpha1.GetInfoRequest]) (*connect.Response[v1alpha1.GetInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.plugin.v1alpha1.StepRunnerService.GetInfo is not implemented"))
+}
+
+func (UnimplementedStepRunnerServiceHandler) RunStep(context.Context, *connect.Request[v1alpha1.RunStepRequest]) (*connect.Response[v1alpha1.RunStepResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStep is not implemented"))
+}
+
+func (UnimplementedStepRunnerServiceHandler) RunStreamedStep(context.Context, *connect.BidiStream[v1alpha1.RunStreamedStepRequest, v1alpha1.RunStreamedStepResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStreamedStep is not implemented"))
+}
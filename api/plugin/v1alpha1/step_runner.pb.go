@@ -0,0 +1,1074 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: api/plugin/v1alpha1/step_runner.proto
+
+package pluginv1alpha1
+
+import (
+	v1alpha1 "github.com/akuity/kargo/api/v1alpha1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WorkDirMode specifies how a plugin accesses the working directory of a
+// promotion.
+type WorkDirMode int32
+
+const (
+	WorkDirMode_WORK_DIR_MODE_UNSPECIFIED WorkDirMode = 0
+	// WORK_DIR_MODE_MOUNTED indicates the plugin has the working directory of
+	// the promotion mounted at the same path as Kargo does and accesses it
+	// directly.
+	WorkDirMode_WORK_DIR_MODE_MOUNTED WorkDirMode = 1
+	// WORK_DIR_MODE_STREAMED indicates the plugin does not have access to the
+	// working directory of the promotion. Its contents are streamed to the
+	// plugin as a gzipped tarball along with the step and the plugin streams
+	// back the contents of the working directory after the step has run.
+	WorkDirMode_WORK_DIR_MODE_STREAMED WorkDirMode = 2
+)
+
+// Enum value maps for WorkDirMode.
+var (
+	WorkDirMode_name = map[int32]string{
+		0: "WORK_DIR_MODE_UNSPECIFIED",
+		1: "WORK_DIR_MODE_MOUNTED",
+		2: "WORK_DIR_MODE_STREAMED",
+	}
+	WorkDirMode_value = map[string]int32{
+		"WORK_DIR_MODE_UNSPECIFIED": 0,
+		"WORK_DIR_MODE_MOUNTED":     1,
+		"WORK_DIR_MODE_STREAMED":    2,
+	}
+)
+
+func (x WorkDirMode) Enum() *WorkDirMode {
+	p := new(WorkDirMode)
+	*p = x
+	return p
+}
+
+func (x WorkDirMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkDirMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_plugin_v1alpha1_step_runner_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkDirMode) Type() protoreflect.EnumType {
+	return &file_api_plugin_v1alpha1_step_runner_proto_enumTypes[0]
+}
+
+func (x WorkDirMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkDirMode.Descriptor instead.
+func (WorkDirMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{0}
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protocol_version is the version of the protocol implemented by the
+	// caller.
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetInfoRequest) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protocol_version is the version of the protocol implemented by the
+	// plugin.
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// steps is the list of names of the steps provided by the plugin.
+	Steps []string `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	// work_dir_mode specifies how the plugin accesses the working directory of
+	// a promotion. When unspecified, WORK_DIR_MODE_MOUNTED is assumed.
+	WorkDirMode WorkDirMode `protobuf:"varint,3,opt,name=work_dir_mode,json=workDirMode,proto3,enum=akuity.io.kargo.plugin.v1alpha1.WorkDirMode" json:"work_dir_mode,omitempty"`
+	// include_git_dirs indicates the plugin requires the .git directories and
+	// files of Git repositories in the working directory. Unless set, these are
+	// neither streamed to nor from the plugin. It only applies to plugins that
+	// stream the working directory.
+	IncludeGitDirs bool `protobuf:"varint,4,opt,name=include_git_dirs,json=includeGitDirs,proto3" json:"include_git_dirs,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInfoResponse) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetWorkDirMode() WorkDirMode {
+	if x != nil {
+		return x.WorkDirMode
+	}
+	return WorkDirMode_WORK_DIR_MODE_UNSPECIFIED
+}
+
+func (x *GetInfoResponse) GetIncludeGitDirs() bool {
+	if x != nil {
+		return x.IncludeGitDirs
+	}
+	return false
+}
+
+// StepContext mirrors the StepContext type of the
+// github.com/akuity/kargo/pkg/promotion package.
+type StepContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UiBaseUrl string `protobuf:"bytes,1,opt,name=ui_base_url,json=uiBaseUrl,proto3" json:"ui_base_url,omitempty"`
+	// work_dir is the path to the working directory of the promotion. Plugins
+	// that stream the working directory run the step in a directory of their
+	// own choosing instead.
+	WorkDir string `protobuf:"bytes,2,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	// shared_state is the JSON representation of the state shared between
+	// steps.
+	SharedState []byte `protobuf:"bytes,3,opt,name=shared_state,json=sharedState,proto3" json:"shared_state,omitempty"`
+	Alias       string `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	// config is the JSON representation of the configuration of the step.
+	Config          []byte                      `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	Project         string                      `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"`
+	Stage           string                      `protobuf:"bytes,7,opt,name=stage,proto3" json:"stage,omitempty"`
+	Promotion       string                      `protobuf:"bytes,8,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	FreightRequests []*v1alpha1.FreightRequest  `protobuf:"bytes,9,rep,name=freight_requests,json=freightRequests,proto3" json:"freight_requests,omitempty"`
+	Freight         *v1alpha1.FreightCollection `protobuf:"bytes,10,opt,name=freight,proto3" json:"freight,omitempty"`
+	// vars is the JSON representation of the evaluated variables available to
+	// the step.
+	Vars  []byte `protobuf:"bytes,11,opt,name=vars,proto3" json:"vars,omitempty"`
+	Actor string `protobuf:"bytes,12,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *StepContext) Reset() {
+	*x = StepContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepContext) ProtoMessage() {}
+
+func (x *StepContext) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepContext.ProtoReflect.Descriptor instead.
+func (*StepContext) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StepContext) GetUiBaseUrl() string {
+	if x != nil {
+		return x.UiBaseUrl
+	}
+	return ""
+}
+
+func (x *StepContext) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+func (x *StepContext) GetSharedState() []byte {
+	if x != nil {
+		return x.SharedState
+	}
+	return nil
+}
+
+func (x *StepContext) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *StepContext) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *StepContext) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *StepContext) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *StepContext) GetPromotion() string {
+	if x != nil {
+		return x.Promotion
+	}
+	return ""
+}
+
+func (x *StepContext) GetFreightRequests() []*v1alpha1.FreightRequest {
+	if x != nil {
+		return x.FreightRequests
+	}
+	return nil
+}
+
+func (x *StepContext) GetFreight() *v1alpha1.FreightCollection {
+	if x != nil {
+		return x.Freight
+	}
+	return nil
+}
+
+func (x *StepContext) GetVars() []byte {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *StepContext) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type RunStepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protocol_version is the version of the protocol implemented by the
+	// caller.
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// step is the name of the step to run.
+	Step    string       `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"`
+	Context *StepContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *RunStepRequest) Reset() {
+	*x = RunStepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunStepRequest) ProtoMessage() {}
+
+func (x *RunStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunStepRequest.ProtoReflect.Descriptor instead.
+func (*RunStepRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RunStepRequest) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *RunStepRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *RunStepRequest) GetContext() *StepContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type RunStreamedStepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//
+	//	*RunStreamedStepRequest_Request
+	//	*RunStreamedStepRequest_WorkDirChunk
+	Msg isRunStreamedStepRequest_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *RunStreamedStepRequest) Reset() {
+	*x = RunStreamedStepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunStreamedStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunStreamedStepRequest) ProtoMessage() {}
+
+func (x *RunStreamedStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunStreamedStepRequest.ProtoReflect.Descriptor instead.
+func (*RunStreamedStepRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *RunStreamedStepRequest) GetMsg() isRunStreamedStepRequest_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *RunStreamedStepRequest) GetRequest() *RunStepRequest {
+	if x, ok := x.GetMsg().(*RunStreamedStepRequest_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *RunStreamedStepRequest) GetWorkDirChunk() []byte {
+	if x, ok := x.GetMsg().(*RunStreamedStepRequest_WorkDirChunk); ok {
+		return x.WorkDirChunk
+	}
+	return nil
+}
+
+type isRunStreamedStepRequest_Msg interface {
+	isRunStreamedStepRequest_Msg()
+}
+
+type RunStreamedStepRequest_Request struct {
+	// request is sent in the first message of the stream.
+	Request *RunStepRequest `protobuf:"bytes,1,opt,name=request,proto3,oneof"`
+}
+
+type RunStreamedStepRequest_WorkDirChunk struct {
+	// work_dir_chunk is sent in every subsequent message of the stream. The
+	// chunks make up a gzipped tarball of the working directory of the
+	// promotion.
+	WorkDirChunk []byte `protobuf:"bytes,2,opt,name=work_dir_chunk,json=workDirChunk,proto3,oneof"`
+}
+
+func (*RunStreamedStepRequest_Request) isRunStreamedStepRequest_Msg() {}
+
+func (*RunStreamedStepRequest_WorkDirChunk) isRunStreamedStepRequest_Msg() {}
+
+// HealthCheck mirrors the Criteria type of the
+// github.com/akuity/kargo/pkg/health package.
+type HealthCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// input is the JSON representation of the input of the health check.
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheck) ProtoMessage() {}
+
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HealthCheck) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type RunStepResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status is the high-level outcome of the step. It is one of the values of
+	// the PromotionStepStatus type of the github.com/akuity/kargo/api/v1alpha1
+	// package.
+	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// output is the JSON representation of the output of the step.
+	Output      []byte       `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	HealthCheck *HealthCheck `protobuf:"bytes,4,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	// error is the error returned by the step, if any.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// terminal indicates the error returned by the step is terminal and the
+	// step must not be retried.
+	Terminal bool `protobuf:"varint,6,opt,name=terminal,proto3" json:"terminal,omitempty"`
+}
+
+func (x *RunStepResponse) Reset() {
+	*x = RunStepResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunStepResponse) ProtoMessage() {}
+
+func (x *RunStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunStepResponse.ProtoReflect.Descriptor instead.
+func (*RunStepResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RunStepResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RunStepResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RunStepResponse) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *RunStepResponse) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *RunStepResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RunStepResponse) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+type RunStreamedStepResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//
+	//	*RunStreamedStepResponse_Response
+	//	*RunStreamedStepResponse_WorkDirChunk
+	Msg isRunStreamedStepResponse_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *RunStreamedStepResponse) Reset() {
+	*x = RunStreamedStepResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunStreamedStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunStreamedStepResponse) ProtoMessage() {}
+
+func (x *RunStreamedStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1alpha1_step_runner_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunStreamedStepResponse.ProtoReflect.Descriptor instead.
+func (*RunStreamedStepResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *RunStreamedStepResponse) GetMsg() isRunStreamedStepResponse_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *RunStreamedStepResponse) GetResponse() *RunStepResponse {
+	if x, ok := x.GetMsg().(*RunStreamedStepResponse_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RunStreamedStepResponse) GetWorkDirChunk() []byte {
+	if x, ok := x.GetMsg().(*RunStreamedStepResponse_WorkDirChunk); ok {
+		return x.WorkDirChunk
+	}
+	return nil
+}
+
+type isRunStreamedStepResponse_Msg interface {
+	isRunStreamedStepResponse_Msg()
+}
+
+type RunStreamedStepResponse_Response struct {
+	// response is sent in the first message of the stream.
+	Response *RunStepResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type RunStreamedStepResponse_WorkDirChunk struct {
+	// work_dir_chunk is sent in every subsequent message of the stream. The
+	// chunks make up a gzipped tarball of the working directory of the
+	// promotion after the step has run.
+	WorkDirChunk []byte `protobuf:"bytes,2,opt,name=work_dir_chunk,json=workDirChunk,proto3,oneof"`
+}
+
+func (*RunStreamedStepResponse_Response) isRunStreamedStepResponse_Msg() {}
+
+func (*RunStreamedStepResponse_WorkDirChunk) isRunStreamedStepResponse_Msg() {}
+
+var File_api_plugin_v1alpha1_step_runner_proto protoreflect.FileDescriptor
+
+var file_api_plugin_v1alpha1_step_runner_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
+	0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x50, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x64, 0x69, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2c, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x67, 0x69, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x47, 0x69, 0x74,
+	0x44, 0x69, 0x72, 0x73, 0x22, 0xc5, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x75, 0x69, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x69, 0x42, 0x61, 0x73,
+	0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f,
+	0x0a, 0x10, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
+	0x67, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f,
+	0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x51, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
+	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x66, 0x72, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x97, 0x01, 0x0a,
+	0x0e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x46,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4b, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69,
+	0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x37, 0x0a,
+	0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x4f, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x6b, 0x75,
+	0x69, 0x74, 0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x98, 0x01, 0x0a, 0x17, 0x52, 0x75, 0x6e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x69,
+	0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x05, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x2a, 0x63, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x44, 0x49, 0x52, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x44, 0x49, 0x52, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x57,
+	0x4f, 0x52, 0x4b, 0x5f, 0x44, 0x49, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x32, 0xfa, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x6b, 0x75, 0x69,
+	0x74, 0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x07, 0x52,
+	0x75, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x2f, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
+	0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
+	0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x65,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x52, 0x75,
+	0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x12, 0x37, 0x2e,
+	0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x75, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
+	0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x30, 0x01, 0x42, 0x93, 0x02, 0x0a, 0x23, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75,
+	0x69, 0x74, 0x79, 0x2e, 0x69, 0x6f, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0f, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6b, 0x75, 0x69,
+	0x74, 0x79, 0x2f, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x04, 0x41, 0x49,
+	0x4b, 0x50, 0xaa, 0x02, 0x1f, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6f, 0x2e, 0x4b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x1f, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x5c, 0x49, 0x6f,
+	0x5c, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x5c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5c, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x2b, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x5c,
+	0x49, 0x6f, 0x5c, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x5c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5c,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x23, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x3a, 0x3a, 0x49,
+	0x6f, 0x3a, 0x3a, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x3a, 0x3a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_api_plugin_v1alpha1_step_runner_proto_rawDescOnce sync.Once
+	file_api_plugin_v1alpha1_step_runner_proto_rawDescData = file_api_plugin_v1alpha1_step_runner_proto_rawDesc
+)
+
+func file_api_plugin_v1alpha1_step_runner_proto_rawDescGZIP() []byte {
+	file_api_plugin_v1alpha1_step_runner_proto_rawDescOnce.Do(func() {
+		file_api_plugin_v1alpha1_step_runner_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_plugin_v1alpha1_step_runner_proto_rawDescData)
+	})
+	return file_api_plugin_v1alpha1_step_runner_proto_rawDescData
+}
+
+var file_api_plugin_v1alpha1_step_runner_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_plugin_v1alpha1_step_runner_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_plugin_v1alpha1_step_runner_proto_goTypes = []interface{}{
+	(WorkDirMode)(0),                   // 0: akuity.io.kargo.plugin.v1alpha1.WorkDirMode
+	(*GetInfoRequest)(nil),             // 1: akuity.io.kargo.plugin.v1alpha1.GetInfoRequest
+	(*GetInfoResponse)(nil),            // 2: akuity.io.kargo.plugin.v1alpha1.GetInfoResponse
+	(*StepContext)(nil),                // 3: akuity.io.kargo.plugin.v1alpha1.StepContext
+	(*RunStepRequest)(nil),             // 4: akuity.io.kargo.plugin.v1alpha1.RunStepRequest
+	(*RunStreamedStepRequest)(nil),     // 5: akuity.io.kargo.plugin.v1alpha1.RunStreamedStepRequest
+	(*HealthCheck)(nil),                // 6: akuity.io.kargo.plugin.v1alpha1.HealthCheck
+	(*RunStepResponse)(nil),            // 7: akuity.io.kargo.plugin.v1alpha1.RunStepResponse
+	(*RunStreamedStepResponse)(nil),    // 8: akuity.io.kargo.plugin.v1alpha1.RunStreamedStepResponse
+	(*v1alpha1.FreightRequest)(nil),    // 9: github.com.akuity.kargo.api.v1alpha1.FreightRequest
+	(*v1alpha1.FreightCollection)(nil), // 10: github.com.akuity.kargo.api.v1alpha1.FreightCollection
+}
+var file_api_plugin_v1alpha1_step_runner_proto_depIdxs = []int32{
+	0,  // 0: akuity.io.kargo.plugin.v1alpha1.GetInfoResponse.work_dir_mode:type_name -> akuity.io.kargo.plugin.v1alpha1.WorkDirMode
+	9,  // 1: akuity.io.kargo.plugin.v1alpha1.StepContext.freight_requests:type_name -> github.com.akuity.kargo.api.v1alpha1.FreightRequest
+	10, // 2: akuity.io.kargo.plugin.v1alpha1.StepContext.freight:type_name -> github.com.akuity.kargo.api.v1alpha1.FreightCollection
+	3,  // 3: akuity.io.kargo.plugin.v1alpha1.RunStepRequest.context:type_name -> akuity.io.kargo.plugin.v1alpha1.StepContext
+	4,  // 4: akuity.io.kargo.plugin.v1alpha1.RunStreamedStepRequest.request:type_name -> akuity.io.kargo.plugin.v1alpha1.RunStepRequest
+	6,  // 5: akuity.io.kargo.plugin.v1alpha1.RunStepResponse.health_check:type_name -> akuity.io.kargo.plugin.v1alpha1.HealthCheck
+	7,  // 6: akuity.io.kargo.plugin.v1alpha1.RunStreamedStepResponse.response:type_name -> akuity.io.kargo.plugin.v1alpha1.RunStepResponse
+	1,  // 7: akuity.io.kargo.plugin.v1alpha1.StepRunnerService.GetInfo:input_type -> akuity.io.kargo.plugin.v1alpha1.GetInfoRequest
+	4,  // 8: akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStep:input_type -> akuity.io.kargo.plugin.v1alpha1.RunStepRequest
+	5,  // 9: akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStreamedStep:input_type -> akuity.io.kargo.plugin.v1alpha1.RunStreamedStepRequest
+	2,  // 10: akuity.io.kargo.plugin.v1alpha1.StepRunnerService.GetInfo:output_type -> akuity.io.kargo.plugin.v1alpha1.GetInfoResponse
+	7,  // 11: akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStep:output_type -> akuity.io.kargo.plugin.v1alpha1.RunStepResponse
+	8,  // 12: akuity.io.kargo.plugin.v1alpha1.StepRunnerService.RunStreamedStep:output_type -> akuity.io.kargo.plugin.v1alpha1.RunStreamedStepResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_plugin_v1alpha1_step_runner_proto_init() }
+func file_api_plugin_v1alpha1_step_runner_proto_init() {
+	if File_api_plugin_v1alpha1_step_runner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunStepRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunStreamedStepRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunStepResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1alpha1_step_runner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunStreamedStepResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_plugin_v1alpha1_step_runner_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*RunStreamedStepRequest_Request)(nil),
+		(*RunStreamedStepRequest_WorkDirChunk)(nil),
+	}
+	file_api_plugin_v1alpha1_step_runner_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*RunStreamedStepResponse_Response)(nil),
+		(*RunStreamedStepResponse_WorkDirChunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_plugin_v1alpha1_step_runner_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_plugin_v1alpha1_step_runner_proto_goTypes,
+		DependencyIndexes: file_api_plugin_v1alpha1_step_runner_proto_depIdxs,
+		EnumInfos:         file_api_plugin_v1alpha1_step_runner_proto_enumTypes,
+		MessageInfos:      file_api_plugin_v1alpha1_step_runner_proto_msgTypes,
+	}.Build()
+	File_api_plugin_v1alpha1_step_runner_proto = out.File
+	file_api_plugin_v1alpha1_step_runner_proto_rawDesc = nil
+	file_api_plugin_v1alpha1_step_runner_proto_goTypes = nil
+	file_api_plugin_v1alpha1_step_runner_proto_depIdxs = nil
+}
@@ -85,6 +85,12 @@ const (
 	// KargoServiceAbortPromotionProcedure is the fully-qualified name of the KargoService's
 	// AbortPromotion RPC.
 	KargoServiceAbortPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AbortPromotion"
+	// KargoServiceUndoPromotionProcedure is the fully-qualified name of the KargoService's
+	// UndoPromotion RPC.
+	KargoServiceUndoPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UndoPromotion"
+	// KargoServiceRetryPromotionProcedure is the fully-qualified name of the KargoService's
+	// RetryPromotion RPC.
+	KargoServiceRetryPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RetryPromotion"
 	// KargoServiceDeleteProjectProcedure is the fully-qualified name of the KargoService's
 	// DeleteProject RPC.
 	KargoServiceDeleteProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteProject"
@@ -119,6 +125,9 @@ const (
 	// KargoServiceQueryFreightProcedure is the fully-qualified name of the KargoService's QueryFreight
 	// RPC.
 	KargoServiceQueryFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight"
+	// KargoServiceRejectFreightProcedure is the fully-qualified name of the KargoService's
+	// RejectFreight RPC.
+	KargoServiceRejectFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RejectFreight"
 	// KargoServiceUpdateFreightAliasProcedure is the fully-qualified name of the KargoService's
 	// UpdateFreightAlias RPC.
 	KargoServiceUpdateFreightAliasProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UpdateFreightAlias"
@@ -246,6 +255,8 @@ var (
 	kargoServiceGetPromotionMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("GetPromotion")
 	kargoServiceWatchPromotionMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotion")
 	kargoServiceAbortPromotionMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("AbortPromotion")
+	kargoServiceUndoPromotionMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("UndoPromotion")
+	kargoServiceRetryPromotionMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("RetryPromotion")
 	kargoServiceDeleteProjectMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("DeleteProject")
 	kargoServiceGetProjectMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("GetProject")
 	kargoServiceListProjectsMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("ListProjects")
@@ -258,6 +269,7 @@ var (
 	kargoServicePromoteToStageMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("PromoteToStage")
 	kargoServicePromoteDownstreamMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("PromoteDownstream")
 	kargoServiceQueryFreightMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("QueryFreight")
+	kargoServiceRejectFreightMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("RejectFreight")
 	kargoServiceUpdateFreightAliasMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("UpdateFreightAlias")
 	kargoServiceReverifyMethodDescriptor                      = kargoServiceServiceDescriptor.Methods().ByName("Reverify")
 	kargoServiceAbortVerificationMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("AbortVerification")
@@ -320,6 +332,8 @@ type KargoServiceClient interface {
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	UndoPromotion(context.Context, *connect.Request[v1alpha1.UndoPromotionRequest]) (*connect.Response[v1alpha1.UndoPromotionResponse], error)
+	RetryPromotion(context.Context, *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	GetProject(context.Context, *connect.Request[v1alpha1.GetProjectRequest]) (*connect.Response[v1alpha1.GetProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
@@ -332,6 +346,7 @@ type KargoServiceClient interface {
 	PromoteToStage(context.Context, *connect.Request[v1alpha1.PromoteToStageRequest]) (*connect.Response[v1alpha1.PromoteToStageResponse], error)
 	PromoteDownstream(context.Context, *connect.Request[v1alpha1.PromoteDownstreamRequest]) (*connect.Response[v1alpha1.PromoteDownstreamResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
+	RejectFreight(context.Context, *connect.Request[v1alpha1.RejectFreightRequest]) (*connect.Response[v1alpha1.RejectFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
 	Reverify(context.Context, *connect.Request[v1alpha1.ReverifyRequest]) (*connect.Response[v1alpha1.ReverifyResponse], error)
 	AbortVerification(context.Context, *connect.Request[v1alpha1.AbortVerificationRequest]) (*connect.Response[v1alpha1.AbortVerificationResponse], error)
@@ -495,6 +510,18 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		undoPromotion: connect.NewClient[v1alpha1.UndoPromotionRequest, v1alpha1.UndoPromotionResponse](
+			httpClient,
+			baseURL+KargoServiceUndoPromotionProcedure,
+			connect.WithSchema(kargoServiceUndoPromotionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		retryPromotion: connect.NewClient[v1alpha1.RetryPromotionRequest, v1alpha1.RetryPromotionResponse](
+			httpClient,
+			baseURL+KargoServiceRetryPromotionProcedure,
+			connect.WithSchema(kargoServiceRetryPromotionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteProject: connect.NewClient[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteProjectProcedure,
@@ -567,6 +594,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceQueryFreightMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		rejectFreight: connect.NewClient[v1alpha1.RejectFreightRequest, v1alpha1.RejectFreightResponse](
+			httpClient,
+			baseURL+KargoServiceRejectFreightProcedure,
+			connect.WithSchema(kargoServiceRejectFreightMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		updateFreightAlias: connect.NewClient[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse](
 			httpClient,
 			baseURL+KargoServiceUpdateFreightAliasProcedure,
@@ -813,6 +846,8 @@ type kargoServiceClient struct {
 	getPromotion                  *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
 	watchPromotion                *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
 	abortPromotion                *connect.Client[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse]
+	undoPromotion                 *connect.Client[v1alpha1.UndoPromotionRequest, v1alpha1.UndoPromotionResponse]
+	retryPromotion                *connect.Client[v1alpha1.RetryPromotionRequest, v1alpha1.RetryPromotionResponse]
 	deleteProject                 *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
 	getProject                    *connect.Client[v1alpha1.GetProjectRequest, v1alpha1.GetProjectResponse]
 	listProjects                  *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
@@ -825,6 +860,7 @@ type kargoServiceClient struct {
 	promoteToStage                *connect.Client[v1alpha1.PromoteToStageRequest, v1alpha1.PromoteToStageResponse]
 	promoteDownstream             *connect.Client[v1alpha1.PromoteDownstreamRequest, v1alpha1.PromoteDownstreamResponse]
 	queryFreight                  *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
+	rejectFreight                 *connect.Client[v1alpha1.RejectFreightRequest, v1alpha1.RejectFreightResponse]
 	updateFreightAlias            *connect.Client[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse]
 	reverify                      *connect.Client[v1alpha1.ReverifyRequest, v1alpha1.ReverifyResponse]
 	abortVerification             *connect.Client[v1alpha1.AbortVerificationRequest, v1alpha1.AbortVerificationResponse]
@@ -960,6 +996,16 @@ func (c *kargoServiceClient) AbortPromotion(ctx context.Context, req *connect.Re
 	return c.abortPromotion.CallUnary(ctx, req)
 }
 
+// UndoPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.UndoPromotion.
+func (c *kargoServiceClient) UndoPromotion(ctx context.Context, req *connect.Request[v1alpha1.UndoPromotionRequest]) (*connect.Response[v1alpha1.UndoPromotionResponse], error) {
+	return c.undoPromotion.CallUnary(ctx, req)
+}
+
+// RetryPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.RetryPromotion.
+func (c *kargoServiceClient) RetryPromotion(ctx context.Context, req *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error) {
+	return c.retryPromotion.CallUnary(ctx, req)
+}
+
 // DeleteProject calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject.
 func (c *kargoServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return c.deleteProject.CallUnary(ctx, req)
@@ -1020,6 +1066,11 @@ func (c *kargoServiceClient) QueryFreight(ctx context.Context, req *connect.Requ
 	return c.queryFreight.CallUnary(ctx, req)
 }
 
+// RejectFreight calls akuity.io.kargo.service.v1alpha1.KargoService.RejectFreight.
+func (c *kargoServiceClient) RejectFreight(ctx context.Context, req *connect.Request[v1alpha1.RejectFreightRequest]) (*connect.Response[v1alpha1.RejectFreightResponse], error) {
+	return c.rejectFreight.CallUnary(ctx, req)
+}
+
 // UpdateFreightAlias calls akuity.io.kargo.service.v1alpha1.KargoService.UpdateFreightAlias.
 func (c *kargoServiceClient) UpdateFreightAlias(ctx context.Context, req *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error) {
 	return c.updateFreightAlias.CallUnary(ctx, req)
@@ -1235,6 +1286,8 @@ type KargoServiceHandler interface {
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	UndoPromotion(context.Context, *connect.Request[v1alpha1.UndoPromotionRequest]) (*connect.Response[v1alpha1.UndoPromotionResponse], error)
+	RetryPromotion(context.Context, *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	GetProject(context.Context, *connect.Request[v1alpha1.GetProjectRequest]) (*connect.Response[v1alpha1.GetProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
@@ -1247,6 +1300,7 @@ type KargoServiceHandler interface {
 	PromoteToStage(context.Context, *connect.Request[v1alpha1.PromoteToStageRequest]) (*connect.Response[v1alpha1.PromoteToStageResponse], error)
 	PromoteDownstream(context.Context, *connect.Request[v1alpha1.PromoteDownstreamRequest]) (*connect.Response[v1alpha1.PromoteDownstreamResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
+	RejectFreight(context.Context, *connect.Request[v1alpha1.RejectFreightRequest]) (*connect.Response[v1alpha1.RejectFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
 	Reverify(context.Context, *connect.Request[v1alpha1.ReverifyRequest]) (*connect.Response[v1alpha1.ReverifyResponse], error)
 	AbortVerification(context.Context, *connect.Request[v1alpha1.AbortVerificationRequest]) (*connect.Response[v1alpha1.AbortVerificationResponse], error)
@@ -1406,6 +1460,18 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceUndoPromotionHandler := connect.NewUnaryHandler(
+		KargoServiceUndoPromotionProcedure,
+		svc.UndoPromotion,
+		connect.WithSchema(kargoServiceUndoPromotionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceRetryPromotionHandler := connect.NewUnaryHandler(
+		KargoServiceRetryPromotionProcedure,
+		svc.RetryPromotion,
+		connect.WithSchema(kargoServiceRetryPromotionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceDeleteProjectHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteProjectProcedure,
 		svc.DeleteProject,
@@ -1478,6 +1544,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceQueryFreightMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceRejectFreightHandler := connect.NewUnaryHandler(
+		KargoServiceRejectFreightProcedure,
+		svc.RejectFreight,
+		connect.WithSchema(kargoServiceRejectFreightMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceUpdateFreightAliasHandler := connect.NewUnaryHandler(
 		KargoServiceUpdateFreightAliasProcedure,
 		svc.UpdateFreightAlias,
@@ -1740,6 +1812,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceAbortPromotionProcedure:
 			kargoServiceAbortPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceUndoPromotionProcedure:
+			kargoServiceUndoPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceRetryPromotionProcedure:
+			kargoServiceRetryPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteProjectProcedure:
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case KargoServiceGetProjectProcedure:
@@ -1764,6 +1840,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServicePromoteDownstreamHandler.ServeHTTP(w, r)
 		case KargoServiceQueryFreightProcedure:
 			kargoServiceQueryFreightHandler.ServeHTTP(w, r)
+		case KargoServiceRejectFreightProcedure:
+			kargoServiceRejectFreightHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateFreightAliasProcedure:
 			kargoServiceUpdateFreightAliasHandler.ServeHTTP(w, r)
 		case KargoServiceReverifyProcedure:
@@ -1923,6 +2001,14 @@ func (UnimplementedKargoServiceHandler) AbortPromotion(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) UndoPromotion(context.Context, *connect.Request[v1alpha1.UndoPromotionRequest]) (*connect.Response[v1alpha1.UndoPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UndoPromotion is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) RetryPromotion(context.Context, *connect.Request[v1alpha1.RetryPromotionRequest]) (*connect.Response[v1alpha1.RetryPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RetryPromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject is not implemented"))
 }
@@ -1971,6 +2057,10 @@ func (UnimplementedKargoServiceHandler) QueryFreight(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) RejectFreight(context.Context, *connect.Request[v1alpha1.RejectFreightRequest]) (*connect.Response[v1alpha1.RejectFreightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RejectFreight is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UpdateFreightAlias is not implemented"))
 }
@@ -39,6 +39,29 @@ const (
 	// resource to indicate that it is related to a specific promotion.
 	AnnotationKeyPromotion = "kargo.akuity.io/promotion"
 
+	// AnnotationKeyRollback is an annotation key that is set on a Promotion
+	// created by the Kargo controller to automatically roll back a Stage after
+	// verification of its current Freight failed. The value of the annotation
+	// is the ID of the failed verification.
+	AnnotationKeyRollback = "kargo.akuity.io/rollback"
+
+	// AnnotationKeyRollbackTarget is an annotation key that is set on a
+	// Promotion created by the Kargo controller to automatically roll back a
+	// Stage. The value of the annotation is the ID of the FreightCollection the
+	// Stage is being rolled back to.
+	AnnotationKeyRollbackTarget = "kargo.akuity.io/rollback-target"
+
+	// AnnotationKeyUndo is an annotation key that is set on a Promotion created
+	// to undo the changes made by a previous Promotion. The value of the
+	// annotation is the name of the Promotion being undone.
+	AnnotationKeyUndo = "kargo.akuity.io/undo"
+
+	// AnnotationKeyRetry is an annotation key that is set on a Promotion created
+	// to resume a previous Promotion that failed or errored from the step at
+	// which it stopped. The value of the annotation is the name of the
+	// Promotion being retried.
+	AnnotationKeyRetry = "kargo.akuity.io/retry"
+
 	// AnnotationKeyArgoCDContext is an annotation key that is set on a Stage
 	// to reference the last ArgoCD Applications that were part of a Promotion.
 	AnnotationKeyArgoCDContext = "kargo.akuity.io/argocd-context"
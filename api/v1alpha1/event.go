@@ -11,12 +11,15 @@ const (
 	AnnotationKeyEventFreightCommits         = "event.kargo.akuity.io/freight-commits"
 	AnnotationKeyEventFreightImages          = "event.kargo.akuity.io/freight-images"
 	AnnotationKeyEventFreightCharts          = "event.kargo.akuity.io/freight-charts"
+	AnnotationKeyEventFreightOCIArtifacts    = "event.kargo.akuity.io/freight-oci-artifacts"
+	AnnotationKeyEventFreightReleases        = "event.kargo.akuity.io/freight-releases"
 	AnnotationKeyEventStageName              = "event.kargo.akuity.io/stage-name"
 	AnnotationKeyEventAnalysisRunName        = "event.kargo.akuity.io/analysis-run-name"
 	AnnotationKeyEventVerificationPending    = "event.kargo.akuity.io/verification-pending"
 	AnnotationKeyEventVerificationStartTime  = "event.kargo.akuity.io/verification-start-time"
 	AnnotationKeyEventVerificationFinishTime = "event.kargo.akuity.io/verification-finish-time"
 	AnnotationKeyEventApplications           = "event.kargo.akuity.io/applications"
+	AnnotationKeyEventRejectionReason        = "event.kargo.akuity.io/rejection-reason"
 )
 
 const (
@@ -25,7 +28,9 @@ const (
 	EventReasonPromotionFailed                 = "PromotionFailed"
 	EventReasonPromotionErrored                = "PromotionErrored"
 	EventReasonPromotionAborted                = "PromotionAborted"
+	EventReasonPromotionRollback               = "PromotionRollback"
 	EventReasonFreightApproved                 = "FreightApproved"
+	EventReasonFreightRejected                 = "FreightRejected"
 	EventReasonFreightVerificationSucceeded    = "FreightVerificationSucceeded"
 	EventReasonFreightVerificationFailed       = "FreightVerificationFailed"
 	EventReasonFreightVerificationErrored      = "FreightVerificationErrored"
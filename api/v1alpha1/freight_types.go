@@ -40,6 +40,10 @@ type Freight struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,4,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,5,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,10,rep,name=ociArtifacts"`
+	// Releases describes specific versions from release feeds.
+	Releases []Release `json:"releases,omitempty" protobuf:"bytes,11,rep,name=releases"`
 	// Status describes the current status of this Freight.
 	Status FreightStatus `json:"status,omitempty" protobuf:"bytes,6,opt,name=status"`
 }
@@ -67,6 +71,12 @@ type GitCommit struct {
 	Author string `json:"author,omitempty" protobuf:"bytes,7,opt,name=author"`
 	// Committer is the person who committed the commit.
 	Committer string `json:"committer,omitempty" protobuf:"bytes,8,opt,name=committer"`
+	// PullRequestNumber is the number of the pull request from which this
+	// commit was selected, if any.
+	PullRequestNumber int64 `json:"pullRequestNumber,omitempty" protobuf:"varint,9,opt,name=pullRequestNumber"`
+	// PullRequestURL is the URL of the pull request from which this commit was
+	// selected, if any.
+	PullRequestURL string `json:"pullRequestURL,omitempty" protobuf:"bytes,10,opt,name=pullRequestURL"`
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -84,7 +94,9 @@ func (g *GitCommit) DeepEquals(other *GitCommit) bool {
 		g.Tag == other.Tag &&
 		g.Message == other.Message &&
 		g.Author == other.Author &&
-		g.Committer == other.Committer
+		g.Committer == other.Committer &&
+		g.PullRequestNumber == other.PullRequestNumber &&
+		g.PullRequestURL == other.PullRequestURL
 }
 
 // Equals returns a bool indicating whether two GitCommits are equivalent.
@@ -111,6 +123,14 @@ type FreightStatus struct {
 	// might wish to promote a piece of Freight to a given Stage without
 	// transiting the entire pipeline.
 	ApprovedFor map[string]ApprovedStage `json:"approvedFor,omitempty" protobuf:"bytes,2,rep,name=approvedFor" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Rejected, if set, indicates that this Freight has been rejected by a user
+	// for all Stages in the Project. Rejected Freight is never available for
+	// promotion.
+	Rejected *RejectedFreight `json:"rejected,omitempty" protobuf:"bytes,5,opt,name=rejected"`
+	// RejectedFor describes the Stages for which this Freight has been rejected
+	// by a user. Freight is never available for promotion to a Stage for which
+	// it has been rejected.
+	RejectedFor map[string]RejectedFreight `json:"rejectedFor,omitempty" protobuf:"bytes,6,rep,name=rejectedFor" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Metadata is a map of arbitrary metadata associated with the Freight.
 	// This is useful for storing additional information about the Freight
 	// or Promotion that can be shared across steps or stages.
@@ -144,6 +164,51 @@ func (f *Freight) IsApprovedFor(stage string) bool {
 	return approved
 }
 
+// IsRejected returns whether the Freight has been rejected for all Stages.
+func (f *Freight) IsRejected() bool {
+	return f.Status.Rejected != nil
+}
+
+// IsRejectedFor returns whether the Freight has been rejected for the
+// specified Stage, either explicitly or because it has been rejected for all
+// Stages.
+func (f *Freight) IsRejectedFor(stage string) bool {
+	if f.IsRejected() {
+		return true
+	}
+	_, rejected := f.Status.RejectedFor[stage]
+	return rejected
+}
+
+// IsApprovedBy returns whether the specified approver has approved the Freight
+// for the specified Stage.
+func (f *Freight) IsApprovedBy(stage, approver string) bool {
+	record, approved := f.Status.ApprovedFor[stage]
+	if !approved {
+		return false
+	}
+	for _, approval := range record.Approvals {
+		if approval.Approver == approver {
+			return true
+		}
+	}
+	return false
+}
+
+// GetApprovalCount returns the number of distinct users who have approved the
+// Freight for the specified Stage.
+func (f *Freight) GetApprovalCount(stage string) int {
+	record, approved := f.Status.ApprovedFor[stage]
+	if !approved {
+		return 0
+	}
+	approvers := make(map[string]struct{}, len(record.Approvals))
+	for _, approval := range record.Approvals {
+		approvers[approval.Approver] = struct{}{}
+	}
+	return len(approvers)
+}
+
 // GetLongestSoak returns the longest soak time for the Freight in the specified
 // Stage if it's been verified in that Stage. If it has not, zero will be
 // returned instead. If the Freight is currently in use by the specified Stage,
@@ -232,6 +297,53 @@ func (f *FreightStatus) AddApprovedStage(stage string, approvedAt time.Time) {
 	}
 }
 
+// AddApproval updates the Freight status to reflect that the specified
+// approver has approved the Freight for the specified Stage. If the approver
+// has already approved the Freight for the Stage, this is a no-op.
+func (f *FreightStatus) AddApproval(stage, approver string, approvedAt time.Time) {
+	record, approved := f.ApprovedFor[stage]
+	if !approved {
+		record = ApprovedStage{ApprovedAt: &metav1.Time{Time: approvedAt}}
+	}
+	for _, approval := range record.Approvals {
+		if approval.Approver == approver {
+			return
+		}
+	}
+	record.Approvals = append(record.Approvals, Approval{
+		Approver:   approver,
+		ApprovedAt: &metav1.Time{Time: approvedAt},
+	})
+	if f.ApprovedFor == nil {
+		f.ApprovedFor = make(map[string]ApprovedStage)
+	}
+	f.ApprovedFor[stage] = record
+}
+
+// AddRejection updates the Freight status to reflect that the Freight has
+// been rejected for the specified Stage. If no Stage is specified, the Freight
+// is rejected for all Stages.
+func (f *FreightStatus) AddRejection(
+	stage string,
+	reason string,
+	rejectedBy string,
+	rejectedAt time.Time,
+) {
+	record := RejectedFreight{
+		Reason:     reason,
+		RejectedBy: rejectedBy,
+		RejectedAt: &metav1.Time{Time: rejectedAt},
+	}
+	if stage == "" {
+		f.Rejected = &record
+		return
+	}
+	if f.RejectedFor == nil {
+		f.RejectedFor = make(map[string]RejectedFreight)
+	}
+	f.RejectedFor[stage] = record
+}
+
 // UpsertMetadata inserts or updates the given key in Freight status Metadata
 func (f *FreightStatus) UpsertMetadata(key string, data any) error {
 	if len(f.Metadata) == 0 {
@@ -291,6 +403,28 @@ type VerifiedStage struct {
 type ApprovedStage struct {
 	// ApprovedAt is the time at which the Freight was approved for the Stage.
 	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,1,opt,name=approvedAt"`
+	// Approvals records the individual approvals the Freight has received for
+	// the Stage. When the Stage has an ApprovalPolicy, the number of distinct
+	// approvers recorded here determines whether the policy has been satisfied.
+	Approvals []Approval `json:"approvals,omitempty" protobuf:"bytes,2,rep,name=approvals"`
+}
+
+// RejectedFreight describes a user's rejection of Freight.
+type RejectedFreight struct {
+	// Reason is a human-readable explanation of why the Freight was rejected.
+	Reason string `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason"`
+	// RejectedBy identifies the user who rejected the Freight.
+	RejectedBy string `json:"rejectedBy,omitempty" protobuf:"bytes,2,opt,name=rejectedBy"`
+	// RejectedAt is the time at which the Freight was rejected.
+	RejectedAt *metav1.Time `json:"rejectedAt,omitempty" protobuf:"bytes,3,opt,name=rejectedAt"`
+}
+
+// Approval describes a single user's approval of Freight for a Stage.
+type Approval struct {
+	// Approver identifies the user who approved the Freight.
+	Approver string `json:"approver,omitempty" protobuf:"bytes,1,opt,name=approver"`
+	// ApprovedAt is the time at which the user approved the Freight.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,2,opt,name=approvedAt"`
 }
 
 // +kubebuilder:object:root=true
@@ -124,6 +124,34 @@ func TestGitCommitDeepEquals(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "pull request numbers differ",
+			a: &GitCommit{
+				RepoURL:           "fake-url",
+				ID:                "fake-commit-id",
+				PullRequestNumber: 1,
+			},
+			b: &GitCommit{
+				RepoURL:           "fake-url",
+				ID:                "fake-commit-id",
+				PullRequestNumber: 2,
+			},
+			expectedResult: false,
+		},
+		{
+			name: "pull request URLs differ",
+			a: &GitCommit{
+				RepoURL:        "fake-url",
+				ID:             "fake-commit-id",
+				PullRequestURL: "foo",
+			},
+			b: &GitCommit{
+				RepoURL:        "fake-url",
+				ID:             "fake-commit-id",
+				PullRequestURL: "bar",
+			},
+			expectedResult: false,
+		},
 		{
 			name: "perfect match",
 			a: &GitCommit{
@@ -452,6 +480,70 @@ func TestFreightStatus_AddApprovedStage(t *testing.T) {
 	})
 }
 
+func TestFreightStatus_AddApproval(t *testing.T) {
+	const testStage = "fake-stage"
+	now := time.Now()
+	t.Run("not already approved", func(t *testing.T) {
+		status := FreightStatus{}
+		status.AddApproval(testStage, "alice", now)
+		record, approved := status.ApprovedFor[testStage]
+		require.True(t, approved)
+		require.Equal(t, now, record.ApprovedAt.Time)
+		require.Len(t, record.Approvals, 1)
+		require.Equal(t, "alice", record.Approvals[0].Approver)
+		require.Equal(t, now, record.Approvals[0].ApprovedAt.Time)
+	})
+	t.Run("approved by another user", func(t *testing.T) {
+		oldTime := now.Add(-time.Hour)
+		status := FreightStatus{}
+		status.AddApproval(testStage, "alice", oldTime)
+		status.AddApproval(testStage, "bob", now)
+		record := status.ApprovedFor[testStage]
+		require.Equal(t, oldTime, record.ApprovedAt.Time)
+		require.Len(t, record.Approvals, 2)
+		f := Freight{Status: status}
+		require.Equal(t, 2, f.GetApprovalCount(testStage))
+		require.True(t, f.IsApprovedBy(testStage, "bob"))
+	})
+	t.Run("already approved by same user", func(t *testing.T) {
+		status := FreightStatus{}
+		status.AddApproval(testStage, "alice", now)
+		status.AddApproval(testStage, "alice", now.Add(time.Hour))
+		record := status.ApprovedFor[testStage]
+		require.Len(t, record.Approvals, 1)
+		require.Equal(t, now, record.Approvals[0].ApprovedAt.Time)
+	})
+}
+
+func TestFreightStatus_AddRejection(t *testing.T) {
+	const testStage = "fake-stage"
+	now := time.Now()
+	t.Run("rejected for a stage", func(t *testing.T) {
+		status := FreightStatus{}
+		status.AddRejection(testStage, "broken", "alice", now)
+		require.Nil(t, status.Rejected)
+		record, rejected := status.RejectedFor[testStage]
+		require.True(t, rejected)
+		require.Equal(t, "broken", record.Reason)
+		require.Equal(t, "alice", record.RejectedBy)
+		require.Equal(t, now, record.RejectedAt.Time)
+		f := Freight{Status: status}
+		require.False(t, f.IsRejected())
+		require.True(t, f.IsRejectedFor(testStage))
+		require.False(t, f.IsRejectedFor("another-stage"))
+	})
+	t.Run("rejected for all stages", func(t *testing.T) {
+		status := FreightStatus{}
+		status.AddRejection("", "broken", "alice", now)
+		require.Empty(t, status.RejectedFor)
+		require.NotNil(t, status.Rejected)
+		require.Equal(t, "broken", status.Rejected.Reason)
+		f := Freight{Status: status}
+		require.True(t, f.IsRejected())
+		require.True(t, f.IsRejectedFor(testStage))
+	})
+}
+
 func TestFreightStatus_UpsertAndGetMetadata_Integration(t *testing.T) {
 	tests := []struct {
 		name string
@@ -142,10 +142,66 @@ func (m *AnalysisTemplateReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AnalysisTemplateReference proto.InternalMessageInfo
 
+func (m *Approval) Reset()      { *m = Approval{} }
+func (*Approval) ProtoMessage() {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{4}
+}
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+func (m *ApprovalPolicy) Reset()      { *m = ApprovalPolicy{} }
+func (*ApprovalPolicy) ProtoMessage() {}
+func (*ApprovalPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{5}
+}
+func (m *ApprovalPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovalPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApprovalPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalPolicy.Merge(m, src)
+}
+func (m *ApprovalPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovalPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovalPolicy proto.InternalMessageInfo
+
 func (m *ApprovedStage) Reset()      { *m = ApprovedStage{} }
 func (*ApprovedStage) ProtoMessage() {}
 func (*ApprovedStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{4}
+	return fileDescriptor_e26b7f7bbc391025, []int{6}
 }
 func (m *ApprovedStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +229,7 @@ var xxx_messageInfo_ApprovedStage proto.InternalMessageInfo
 func (m *ArgoCDAppHealthStatus) Reset()      { *m = ArgoCDAppHealthStatus{} }
 func (*ArgoCDAppHealthStatus) ProtoMessage() {}
 func (*ArgoCDAppHealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{5}
+	return fileDescriptor_e26b7f7bbc391025, []int{7}
 }
 func (m *ArgoCDAppHealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +257,7 @@ var xxx_messageInfo_ArgoCDAppHealthStatus proto.InternalMessageInfo
 func (m *ArgoCDAppStatus) Reset()      { *m = ArgoCDAppStatus{} }
 func (*ArgoCDAppStatus) ProtoMessage() {}
 func (*ArgoCDAppStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{6}
+	return fileDescriptor_e26b7f7bbc391025, []int{8}
 }
 func (m *ArgoCDAppStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +285,7 @@ var xxx_messageInfo_ArgoCDAppStatus proto.InternalMessageInfo
 func (m *ArgoCDAppSyncStatus) Reset()      { *m = ArgoCDAppSyncStatus{} }
 func (*ArgoCDAppSyncStatus) ProtoMessage() {}
 func (*ArgoCDAppSyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{7}
+	return fileDescriptor_e26b7f7bbc391025, []int{9}
 }
 func (m *ArgoCDAppSyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,10 +310,38 @@ func (m *ArgoCDAppSyncStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArgoCDAppSyncStatus proto.InternalMessageInfo
 
+func (m *AutoRollback) Reset()      { *m = AutoRollback{} }
+func (*AutoRollback) ProtoMessage() {}
+func (*AutoRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{10}
+}
+func (m *AutoRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutoRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRollback.Merge(m, src)
+}
+func (m *AutoRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRollback proto.InternalMessageInfo
+
 func (m *Chart) Reset()      { *m = Chart{} }
 func (*Chart) ProtoMessage() {}
 func (*Chart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{8}
+	return fileDescriptor_e26b7f7bbc391025, []int{11}
 }
 func (m *Chart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,10 +366,38 @@ func (m *Chart) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Chart proto.InternalMessageInfo
 
+func (m *ChartDependency) Reset()      { *m = ChartDependency{} }
+func (*ChartDependency) ProtoMessage() {}
+func (*ChartDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{12}
+}
+func (m *ChartDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChartDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChartDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChartDependency.Merge(m, src)
+}
+func (m *ChartDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChartDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChartDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChartDependency proto.InternalMessageInfo
+
 func (m *ChartDiscoveryResult) Reset()      { *m = ChartDiscoveryResult{} }
 func (*ChartDiscoveryResult) ProtoMessage() {}
 func (*ChartDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{9}
+	return fileDescriptor_e26b7f7bbc391025, []int{13}
 }
 func (m *ChartDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,7 +425,7 @@ var xxx_messageInfo_ChartDiscoveryResult proto.InternalMessageInfo
 func (m *ChartSubscription) Reset()      { *m = ChartSubscription{} }
 func (*ChartSubscription) ProtoMessage() {}
 func (*ChartSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{10}
+	return fileDescriptor_e26b7f7bbc391025, []int{14}
 }
 func (m *ChartSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,10 +450,38 @@ func (m *ChartSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChartSubscription proto.InternalMessageInfo
 
+func (m *ChartVersionMetadata) Reset()      { *m = ChartVersionMetadata{} }
+func (*ChartVersionMetadata) ProtoMessage() {}
+func (*ChartVersionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{15}
+}
+func (m *ChartVersionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChartVersionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChartVersionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChartVersionMetadata.Merge(m, src)
+}
+func (m *ChartVersionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChartVersionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChartVersionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChartVersionMetadata proto.InternalMessageInfo
+
 func (m *ClusterPromotionTask) Reset()      { *m = ClusterPromotionTask{} }
 func (*ClusterPromotionTask) ProtoMessage() {}
 func (*ClusterPromotionTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{11}
+	return fileDescriptor_e26b7f7bbc391025, []int{16}
 }
 func (m *ClusterPromotionTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,7 +509,7 @@ var xxx_messageInfo_ClusterPromotionTask proto.InternalMessageInfo
 func (m *ClusterPromotionTaskList) Reset()      { *m = ClusterPromotionTaskList{} }
 func (*ClusterPromotionTaskList) ProtoMessage() {}
 func (*ClusterPromotionTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{12}
+	return fileDescriptor_e26b7f7bbc391025, []int{17}
 }
 func (m *ClusterPromotionTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +537,7 @@ var xxx_messageInfo_ClusterPromotionTaskList proto.InternalMessageInfo
 func (m *CurrentStage) Reset()      { *m = CurrentStage{} }
 func (*CurrentStage) ProtoMessage() {}
 func (*CurrentStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{13}
+	return fileDescriptor_e26b7f7bbc391025, []int{18}
 }
 func (m *CurrentStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +565,7 @@ var xxx_messageInfo_CurrentStage proto.InternalMessageInfo
 func (m *DiscoveredArtifacts) Reset()      { *m = DiscoveredArtifacts{} }
 func (*DiscoveredArtifacts) ProtoMessage() {}
 func (*DiscoveredArtifacts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{14}
+	return fileDescriptor_e26b7f7bbc391025, []int{19}
 }
 func (m *DiscoveredArtifacts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +593,7 @@ var xxx_messageInfo_DiscoveredArtifacts proto.InternalMessageInfo
 func (m *DiscoveredCommit) Reset()      { *m = DiscoveredCommit{} }
 func (*DiscoveredCommit) ProtoMessage() {}
 func (*DiscoveredCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{15}
+	return fileDescriptor_e26b7f7bbc391025, []int{20}
 }
 func (m *DiscoveredCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +621,7 @@ var xxx_messageInfo_DiscoveredCommit proto.InternalMessageInfo
 func (m *DiscoveredImageReference) Reset()      { *m = DiscoveredImageReference{} }
 func (*DiscoveredImageReference) ProtoMessage() {}
 func (*DiscoveredImageReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{16}
+	return fileDescriptor_e26b7f7bbc391025, []int{21}
 }
 func (m *DiscoveredImageReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,10 +646,66 @@ func (m *DiscoveredImageReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DiscoveredImageReference proto.InternalMessageInfo
 
+func (m *DiscoveredOCIArtifactReference) Reset()      { *m = DiscoveredOCIArtifactReference{} }
+func (*DiscoveredOCIArtifactReference) ProtoMessage() {}
+func (*DiscoveredOCIArtifactReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{22}
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveredOCIArtifactReference.Merge(m, src)
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiscoveredOCIArtifactReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveredOCIArtifactReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveredOCIArtifactReference proto.InternalMessageInfo
+
+func (m *DiscoveredRelease) Reset()      { *m = DiscoveredRelease{} }
+func (*DiscoveredRelease) ProtoMessage() {}
+func (*DiscoveredRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{23}
+}
+func (m *DiscoveredRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiscoveredRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DiscoveredRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveredRelease.Merge(m, src)
+}
+func (m *DiscoveredRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiscoveredRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveredRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveredRelease proto.InternalMessageInfo
+
 func (m *ExpressionVariable) Reset()      { *m = ExpressionVariable{} }
 func (*ExpressionVariable) ProtoMessage() {}
 func (*ExpressionVariable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{17}
+	return fileDescriptor_e26b7f7bbc391025, []int{24}
 }
 func (m *ExpressionVariable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +733,7 @@ var xxx_messageInfo_ExpressionVariable proto.InternalMessageInfo
 func (m *Freight) Reset()      { *m = Freight{} }
 func (*Freight) ProtoMessage() {}
 func (*Freight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{18}
+	return fileDescriptor_e26b7f7bbc391025, []int{25}
 }
 func (m *Freight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +761,7 @@ var xxx_messageInfo_Freight proto.InternalMessageInfo
 func (m *FreightCollection) Reset()      { *m = FreightCollection{} }
 func (*FreightCollection) ProtoMessage() {}
 func (*FreightCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{19}
+	return fileDescriptor_e26b7f7bbc391025, []int{26}
 }
 func (m *FreightCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,10 +786,38 @@ func (m *FreightCollection) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FreightCollection proto.InternalMessageInfo
 
+func (m *FreightCreationCriteria) Reset()      { *m = FreightCreationCriteria{} }
+func (*FreightCreationCriteria) ProtoMessage() {}
+func (*FreightCreationCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{27}
+}
+func (m *FreightCreationCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreightCreationCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreightCreationCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreightCreationCriteria.Merge(m, src)
+}
+func (m *FreightCreationCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreightCreationCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreightCreationCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreightCreationCriteria proto.InternalMessageInfo
+
 func (m *FreightList) Reset()      { *m = FreightList{} }
 func (*FreightList) ProtoMessage() {}
 func (*FreightList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{20}
+	return fileDescriptor_e26b7f7bbc391025, []int{28}
 }
 func (m *FreightList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +845,7 @@ var xxx_messageInfo_FreightList proto.InternalMessageInfo
 func (m *FreightOrigin) Reset()      { *m = FreightOrigin{} }
 func (*FreightOrigin) ProtoMessage() {}
 func (*FreightOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{21}
+	return fileDescriptor_e26b7f7bbc391025, []int{29}
 }
 func (m *FreightOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +873,7 @@ var xxx_messageInfo_FreightOrigin proto.InternalMessageInfo
 func (m *FreightReference) Reset()      { *m = FreightReference{} }
 func (*FreightReference) ProtoMessage() {}
 func (*FreightReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{22}
+	return fileDescriptor_e26b7f7bbc391025, []int{30}
 }
 func (m *FreightReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +901,7 @@ var xxx_messageInfo_FreightReference proto.InternalMessageInfo
 func (m *FreightRequest) Reset()      { *m = FreightRequest{} }
 func (*FreightRequest) ProtoMessage() {}
 func (*FreightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{23}
+	return fileDescriptor_e26b7f7bbc391025, []int{31}
 }
 func (m *FreightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +929,7 @@ var xxx_messageInfo_FreightRequest proto.InternalMessageInfo
 func (m *FreightSources) Reset()      { *m = FreightSources{} }
 func (*FreightSources) ProtoMessage() {}
 func (*FreightSources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{24}
+	return fileDescriptor_e26b7f7bbc391025, []int{32}
 }
 func (m *FreightSources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +957,7 @@ var xxx_messageInfo_FreightSources proto.InternalMessageInfo
 func (m *FreightStatus) Reset()      { *m = FreightStatus{} }
 func (*FreightStatus) ProtoMessage() {}
 func (*FreightStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{25}
+	return fileDescriptor_e26b7f7bbc391025, []int{33}
 }
 func (m *FreightStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +985,7 @@ var xxx_messageInfo_FreightStatus proto.InternalMessageInfo
 func (m *GitCommit) Reset()      { *m = GitCommit{} }
 func (*GitCommit) ProtoMessage() {}
 func (*GitCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{26}
+	return fileDescriptor_e26b7f7bbc391025, []int{34}
 }
 func (m *GitCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +1013,7 @@ var xxx_messageInfo_GitCommit proto.InternalMessageInfo
 func (m *GitDiscoveryResult) Reset()      { *m = GitDiscoveryResult{} }
 func (*GitDiscoveryResult) ProtoMessage() {}
 func (*GitDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{27}
+	return fileDescriptor_e26b7f7bbc391025, []int{35}
 }
 func (m *GitDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +1041,7 @@ var xxx_messageInfo_GitDiscoveryResult proto.InternalMessageInfo
 func (m *GitHubWebhookReceiver) Reset()      { *m = GitHubWebhookReceiver{} }
 func (*GitHubWebhookReceiver) ProtoMessage() {}
 func (*GitHubWebhookReceiver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{28}
+	return fileDescriptor_e26b7f7bbc391025, []int{36}
 }
 func (m *GitHubWebhookReceiver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,10 +1066,38 @@ func (m *GitHubWebhookReceiver) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GitHubWebhookReceiver proto.InternalMessageInfo
 
+func (m *GitPullRequestFilter) Reset()      { *m = GitPullRequestFilter{} }
+func (*GitPullRequestFilter) ProtoMessage() {}
+func (*GitPullRequestFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{37}
+}
+func (m *GitPullRequestFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GitPullRequestFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GitPullRequestFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitPullRequestFilter.Merge(m, src)
+}
+func (m *GitPullRequestFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *GitPullRequestFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitPullRequestFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GitPullRequestFilter proto.InternalMessageInfo
+
 func (m *GitSubscription) Reset()      { *m = GitSubscription{} }
 func (*GitSubscription) ProtoMessage() {}
 func (*GitSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{29}
+	return fileDescriptor_e26b7f7bbc391025, []int{38}
 }
 func (m *GitSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +1125,7 @@ var xxx_messageInfo_GitSubscription proto.InternalMessageInfo
 func (m *Health) Reset()      { *m = Health{} }
 func (*Health) ProtoMessage() {}
 func (*Health) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{30}
+	return fileDescriptor_e26b7f7bbc391025, []int{39}
 }
 func (m *Health) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,7 +1153,7 @@ var xxx_messageInfo_Health proto.InternalMessageInfo
 func (m *HealthCheckStep) Reset()      { *m = HealthCheckStep{} }
 func (*HealthCheckStep) ProtoMessage() {}
 func (*HealthCheckStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{31}
+	return fileDescriptor_e26b7f7bbc391025, []int{40}
 }
 func (m *HealthCheckStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,7 +1181,7 @@ var xxx_messageInfo_HealthCheckStep proto.InternalMessageInfo
 func (m *HealthStats) Reset()      { *m = HealthStats{} }
 func (*HealthStats) ProtoMessage() {}
 func (*HealthStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{32}
+	return fileDescriptor_e26b7f7bbc391025, []int{41}
 }
 func (m *HealthStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1209,7 @@ var xxx_messageInfo_HealthStats proto.InternalMessageInfo
 func (m *Image) Reset()      { *m = Image{} }
 func (*Image) ProtoMessage() {}
 func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{33}
+	return fileDescriptor_e26b7f7bbc391025, []int{42}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1237,7 @@ var xxx_messageInfo_Image proto.InternalMessageInfo
 func (m *ImageDiscoveryResult) Reset()      { *m = ImageDiscoveryResult{} }
 func (*ImageDiscoveryResult) ProtoMessage() {}
 func (*ImageDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{34}
+	return fileDescriptor_e26b7f7bbc391025, []int{43}
 }
 func (m *ImageDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1265,7 @@ var xxx_messageInfo_ImageDiscoveryResult proto.InternalMessageInfo
 func (m *ImageSubscription) Reset()      { *m = ImageSubscription{} }
 func (*ImageSubscription) ProtoMessage() {}
 func (*ImageSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{35}
+	return fileDescriptor_e26b7f7bbc391025, []int{44}
 }
 func (m *ImageSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,15 +1290,15 @@ func (m *ImageSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ImageSubscription proto.InternalMessageInfo
 
-func (m *Project) Reset()      { *m = Project{} }
-func (*Project) ProtoMessage() {}
-func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{36}
+func (m *OCIArtifact) Reset()      { *m = OCIArtifact{} }
+func (*OCIArtifact) ProtoMessage() {}
+func (*OCIArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{45}
 }
-func (m *Project) XXX_Unmarshal(b []byte) error {
+func (m *OCIArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OCIArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1054,27 +1306,27 @@ func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Project) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Project.Merge(m, src)
+func (m *OCIArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifact.Merge(m, src)
 }
-func (m *Project) XXX_Size() int {
+func (m *OCIArtifact) XXX_Size() int {
 	return m.Size()
 }
-func (m *Project) XXX_DiscardUnknown() {
-	xxx_messageInfo_Project.DiscardUnknown(m)
+func (m *OCIArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifact.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Project proto.InternalMessageInfo
+var xxx_messageInfo_OCIArtifact proto.InternalMessageInfo
 
-func (m *ProjectConfig) Reset()      { *m = ProjectConfig{} }
-func (*ProjectConfig) ProtoMessage() {}
-func (*ProjectConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{37}
+func (m *OCIArtifactDiscoveryResult) Reset()      { *m = OCIArtifactDiscoveryResult{} }
+func (*OCIArtifactDiscoveryResult) ProtoMessage() {}
+func (*OCIArtifactDiscoveryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{46}
 }
-func (m *ProjectConfig) XXX_Unmarshal(b []byte) error {
+func (m *OCIArtifactDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OCIArtifactDiscoveryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1082,27 +1334,27 @@ func (m *ProjectConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (m *ProjectConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectConfig.Merge(m, src)
+func (m *OCIArtifactDiscoveryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifactDiscoveryResult.Merge(m, src)
 }
-func (m *ProjectConfig) XXX_Size() int {
+func (m *OCIArtifactDiscoveryResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProjectConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectConfig.DiscardUnknown(m)
+func (m *OCIArtifactDiscoveryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifactDiscoveryResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectConfig proto.InternalMessageInfo
+var xxx_messageInfo_OCIArtifactDiscoveryResult proto.InternalMessageInfo
 
-func (m *ProjectConfigList) Reset()      { *m = ProjectConfigList{} }
-func (*ProjectConfigList) ProtoMessage() {}
-func (*ProjectConfigList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{38}
+func (m *OCIArtifactSubscription) Reset()      { *m = OCIArtifactSubscription{} }
+func (*OCIArtifactSubscription) ProtoMessage() {}
+func (*OCIArtifactSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{47}
 }
-func (m *ProjectConfigList) XXX_Unmarshal(b []byte) error {
+func (m *OCIArtifactSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectConfigList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OCIArtifactSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1110,22 +1362,106 @@ func (m *ProjectConfigList) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 	}
 	return b[:n], nil
 }
-func (m *ProjectConfigList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectConfigList.Merge(m, src)
+func (m *OCIArtifactSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifactSubscription.Merge(m, src)
 }
-func (m *ProjectConfigList) XXX_Size() int {
+func (m *OCIArtifactSubscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProjectConfigList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectConfigList.DiscardUnknown(m)
+func (m *OCIArtifactSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifactSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectConfigList proto.InternalMessageInfo
+var xxx_messageInfo_OCIArtifactSubscription proto.InternalMessageInfo
+
+func (m *Project) Reset()      { *m = Project{} }
+func (*Project) ProtoMessage() {}
+func (*Project) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{48}
+}
+func (m *Project) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Project) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Project.Merge(m, src)
+}
+func (m *Project) XXX_Size() int {
+	return m.Size()
+}
+func (m *Project) XXX_DiscardUnknown() {
+	xxx_messageInfo_Project.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Project proto.InternalMessageInfo
+
+func (m *ProjectConfig) Reset()      { *m = ProjectConfig{} }
+func (*ProjectConfig) ProtoMessage() {}
+func (*ProjectConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{49}
+}
+func (m *ProjectConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectConfig.Merge(m, src)
+}
+func (m *ProjectConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectConfig proto.InternalMessageInfo
+
+func (m *ProjectConfigList) Reset()      { *m = ProjectConfigList{} }
+func (*ProjectConfigList) ProtoMessage() {}
+func (*ProjectConfigList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{50}
+}
+func (m *ProjectConfigList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectConfigList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectConfigList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectConfigList.Merge(m, src)
+}
+func (m *ProjectConfigList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectConfigList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectConfigList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectConfigList proto.InternalMessageInfo
 
 func (m *ProjectConfigSpec) Reset()      { *m = ProjectConfigSpec{} }
 func (*ProjectConfigSpec) ProtoMessage() {}
 func (*ProjectConfigSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{39}
+	return fileDescriptor_e26b7f7bbc391025, []int{51}
 }
 func (m *ProjectConfigSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1489,7 @@ var xxx_messageInfo_ProjectConfigSpec proto.InternalMessageInfo
 func (m *ProjectConfigStatus) Reset()      { *m = ProjectConfigStatus{} }
 func (*ProjectConfigStatus) ProtoMessage() {}
 func (*ProjectConfigStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{40}
+	return fileDescriptor_e26b7f7bbc391025, []int{52}
 }
 func (m *ProjectConfigStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1517,7 @@ var xxx_messageInfo_ProjectConfigStatus proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{41}
+	return fileDescriptor_e26b7f7bbc391025, []int{53}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1545,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectStats) Reset()      { *m = ProjectStats{} }
 func (*ProjectStats) ProtoMessage() {}
 func (*ProjectStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{42}
+	return fileDescriptor_e26b7f7bbc391025, []int{54}
 }
 func (m *ProjectStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,7 +1573,7 @@ var xxx_messageInfo_ProjectStats proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{43}
+	return fileDescriptor_e26b7f7bbc391025, []int{55}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1601,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *Promotion) Reset()      { *m = Promotion{} }
 func (*Promotion) ProtoMessage() {}
 func (*Promotion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{44}
+	return fileDescriptor_e26b7f7bbc391025, []int{56}
 }
 func (m *Promotion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1629,7 @@ var xxx_messageInfo_Promotion proto.InternalMessageInfo
 func (m *PromotionList) Reset()      { *m = PromotionList{} }
 func (*PromotionList) ProtoMessage() {}
 func (*PromotionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{45}
+	return fileDescriptor_e26b7f7bbc391025, []int{57}
 }
 func (m *PromotionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1657,7 @@ var xxx_messageInfo_PromotionList proto.InternalMessageInfo
 func (m *PromotionPolicy) Reset()      { *m = PromotionPolicy{} }
 func (*PromotionPolicy) ProtoMessage() {}
 func (*PromotionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{46}
+	return fileDescriptor_e26b7f7bbc391025, []int{58}
 }
 func (m *PromotionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1349,7 +1685,7 @@ var xxx_messageInfo_PromotionPolicy proto.InternalMessageInfo
 func (m *PromotionPolicySelector) Reset()      { *m = PromotionPolicySelector{} }
 func (*PromotionPolicySelector) ProtoMessage() {}
 func (*PromotionPolicySelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{47}
+	return fileDescriptor_e26b7f7bbc391025, []int{59}
 }
 func (m *PromotionPolicySelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1713,7 @@ var xxx_messageInfo_PromotionPolicySelector proto.InternalMessageInfo
 func (m *PromotionReference) Reset()      { *m = PromotionReference{} }
 func (*PromotionReference) ProtoMessage() {}
 func (*PromotionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{48}
+	return fileDescriptor_e26b7f7bbc391025, []int{60}
 }
 func (m *PromotionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1405,7 +1741,7 @@ var xxx_messageInfo_PromotionReference proto.InternalMessageInfo
 func (m *PromotionSpec) Reset()      { *m = PromotionSpec{} }
 func (*PromotionSpec) ProtoMessage() {}
 func (*PromotionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{49}
+	return fileDescriptor_e26b7f7bbc391025, []int{61}
 }
 func (m *PromotionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1769,7 @@ var xxx_messageInfo_PromotionSpec proto.InternalMessageInfo
 func (m *PromotionStatus) Reset()      { *m = PromotionStatus{} }
 func (*PromotionStatus) ProtoMessage() {}
 func (*PromotionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{50}
+	return fileDescriptor_e26b7f7bbc391025, []int{62}
 }
 func (m *PromotionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1797,7 @@ var xxx_messageInfo_PromotionStatus proto.InternalMessageInfo
 func (m *PromotionStep) Reset()      { *m = PromotionStep{} }
 func (*PromotionStep) ProtoMessage() {}
 func (*PromotionStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{51}
+	return fileDescriptor_e26b7f7bbc391025, []int{63}
 }
 func (m *PromotionStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,7 +1825,7 @@ var xxx_messageInfo_PromotionStep proto.InternalMessageInfo
 func (m *PromotionStepRetry) Reset()      { *m = PromotionStepRetry{} }
 func (*PromotionStepRetry) ProtoMessage() {}
 func (*PromotionStepRetry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{52}
+	return fileDescriptor_e26b7f7bbc391025, []int{64}
 }
 func (m *PromotionStepRetry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1517,7 +1853,7 @@ var xxx_messageInfo_PromotionStepRetry proto.InternalMessageInfo
 func (m *PromotionTask) Reset()      { *m = PromotionTask{} }
 func (*PromotionTask) ProtoMessage() {}
 func (*PromotionTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{53}
+	return fileDescriptor_e26b7f7bbc391025, []int{65}
 }
 func (m *PromotionTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1881,7 @@ var xxx_messageInfo_PromotionTask proto.InternalMessageInfo
 func (m *PromotionTaskList) Reset()      { *m = PromotionTaskList{} }
 func (*PromotionTaskList) ProtoMessage() {}
 func (*PromotionTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{54}
+	return fileDescriptor_e26b7f7bbc391025, []int{66}
 }
 func (m *PromotionTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1909,7 @@ var xxx_messageInfo_PromotionTaskList proto.InternalMessageInfo
 func (m *PromotionTaskReference) Reset()      { *m = PromotionTaskReference{} }
 func (*PromotionTaskReference) ProtoMessage() {}
 func (*PromotionTaskReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{55}
+	return fileDescriptor_e26b7f7bbc391025, []int{67}
 }
 func (m *PromotionTaskReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1937,7 @@ var xxx_messageInfo_PromotionTaskReference proto.InternalMessageInfo
 func (m *PromotionTaskSpec) Reset()      { *m = PromotionTaskSpec{} }
 func (*PromotionTaskSpec) ProtoMessage() {}
 func (*PromotionTaskSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{56}
+	return fileDescriptor_e26b7f7bbc391025, []int{68}
 }
 func (m *PromotionTaskSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1629,7 +1965,7 @@ var xxx_messageInfo_PromotionTaskSpec proto.InternalMessageInfo
 func (m *PromotionTemplate) Reset()      { *m = PromotionTemplate{} }
 func (*PromotionTemplate) ProtoMessage() {}
 func (*PromotionTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{57}
+	return fileDescriptor_e26b7f7bbc391025, []int{69}
 }
 func (m *PromotionTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1657,7 +1993,7 @@ var xxx_messageInfo_PromotionTemplate proto.InternalMessageInfo
 func (m *PromotionTemplateSpec) Reset()      { *m = PromotionTemplateSpec{} }
 func (*PromotionTemplateSpec) ProtoMessage() {}
 func (*PromotionTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{58}
+	return fileDescriptor_e26b7f7bbc391025, []int{70}
 }
 func (m *PromotionTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,10 +2018,178 @@ func (m *PromotionTemplateSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PromotionTemplateSpec proto.InternalMessageInfo
 
+func (m *RejectedFreight) Reset()      { *m = RejectedFreight{} }
+func (*RejectedFreight) ProtoMessage() {}
+func (*RejectedFreight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{71}
+}
+func (m *RejectedFreight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedFreight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RejectedFreight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedFreight.Merge(m, src)
+}
+func (m *RejectedFreight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedFreight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedFreight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedFreight proto.InternalMessageInfo
+
+func (m *Release) Reset()      { *m = Release{} }
+func (*Release) ProtoMessage() {}
+func (*Release) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{72}
+}
+func (m *Release) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Release) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Release) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Release.Merge(m, src)
+}
+func (m *Release) XXX_Size() int {
+	return m.Size()
+}
+func (m *Release) XXX_DiscardUnknown() {
+	xxx_messageInfo_Release.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Release proto.InternalMessageInfo
+
+func (m *ReleaseFeedDiscoveryResult) Reset()      { *m = ReleaseFeedDiscoveryResult{} }
+func (*ReleaseFeedDiscoveryResult) ProtoMessage() {}
+func (*ReleaseFeedDiscoveryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{73}
+}
+func (m *ReleaseFeedDiscoveryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseFeedDiscoveryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseFeedDiscoveryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseFeedDiscoveryResult.Merge(m, src)
+}
+func (m *ReleaseFeedDiscoveryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseFeedDiscoveryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseFeedDiscoveryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseFeedDiscoveryResult proto.InternalMessageInfo
+
+func (m *ReleaseFeedHeader) Reset()      { *m = ReleaseFeedHeader{} }
+func (*ReleaseFeedHeader) ProtoMessage() {}
+func (*ReleaseFeedHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{74}
+}
+func (m *ReleaseFeedHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseFeedHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseFeedHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseFeedHeader.Merge(m, src)
+}
+func (m *ReleaseFeedHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseFeedHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseFeedHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseFeedHeader proto.InternalMessageInfo
+
+func (m *ReleaseFeedMetadata) Reset()      { *m = ReleaseFeedMetadata{} }
+func (*ReleaseFeedMetadata) ProtoMessage() {}
+func (*ReleaseFeedMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{75}
+}
+func (m *ReleaseFeedMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseFeedMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseFeedMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseFeedMetadata.Merge(m, src)
+}
+func (m *ReleaseFeedMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseFeedMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseFeedMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseFeedMetadata proto.InternalMessageInfo
+
+func (m *ReleaseFeedSubscription) Reset()      { *m = ReleaseFeedSubscription{} }
+func (*ReleaseFeedSubscription) ProtoMessage() {}
+func (*ReleaseFeedSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{76}
+}
+func (m *ReleaseFeedSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseFeedSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseFeedSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseFeedSubscription.Merge(m, src)
+}
+func (m *ReleaseFeedSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseFeedSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseFeedSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseFeedSubscription proto.InternalMessageInfo
+
 func (m *RepoSubscription) Reset()      { *m = RepoSubscription{} }
 func (*RepoSubscription) ProtoMessage() {}
 func (*RepoSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{59}
+	return fileDescriptor_e26b7f7bbc391025, []int{77}
 }
 func (m *RepoSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1713,7 +2217,7 @@ var xxx_messageInfo_RepoSubscription proto.InternalMessageInfo
 func (m *Stage) Reset()      { *m = Stage{} }
 func (*Stage) ProtoMessage() {}
 func (*Stage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{60}
+	return fileDescriptor_e26b7f7bbc391025, []int{78}
 }
 func (m *Stage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,7 +2245,7 @@ var xxx_messageInfo_Stage proto.InternalMessageInfo
 func (m *StageList) Reset()      { *m = StageList{} }
 func (*StageList) ProtoMessage() {}
 func (*StageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{61}
+	return fileDescriptor_e26b7f7bbc391025, []int{79}
 }
 func (m *StageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,7 +2273,7 @@ var xxx_messageInfo_StageList proto.InternalMessageInfo
 func (m *StageSpec) Reset()      { *m = StageSpec{} }
 func (*StageSpec) ProtoMessage() {}
 func (*StageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{62}
+	return fileDescriptor_e26b7f7bbc391025, []int{80}
 }
 func (m *StageSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,7 +2301,7 @@ var xxx_messageInfo_StageSpec proto.InternalMessageInfo
 func (m *StageStats) Reset()      { *m = StageStats{} }
 func (*StageStats) ProtoMessage() {}
 func (*StageStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{63}
+	return fileDescriptor_e26b7f7bbc391025, []int{81}
 }
 func (m *StageStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,7 +2329,7 @@ var xxx_messageInfo_StageStats proto.InternalMessageInfo
 func (m *StageStatus) Reset()      { *m = StageStatus{} }
 func (*StageStatus) ProtoMessage() {}
 func (*StageStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{64}
+	return fileDescriptor_e26b7f7bbc391025, []int{82}
 }
 func (m *StageStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1853,7 +2357,7 @@ var xxx_messageInfo_StageStatus proto.InternalMessageInfo
 func (m *StepExecutionMetadata) Reset()      { *m = StepExecutionMetadata{} }
 func (*StepExecutionMetadata) ProtoMessage() {}
 func (*StepExecutionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{65}
+	return fileDescriptor_e26b7f7bbc391025, []int{83}
 }
 func (m *StepExecutionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1878,15 +2382,15 @@ func (m *StepExecutionMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StepExecutionMetadata proto.InternalMessageInfo
 
-func (m *Verification) Reset()      { *m = Verification{} }
-func (*Verification) ProtoMessage() {}
-func (*Verification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{66}
+func (m *StepRunnerPlugin) Reset()      { *m = StepRunnerPlugin{} }
+func (*StepRunnerPlugin) ProtoMessage() {}
+func (*StepRunnerPlugin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{84}
 }
-func (m *Verification) XXX_Unmarshal(b []byte) error {
+func (m *StepRunnerPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StepRunnerPlugin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1894,27 +2398,27 @@ func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *Verification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Verification.Merge(m, src)
+func (m *StepRunnerPlugin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepRunnerPlugin.Merge(m, src)
 }
-func (m *Verification) XXX_Size() int {
+func (m *StepRunnerPlugin) XXX_Size() int {
 	return m.Size()
 }
-func (m *Verification) XXX_DiscardUnknown() {
-	xxx_messageInfo_Verification.DiscardUnknown(m)
+func (m *StepRunnerPlugin) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepRunnerPlugin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Verification proto.InternalMessageInfo
+var xxx_messageInfo_StepRunnerPlugin proto.InternalMessageInfo
 
-func (m *VerificationInfo) Reset()      { *m = VerificationInfo{} }
-func (*VerificationInfo) ProtoMessage() {}
-func (*VerificationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{67}
+func (m *StepRunnerPluginList) Reset()      { *m = StepRunnerPluginList{} }
+func (*StepRunnerPluginList) ProtoMessage() {}
+func (*StepRunnerPluginList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{85}
 }
-func (m *VerificationInfo) XXX_Unmarshal(b []byte) error {
+func (m *StepRunnerPluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VerificationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StepRunnerPluginList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1922,22 +2426,106 @@ func (m *VerificationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 	return b[:n], nil
 }
-func (m *VerificationInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerificationInfo.Merge(m, src)
+func (m *StepRunnerPluginList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepRunnerPluginList.Merge(m, src)
 }
-func (m *VerificationInfo) XXX_Size() int {
+func (m *StepRunnerPluginList) XXX_Size() int {
 	return m.Size()
 }
-func (m *VerificationInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerificationInfo.DiscardUnknown(m)
+func (m *StepRunnerPluginList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepRunnerPluginList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerificationInfo proto.InternalMessageInfo
+var xxx_messageInfo_StepRunnerPluginList proto.InternalMessageInfo
+
+func (m *StepRunnerPluginSpec) Reset()      { *m = StepRunnerPluginSpec{} }
+func (*StepRunnerPluginSpec) ProtoMessage() {}
+func (*StepRunnerPluginSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{86}
+}
+func (m *StepRunnerPluginSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepRunnerPluginSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StepRunnerPluginSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepRunnerPluginSpec.Merge(m, src)
+}
+func (m *StepRunnerPluginSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepRunnerPluginSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepRunnerPluginSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepRunnerPluginSpec proto.InternalMessageInfo
+
+func (m *Verification) Reset()      { *m = Verification{} }
+func (*Verification) ProtoMessage() {}
+func (*Verification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{87}
+}
+func (m *Verification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Verification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Verification.Merge(m, src)
+}
+func (m *Verification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Verification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Verification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Verification proto.InternalMessageInfo
+
+func (m *VerificationInfo) Reset()      { *m = VerificationInfo{} }
+func (*VerificationInfo) ProtoMessage() {}
+func (*VerificationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{88}
+}
+func (m *VerificationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VerificationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationInfo.Merge(m, src)
+}
+func (m *VerificationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationInfo proto.InternalMessageInfo
 
 func (m *VerifiedStage) Reset()      { *m = VerifiedStage{} }
 func (*VerifiedStage) ProtoMessage() {}
 func (*VerifiedStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{68}
+	return fileDescriptor_e26b7f7bbc391025, []int{89}
 }
 func (m *VerifiedStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1965,7 +2553,7 @@ var xxx_messageInfo_VerifiedStage proto.InternalMessageInfo
 func (m *Warehouse) Reset()      { *m = Warehouse{} }
 func (*Warehouse) ProtoMessage() {}
 func (*Warehouse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{69}
+	return fileDescriptor_e26b7f7bbc391025, []int{90}
 }
 func (m *Warehouse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1993,7 +2581,7 @@ var xxx_messageInfo_Warehouse proto.InternalMessageInfo
 func (m *WarehouseList) Reset()      { *m = WarehouseList{} }
 func (*WarehouseList) ProtoMessage() {}
 func (*WarehouseList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{70}
+	return fileDescriptor_e26b7f7bbc391025, []int{91}
 }
 func (m *WarehouseList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2021,7 +2609,7 @@ var xxx_messageInfo_WarehouseList proto.InternalMessageInfo
 func (m *WarehouseSpec) Reset()      { *m = WarehouseSpec{} }
 func (*WarehouseSpec) ProtoMessage() {}
 func (*WarehouseSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{71}
+	return fileDescriptor_e26b7f7bbc391025, []int{92}
 }
 func (m *WarehouseSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2049,7 +2637,7 @@ var xxx_messageInfo_WarehouseSpec proto.InternalMessageInfo
 func (m *WarehouseStats) Reset()      { *m = WarehouseStats{} }
 func (*WarehouseStats) ProtoMessage() {}
 func (*WarehouseStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{72}
+	return fileDescriptor_e26b7f7bbc391025, []int{93}
 }
 func (m *WarehouseStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2077,7 +2665,7 @@ var xxx_messageInfo_WarehouseStats proto.InternalMessageInfo
 func (m *WarehouseStatus) Reset()      { *m = WarehouseStatus{} }
 func (*WarehouseStatus) ProtoMessage() {}
 func (*WarehouseStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{73}
+	return fileDescriptor_e26b7f7bbc391025, []int{94}
 }
 func (m *WarehouseStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2105,7 +2693,7 @@ var xxx_messageInfo_WarehouseStatus proto.InternalMessageInfo
 func (m *WebhookReceiver) Reset()      { *m = WebhookReceiver{} }
 func (*WebhookReceiver) ProtoMessage() {}
 func (*WebhookReceiver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{74}
+	return fileDescriptor_e26b7f7bbc391025, []int{95}
 }
 func (m *WebhookReceiver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2133,7 +2721,7 @@ var xxx_messageInfo_WebhookReceiver proto.InternalMessageInfo
 func (m *WebhookReceiverConfig) Reset()      { *m = WebhookReceiverConfig{} }
 func (*WebhookReceiverConfig) ProtoMessage() {}
 func (*WebhookReceiverConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{75}
+	return fileDescriptor_e26b7f7bbc391025, []int{96}
 }
 func (m *WebhookReceiverConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2165,13 +2753,20 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunMetadata.LabelsEntry")
 	proto.RegisterType((*AnalysisRunReference)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunReference")
 	proto.RegisterType((*AnalysisTemplateReference)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisTemplateReference")
+	proto.RegisterType((*Approval)(nil), "github.com.akuity.kargo.api.v1alpha1.Approval")
+	proto.RegisterType((*ApprovalPolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.ApprovalPolicy")
 	proto.RegisterType((*ApprovedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.ApprovedStage")
 	proto.RegisterType((*ArgoCDAppHealthStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppHealthStatus")
 	proto.RegisterType((*ArgoCDAppStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatus")
 	proto.RegisterType((*ArgoCDAppSyncStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppSyncStatus")
+	proto.RegisterType((*AutoRollback)(nil), "github.com.akuity.kargo.api.v1alpha1.AutoRollback")
 	proto.RegisterType((*Chart)(nil), "github.com.akuity.kargo.api.v1alpha1.Chart")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.Chart.AnnotationsEntry")
+	proto.RegisterType((*ChartDependency)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartDependency")
 	proto.RegisterType((*ChartDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartDiscoveryResult")
 	proto.RegisterType((*ChartSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartSubscription")
+	proto.RegisterType((*ChartVersionMetadata)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartVersionMetadata")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartVersionMetadata.AnnotationsEntry")
 	proto.RegisterType((*ClusterPromotionTask)(nil), "github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTask")
 	proto.RegisterType((*ClusterPromotionTaskList)(nil), "github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTaskList")
 	proto.RegisterType((*CurrentStage)(nil), "github.com.akuity.kargo.api.v1alpha1.CurrentStage")
@@ -2179,10 +2774,15 @@ func init() {
 	proto.RegisterType((*DiscoveredCommit)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredCommit")
 	proto.RegisterType((*DiscoveredImageReference)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredImageReference")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredImageReference.AnnotationsEntry")
+	proto.RegisterType((*DiscoveredOCIArtifactReference)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference.AnnotationsEntry")
+	proto.RegisterType((*DiscoveredRelease)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease.MetadataEntry")
 	proto.RegisterType((*ExpressionVariable)(nil), "github.com.akuity.kargo.api.v1alpha1.ExpressionVariable")
 	proto.RegisterType((*Freight)(nil), "github.com.akuity.kargo.api.v1alpha1.Freight")
 	proto.RegisterType((*FreightCollection)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCollection")
 	proto.RegisterMapType((map[string]FreightReference)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCollection.ItemsEntry")
+	proto.RegisterType((*FreightCreationCriteria)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCreationCriteria")
 	proto.RegisterType((*FreightList)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightList")
 	proto.RegisterType((*FreightOrigin)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightOrigin")
 	proto.RegisterType((*FreightReference)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightReference")
@@ -2192,10 +2792,12 @@ func init() {
 	proto.RegisterMapType((map[string]ApprovedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.ApprovedForEntry")
 	proto.RegisterMapType((map[string]CurrentStage)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.CurrentlyInEntry")
 	proto.RegisterMapType((map[string]v11.JSON)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.MetadataEntry")
+	proto.RegisterMapType((map[string]RejectedFreight)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.RejectedForEntry")
 	proto.RegisterMapType((map[string]VerifiedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.VerifiedInEntry")
 	proto.RegisterType((*GitCommit)(nil), "github.com.akuity.kargo.api.v1alpha1.GitCommit")
 	proto.RegisterType((*GitDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.GitDiscoveryResult")
 	proto.RegisterType((*GitHubWebhookReceiver)(nil), "github.com.akuity.kargo.api.v1alpha1.GitHubWebhookReceiver")
+	proto.RegisterType((*GitPullRequestFilter)(nil), "github.com.akuity.kargo.api.v1alpha1.GitPullRequestFilter")
 	proto.RegisterType((*GitSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.GitSubscription")
 	proto.RegisterType((*Health)(nil), "github.com.akuity.kargo.api.v1alpha1.Health")
 	proto.RegisterType((*HealthCheckStep)(nil), "github.com.akuity.kargo.api.v1alpha1.HealthCheckStep")
@@ -2204,6 +2806,10 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.Image.AnnotationsEntry")
 	proto.RegisterType((*ImageDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.ImageDiscoveryResult")
 	proto.RegisterType((*ImageSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ImageSubscription")
+	proto.RegisterType((*OCIArtifact)(nil), "github.com.akuity.kargo.api.v1alpha1.OCIArtifact")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.OCIArtifact.AnnotationsEntry")
+	proto.RegisterType((*OCIArtifactDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult")
+	proto.RegisterType((*OCIArtifactSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription")
 	proto.RegisterType((*Project)(nil), "github.com.akuity.kargo.api.v1alpha1.Project")
 	proto.RegisterType((*ProjectConfig)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectConfig")
 	proto.RegisterType((*ProjectConfigList)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectConfigList")
@@ -2227,6 +2833,13 @@ func init() {
 	proto.RegisterType((*PromotionTaskSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec")
 	proto.RegisterType((*PromotionTemplate)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTemplate")
 	proto.RegisterType((*PromotionTemplateSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec")
+	proto.RegisterType((*RejectedFreight)(nil), "github.com.akuity.kargo.api.v1alpha1.RejectedFreight")
+	proto.RegisterType((*Release)(nil), "github.com.akuity.kargo.api.v1alpha1.Release")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.Release.MetadataEntry")
+	proto.RegisterType((*ReleaseFeedDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.ReleaseFeedDiscoveryResult")
+	proto.RegisterType((*ReleaseFeedHeader)(nil), "github.com.akuity.kargo.api.v1alpha1.ReleaseFeedHeader")
+	proto.RegisterType((*ReleaseFeedMetadata)(nil), "github.com.akuity.kargo.api.v1alpha1.ReleaseFeedMetadata")
+	proto.RegisterType((*ReleaseFeedSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ReleaseFeedSubscription")
 	proto.RegisterType((*RepoSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.RepoSubscription")
 	proto.RegisterType((*Stage)(nil), "github.com.akuity.kargo.api.v1alpha1.Stage")
 	proto.RegisterType((*StageList)(nil), "github.com.akuity.kargo.api.v1alpha1.StageList")
@@ -2234,6 +2847,9 @@ func init() {
 	proto.RegisterType((*StageStats)(nil), "github.com.akuity.kargo.api.v1alpha1.StageStats")
 	proto.RegisterType((*StageStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.StageStatus")
 	proto.RegisterType((*StepExecutionMetadata)(nil), "github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata")
+	proto.RegisterType((*StepRunnerPlugin)(nil), "github.com.akuity.kargo.api.v1alpha1.StepRunnerPlugin")
+	proto.RegisterType((*StepRunnerPluginList)(nil), "github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginList")
+	proto.RegisterType((*StepRunnerPluginSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginSpec")
 	proto.RegisterType((*Verification)(nil), "github.com.akuity.kargo.api.v1alpha1.Verification")
 	proto.RegisterType((*VerificationInfo)(nil), "github.com.akuity.kargo.api.v1alpha1.VerificationInfo")
 	proto.RegisterType((*VerifiedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.VerifiedStage")
@@ -2251,296 +2867,387 @@ func init() {
 }
 
 var fileDescriptor_e26b7f7bbc391025 = []byte{
-	// 4614 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0x4b, 0x6c, 0x1c, 0x47,
-	0x7a, 0x56, 0xcf, 0xf0, 0xf9, 0x0f, 0x9f, 0x25, 0xd2, 0xe6, 0x72, 0x63, 0x51, 0xe9, 0x75, 0x0c,
-	0x3b, 0xb6, 0x87, 0x91, 0x6c, 0xd9, 0x7a, 0x78, 0x15, 0x70, 0x86, 0x7a, 0xd0, 0xcb, 0xb5, 0x98,
-	0x1a, 0x4a, 0x5a, 0xcb, 0x36, 0x94, 0xe2, 0x4c, 0x71, 0xa6, 0x97, 0x33, 0xdd, 0xe3, 0xaa, 0x1e,
-	0x5a, 0xcc, 0x06, 0x89, 0xf3, 0xc4, 0x02, 0x09, 0x02, 0x1f, 0x36, 0xf0, 0x22, 0x48, 0x90, 0x20,
-	0x7b, 0x0a, 0x16, 0x48, 0x8e, 0x39, 0xe4, 0xe0, 0x43, 0x2e, 0xde, 0x64, 0x37, 0x30, 0x9c, 0x43,
-	0x1c, 0x60, 0x41, 0xc4, 0x5c, 0x20, 0x40, 0x6e, 0xb9, 0xe4, 0x22, 0x20, 0x40, 0x50, 0x8f, 0xee,
-	0xae, 0xee, 0xe9, 0x11, 0xa7, 0x87, 0xa4, 0xa2, 0xe4, 0x36, 0x53, 0x7f, 0xd5, 0xf7, 0x57, 0xfd,
-	0x55, 0xf5, 0xd7, 0xff, 0xa8, 0x6a, 0x78, 0xb5, 0xee, 0xf8, 0x8d, 0xce, 0x56, 0xb1, 0xea, 0xb5,
-	0x96, 0xc9, 0x4e, 0xc7, 0xf1, 0xf7, 0x96, 0x77, 0x08, 0xab, 0x7b, 0xcb, 0xa4, 0xed, 0x2c, 0xef,
-	0x9e, 0x23, 0xcd, 0x76, 0x83, 0x9c, 0x5b, 0xae, 0x53, 0x97, 0x32, 0xe2, 0xd3, 0x5a, 0xb1, 0xcd,
-	0x3c, 0xdf, 0x43, 0xcf, 0x46, 0xad, 0x8a, 0xaa, 0x55, 0x51, 0xb6, 0x2a, 0x92, 0xb6, 0x53, 0x0c,
-	0x5a, 0x2d, 0xbe, 0x6c, 0x60, 0xd7, 0xbd, 0xba, 0xb7, 0x2c, 0x1b, 0x6f, 0x75, 0xb6, 0xe5, 0x3f,
-	0xf9, 0x47, 0xfe, 0x52, 0xa0, 0x8b, 0xf6, 0xce, 0x45, 0x5e, 0x74, 0x14, 0xe7, 0xaa, 0xc7, 0xe8,
-	0xf2, 0x6e, 0x17, 0xe3, 0xc5, 0x9b, 0x51, 0x1d, 0xfa, 0xc0, 0xa7, 0x2e, 0x77, 0x3c, 0x97, 0xbf,
-	0x4c, 0xda, 0x0e, 0xa7, 0x6c, 0x97, 0xb2, 0xe5, 0xf6, 0x4e, 0x5d, 0xd0, 0x78, 0xbc, 0x42, 0x1a,
-	0xd2, 0xab, 0x11, 0x52, 0x8b, 0x54, 0x1b, 0x8e, 0x4b, 0xd9, 0x5e, 0xd4, 0xbc, 0x45, 0x7d, 0x92,
-	0xd6, 0x6a, 0xb9, 0x57, 0x2b, 0xd6, 0x71, 0x7d, 0xa7, 0x45, 0xbb, 0x1a, 0xbc, 0x76, 0x58, 0x03,
-	0x5e, 0x6d, 0xd0, 0x16, 0x49, 0xb6, 0xb3, 0xdf, 0x85, 0xd3, 0x2b, 0x2e, 0x69, 0xee, 0x71, 0x87,
-	0xe3, 0x8e, 0xbb, 0xc2, 0xea, 0x9d, 0x16, 0x75, 0x7d, 0x74, 0x16, 0x86, 0x5c, 0xd2, 0xa2, 0x0b,
-	0xd6, 0x59, 0xeb, 0xf9, 0xf1, 0xd2, 0xc4, 0xa7, 0xfb, 0x4b, 0xa7, 0x0e, 0xf6, 0x97, 0x86, 0xde,
-	0x22, 0x2d, 0x8a, 0x25, 0x05, 0x7d, 0x0d, 0x86, 0x77, 0x49, 0xb3, 0x43, 0x17, 0x72, 0xb2, 0xca,
-	0xa4, 0xae, 0x32, 0x7c, 0x47, 0x14, 0x62, 0x45, 0xb3, 0x7f, 0x27, 0x1f, 0x83, 0xff, 0x26, 0xf5,
-	0x49, 0x8d, 0xf8, 0x04, 0xb5, 0x60, 0xa4, 0x49, 0xb6, 0x68, 0x93, 0x2f, 0x58, 0x67, 0xf3, 0xcf,
-	0x17, 0xce, 0x5f, 0x2b, 0xf6, 0x33, 0xd1, 0xc5, 0x14, 0xa8, 0xe2, 0xba, 0xc4, 0xb9, 0xe6, 0xfa,
-	0x6c, 0xaf, 0x34, 0xa5, 0x3b, 0x31, 0xa2, 0x0a, 0xb1, 0x66, 0x82, 0x7e, 0xcb, 0x82, 0x02, 0x71,
-	0x5d, 0xcf, 0x27, 0xbe, 0x98, 0xa6, 0x85, 0x9c, 0x64, 0xfa, 0xe6, 0xe0, 0x4c, 0x57, 0x22, 0x30,
-	0xc5, 0xf9, 0xb4, 0xe6, 0x5c, 0x30, 0x28, 0xd8, 0xe4, 0xb9, 0x78, 0x09, 0x0a, 0x46, 0x57, 0xd1,
-	0x0c, 0xe4, 0x77, 0xe8, 0x9e, 0x92, 0x2f, 0x16, 0x3f, 0xd1, 0x5c, 0x4c, 0xa0, 0x5a, 0x82, 0x97,
-	0x73, 0x17, 0xad, 0xc5, 0xab, 0x30, 0x93, 0x64, 0x98, 0xa5, 0xbd, 0xfd, 0x47, 0x16, 0xcc, 0x19,
-	0xa3, 0xc0, 0x74, 0x9b, 0x32, 0xea, 0x56, 0x29, 0x5a, 0x86, 0x71, 0x31, 0x97, 0xbc, 0x4d, 0xaa,
-	0xc1, 0x54, 0xcf, 0xea, 0x81, 0x8c, 0xbf, 0x15, 0x10, 0x70, 0x54, 0x27, 0x5c, 0x16, 0xb9, 0x47,
-	0x2d, 0x8b, 0x76, 0x83, 0x70, 0xba, 0x90, 0x8f, 0x2f, 0x8b, 0x0d, 0x51, 0x88, 0x15, 0xcd, 0xbe,
-	0x0f, 0x5f, 0x09, 0xfa, 0xb3, 0x49, 0x5b, 0xed, 0x26, 0xf1, 0x69, 0xd4, 0xa9, 0xc3, 0x97, 0xde,
-	0x59, 0x18, 0xda, 0x71, 0xdc, 0x5a, 0xb2, 0x17, 0xdf, 0x70, 0xdc, 0x1a, 0x96, 0x14, 0x7b, 0x07,
-	0x26, 0x57, 0xda, 0x6d, 0xe6, 0xed, 0xd2, 0x5a, 0xc5, 0x27, 0x75, 0x8a, 0xee, 0x01, 0x10, 0x5d,
-	0xb0, 0xe2, 0x4b, 0xe8, 0xc2, 0xf9, 0x5f, 0x2c, 0xaa, 0x3d, 0x53, 0x34, 0xf7, 0x4c, 0xb1, 0xbd,
-	0x53, 0x17, 0x05, 0xbc, 0x28, 0xb6, 0x66, 0x71, 0xf7, 0x5c, 0x71, 0xd3, 0x69, 0xd1, 0xd2, 0xd4,
-	0xc1, 0xfe, 0x12, 0xac, 0x84, 0x08, 0xd8, 0x40, 0xb3, 0x7f, 0xdb, 0x82, 0xf9, 0x15, 0x56, 0xf7,
-	0xca, 0xab, 0x2b, 0xed, 0xf6, 0x4d, 0x4a, 0x9a, 0x7e, 0xa3, 0xe2, 0x13, 0xbf, 0xc3, 0xd1, 0x55,
-	0x18, 0xe1, 0xf2, 0x97, 0x1e, 0xcc, 0x73, 0xc1, 0xfa, 0x54, 0xf4, 0x87, 0xfb, 0x4b, 0x73, 0x29,
-	0x0d, 0x29, 0xd6, 0xad, 0xd0, 0x0b, 0x30, 0xda, 0xa2, 0x9c, 0x93, 0x7a, 0x20, 0xf1, 0x69, 0x0d,
-	0x30, 0xfa, 0x4d, 0x55, 0x8c, 0x03, 0xba, 0xfd, 0x0f, 0x39, 0x98, 0x0e, 0xb1, 0x34, 0xfb, 0x13,
-	0x98, 0xde, 0x0e, 0x4c, 0x34, 0x8c, 0x11, 0xca, 0x59, 0x2e, 0x9c, 0xbf, 0xd2, 0xe7, 0x4e, 0x4a,
-	0x13, 0x52, 0x69, 0x4e, 0xb3, 0x99, 0x30, 0x4b, 0x71, 0x8c, 0x0d, 0x6a, 0x01, 0xf0, 0x3d, 0xb7,
-	0xaa, 0x99, 0x0e, 0x49, 0xa6, 0x97, 0x32, 0x32, 0xad, 0x84, 0x00, 0x25, 0xa4, 0x59, 0x42, 0x54,
-	0x86, 0x0d, 0x06, 0xf6, 0x5f, 0x5b, 0x70, 0x3a, 0xa5, 0x1d, 0x7a, 0x23, 0x31, 0x9f, 0xcf, 0x76,
-	0xcd, 0x27, 0xea, 0x6a, 0x16, 0xcd, 0xe6, 0x4b, 0x30, 0xc6, 0xe8, 0xae, 0x23, 0x4e, 0x0a, 0x2d,
-	0xe1, 0x19, 0xdd, 0x7e, 0x0c, 0xeb, 0x72, 0x1c, 0xd6, 0x40, 0x2f, 0xc2, 0x78, 0xf0, 0x5b, 0x88,
-	0x39, 0x2f, 0x36, 0x93, 0x98, 0xb8, 0xa0, 0x2a, 0xc7, 0x11, 0xdd, 0xfe, 0x4d, 0x18, 0x2e, 0x37,
-	0x08, 0xf3, 0xc5, 0x8a, 0x61, 0xb4, 0xed, 0xdd, 0xc6, 0xeb, 0xba, 0x8b, 0xe1, 0x8a, 0xc1, 0xaa,
-	0x18, 0x07, 0xf4, 0x3e, 0x26, 0xfb, 0x05, 0x18, 0xdd, 0xa5, 0x4c, 0xf6, 0x37, 0x1f, 0x07, 0xbb,
-	0xa3, 0x8a, 0x71, 0x40, 0xb7, 0xff, 0xd9, 0x82, 0x39, 0xd9, 0x83, 0x55, 0x87, 0x57, 0xbd, 0x5d,
-	0xca, 0xf6, 0x30, 0xe5, 0x9d, 0xe6, 0x31, 0x77, 0x68, 0x15, 0x66, 0x38, 0x6d, 0xed, 0x52, 0x56,
-	0xf6, 0x5c, 0xee, 0x33, 0xe2, 0xb8, 0xbe, 0xee, 0xd9, 0x82, 0xae, 0x3d, 0x53, 0x49, 0xd0, 0x71,
-	0x57, 0x0b, 0xf4, 0x3c, 0x8c, 0xe9, 0x6e, 0x8b, 0xa5, 0x24, 0x04, 0x3b, 0x21, 0xe6, 0x40, 0x8f,
-	0x89, 0xe3, 0x90, 0x6a, 0xff, 0xbb, 0x05, 0xb3, 0x72, 0x54, 0x95, 0xce, 0x16, 0xaf, 0x32, 0xa7,
-	0x2d, 0x14, 0xf0, 0x93, 0x38, 0xa4, 0xab, 0x30, 0x55, 0x0b, 0x04, 0xbf, 0xee, 0xb4, 0x1c, 0x5f,
-	0xee, 0x91, 0xe1, 0xd2, 0x53, 0x1a, 0x63, 0x6a, 0x35, 0x46, 0xc5, 0x89, 0xda, 0x6a, 0xfa, 0x9a,
-	0x1d, 0xee, 0x53, 0xb6, 0xc1, 0xbc, 0x96, 0x27, 0xc6, 0xb9, 0x49, 0xf8, 0x0e, 0xfa, 0x55, 0x18,
-	0x6b, 0xe9, 0x43, 0x4f, 0x6b, 0xcd, 0x5f, 0xea, 0x4f, 0x6b, 0xde, 0xda, 0xfa, 0x36, 0xad, 0xfa,
-	0xe2, 0xc0, 0x8c, 0x76, 0x5b, 0x54, 0x86, 0x43, 0x54, 0xf4, 0x36, 0x0c, 0xf1, 0x36, 0xad, 0x4a,
-	0x11, 0x15, 0xce, 0xbf, 0xde, 0xdf, 0xa6, 0x8e, 0x75, 0xb2, 0xd2, 0xa6, 0xd5, 0x48, 0xb6, 0xe2,
-	0x1f, 0x96, 0x90, 0xf6, 0xbf, 0x5a, 0xb0, 0x90, 0x36, 0xaa, 0x75, 0x87, 0xfb, 0xe8, 0xdd, 0xae,
-	0x91, 0x15, 0xfb, 0x1b, 0x99, 0x68, 0x2d, 0xc7, 0x15, 0xee, 0xde, 0xa0, 0xc4, 0x18, 0xd5, 0x7d,
-	0x18, 0x76, 0x7c, 0xda, 0x0a, 0x4c, 0x8d, 0xcb, 0xfd, 0x0d, 0x2b, 0xad, 0xb3, 0xd1, 0x11, 0xba,
-	0x26, 0x00, 0xb1, 0xc2, 0xb5, 0xdf, 0x81, 0x89, 0x72, 0x87, 0x31, 0xea, 0xfa, 0xea, 0x80, 0xfb,
-	0x06, 0x0c, 0x73, 0xc7, 0xd5, 0x7a, 0x3e, 0xdb, 0xd9, 0x36, 0x2e, 0xc0, 0x2b, 0xa2, 0x31, 0x56,
-	0x18, 0xf6, 0x9f, 0xe6, 0xe1, 0x74, 0xb0, 0x62, 0x68, 0x6d, 0x85, 0xf9, 0xce, 0x36, 0xa9, 0xfa,
-	0x1c, 0xd5, 0x60, 0xa2, 0x16, 0x15, 0xfb, 0x5a, 0x11, 0x67, 0xe1, 0x15, 0x2a, 0x7b, 0x03, 0xde,
-	0xc7, 0x31, 0x54, 0x74, 0x17, 0xf2, 0x75, 0xc7, 0xd7, 0x96, 0xe1, 0xc5, 0xfe, 0x24, 0x77, 0xc3,
-	0x49, 0x6a, 0x9e, 0x52, 0x41, 0xb3, 0xca, 0xdf, 0x70, 0x7c, 0x2c, 0x10, 0xd1, 0x16, 0x8c, 0x38,
-	0x2d, 0x52, 0xa7, 0x19, 0x67, 0x65, 0x4d, 0xb4, 0x49, 0xa2, 0x87, 0xa6, 0xa6, 0xa4, 0x72, 0xac,
-	0x91, 0x05, 0x8f, 0xaa, 0xd0, 0x18, 0x4a, 0x67, 0xf7, 0x3f, 0xf3, 0x29, 0xba, 0x33, 0xe2, 0x21,
-	0xa9, 0x1c, 0x6b, 0x64, 0xfb, 0x8b, 0x1c, 0xcc, 0x44, 0xf2, 0x2b, 0x7b, 0xad, 0x96, 0xe3, 0xa3,
-	0x45, 0xc8, 0x39, 0x35, 0xad, 0x90, 0x40, 0x37, 0xcc, 0xad, 0xad, 0xe2, 0x9c, 0x53, 0x43, 0xcf,
-	0xc1, 0xc8, 0x16, 0x23, 0x6e, 0xb5, 0xa1, 0x15, 0x51, 0x08, 0x5c, 0x92, 0xa5, 0x58, 0x53, 0xd1,
-	0x33, 0x90, 0xf7, 0x49, 0x5d, 0xeb, 0x9f, 0x50, 0x7e, 0x9b, 0xa4, 0x8e, 0x45, 0xb9, 0x50, 0x7c,
-	0xbc, 0x23, 0xf7, 0xb0, 0x9c, 0x79, 0x43, 0xf1, 0x55, 0x54, 0x31, 0x0e, 0xe8, 0x82, 0x23, 0xe9,
-	0xf8, 0x0d, 0x8f, 0x2d, 0x0c, 0xc7, 0x39, 0xae, 0xc8, 0x52, 0xac, 0xa9, 0xc2, 0x44, 0xa9, 0xca,
-	0xfe, 0xfb, 0x94, 0x2d, 0x8c, 0xc4, 0x4d, 0x94, 0x72, 0x40, 0xc0, 0x51, 0x1d, 0xf4, 0x1e, 0x14,
-	0xaa, 0x8c, 0x12, 0xdf, 0x63, 0xab, 0xc4, 0xa7, 0x0b, 0xa3, 0x99, 0x57, 0xe0, 0xb4, 0xb0, 0xd2,
-	0xcb, 0x11, 0x04, 0x36, 0xf1, 0xec, 0xbf, 0xcd, 0xc3, 0x42, 0x24, 0x5a, 0x39, 0xb7, 0x91, 0x65,
-	0xaa, 0xc5, 0x63, 0xf5, 0x10, 0xcf, 0x73, 0x30, 0x52, 0x73, 0xea, 0x94, 0xfb, 0x49, 0x29, 0xaf,
-	0xca, 0x52, 0xac, 0xa9, 0xe8, 0xf7, 0x13, 0xde, 0xc8, 0xb0, 0x5c, 0x28, 0xb7, 0xfa, 0x5b, 0x28,
-	0xbd, 0x3a, 0x37, 0x80, 0x4b, 0x82, 0xce, 0x03, 0xd4, 0x1d, 0x5f, 0x1f, 0x5a, 0x7a, 0xd6, 0x43,
-	0x65, 0x7d, 0x23, 0xa4, 0x60, 0xa3, 0x16, 0xba, 0x0b, 0xe3, 0x52, 0x5e, 0x03, 0xee, 0x7f, 0x69,
-	0xc2, 0x94, 0x03, 0x00, 0x1c, 0x61, 0x1d, 0xd9, 0xc9, 0x79, 0x07, 0xd0, 0xb5, 0x07, 0x6d, 0x46,
-	0xb9, 0x38, 0xba, 0xef, 0x10, 0xe6, 0x90, 0xad, 0x26, 0x3d, 0x2e, 0x3f, 0xf6, 0xb3, 0x21, 0x18,
-	0xbd, 0xce, 0xa8, 0x53, 0x6f, 0xf8, 0x8f, 0xe1, 0x48, 0xfc, 0x1a, 0x0c, 0x93, 0xa6, 0x43, 0xb8,
-	0x5c, 0xdd, 0x46, 0x97, 0x56, 0x44, 0x21, 0x56, 0x34, 0xf4, 0x0e, 0x8c, 0x78, 0xcc, 0xa9, 0x3b,
-	0xee, 0xc2, 0xb8, 0xec, 0xc4, 0x2b, 0xfd, 0xad, 0x1f, 0x3d, 0x8a, 0x5b, 0xb2, 0x69, 0xb4, 0x44,
-	0xd5, 0x7f, 0xac, 0x21, 0xd1, 0x3d, 0x18, 0x55, 0x5b, 0x2e, 0x50, 0x63, 0xcb, 0x7d, 0xab, 0x61,
-	0xb5, 0x6b, 0x23, 0xd5, 0xa0, 0xfe, 0x73, 0x1c, 0x00, 0xa2, 0x4a, 0xa8, 0x85, 0x87, 0x24, 0xf4,
-	0x8b, 0x19, 0xb4, 0x70, 0x4f, 0xb5, 0x5b, 0x09, 0xd5, 0xee, 0x70, 0x16, 0x50, 0xa9, 0x58, 0x7b,
-	0xe9, 0x59, 0x21, 0x62, 0x6d, 0xee, 0x8f, 0x0c, 0x20, 0x62, 0xed, 0x6b, 0x4c, 0xc5, 0x7d, 0x84,
-	0xc0, 0x1b, 0xb0, 0xbf, 0x97, 0x87, 0x59, 0x5d, 0xb3, 0xec, 0x35, 0x9b, 0xb4, 0x2a, 0x6d, 0x4b,
-	0xa5, 0xc5, 0xf3, 0xa9, 0x5a, 0xdc, 0x09, 0x6c, 0x0a, 0x75, 0x32, 0x96, 0x32, 0xf5, 0x26, 0xe2,
-	0x51, 0x94, 0x76, 0x84, 0xd2, 0x11, 0xe1, 0x2c, 0xe9, 0x5a, 0xda, 0xba, 0x40, 0xbf, 0x67, 0xc1,
-	0xe9, 0x5d, 0xca, 0x9c, 0x6d, 0xa7, 0x2a, 0xf7, 0xe3, 0x4d, 0x87, 0xfb, 0x1e, 0xdb, 0xd3, 0xe7,
-	0xe6, 0x6b, 0xfd, 0x71, 0xbe, 0x63, 0x00, 0xac, 0xb9, 0xdb, 0x5e, 0xe9, 0xab, 0x9a, 0xdb, 0xe9,
-	0x3b, 0xdd, 0xd0, 0x38, 0x8d, 0xdf, 0x62, 0x1b, 0x20, 0xea, 0x6d, 0x8a, 0x3a, 0x58, 0x37, 0x37,
-	0x6f, 0xdf, 0x1d, 0x0b, 0x06, 0x1b, 0xe8, 0x4e, 0x53, 0x8d, 0x7c, 0x62, 0x41, 0x41, 0xd3, 0x1f,
-	0x83, 0x99, 0x88, 0xe3, 0x66, 0xe2, 0xcb, 0x99, 0xfa, 0xdf, 0xc3, 0x32, 0x64, 0x30, 0x19, 0xdb,
-	0xe4, 0xe8, 0x82, 0x0e, 0x97, 0x28, 0x1d, 0xf8, 0xf3, 0x66, 0xb8, 0xe4, 0xe1, 0xfe, 0xd2, 0x6c,
-	0xac, 0x72, 0x14, 0x43, 0x39, 0xdc, 0x77, 0xb9, 0x3c, 0xf6, 0xfd, 0xbf, 0x58, 0x3a, 0xf5, 0xe1,
-	0x4f, 0xcf, 0x9e, 0xb2, 0x3f, 0xce, 0xc3, 0x4c, 0x52, 0xaa, 0x7d, 0xe8, 0xde, 0x48, 0x87, 0x8d,
-	0x9d, 0xa8, 0x0e, 0xcb, 0x9d, 0x9c, 0x0e, 0xcb, 0x9f, 0x84, 0x0e, 0x1b, 0x3a, 0x36, 0x1d, 0x66,
-	0xff, 0x93, 0x05, 0x53, 0xe1, 0xcc, 0xbc, 0xdf, 0x11, 0xf6, 0x47, 0x24, 0x75, 0xeb, 0xf8, 0xa5,
-	0x7e, 0x1f, 0x46, 0xb9, 0xd7, 0x61, 0x55, 0x69, 0x64, 0x0b, 0xf4, 0x57, 0xb3, 0x29, 0x4d, 0xd5,
-	0xd6, 0xb0, 0x2c, 0x55, 0x01, 0x0e, 0x50, 0xed, 0x4f, 0x72, 0xe1, 0x80, 0x34, 0x4d, 0x19, 0x5e,
-	0x4c, 0x98, 0xa5, 0x62, 0x40, 0x63, 0xa6, 0xe1, 0x25, 0x4a, 0xb1, 0xa6, 0x22, 0x5b, 0xea, 0xf3,
-	0xc0, 0xfe, 0x1f, 0x2f, 0x81, 0x56, 0xcb, 0x72, 0x12, 0x14, 0x05, 0xb5, 0x61, 0x86, 0xd1, 0xf7,
-	0x3b, 0x0e, 0xa3, 0xb5, 0x8a, 0x47, 0x76, 0x84, 0xd1, 0xa2, 0x83, 0x5c, 0x7d, 0xee, 0xfb, 0xd5,
-	0x0e, 0x93, 0x2a, 0xac, 0x34, 0x27, 0x7c, 0x77, 0x9c, 0xc0, 0xc2, 0x5d, 0xe8, 0xc8, 0x83, 0x39,
-	0xb2, 0x4b, 0x9c, 0x26, 0xd9, 0x72, 0x9a, 0x8e, 0xbf, 0x57, 0xf1, 0x19, 0xf1, 0x69, 0x7d, 0x4f,
-	0x9b, 0xd8, 0x57, 0xf4, 0x58, 0xe6, 0x56, 0x52, 0xea, 0x3c, 0xdc, 0x5f, 0xfa, 0xaa, 0x96, 0x45,
-	0x1a, 0x19, 0xa7, 0x02, 0xdb, 0x3f, 0x19, 0x0d, 0x35, 0x84, 0x8e, 0x6b, 0x7d, 0x07, 0x0a, 0x55,
-	0xe5, 0x4c, 0x36, 0xf7, 0xd6, 0x5c, 0xbd, 0xa6, 0x57, 0x07, 0x38, 0xed, 0x8a, 0xe5, 0x08, 0x26,
-	0x61, 0x85, 0x1a, 0x14, 0x6c, 0x72, 0x43, 0x1f, 0x00, 0x28, 0xd5, 0x4f, 0x6b, 0x6b, 0xae, 0x3e,
-	0xdb, 0xca, 0x83, 0xf0, 0xbe, 0x13, 0xa2, 0x28, 0xd6, 0xa1, 0x91, 0x15, 0x11, 0xb0, 0xc1, 0x4a,
-	0x8c, 0x3a, 0x88, 0xe2, 0x5e, 0xf7, 0x98, 0x56, 0x12, 0x03, 0x8d, 0x7a, 0x25, 0x82, 0x49, 0xda,
-	0xde, 0x11, 0x05, 0x9b, 0xdc, 0x90, 0x67, 0x9c, 0x2b, 0x6a, 0xbb, 0xaf, 0x0c, 0xc2, 0x39, 0xc8,
-	0x48, 0x28, 0xb6, 0xe1, 0x51, 0x13, 0x14, 0x47, 0x47, 0xcd, 0x22, 0x83, 0x99, 0xe4, 0xe4, 0xa4,
-	0x1c, 0xa8, 0x37, 0xe3, 0x07, 0xea, 0xf9, 0x3e, 0x55, 0x90, 0x11, 0x89, 0x30, 0x13, 0x17, 0x0c,
-	0xa6, 0x13, 0x93, 0x92, 0xc2, 0x72, 0x2d, 0xce, 0xf2, 0x95, 0x2c, 0xc6, 0x85, 0x0e, 0xef, 0x9b,
-	0x3c, 0x39, 0xcc, 0x24, 0xa7, 0xe3, 0xd8, 0x98, 0xc6, 0x72, 0x0a, 0x26, 0xd3, 0xef, 0xc0, 0x64,
-	0x6c, 0x26, 0x52, 0x38, 0x6e, 0xc6, 0x39, 0x5e, 0x35, 0xb4, 0x49, 0x94, 0x40, 0xbc, 0x1f, 0x66,
-	0x18, 0x23, 0xc5, 0x12, 0xab, 0x20, 0x34, 0xcc, 0x9b, 0x95, 0x5b, 0x6f, 0x99, 0x26, 0xcb, 0x9f,
-	0xe5, 0x60, 0x3c, 0x3c, 0xb4, 0xb2, 0x44, 0x27, 0x95, 0xb1, 0x99, 0x3b, 0x24, 0x64, 0x90, 0xef,
-	0x27, 0x64, 0x30, 0xd4, 0x3b, 0x64, 0x10, 0x64, 0x30, 0x46, 0x1e, 0x9d, 0xc1, 0x30, 0x42, 0x06,
-	0xa3, 0xfd, 0x87, 0x0c, 0xc6, 0x0e, 0x0f, 0x19, 0xd8, 0x7f, 0x69, 0x01, 0xea, 0x8e, 0x0f, 0x65,
-	0x11, 0x14, 0x49, 0x9a, 0x12, 0xaf, 0x65, 0x75, 0xd6, 0x0f, 0xb3, 0x28, 0x6c, 0x06, 0xf3, 0x37,
-	0x1c, 0xff, 0x66, 0x67, 0xeb, 0x2e, 0xdd, 0x6a, 0x78, 0xde, 0x0e, 0xa6, 0x55, 0xea, 0xec, 0x52,
-	0x86, 0xde, 0x86, 0x71, 0x4e, 0xab, 0x8c, 0x0a, 0xc3, 0x4a, 0x1f, 0xd8, 0xcf, 0x1b, 0x6b, 0xa7,
-	0x58, 0xf5, 0x18, 0x95, 0xf6, 0xa6, 0x57, 0x25, 0x4d, 0xe5, 0x4e, 0x86, 0x26, 0x58, 0x24, 0x98,
-	0x4a, 0x00, 0x81, 0x23, 0x34, 0xfb, 0x93, 0x61, 0x98, 0xbe, 0xe1, 0x0c, 0x1c, 0xdc, 0xf6, 0xe1,
-	0x69, 0xd5, 0xfb, 0x0a, 0xd5, 0xae, 0x45, 0x78, 0x76, 0xa9, 0x35, 0x75, 0x59, 0x37, 0x7d, 0xba,
-	0x9c, 0x5e, 0xed, 0x61, 0x6f, 0x12, 0xee, 0x05, 0xdd, 0xf7, 0xc2, 0xbc, 0x02, 0x93, 0xdc, 0x67,
-	0x4e, 0xd5, 0x57, 0xe1, 0x73, 0xbe, 0x50, 0x90, 0xb6, 0xc1, 0xbc, 0xae, 0x3e, 0x59, 0x31, 0x89,
-	0x38, 0x5e, 0x37, 0x35, 0x2a, 0x3f, 0x94, 0x39, 0x2a, 0xbf, 0x0c, 0xe3, 0xa4, 0xd9, 0xf4, 0x3e,
-	0xd8, 0x24, 0x75, 0xae, 0xe3, 0x60, 0xe1, 0x84, 0xac, 0x04, 0x04, 0x1c, 0xd5, 0x41, 0x45, 0x00,
-	0xa7, 0xee, 0x7a, 0x8c, 0xca, 0x16, 0x23, 0xd2, 0x48, 0x91, 0x99, 0xc7, 0xb5, 0xb0, 0x14, 0x1b,
-	0x35, 0x50, 0x05, 0xe6, 0x1d, 0x97, 0xd3, 0x6a, 0x87, 0xd1, 0xca, 0x8e, 0xd3, 0xde, 0x5c, 0xaf,
-	0x48, 0xb5, 0xb8, 0x27, 0x77, 0xd0, 0x58, 0xe9, 0x19, 0xcd, 0x6c, 0x7e, 0x2d, 0xad, 0x12, 0x4e,
-	0x6f, 0x8b, 0x5e, 0x85, 0x09, 0xc7, 0xad, 0x36, 0x3b, 0x35, 0xba, 0x41, 0xfc, 0x06, 0x5f, 0x18,
-	0x93, 0xdd, 0x98, 0x39, 0xd8, 0x5f, 0x9a, 0x58, 0x33, 0xca, 0x71, 0xac, 0x96, 0x68, 0x45, 0x1f,
-	0x18, 0xad, 0xc6, 0xa3, 0x56, 0xd7, 0x1e, 0x98, 0xad, 0xcc, 0x5a, 0x29, 0x79, 0x0b, 0xc8, 0x94,
-	0xb7, 0xf8, 0x61, 0x0e, 0x46, 0x54, 0xda, 0x10, 0x5d, 0x48, 0xe4, 0xe6, 0x9e, 0xe9, 0xca, 0xcd,
-	0x15, 0xd2, 0x52, 0xac, 0x36, 0x8c, 0x38, 0x9c, 0x77, 0xe2, 0x36, 0xe1, 0x9a, 0x2c, 0xc1, 0x9a,
-	0x22, 0x63, 0xba, 0x9e, 0xbb, 0xed, 0xd4, 0x75, 0xc0, 0xeb, 0x88, 0xba, 0x5b, 0xf1, 0x28, 0x4b,
-	0x44, 0xac, 0x91, 0x05, 0x0f, 0xaf, 0xe3, 0xb7, 0x3b, 0xbe, 0x5c, 0x28, 0xc7, 0xc4, 0xe3, 0x96,
-	0x44, 0xc4, 0x1a, 0xd9, 0xfe, 0xd8, 0x82, 0x69, 0x25, 0x83, 0x72, 0x83, 0x56, 0x77, 0x2a, 0x3e,
-	0x6d, 0x0b, 0x27, 0xad, 0xc3, 0x29, 0x4f, 0x3a, 0x69, 0xb7, 0x39, 0xe5, 0x58, 0x52, 0x8c, 0xd1,
-	0xe7, 0x4e, 0x6a, 0xf4, 0xf6, 0x45, 0x30, 0x26, 0x47, 0xe6, 0xbd, 0x55, 0xfa, 0x57, 0x9d, 0xa0,
-	0xf9, 0x48, 0x09, 0xa9, 0x5a, 0x7b, 0x38, 0xa0, 0xdb, 0x07, 0x39, 0x18, 0x96, 0x7e, 0x54, 0x16,
-	0xcd, 0x15, 0x0f, 0x7c, 0xe6, 0xfa, 0x0a, 0x7c, 0x1e, 0x12, 0x1b, 0x8f, 0x82, 0xbf, 0x43, 0x8f,
-	0x0c, 0xfe, 0xf2, 0xb4, 0xd8, 0xef, 0x1b, 0x19, 0xdc, 0xc7, 0x41, 0xee, 0x9e, 0x1c, 0x35, 0xb6,
-	0xfa, 0x33, 0x0b, 0xe6, 0xd2, 0xb2, 0x20, 0x59, 0x64, 0xfe, 0x12, 0x8c, 0xb5, 0x9b, 0xc4, 0xdf,
-	0xf6, 0x58, 0x2b, 0x99, 0xfd, 0xde, 0xd0, 0xe5, 0x38, 0xac, 0x81, 0x18, 0x00, 0x0b, 0x4e, 0xb1,
-	0xc0, 0xc9, 0xbe, 0x7a, 0xb4, 0x08, 0x79, 0x34, 0xc3, 0x61, 0x11, 0xc7, 0x06, 0x17, 0xfb, 0x0f,
-	0x86, 0x61, 0x56, 0x36, 0x19, 0xf4, 0x40, 0x1c, 0x64, 0x59, 0xb5, 0xe1, 0x29, 0xe9, 0xfe, 0x77,
-	0x9f, 0xa1, 0x6a, 0xa5, 0x5d, 0xd4, 0xed, 0x9f, 0x5a, 0x4b, 0xad, 0xf5, 0xb0, 0x27, 0x05, 0xf7,
-	0xc0, 0xed, 0x3e, 0x18, 0xe1, 0xff, 0xdf, 0xc1, 0x68, 0x2e, 0xb6, 0xd1, 0x43, 0x17, 0x5b, 0xcf,
-	0x63, 0x74, 0xec, 0x08, 0xc7, 0x68, 0xf7, 0xd1, 0x36, 0x9e, 0xe9, 0x68, 0xfb, 0xf3, 0x1c, 0x8c,
-	0x6e, 0x30, 0x4f, 0x66, 0xd3, 0x4e, 0x3e, 0xe5, 0x70, 0x7b, 0xc0, 0x2c, 0xbc, 0x80, 0x52, 0xba,
-	0x5c, 0x66, 0xe1, 0xc7, 0xe2, 0x19, 0x78, 0x23, 0x82, 0x9e, 0xcf, 0xe2, 0x67, 0x69, 0xe0, 0x43,
-	0x22, 0xe8, 0x7f, 0x93, 0x83, 0xc9, 0x58, 0x17, 0x9e, 0xe0, 0xdb, 0x0a, 0x09, 0x39, 0xa5, 0xdc,
-	0x56, 0x40, 0x24, 0x21, 0xab, 0x4b, 0x83, 0x80, 0x3f, 0x5a, 0x62, 0xff, 0x68, 0xc1, 0x6c, 0xac,
-	0xfe, 0x63, 0x08, 0x71, 0x7f, 0x2b, 0x1e, 0xe2, 0x7e, 0x65, 0x80, 0x51, 0xf5, 0x08, 0x74, 0x7f,
-	0x37, 0x97, 0x18, 0x8d, 0x10, 0x26, 0xfa, 0x0d, 0x98, 0x6d, 0x07, 0xf7, 0x27, 0x36, 0xbc, 0xa6,
-	0x53, 0x75, 0x68, 0x90, 0x31, 0xb9, 0x90, 0xf1, 0x72, 0x89, 0x6c, 0xbe, 0x57, 0xfa, 0x8a, 0xe6,
-	0x3e, 0xbb, 0x91, 0xc4, 0xc5, 0xdd, 0xac, 0x10, 0x87, 0x71, 0xa6, 0x7d, 0xb7, 0x60, 0xcc, 0x7d,
-	0x5e, 0x8f, 0x4b, 0x78, 0x7e, 0x7a, 0xec, 0xa1, 0x5e, 0x4d, 0x90, 0xe5, 0xfd, 0x2f, 0xfd, 0xd3,
-	0xfe, 0x0f, 0x0b, 0x4e, 0xa7, 0x2c, 0x04, 0x54, 0x05, 0xa8, 0x7a, 0x6e, 0xcd, 0x51, 0xc6, 0x86,
-	0xa5, 0xc3, 0xe0, 0x7d, 0x4d, 0x6e, 0x39, 0x68, 0x17, 0xed, 0x88, 0xb0, 0x88, 0x63, 0x03, 0x16,
-	0xb5, 0xba, 0x47, 0x7c, 0x61, 0xa0, 0x11, 0xf7, 0x37, 0xd6, 0x4f, 0x2c, 0x28, 0xe8, 0xb1, 0x3e,
-	0xb1, 0x19, 0x1a, 0xdd, 0xbf, 0x1e, 0x0b, 0xf7, 0x73, 0x0b, 0x26, 0x0c, 0x15, 0xc7, 0x51, 0x03,
-	0xe0, 0x03, 0xc2, 0x68, 0xc3, 0x0b, 0x4d, 0xf1, 0xbe, 0xe3, 0xe6, 0x77, 0x83, 0x76, 0x12, 0x29,
-	0x9a, 0xab, 0xb0, 0x9c, 0x63, 0x03, 0x1b, 0x7d, 0xcb, 0x08, 0x81, 0x2b, 0xfd, 0xd8, 0x17, 0x17,
-	0x19, 0xf0, 0x52, 0x1c, 0x4c, 0xdd, 0x62, 0x04, 0xce, 0xed, 0x1f, 0x59, 0xa1, 0x36, 0x4e, 0x5d,
-	0x7c, 0xf9, 0x93, 0x59, 0x7c, 0x15, 0x18, 0x16, 0xca, 0x2d, 0xb8, 0x14, 0x7a, 0x3e, 0xf3, 0x01,
-	0xc3, 0xf5, 0xfd, 0x27, 0xf1, 0x13, 0x2b, 0x2c, 0xfb, 0x07, 0x39, 0x18, 0x0f, 0x37, 0xfb, 0x63,
-	0x3f, 0x7d, 0x5f, 0xc9, 0xa8, 0xa6, 0x7a, 0x9e, 0x28, 0xef, 0x25, 0x4e, 0x94, 0xac, 0xfa, 0xef,
-	0x90, 0xd3, 0xe4, 0xef, 0xd5, 0x8c, 0xab, 0xba, 0x8f, 0x61, 0x2b, 0x6e, 0xc6, 0xb7, 0xe2, 0x72,
-	0xc6, 0xd1, 0xf4, 0xd8, 0x8c, 0x1f, 0xe6, 0x60, 0x3a, 0xa1, 0xf1, 0xd1, 0xd7, 0xe4, 0xa2, 0xaa,
-	0x07, 0xa9, 0xcb, 0xb0, 0xa1, 0x8e, 0xfb, 0x4a, 0x1a, 0xda, 0x15, 0x76, 0x74, 0x68, 0x61, 0x7b,
-	0x4c, 0x0b, 0xf9, 0xeb, 0x03, 0x1d, 0x32, 0x01, 0x48, 0x69, 0x56, 0x99, 0xe0, 0x06, 0x2e, 0x8e,
-	0xb3, 0x41, 0x1b, 0x30, 0x47, 0x3a, 0xbe, 0x17, 0x02, 0x5c, 0x73, 0xc9, 0x56, 0x93, 0xaa, 0x38,
-	0xee, 0x58, 0xe9, 0xe7, 0xc2, 0x7c, 0x51, 0x4a, 0x1d, 0x9c, 0xda, 0xd2, 0xfe, 0x2b, 0x0b, 0x9e,
-	0xee, 0xd1, 0x9f, 0x3e, 0x92, 0xb8, 0x4d, 0x98, 0x94, 0xcf, 0x2c, 0x42, 0x39, 0x04, 0xab, 0xb8,
-	0xbf, 0x99, 0x37, 0x9b, 0xaa, 0xd1, 0xc7, 0x8a, 0x70, 0x1c, 0xdc, 0xfe, 0x71, 0x0e, 0x50, 0xd8,
-	0xd7, 0x2c, 0xb9, 0xe6, 0xf7, 0x60, 0x74, 0x5b, 0xa5, 0x4e, 0x8e, 0x76, 0x59, 0xa0, 0x54, 0x30,
-	0xef, 0x4b, 0x04, 0x98, 0xe8, 0xed, 0xe3, 0xd9, 0x6b, 0xd0, 0xbd, 0xcf, 0xd0, 0x3d, 0x80, 0x6d,
-	0xc7, 0x75, 0x78, 0x63, 0xc0, 0x3b, 0x57, 0xd2, 0x51, 0xba, 0x1e, 0x22, 0x60, 0x03, 0xcd, 0xfe,
-	0xe3, 0x9c, 0xb1, 0x87, 0xa5, 0xfd, 0xd4, 0xd7, 0xda, 0x7f, 0x21, 0x2e, 0xcc, 0xf1, 0xee, 0x8b,
-	0x24, 0xa1, 0x60, 0xee, 0xc1, 0xd0, 0x2e, 0x61, 0x41, 0x4e, 0xbb, 0xcf, 0xeb, 0x9c, 0xdd, 0x37,
-	0xb9, 0xa2, 0x39, 0xbd, 0x43, 0x18, 0xc7, 0x12, 0x53, 0xd8, 0x96, 0xdc, 0xa7, 0xed, 0xe0, 0x70,
-	0xc9, 0xac, 0x38, 0x7d, 0xda, 0x36, 0x07, 0x48, 0xdb, 0xf2, 0x04, 0xa0, 0x6d, 0x6e, 0x7f, 0x6f,
-	0xd4, 0xd0, 0x0a, 0xfa, 0x3c, 0x7b, 0x13, 0x50, 0x93, 0x70, 0xff, 0x26, 0x71, 0x6b, 0x62, 0x2f,
-	0xd1, 0x6d, 0x46, 0x79, 0x43, 0x7b, 0xbf, 0x8b, 0x1a, 0x05, 0xad, 0x77, 0xd5, 0xc0, 0x29, 0xad,
-	0xd0, 0x85, 0xe0, 0x99, 0x8c, 0x92, 0xf2, 0x52, 0xec, 0x99, 0xcc, 0xc3, 0xfd, 0xa5, 0xa9, 0x68,
-	0x3f, 0x1a, 0x0f, 0x67, 0x32, 0x3c, 0x08, 0x31, 0xd7, 0xfb, 0xf0, 0x09, 0xac, 0xf7, 0x5f, 0x87,
-	0xd9, 0xed, 0xe4, 0xcd, 0x22, 0x7d, 0x1b, 0xf3, 0xf5, 0x01, 0x2f, 0x26, 0x95, 0xe6, 0x0f, 0xa2,
-	0xeb, 0x28, 0x51, 0x31, 0xee, 0x66, 0x84, 0xbc, 0xe0, 0x19, 0x8a, 0x0c, 0x64, 0xaa, 0x18, 0x75,
-	0xdf, 0x7b, 0x2e, 0x11, 0x02, 0x4d, 0x3e, 0x40, 0x51, 0x90, 0x38, 0xc6, 0x20, 0xb1, 0x07, 0x47,
-	0x8e, 0x73, 0x0f, 0xa2, 0x0b, 0x61, 0xf6, 0x5d, 0x74, 0x47, 0x86, 0x09, 0xf2, 0x5d, 0x79, 0x73,
-	0x41, 0xc2, 0x66, 0x3d, 0xf4, 0x91, 0x05, 0xf3, 0x62, 0xb1, 0x5e, 0x7b, 0x40, 0xab, 0x1d, 0x21,
-	0x95, 0x20, 0x03, 0xb9, 0x50, 0xc8, 0xe2, 0x75, 0x54, 0xd2, 0x20, 0xa2, 0x98, 0x47, 0x2a, 0x19,
-	0xa7, 0x33, 0x46, 0xf7, 0x95, 0x31, 0x46, 0x65, 0x48, 0xe9, 0xe8, 0x91, 0xe2, 0xd0, 0x30, 0x53,
-	0x7a, 0xc7, 0xa7, 0xf6, 0x0f, 0x86, 0x4c, 0x75, 0xd5, 0x5f, 0xfc, 0xfa, 0x1e, 0x0c, 0xf9, 0x84,
-	0xef, 0xe8, 0x5d, 0xf0, 0xc6, 0x00, 0x0f, 0x0c, 0xa2, 0xbd, 0x20, 0xe3, 0x1b, 0xb2, 0x48, 0x62,
-	0xa2, 0x45, 0xc8, 0x11, 0x9e, 0xcc, 0xa0, 0xae, 0x70, 0x9c, 0x23, 0x5c, 0x66, 0x57, 0xb7, 0x75,
-	0xf4, 0x29, 0xca, 0xae, 0x6e, 0xe3, 0x9c, 0xb3, 0x8d, 0x56, 0x60, 0xba, 0xea, 0xb9, 0xbe, 0xe3,
-	0x76, 0xe8, 0x2d, 0xf7, 0x1a, 0x63, 0x1e, 0xd3, 0xb1, 0xa6, 0xa7, 0x75, 0xc5, 0xe9, 0x72, 0x9c,
-	0x8c, 0x93, 0xf5, 0xd1, 0xdb, 0x30, 0xcc, 0xa8, 0xcf, 0xf6, 0xf4, 0x81, 0x70, 0x71, 0x00, 0xdd,
-	0x87, 0x45, 0x7b, 0x25, 0x65, 0xf9, 0x13, 0x2b, 0xc4, 0x50, 0x65, 0x8f, 0x9c, 0x80, 0xca, 0x8e,
-	0xb2, 0x09, 0xf9, 0x13, 0xcb, 0x26, 0xfc, 0xd0, 0x32, 0x6c, 0x84, 0x70, 0xa0, 0xe8, 0x36, 0x8c,
-	0xfa, 0x4e, 0x8b, 0x7a, 0x1d, 0x3f, 0x9b, 0x71, 0x1a, 0xde, 0xe8, 0x91, 0x9a, 0x70, 0x53, 0x41,
-	0xe0, 0x00, 0x0b, 0x5d, 0x85, 0x29, 0x2a, 0x66, 0x64, 0xb3, 0x21, 0x34, 0xbb, 0xd7, 0x54, 0x96,
-	0xd8, 0x64, 0x14, 0xe8, 0xbb, 0x16, 0xa3, 0xe2, 0x44, 0x6d, 0xfb, 0xc7, 0xa6, 0x19, 0xfd, 0x7f,
-	0xff, 0xd1, 0x8d, 0x8e, 0x31, 0x3d, 0xd6, 0xd7, 0x36, 0x03, 0xc7, 0x98, 0x0e, 0x7d, 0x66, 0xf3,
-	0x2e, 0x3c, 0x95, 0xae, 0x0a, 0x8e, 0xe5, 0x99, 0xea, 0x8f, 0x92, 0xb2, 0x92, 0x16, 0x58, 0xb0,
-	0xfd, 0xac, 0x93, 0xb4, 0x98, 0x72, 0xc7, 0x6d, 0x31, 0x31, 0x73, 0x28, 0xfa, 0x51, 0x2f, 0x7a,
-	0x4f, 0xaf, 0x33, 0x2b, 0xcb, 0x33, 0xd1, 0x2e, 0x98, 0x9e, 0x6b, 0xed, 0x27, 0x16, 0xcc, 0xa7,
-	0xd6, 0x0e, 0x65, 0x98, 0x3b, 0x49, 0x19, 0x5a, 0xc7, 0x2d, 0xc3, 0xef, 0xe7, 0x60, 0x06, 0xd3,
-	0xb6, 0x17, 0x4b, 0x40, 0x6d, 0x04, 0xcf, 0xa1, 0x32, 0xb8, 0x15, 0x89, 0x5b, 0x1d, 0xa5, 0xd1,
-	0xd8, 0x3b, 0x28, 0xb1, 0x5d, 0x5a, 0x81, 0x0d, 0xd9, 0xf7, 0xf6, 0xef, 0x4a, 0x8d, 0xa9, 0x93,
-	0x43, 0x25, 0xd9, 0x14, 0xa0, 0x40, 0x96, 0xf7, 0x4e, 0xb5, 0x72, 0x7f, 0x3d, 0xc3, 0x0d, 0xd6,
-	0x6e, 0x64, 0x59, 0x8c, 0x15, 0xa0, 0xfd, 0x71, 0x0e, 0x94, 0x0b, 0xf2, 0x18, 0xb4, 0xe3, 0xaf,
-	0xc4, 0xb4, 0xe3, 0x72, 0x96, 0x10, 0x59, 0xaf, 0x50, 0x4c, 0xd2, 0x3d, 0x3c, 0x97, 0x31, 0xee,
-	0xf6, 0x88, 0x30, 0xcc, 0xdf, 0x59, 0x30, 0x2e, 0xeb, 0x3d, 0x06, 0x45, 0xbb, 0x11, 0x57, 0xb4,
-	0x2f, 0x66, 0x18, 0x45, 0x0f, 0x05, 0xfb, 0x9f, 0x79, 0xdd, 0xfb, 0xd0, 0xf9, 0x6c, 0x10, 0x56,
-	0xd3, 0x5e, 0x55, 0xb4, 0x4b, 0x44, 0x21, 0x56, 0xb4, 0x70, 0x6f, 0x8f, 0x9e, 0xc0, 0xde, 0xfe,
-	0x35, 0x75, 0xfd, 0x97, 0x72, 0x9f, 0xd6, 0xae, 0x87, 0xee, 0x53, 0x3e, 0xf3, 0x3d, 0x66, 0x7d,
-	0xd7, 0x3a, 0x8a, 0x68, 0xe3, 0x04, 0x2a, 0xee, 0xe2, 0x23, 0x5c, 0xaa, 0x76, 0x52, 0x99, 0x69,
-	0x57, 0xe3, 0xf5, 0x01, 0x35, 0xa7, 0x72, 0xa9, 0xba, 0x8a, 0x71, 0x37, 0x23, 0xd4, 0x80, 0x09,
-	0xf3, 0x05, 0x86, 0x5e, 0xa7, 0xe7, 0xb3, 0x3f, 0xf5, 0x50, 0x97, 0x7e, 0xcc, 0x12, 0x1c, 0x43,
-	0xb6, 0xff, 0xd0, 0x02, 0x88, 0x02, 0xca, 0x62, 0xce, 0xab, 0x5e, 0xc7, 0x55, 0x91, 0x84, 0x7c,
-	0x34, 0xe7, 0x65, 0x51, 0x88, 0x15, 0x4d, 0xec, 0x1f, 0xe5, 0x8f, 0xe9, 0x45, 0x7d, 0x2e, 0x8b,
-	0xab, 0x97, 0x08, 0x5c, 0xab, 0x42, 0xac, 0x01, 0xed, 0x0f, 0x47, 0xa0, 0x60, 0xec, 0xb3, 0x44,
-	0xd8, 0x7a, 0xf2, 0x64, 0xc2, 0xd6, 0xe9, 0xb1, 0x84, 0xc2, 0x40, 0xb1, 0x04, 0x0e, 0x53, 0xda,
-	0x43, 0x0e, 0x9e, 0xe9, 0xa8, 0x58, 0xcb, 0xc0, 0x7e, 0x38, 0x12, 0x56, 0xeb, 0xf5, 0x18, 0x24,
-	0x4e, 0xb0, 0x10, 0x56, 0xaf, 0x2e, 0xa9, 0x74, 0x5a, 0x2d, 0xc2, 0xf6, 0x16, 0x26, 0x64, 0xe7,
-	0x43, 0xab, 0xf7, 0x7a, 0x8c, 0x8a, 0x13, 0xb5, 0xd1, 0x46, 0x38, 0xa1, 0xea, 0xe9, 0xc7, 0x4b,
-	0x59, 0x26, 0x54, 0x59, 0xfd, 0xf1, 0x79, 0x14, 0x22, 0xf5, 0xb6, 0xa4, 0xd3, 0x50, 0xbb, 0xa1,
-	0x3e, 0x72, 0x23, 0x96, 0xf1, 0x88, 0x5c, 0x54, 0xa1, 0x48, 0x6f, 0x75, 0xd5, 0xc0, 0x29, 0xad,
-	0x84, 0x1a, 0xd0, 0xae, 0x76, 0xb8, 0x77, 0x74, 0x70, 0x23, 0xab, 0x9f, 0x15, 0xf9, 0x8e, 0xf2,
-	0x3d, 0x40, 0x39, 0x81, 0x8a, 0xbb, 0xf8, 0xa0, 0xf7, 0x61, 0x52, 0x4c, 0x72, 0xc4, 0x18, 0x8e,
-	0xc8, 0x58, 0x07, 0x55, 0x0d, 0x48, 0x1c, 0xe7, 0x60, 0x7f, 0x9e, 0x87, 0x74, 0x47, 0x3f, 0x7a,
-	0x8a, 0x68, 0x3d, 0xe2, 0x29, 0xe2, 0x5d, 0x18, 0xe7, 0x3e, 0x61, 0xea, 0x4d, 0x68, 0x6e, 0xb0,
-	0x37, 0xa1, 0x95, 0x00, 0x00, 0x47, 0x58, 0x89, 0xa8, 0x4b, 0xfe, 0x58, 0xa3, 0x2e, 0xe7, 0x01,
-	0xa4, 0x23, 0x26, 0xd5, 0x8c, 0x3c, 0x6f, 0x26, 0xa3, 0x5d, 0x7b, 0x2d, 0xa4, 0x60, 0xa3, 0x16,
-	0xfa, 0x7a, 0x78, 0x8a, 0xab, 0x4b, 0x2b, 0xbf, 0xd0, 0x75, 0xc7, 0xf0, 0x74, 0xcc, 0xcc, 0x4b,
-	0x04, 0x72, 0x33, 0x5c, 0x86, 0x4e, 0x09, 0x10, 0x8c, 0x66, 0x0b, 0x10, 0xd8, 0xff, 0x9d, 0x83,
-	0x98, 0x16, 0x46, 0xdf, 0xb5, 0x60, 0x96, 0x24, 0x3e, 0xbb, 0x13, 0x18, 0xb1, 0xbf, 0x9c, 0xed,
-	0x5b, 0x48, 0x5d, 0x5f, 0xed, 0x89, 0x92, 0xe4, 0xc9, 0x2a, 0x1c, 0x77, 0x33, 0x45, 0xbf, 0x6b,
-	0xc1, 0x69, 0xd2, 0xfd, 0x5d, 0x25, 0xbd, 0x78, 0x2e, 0x0d, 0xfc, 0x61, 0xa6, 0xd2, 0xd3, 0x07,
-	0xfb, 0x4b, 0x69, 0x5f, 0x9c, 0xc2, 0x69, 0xec, 0xd0, 0x3b, 0x30, 0x44, 0x58, 0x3d, 0x08, 0x1f,
-	0x67, 0x67, 0x1b, 0x7c, 0x2e, 0x2b, 0x32, 0x25, 0x56, 0x58, 0x9d, 0x63, 0x09, 0x6a, 0xff, 0x34,
-	0x0f, 0x33, 0xc9, 0x27, 0x90, 0xfa, 0xca, 0xfd, 0x50, 0xea, 0x95, 0x7b, 0xb1, 0xd7, 0x64, 0x02,
-	0x25, 0xf9, 0xec, 0x57, 0xe6, 0x41, 0x14, 0x2d, 0xdc, 0x6b, 0xf2, 0x61, 0xd2, 0xf0, 0x11, 0xf6,
-	0x9a, 0x7c, 0x8d, 0x14, 0x61, 0xa1, 0x8b, 0xf1, 0x88, 0xb4, 0x9d, 0x8c, 0x48, 0xcf, 0x9a, 0x63,
-	0x19, 0x34, 0x28, 0xdd, 0x82, 0x82, 0x31, 0x0f, 0x7a, 0x47, 0x5f, 0xce, 0x2c, 0xf7, 0x68, 0xd9,
-	0x4d, 0xab, 0x7b, 0x8f, 0x11, 0xc5, 0xc4, 0x8f, 0xf4, 0x87, 0x94, 0xd6, 0x91, 0xa2, 0xb6, 0x52,
-	0x5c, 0x06, 0x9a, 0xfd, 0x2f, 0x16, 0x4c, 0xc6, 0x1e, 0xa1, 0x08, 0x6e, 0xc1, 0xeb, 0xa2, 0xc1,
-	0xbf, 0x31, 0x75, 0x27, 0x44, 0xc0, 0x06, 0x1a, 0xfa, 0x36, 0x14, 0x9a, 0x9e, 0x5b, 0xa7, 0xdc,
-	0xaf, 0x78, 0x64, 0x47, 0xef, 0x93, 0xac, 0xf1, 0xab, 0x85, 0x83, 0xfd, 0xa5, 0xb9, 0x75, 0x05,
-	0x53, 0xf6, 0x5a, 0xed, 0x26, 0xf5, 0xd5, 0x3b, 0x34, 0x6c, 0x82, 0xcb, 0xec, 0x77, 0x78, 0x7d,
-	0xe0, 0x49, 0xcd, 0x7e, 0x47, 0xf7, 0x1e, 0x8e, 0x39, 0xfb, 0x1d, 0xbb, 0x50, 0x71, 0x48, 0xf6,
-	0x3b, 0xac, 0xfb, 0xc4, 0x66, 0xbf, 0xc3, 0x1e, 0xf6, 0x70, 0xbf, 0xfe, 0x2b, 0x67, 0x8c, 0x22,
-	0xee, 0x82, 0xe5, 0x1e, 0xe1, 0x82, 0xbd, 0x0b, 0x63, 0x8e, 0xeb, 0x53, 0xb6, 0x4b, 0x9a, 0x3a,
-	0xfe, 0x9c, 0x75, 0x2d, 0x86, 0x43, 0x5d, 0xd3, 0x38, 0x38, 0x44, 0x44, 0x4d, 0x98, 0x0f, 0x52,
-	0x3e, 0x8c, 0x92, 0x28, 0x29, 0xad, 0xaf, 0xc4, 0xbe, 0x16, 0xe4, 0x26, 0xae, 0xa7, 0x55, 0x7a,
-	0xd8, 0x8b, 0x80, 0xd3, 0x41, 0x11, 0x87, 0x49, 0x6e, 0xc4, 0x1e, 0x82, 0x13, 0xb1, 0xcf, 0x74,
-	0x59, 0x32, 0x5c, 0x63, 0xdc, 0xa3, 0x35, 0x41, 0x71, 0x9c, 0x87, 0xfd, 0x91, 0x05, 0x53, 0xf1,
-	0xab, 0x3b, 0xff, 0xeb, 0x7e, 0xd0, 0xe7, 0x79, 0x98, 0x4e, 0x2c, 0xfe, 0x84, 0x2f, 0x34, 0xfe,
-	0x38, 0x7d, 0xa1, 0x91, 0x81, 0x7c, 0xa1, 0x74, 0x27, 0x60, 0x68, 0x20, 0x27, 0xe0, 0x8a, 0x32,
-	0xc4, 0xf5, 0x62, 0x5a, 0x5b, 0xd5, 0x8f, 0xcd, 0xc2, 0x09, 0x5e, 0x37, 0x89, 0x38, 0x5e, 0x57,
-	0x5a, 0x38, 0xb5, 0xee, 0x4f, 0x28, 0x69, 0x2f, 0xe2, 0x52, 0xd6, 0xab, 0xec, 0x21, 0x80, 0xb2,
-	0x70, 0x52, 0x08, 0x38, 0x8d, 0x9d, 0xed, 0xc3, 0x74, 0xf2, 0x41, 0x59, 0x5f, 0x81, 0xeb, 0x36,
-	0xf1, 0x83, 0x07, 0x56, 0x61, 0x8d, 0x0d, 0xe2, 0x37, 0xb0, 0xa4, 0xa0, 0x67, 0x20, 0xdf, 0x61,
-	0xcd, 0xe4, 0xab, 0xbf, 0xdb, 0x78, 0x1d, 0x8b, 0x72, 0xfb, 0x4f, 0x2c, 0x98, 0x4f, 0xbd, 0xcd,
-	0xd8, 0x07, 0xf3, 0xfb, 0x30, 0xa2, 0x64, 0xa3, 0xcf, 0x83, 0x2b, 0x7d, 0x47, 0x3c, 0xbb, 0x1f,
-	0xcf, 0x29, 0x3f, 0x51, 0x91, 0xb0, 0x86, 0x2d, 0xbd, 0xf9, 0xe9, 0x97, 0x67, 0x4e, 0x7d, 0xf6,
-	0xe5, 0x99, 0x53, 0x5f, 0x7c, 0x79, 0xe6, 0xd4, 0x87, 0x07, 0x67, 0xac, 0x4f, 0x0f, 0xce, 0x58,
-	0x9f, 0x1d, 0x9c, 0xb1, 0xbe, 0x38, 0x38, 0x63, 0xfd, 0xdb, 0xc1, 0x19, 0xeb, 0xa3, 0x9f, 0x9d,
-	0x39, 0x75, 0xef, 0xd9, 0x7e, 0xbe, 0x57, 0xfb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x82,
-	0x13, 0x96, 0xd6, 0x56, 0x00, 0x00,
+	// 6070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7d, 0x5d, 0x6c, 0x1c, 0x47,
+	0x72, 0xb0, 0x66, 0x77, 0xb9, 0xe4, 0x16, 0xff, 0x5b, 0x94, 0xc5, 0xe3, 0x9d, 0x45, 0x7d, 0x63,
+	0x7f, 0x86, 0x1c, 0xdb, 0x64, 0x24, 0xeb, 0x5f, 0xb6, 0x92, 0x5d, 0x92, 0x92, 0x68, 0xcb, 0x16,
+	0xd3, 0x2b, 0xc9, 0x67, 0xc9, 0x86, 0x32, 0xdc, 0x6d, 0xee, 0xce, 0x71, 0x77, 0x66, 0x3d, 0x33,
+	0x4b, 0x8b, 0x77, 0x87, 0xc0, 0xb9, 0xfc, 0xc0, 0x0f, 0x46, 0x62, 0x04, 0x0e, 0x2e, 0x30, 0x02,
+	0xe4, 0xe0, 0x03, 0x02, 0x24, 0x06, 0x2e, 0xaf, 0x01, 0xf2, 0x60, 0x20, 0x79, 0xb1, 0x13, 0x27,
+	0xb8, 0x73, 0x1e, 0x72, 0x17, 0x1c, 0x88, 0x98, 0xf7, 0x94, 0xb7, 0x3c, 0xe4, 0x49, 0x97, 0x00,
+	0x41, 0xff, 0x4c, 0x4f, 0xcf, 0xcf, 0x8a, 0x33, 0xcb, 0x9f, 0x28, 0x7e, 0xdb, 0xed, 0xaa, 0xae,
+	0xea, 0x9f, 0xea, 0xea, 0xea, 0xaa, 0xea, 0x1e, 0x38, 0xdd, 0x30, 0xbd, 0x66, 0x77, 0x75, 0xae,
+	0x66, 0xb7, 0xe7, 0x8d, 0xf5, 0xae, 0xe9, 0x6d, 0xce, 0xaf, 0x1b, 0x4e, 0xc3, 0x9e, 0x37, 0x3a,
+	0xe6, 0xfc, 0xc6, 0x49, 0xa3, 0xd5, 0x69, 0x1a, 0x27, 0xe7, 0x1b, 0xc4, 0x22, 0x8e, 0xe1, 0x91,
+	0xfa, 0x5c, 0xc7, 0xb1, 0x3d, 0x1b, 0x3d, 0x19, 0xd4, 0x9a, 0xe3, 0xb5, 0xe6, 0x58, 0xad, 0x39,
+	0xa3, 0x63, 0xce, 0xf9, 0xb5, 0x66, 0x9e, 0x53, 0x68, 0x37, 0xec, 0x86, 0x3d, 0xcf, 0x2a, 0xaf,
+	0x76, 0xd7, 0xd8, 0x3f, 0xf6, 0x87, 0xfd, 0xe2, 0x44, 0x67, 0xf4, 0xf5, 0xf3, 0xee, 0x9c, 0xc9,
+	0x39, 0xd7, 0x6c, 0x87, 0xcc, 0x6f, 0xc4, 0x18, 0xcf, 0x5c, 0x0b, 0x70, 0xc8, 0x7d, 0x8f, 0x58,
+	0xae, 0x69, 0x5b, 0xee, 0x73, 0x46, 0xc7, 0x74, 0x89, 0xb3, 0x41, 0x9c, 0xf9, 0xce, 0x7a, 0x83,
+	0xc2, 0xdc, 0x30, 0x42, 0x12, 0xa5, 0xd3, 0x01, 0xa5, 0xb6, 0x51, 0x6b, 0x9a, 0x16, 0x71, 0x36,
+	0x83, 0xea, 0x6d, 0xe2, 0x19, 0x49, 0xb5, 0xe6, 0x7b, 0xd5, 0x72, 0xba, 0x96, 0x67, 0xb6, 0x49,
+	0xac, 0xc2, 0xd9, 0x9d, 0x2a, 0xb8, 0xb5, 0x26, 0x69, 0x1b, 0xd1, 0x7a, 0xfa, 0x1b, 0x70, 0xb8,
+	0x6c, 0x19, 0xad, 0x4d, 0xd7, 0x74, 0x71, 0xd7, 0x2a, 0x3b, 0x8d, 0x6e, 0x9b, 0x58, 0x1e, 0x3a,
+	0x0e, 0x05, 0xcb, 0x68, 0x93, 0x69, 0xed, 0xb8, 0x76, 0xa2, 0x54, 0x19, 0xf9, 0x74, 0x6b, 0xf6,
+	0xd0, 0xf6, 0xd6, 0x6c, 0xe1, 0x55, 0xa3, 0x4d, 0x30, 0x83, 0xa0, 0x27, 0x60, 0x60, 0xc3, 0x68,
+	0x75, 0xc9, 0x74, 0x8e, 0xa1, 0x8c, 0x0a, 0x94, 0x81, 0xdb, 0xb4, 0x10, 0x73, 0x98, 0xfe, 0x3b,
+	0xf9, 0x10, 0xf9, 0x57, 0x88, 0x67, 0xd4, 0x0d, 0xcf, 0x40, 0x6d, 0x28, 0xb6, 0x8c, 0x55, 0xd2,
+	0x72, 0xa7, 0xb5, 0xe3, 0xf9, 0x13, 0xc3, 0xa7, 0x96, 0xe6, 0xd2, 0x4c, 0xf4, 0x5c, 0x02, 0xa9,
+	0xb9, 0xeb, 0x8c, 0xce, 0x92, 0xe5, 0x39, 0x9b, 0x95, 0x31, 0xd1, 0x88, 0x22, 0x2f, 0xc4, 0x82,
+	0x09, 0xfa, 0x6d, 0x0d, 0x86, 0x0d, 0xcb, 0xb2, 0x3d, 0xc3, 0xa3, 0xd3, 0x34, 0x9d, 0x63, 0x4c,
+	0x5f, 0xea, 0x9f, 0x69, 0x39, 0x20, 0xc6, 0x39, 0x1f, 0x16, 0x9c, 0x87, 0x15, 0x08, 0x56, 0x79,
+	0xce, 0x5c, 0x80, 0x61, 0xa5, 0xa9, 0x68, 0x02, 0xf2, 0xeb, 0x64, 0x93, 0x8f, 0x2f, 0xa6, 0x3f,
+	0xd1, 0x54, 0x68, 0x40, 0xc5, 0x08, 0x5e, 0xcc, 0x9d, 0xd7, 0x66, 0x2e, 0xc3, 0x44, 0x94, 0x61,
+	0x96, 0xfa, 0xfa, 0x1f, 0x68, 0x30, 0xa5, 0xf4, 0x02, 0x93, 0x35, 0xe2, 0x10, 0xab, 0x46, 0xd0,
+	0x3c, 0x94, 0xe8, 0x5c, 0xba, 0x1d, 0xa3, 0xe6, 0x4f, 0xf5, 0xa4, 0xe8, 0x48, 0xe9, 0x55, 0x1f,
+	0x80, 0x03, 0x1c, 0x29, 0x16, 0xb9, 0x87, 0x89, 0x45, 0xa7, 0x69, 0xb8, 0x64, 0x3a, 0x1f, 0x16,
+	0x8b, 0x15, 0x5a, 0x88, 0x39, 0x4c, 0xbf, 0x07, 0x5f, 0xf3, 0xdb, 0x73, 0x93, 0xb4, 0x3b, 0x2d,
+	0xc3, 0x23, 0x41, 0xa3, 0x76, 0x16, 0xbd, 0xe3, 0x50, 0x58, 0x37, 0xad, 0x7a, 0xb4, 0x15, 0x2f,
+	0x9b, 0x56, 0x1d, 0x33, 0x88, 0xfe, 0x81, 0x06, 0x43, 0xe5, 0x4e, 0xc7, 0xb1, 0x37, 0x8c, 0x16,
+	0x7a, 0x16, 0x86, 0x0c, 0xf6, 0x9b, 0x38, 0x82, 0xe8, 0x84, 0xa8, 0x22, 0x70, 0x88, 0x83, 0x25,
+	0x06, 0xba, 0x03, 0x20, 0x7e, 0xd7, 0xcb, 0x1e, 0x63, 0x31, 0x7c, 0xea, 0x57, 0xe6, 0xf8, 0xea,
+	0x9a, 0x53, 0x57, 0xd7, 0x5c, 0x67, 0xbd, 0x41, 0x0b, 0xdc, 0x39, 0xba, 0x88, 0xe7, 0x36, 0x4e,
+	0xce, 0xdd, 0x34, 0xdb, 0xa4, 0x32, 0xb6, 0xbd, 0x35, 0x0b, 0x65, 0x49, 0x01, 0x2b, 0xd4, 0xf4,
+	0x1f, 0xe4, 0x60, 0xcc, 0x6f, 0xd6, 0x8a, 0xdd, 0x32, 0x6b, 0x9b, 0xe8, 0x2a, 0x4c, 0x3a, 0xe4,
+	0xad, 0xae, 0xe9, 0x90, 0xba, 0x0f, 0x71, 0x59, 0x2b, 0x07, 0x2a, 0x5f, 0x13, 0xad, 0x9c, 0xc4,
+	0x51, 0x04, 0x1c, 0xaf, 0x83, 0x2e, 0xc2, 0x18, 0x69, 0x99, 0x0d, 0x73, 0xb5, 0x45, 0xae, 0x3a,
+	0x76, 0xb7, 0xc3, 0xa5, 0xbc, 0x54, 0x41, 0xdb, 0x5b, 0xb3, 0x63, 0x4b, 0x21, 0x08, 0x8e, 0x60,
+	0xa2, 0x73, 0x30, 0xea, 0x97, 0x60, 0xbb, 0x45, 0xdc, 0xe9, 0x3c, 0xab, 0x3a, 0xb9, 0xbd, 0x35,
+	0x3b, 0xba, 0xa4, 0x02, 0x70, 0x18, 0x0f, 0xad, 0xc0, 0x14, 0xb9, 0x5f, 0x6b, 0x75, 0xeb, 0x64,
+	0xc1, 0x6e, 0xb7, 0x4d, 0xaf, 0xdc, 0xf5, 0x9a, 0xb6, 0xe3, 0x4e, 0x17, 0x8e, 0x6b, 0x27, 0x86,
+	0x2a, 0xdf, 0x10, 0x1d, 0x98, 0x5a, 0x4a, 0xc0, 0xc1, 0x89, 0x35, 0xf5, 0xcf, 0x35, 0x18, 0xf5,
+	0x47, 0xaf, 0xea, 0x19, 0x0d, 0x12, 0x99, 0x10, 0x6d, 0x2f, 0x27, 0x04, 0xdd, 0x83, 0x92, 0x21,
+	0x47, 0x9d, 0x6b, 0x85, 0xb9, 0x94, 0x5a, 0x41, 0x54, 0x0b, 0x16, 0x4c, 0x30, 0x3b, 0x01, 0x4d,
+	0xfd, 0x7b, 0x1a, 0x1c, 0x29, 0x3b, 0x0d, 0x7b, 0x61, 0xb1, 0xdc, 0xe9, 0x5c, 0x23, 0x46, 0xcb,
+	0x6b, 0x56, 0x3d, 0xc3, 0xeb, 0xba, 0xe8, 0x32, 0x14, 0x5d, 0xf6, 0x4b, 0xc8, 0xe4, 0x53, 0xbe,
+	0xee, 0xe2, 0xf0, 0x07, 0x5b, 0xb3, 0x53, 0x09, 0x15, 0x09, 0x16, 0xb5, 0xd0, 0xd3, 0x30, 0xd8,
+	0x26, 0xae, 0x6b, 0x34, 0xfc, 0xd5, 0x38, 0x2e, 0x08, 0x0c, 0xbe, 0xc2, 0x8b, 0xb1, 0x0f, 0xd7,
+	0xff, 0x3e, 0x07, 0xe3, 0x92, 0x96, 0x60, 0xbf, 0x0f, 0x4b, 0xbf, 0x0b, 0x23, 0x4d, 0xa5, 0x87,
+	0x4c, 0x03, 0x0c, 0x9f, 0xba, 0x94, 0x72, 0x3c, 0x93, 0x06, 0xa9, 0x32, 0x25, 0xd8, 0x8c, 0xa8,
+	0xa5, 0x38, 0xc4, 0x06, 0xb5, 0x01, 0xdc, 0x4d, 0xab, 0x26, 0x98, 0x16, 0x18, 0xd3, 0x0b, 0x19,
+	0x99, 0x56, 0x25, 0x81, 0x0a, 0x12, 0x2c, 0x21, 0x28, 0xc3, 0x0a, 0x03, 0xfd, 0x47, 0x1a, 0x1c,
+	0x4e, 0xa8, 0x87, 0x5e, 0x88, 0xcc, 0xe7, 0x93, 0xb1, 0xf9, 0x44, 0xb1, 0x6a, 0xc1, 0x6c, 0x3e,
+	0x0b, 0x43, 0x0e, 0xd9, 0x30, 0xa9, 0x15, 0x21, 0x46, 0x58, 0xea, 0x28, 0x2c, 0xca, 0xb1, 0xc4,
+	0x40, 0xcf, 0x40, 0xc9, 0xff, 0xed, 0xaf, 0xd5, 0x51, 0x3a, 0x71, 0x3e, 0xaa, 0x8b, 0x03, 0xb8,
+	0x7e, 0x01, 0x46, 0xca, 0x5d, 0xcf, 0xc6, 0x76, 0xab, 0xb5, 0x6a, 0xd4, 0xd6, 0xa9, 0xe0, 0x10,
+	0xcb, 0x58, 0x6d, 0x91, 0x3a, 0x6b, 0xe9, 0x50, 0x20, 0x38, 0x4b, 0xbc, 0x18, 0xfb, 0x70, 0xfd,
+	0x67, 0x79, 0x18, 0x58, 0x68, 0x1a, 0x8e, 0x47, 0x2b, 0x39, 0xa4, 0x63, 0xdf, 0xc2, 0xd7, 0x45,
+	0xf7, 0x64, 0x25, 0xcc, 0x8b, 0xb1, 0x0f, 0x4f, 0x21, 0x28, 0x4f, 0xc3, 0xe0, 0x06, 0x71, 0x58,
+	0x5f, 0xf3, 0x61, 0x62, 0xb7, 0x79, 0x31, 0xf6, 0xe1, 0xe8, 0x14, 0x5b, 0xfc, 0xa2, 0x98, 0x4d,
+	0x6e, 0x29, 0x98, 0xa1, 0xb2, 0x84, 0x60, 0x05, 0x0b, 0xb9, 0xe1, 0xcd, 0x7e, 0x80, 0x2d, 0xeb,
+	0x17, 0xd2, 0x49, 0x04, 0xeb, 0x6d, 0x1f, 0xdb, 0x3b, 0xb2, 0x61, 0xa4, 0x4e, 0x3a, 0xc4, 0xaa,
+	0x13, 0xab, 0x66, 0x12, 0x77, 0xba, 0xc8, 0xb8, 0x9e, 0xc9, 0xc0, 0x75, 0xd1, 0xaf, 0xbe, 0x19,
+	0x88, 0xfd, 0xa2, 0x42, 0x12, 0x87, 0x18, 0xec, 0xda, 0x28, 0xf8, 0x23, 0x0d, 0xc6, 0x23, 0x7c,
+	0x53, 0x6c, 0xbd, 0xca, 0xd4, 0xe5, 0x76, 0x9e, 0x3a, 0x2a, 0x12, 0xae, 0xe9, 0xd9, 0xce, 0xa6,
+	0x98, 0x68, 0x39, 0x75, 0x58, 0x42, 0xb0, 0x82, 0xa5, 0xff, 0x61, 0x1e, 0xa6, 0x78, 0xa3, 0x4c,
+	0xb7, 0x46, 0xb7, 0xe3, 0x4d, 0x4c, 0xdc, 0x6e, 0x6b, 0x8f, 0xe5, 0x6f, 0x11, 0x26, 0x5c, 0xd2,
+	0xde, 0x20, 0xce, 0x82, 0x6d, 0xb9, 0x9e, 0x63, 0x98, 0x96, 0x27, 0xda, 0x37, 0x2d, 0xb0, 0x27,
+	0xaa, 0x11, 0x38, 0x8e, 0xd5, 0x40, 0x27, 0x60, 0x48, 0x74, 0x95, 0x6a, 0x1d, 0xba, 0x06, 0x47,
+	0xe8, 0x72, 0x15, 0xe3, 0xe0, 0x62, 0x09, 0xa5, 0xbb, 0x64, 0x20, 0x9e, 0x0a, 0xcf, 0x01, 0xc6,
+	0x53, 0xee, 0x92, 0xe5, 0x04, 0x1c, 0x9c, 0x58, 0x13, 0x35, 0x61, 0xa8, 0x2d, 0x6c, 0x51, 0x21,
+	0x69, 0x17, 0x33, 0x48, 0x9a, 0xa0, 0xe7, 0x5b, 0xb3, 0x81, 0xaa, 0xf1, 0x4b, 0xb0, 0xa4, 0xae,
+	0xff, 0x75, 0x0e, 0x26, 0x59, 0xa5, 0x6a, 0x77, 0xd5, 0xad, 0x39, 0x66, 0x87, 0x8a, 0xdb, 0xa3,
+	0x38, 0x1d, 0x7b, 0x3f, 0xc8, 0x97, 0x61, 0xac, 0xee, 0x8b, 0xe1, 0x75, 0xb3, 0x6d, 0x7a, 0x4c,
+	0xff, 0x0c, 0x54, 0x1e, 0x13, 0xb4, 0xc6, 0x16, 0x43, 0x50, 0x1c, 0xc1, 0xd6, 0x3f, 0xf6, 0x85,
+	0x39, 0x32, 0xde, 0xea, 0x22, 0xd2, 0x32, 0xe9, 0xbf, 0x5c, 0x2a, 0xfd, 0xf7, 0xbd, 0xc8, 0x69,
+	0x27, 0xcf, 0x04, 0xe4, 0xe5, 0xfe, 0x05, 0x64, 0x2f, 0xf4, 0x61, 0xe1, 0x51, 0xd7, 0x87, 0xff,
+	0xac, 0xc1, 0xd4, 0x42, 0xab, 0xeb, 0x7a, 0xc4, 0x59, 0x71, 0xec, 0xb6, 0x4d, 0xc9, 0xdc, 0x34,
+	0xdc, 0x75, 0xf4, 0x9b, 0xca, 0x5a, 0xe3, 0xd6, 0xe7, 0xaf, 0xa6, 0xb3, 0x3e, 0x6f, 0xac, 0x7e,
+	0x8b, 0xd4, 0x3c, 0x3a, 0x88, 0xc1, 0x94, 0x05, 0x65, 0xc1, 0x1a, 0x43, 0xaf, 0x43, 0xc1, 0xed,
+	0x90, 0x9a, 0x38, 0x6c, 0x9c, 0x4b, 0x37, 0x46, 0xa1, 0x46, 0x56, 0x3b, 0xa4, 0x16, 0xac, 0x2d,
+	0xfa, 0x0f, 0x33, 0x92, 0xfa, 0xcf, 0x34, 0x98, 0x4e, 0xea, 0xd5, 0x75, 0xd3, 0xf5, 0xd0, 0x1b,
+	0xb1, 0x9e, 0xcd, 0xa5, 0xeb, 0x19, 0xad, 0xcd, 0xfa, 0x25, 0x35, 0x87, 0x5f, 0xa2, 0xf4, 0xea,
+	0x1e, 0x0c, 0x98, 0x1e, 0x69, 0xfb, 0x76, 0x75, 0x5a, 0x05, 0x95, 0xd0, 0xd8, 0xe0, 0x14, 0xb9,
+	0x4c, 0x09, 0x62, 0x4e, 0x57, 0xbf, 0x0b, 0x23, 0x0b, 0x5d, 0xc7, 0x21, 0x96, 0xc7, 0x0f, 0x0a,
+	0x2f, 0xc3, 0x80, 0x6b, 0x5a, 0xc2, 0x9c, 0xcd, 0x76, 0x46, 0x28, 0x51, 0xe2, 0x55, 0x5a, 0x19,
+	0x73, 0x1a, 0xfa, 0xbb, 0x03, 0x70, 0xd8, 0x5f, 0xdf, 0xa4, 0x5e, 0x76, 0x3c, 0x73, 0xcd, 0xa8,
+	0x79, 0x2e, 0xaa, 0xc3, 0x48, 0x3d, 0x28, 0xf6, 0x84, 0xbd, 0x99, 0x85, 0x57, 0x20, 0xcc, 0x0a,
+	0x1d, 0x1c, 0xa2, 0x8a, 0x5e, 0x83, 0x7c, 0xc3, 0xf4, 0x84, 0x73, 0xe4, 0x7c, 0xba, 0x91, 0xbb,
+	0x6a, 0x46, 0x77, 0xcd, 0xca, 0xb0, 0x60, 0x95, 0xbf, 0x6a, 0x7a, 0x98, 0x52, 0x44, 0xab, 0x50,
+	0x34, 0xdb, 0x46, 0x83, 0x64, 0x9c, 0x95, 0x65, 0x5a, 0x27, 0x4a, 0x5d, 0x7a, 0x5b, 0x18, 0xd4,
+	0xc5, 0x82, 0x32, 0xe5, 0x51, 0xa3, 0x0b, 0xd8, 0xd7, 0x3c, 0x59, 0xb6, 0xa6, 0x9e, 0x3c, 0x18,
+	0xd4, 0xc5, 0x82, 0x32, 0xfa, 0x36, 0x8c, 0xd8, 0x35, 0x53, 0x4e, 0x8b, 0x30, 0xf2, 0x7e, 0x3d,
+	0x1d, 0xa7, 0x1b, 0x0b, 0xcb, 0x7e, 0xcd, 0x28, 0x3f, 0x39, 0x39, 0x0a, 0x8e, 0x8b, 0x43, 0xbc,
+	0x90, 0x45, 0x6d, 0xf5, 0x16, 0x31, 0x5c, 0x69, 0xe6, 0xa5, 0xe4, 0x8b, 0x79, 0xad, 0x2b, 0x84,
+	0xd4, 0xa3, 0x7c, 0x15, 0x6b, 0x9f, 0x53, 0xc6, 0x92, 0x87, 0xfe, 0x65, 0x1e, 0x26, 0x02, 0x59,
+	0xe1, 0xc7, 0x65, 0x34, 0x03, 0x39, 0xb3, 0x2e, 0xb6, 0x0f, 0x10, 0x95, 0x73, 0xcb, 0x8b, 0x38,
+	0x67, 0xd6, 0xd1, 0x53, 0x50, 0x5c, 0x75, 0x0c, 0xab, 0xd6, 0x14, 0x1b, 0x86, 0x1c, 0xc4, 0x0a,
+	0x2b, 0xc5, 0x02, 0x8a, 0x1e, 0x87, 0xbc, 0x67, 0x34, 0xc4, 0x5e, 0x2b, 0x65, 0xe5, 0xa6, 0xd1,
+	0xc0, 0xb4, 0x9c, 0x6e, 0x53, 0x6e, 0x97, 0xe9, 0x2b, 0x61, 0x78, 0xcb, 0x6d, 0xaa, 0xca, 0x8b,
+	0xb1, 0x0f, 0xa7, 0x1c, 0x0d, 0x76, 0x80, 0x17, 0xdb, 0xad, 0xe4, 0xc8, 0x8f, 0xf5, 0x58, 0x40,
+	0xe9, 0xa9, 0xb3, 0xc6, 0xda, 0xef, 0x11, 0x67, 0xba, 0x18, 0x3e, 0x75, 0x2e, 0xf8, 0x00, 0x1c,
+	0xe0, 0xa0, 0x37, 0x61, 0xb8, 0xe6, 0x10, 0xc3, 0xb3, 0x9d, 0x45, 0xc3, 0x23, 0xd3, 0x83, 0x99,
+	0x57, 0xdb, 0x38, 0xdd, 0xa5, 0x16, 0x02, 0x12, 0x58, 0xa5, 0x87, 0xae, 0xc2, 0x64, 0xa7, 0xdb,
+	0x6a, 0x61, 0xf2, 0x56, 0x97, 0xb8, 0xde, 0xab, 0xdd, 0xf6, 0x2a, 0x71, 0xa6, 0x87, 0x8e, 0x6b,
+	0x27, 0xf2, 0x81, 0xf7, 0x65, 0x25, 0x8a, 0x80, 0xe3, 0x75, 0xa8, 0xad, 0xa0, 0x14, 0x52, 0xbb,
+	0xa8, 0xc4, 0x7a, 0x27, 0x6d, 0x85, 0x95, 0x10, 0x14, 0x47, 0xb0, 0xf5, 0x1f, 0x15, 0x60, 0x3a,
+	0x98, 0x63, 0xb6, 0xa0, 0x02, 0x8f, 0x98, 0x98, 0x27, 0xad, 0xc7, 0x3c, 0x3d, 0x05, 0xc5, 0xba,
+	0xd9, 0x20, 0xae, 0x17, 0x9d, 0xee, 0x45, 0x56, 0x8a, 0x05, 0x14, 0xfd, 0xbe, 0x96, 0x74, 0x30,
+	0xba, 0x91, 0x4e, 0x76, 0x7b, 0x35, 0xae, 0x1f, 0xdb, 0xe0, 0x14, 0x40, 0xc3, 0xf4, 0x84, 0xa5,
+	0x18, 0x3d, 0x19, 0x5c, 0x95, 0x10, 0xac, 0x60, 0xa1, 0xd7, 0xa0, 0xc4, 0x26, 0xae, 0x4f, 0xa5,
+	0xcb, 0x8e, 0xc7, 0x0b, 0x3e, 0x01, 0x1c, 0xd0, 0x42, 0x97, 0x60, 0xd4, 0xb5, 0xbb, 0x4e, 0x8d,
+	0xf8, 0xed, 0xe1, 0x62, 0x79, 0x44, 0xb4, 0x67, 0xb4, 0xaa, 0x02, 0x71, 0x18, 0x17, 0x9d, 0x87,
+	0x11, 0x5e, 0xc0, 0x85, 0x97, 0xc9, 0x67, 0x29, 0x50, 0x22, 0x55, 0x05, 0x86, 0x43, 0x98, 0xbb,
+	0x36, 0x57, 0x3e, 0xcb, 0xc3, 0xb1, 0x60, 0x4e, 0x14, 0x6d, 0xb5, 0xe7, 0x62, 0x73, 0x1e, 0x46,
+	0x0c, 0x41, 0xfb, 0xe6, 0x66, 0xc7, 0x77, 0xec, 0xca, 0x3e, 0x96, 0x15, 0x18, 0x0e, 0x61, 0xa2,
+	0xf7, 0x22, 0x02, 0xc7, 0x6d, 0xc0, 0x5b, 0x59, 0x05, 0x2e, 0xa9, 0x73, 0xfd, 0x88, 0x5d, 0x48,
+	0x84, 0x06, 0xf6, 0x4e, 0x84, 0x76, 0x3d, 0x97, 0xff, 0xa1, 0xc1, 0x64, 0xd0, 0x5d, 0xb1, 0x03,
+	0x64, 0x39, 0x25, 0xb8, 0x8a, 0x21, 0x97, 0xcb, 0x12, 0x50, 0x89, 0x71, 0x9d, 0xf3, 0x6d, 0x7e,
+	0x3e, 0xa8, 0x0f, 0x39, 0x19, 0xce, 0x5c, 0x82, 0xd1, 0x10, 0x72, 0xa6, 0x2e, 0xdf, 0x05, 0xb4,
+	0x74, 0xbf, 0xe3, 0x10, 0x97, 0xb6, 0xff, 0xb6, 0xe1, 0x98, 0xc6, 0x6a, 0x8b, 0xec, 0x55, 0xd4,
+	0xe9, 0xc3, 0x22, 0x0c, 0x5e, 0x71, 0x88, 0xd9, 0x68, 0x7a, 0x07, 0x60, 0xbd, 0x3f, 0x01, 0x03,
+	0x46, 0xcb, 0x34, 0x5c, 0xb1, 0xf8, 0x65, 0x93, 0xca, 0xb4, 0x10, 0x73, 0x18, 0xba, 0x0b, 0x45,
+	0xdb, 0x31, 0x1b, 0xa6, 0xc5, 0xf6, 0x85, 0xe1, 0x53, 0xcf, 0xa7, 0x9b, 0x1f, 0xd1, 0x8b, 0x1b,
+	0xac, 0x6a, 0xb0, 0x42, 0xf9, 0x7f, 0x2c, 0x48, 0xa2, 0x3b, 0x30, 0xc8, 0x77, 0x4c, 0xdf, 0xe2,
+	0x9a, 0x4f, 0x6d, 0x31, 0x72, 0x6d, 0x14, 0x88, 0x16, 0xff, 0xef, 0x62, 0x9f, 0x20, 0xaa, 0x4a,
+	0x83, 0x91, 0xaf, 0xde, 0x67, 0x32, 0x18, 0x8c, 0x3d, 0x2d, 0xc4, 0xaa, 0xb4, 0x10, 0x07, 0xb2,
+	0x10, 0x65, 0x36, 0x60, 0x4f, 0x93, 0x70, 0x3d, 0x62, 0x12, 0x02, 0x23, 0x7d, 0x32, 0xb3, 0x49,
+	0x98, 0xca, 0x06, 0xbc, 0xab, 0xd8, 0x80, 0xc3, 0x8c, 0xd1, 0x73, 0x99, 0x6c, 0xc0, 0x87, 0x19,
+	0x7c, 0x54, 0x58, 0x84, 0x2b, 0xb9, 0xd8, 0x87, 0xb0, 0x08, 0x3f, 0xf6, 0x58, 0xd8, 0xff, 0xec,
+	0x7b, 0x9a, 0xf5, 0x0f, 0xf2, 0x30, 0x29, 0x30, 0x17, 0xec, 0x56, 0x8b, 0xd4, 0x98, 0x43, 0x87,
+	0x9b, 0x93, 0xf9, 0x44, 0x73, 0xd2, 0xf4, 0x0f, 0x72, 0xfc, 0x38, 0x52, 0xc9, 0xd4, 0x9a, 0x80,
+	0xc7, 0x1c, 0x3b, 0xbc, 0x71, 0xbd, 0x22, 0xe5, 0x4d, 0x60, 0x89, 0x23, 0x1d, 0xfa, 0x3d, 0x0d,
+	0x0e, 0x6f, 0x10, 0xc7, 0x5c, 0x33, 0x6b, 0x4c, 0x99, 0x5e, 0x33, 0x5d, 0xe6, 0x3d, 0xe4, 0x4a,
+	0xed, 0x6c, 0x3a, 0xce, 0xb7, 0x15, 0x02, 0xcb, 0xd6, 0x9a, 0x5d, 0xf9, 0xba, 0xe0, 0x76, 0xf8,
+	0x76, 0x9c, 0x34, 0x4e, 0xe2, 0x37, 0xd3, 0x01, 0x08, 0x5a, 0x9b, 0xa0, 0xd8, 0xae, 0xab, 0x6a,
+	0x28, 0x75, 0xc3, 0xfc, 0xce, 0xfa, 0x9b, 0x98, 0xaa, 0x10, 0x5f, 0x81, 0xa3, 0xfe, 0x88, 0xd1,
+	0x7d, 0xc5, 0xb4, 0xad, 0x05, 0xc7, 0xf4, 0x88, 0x63, 0x1a, 0xd4, 0x5c, 0x22, 0x52, 0x57, 0x0a,
+	0xdd, 0x28, 0x55, 0x52, 0xa0, 0x45, 0xb1, 0x82, 0xa5, 0x7f, 0xa2, 0xc1, 0xb0, 0xa0, 0x77, 0x00,
+	0x47, 0x7d, 0x1c, 0x3e, 0xea, 0x3f, 0x97, 0x69, 0x38, 0x7a, 0x9c, 0xee, 0x1d, 0x18, 0x0d, 0x69,
+	0x3f, 0x74, 0x46, 0x44, 0x7d, 0xf9, 0x00, 0xfc, 0x3f, 0x35, 0xea, 0xfb, 0x60, 0x6b, 0x76, 0x32,
+	0x84, 0x1c, 0x84, 0x82, 0x77, 0xf6, 0x3f, 0x5e, 0x1c, 0xfa, 0x93, 0x1f, 0xcc, 0x1e, 0x7a, 0xe7,
+	0xe7, 0xc7, 0x0f, 0xe9, 0x5f, 0x16, 0x60, 0x22, 0x3a, 0x49, 0x29, 0x36, 0xa5, 0x40, 0xb9, 0x0f,
+	0xed, 0xab, 0x72, 0xcf, 0xed, 0x9f, 0x72, 0xcf, 0xef, 0x87, 0x72, 0x2f, 0xec, 0x9f, 0x72, 0x2f,
+	0x1d, 0x94, 0x72, 0x87, 0x3d, 0x56, 0xee, 0xfa, 0x3f, 0x69, 0x30, 0x26, 0x65, 0x8c, 0x9d, 0xff,
+	0x14, 0xf9, 0xd1, 0xf6, 0x5e, 0x7e, 0xee, 0xc1, 0x20, 0x3f, 0x78, 0xb8, 0x42, 0x59, 0x9d, 0xce,
+	0xb6, 0x9b, 0xf0, 0xba, 0xca, 0xd9, 0x9f, 0x17, 0x60, 0x9f, 0xaa, 0xfe, 0x49, 0x4e, 0x76, 0x48,
+	0xc0, 0xf8, 0xd1, 0xc2, 0x21, 0x35, 0x4f, 0x44, 0x18, 0x95, 0xa3, 0x05, 0x2d, 0xc5, 0x02, 0x8a,
+	0x74, 0xb6, 0xd1, 0xf9, 0xde, 0xa8, 0x52, 0x05, 0xc4, 0x7e, 0xc5, 0xc4, 0x89, 0x43, 0x50, 0x07,
+	0x26, 0xfc, 0x64, 0x87, 0xaa, 0x6d, 0xac, 0x53, 0x53, 0x5c, 0x44, 0x96, 0x53, 0x6a, 0xb0, 0xc5,
+	0xae, 0xc3, 0xf4, 0x69, 0x65, 0x6a, 0x7b, 0x6b, 0x76, 0x02, 0x47, 0x68, 0xe1, 0x18, 0x75, 0x64,
+	0xc3, 0x94, 0xb1, 0x61, 0x98, 0x2d, 0x63, 0xd5, 0x6c, 0x99, 0xde, 0x66, 0xd5, 0x73, 0x0c, 0x8f,
+	0x34, 0x36, 0x85, 0x13, 0xe4, 0x92, 0x8c, 0x24, 0x24, 0xe0, 0x3c, 0xd8, 0x9a, 0xfd, 0xba, 0x18,
+	0x8b, 0x24, 0x30, 0x4e, 0x24, 0xac, 0x7f, 0x04, 0x52, 0xd7, 0x89, 0x60, 0xf2, 0x77, 0x60, 0xb8,
+	0xc6, 0x5d, 0x9b, 0xad, 0xcd, 0x65, 0x4b, 0xac, 0xce, 0xc5, 0x3e, 0xcc, 0x80, 0xb9, 0x85, 0x80,
+	0x4c, 0xe4, 0x9c, 0xa4, 0x40, 0xb0, 0xca, 0x0d, 0xbd, 0x0d, 0xc0, 0xf7, 0x44, 0x52, 0x5f, 0xb6,
+	0xc4, 0xa6, 0xbf, 0xd0, 0x0f, 0xef, 0xdb, 0x92, 0x0a, 0x67, 0x2d, 0x37, 0xad, 0x00, 0x80, 0x15,
+	0x56, 0xb4, 0xd7, 0x7e, 0x6e, 0xc6, 0x15, 0xdb, 0x11, 0xea, 0xae, 0xaf, 0x5e, 0x97, 0x03, 0x32,
+	0xd1, 0xd3, 0x61, 0x00, 0xc1, 0x2a, 0x37, 0x74, 0x8f, 0x2e, 0x7a, 0x6a, 0xde, 0x93, 0xba, 0x38,
+	0x1c, 0x9e, 0x49, 0xbb, 0xe8, 0x79, 0x2d, 0x7f, 0x3b, 0x1b, 0xe1, 0x0b, 0x9f, 0x17, 0x62, 0x49,
+	0x94, 0xf6, 0xce, 0xff, 0x4d, 0x7b, 0x57, 0xec, 0xbf, 0x77, 0x38, 0x20, 0x13, 0xe9, 0x9d, 0x02,
+	0xc1, 0x2a, 0x37, 0x64, 0x2b, 0xfb, 0x3f, 0x57, 0xcb, 0xe5, 0x7e, 0x38, 0xa7, 0x3f, 0x1d, 0x3a,
+	0x30, 0x11, 0x15, 0xbd, 0x04, 0x3b, 0xea, 0x5a, 0xd8, 0x8e, 0x3a, 0x95, 0x72, 0xab, 0x50, 0xbc,
+	0xfe, 0x6a, 0x9e, 0x9c, 0x03, 0xe3, 0x11, 0x91, 0x4b, 0x60, 0xb9, 0x1c, 0x66, 0xf9, 0x7c, 0x16,
+	0x9b, 0x52, 0xa4, 0x24, 0xa9, 0x3c, 0x5d, 0x98, 0x88, 0x0a, 0xdb, 0x9e, 0x31, 0x0d, 0xe5, 0x41,
+	0xa9, 0x4c, 0xbb, 0x30, 0x11, 0x95, 0x81, 0x04, 0xa6, 0x2f, 0x87, 0x99, 0xf6, 0x27, 0xce, 0x2a,
+	0xdb, 0xef, 0xec, 0x7c, 0xe2, 0xbf, 0x19, 0xe6, 0x79, 0x59, 0x51, 0xd1, 0x41, 0x9a, 0xec, 0x3d,
+	0x99, 0x47, 0x1b, 0x68, 0xeb, 0x10, 0x02, 0x55, 0xdb, 0x2f, 0x55, 0x6f, 0xbc, 0xaa, 0x1a, 0xc8,
+	0x7f, 0x9e, 0x87, 0x92, 0xb4, 0x69, 0xb2, 0x04, 0xa0, 0xf9, 0xd1, 0x26, 0xb7, 0x83, 0xa7, 0x3c,
+	0x9f, 0xc6, 0x53, 0x5e, 0xe8, 0xed, 0x29, 0xf7, 0x73, 0xb1, 0x8a, 0x0f, 0xcf, 0xc5, 0x52, 0x3c,
+	0xe5, 0x83, 0xe9, 0x3d, 0xe5, 0x43, 0x29, 0x3c, 0xe5, 0x89, 0xae, 0xec, 0xd2, 0x9e, 0xb8, 0xb2,
+	0x21, 0x93, 0x2b, 0xfb, 0x23, 0x0d, 0x50, 0x3c, 0x16, 0x95, 0x65, 0xc6, 0x8c, 0xa8, 0xc9, 0x7b,
+	0x36, 0xab, 0x37, 0x6b, 0x27, 0xcb, 0x57, 0x77, 0xe0, 0xc8, 0x55, 0xd3, 0xbb, 0xd6, 0x5d, 0x7d,
+	0x8d, 0xac, 0x36, 0x6d, 0x7b, 0x1d, 0x93, 0x1a, 0x31, 0x37, 0x88, 0x83, 0x5e, 0x87, 0x92, 0x4b,
+	0x6a, 0x0e, 0xa1, 0x07, 0x00, 0x61, 0x8e, 0x9d, 0x50, 0x84, 0x78, 0xae, 0x66, 0x3b, 0x84, 0x9d,
+	0x8b, 0xec, 0x9a, 0xd1, 0xe2, 0xfe, 0x20, 0x79, 0x54, 0x08, 0x66, 0xa8, 0xea, 0x93, 0xc0, 0x01,
+	0x35, 0xfd, 0x4f, 0x35, 0x98, 0xba, 0x6a, 0x7a, 0xca, 0xf0, 0x5d, 0x31, 0x5b, 0x74, 0xea, 0x9e,
+	0x85, 0x21, 0xba, 0xd0, 0xcd, 0x7a, 0x3c, 0x41, 0x75, 0x45, 0x94, 0x63, 0x89, 0x41, 0x8f, 0x83,
+	0xab, 0xd4, 0xc8, 0x54, 0x23, 0x3c, 0x72, 0x67, 0xad, 0x48, 0x08, 0x56, 0xb0, 0xa8, 0xa1, 0x25,
+	0xf2, 0xad, 0xf3, 0x81, 0xa1, 0x15, 0x4e, 0x92, 0xd6, 0xff, 0x72, 0x08, 0xc6, 0xaf, 0x9a, 0x7d,
+	0xe7, 0x79, 0x78, 0x70, 0x94, 0x0f, 0x6e, 0x95, 0x88, 0x03, 0xbf, 0x34, 0x9c, 0x78, 0x1b, 0x2f,
+	0x8a, 0xaa, 0x47, 0x17, 0x92, 0xd1, 0x1e, 0xf4, 0x06, 0xe1, 0x5e, 0xa4, 0x53, 0x2f, 0xe0, 0x4b,
+	0x30, 0xca, 0x7f, 0xad, 0x18, 0x74, 0xb5, 0x58, 0xd3, 0xa3, 0x61, 0x2f, 0x7f, 0x45, 0x05, 0xe2,
+	0x30, 0x2e, 0xed, 0x1a, 0x2f, 0x88, 0x77, 0x6d, 0x2c, 0xdc, 0xb5, 0x4a, 0x32, 0xda, 0x83, 0xde,
+	0x20, 0xdc, 0x8b, 0x34, 0x5a, 0x84, 0x89, 0x35, 0xae, 0x83, 0x57, 0x88, 0x23, 0x66, 0x7b, 0x9c,
+	0x99, 0xd3, 0x32, 0x2d, 0xe6, 0x4a, 0x04, 0x8e, 0x63, 0x35, 0x58, 0x78, 0xc3, 0x73, 0xcc, 0x9a,
+	0xc7, 0x53, 0x68, 0xdc, 0xe9, 0x61, 0x46, 0x22, 0x08, 0x6f, 0xa8, 0x40, 0x1c, 0xc6, 0x4d, 0xcc,
+	0xcc, 0x29, 0x64, 0xce, 0xcc, 0x99, 0x87, 0x92, 0xd1, 0x6a, 0xd9, 0x6f, 0xdf, 0x34, 0x1a, 0xae,
+	0x88, 0x0f, 0x06, 0x49, 0xb3, 0x3e, 0x00, 0x07, 0x38, 0x68, 0x0e, 0xc0, 0x6c, 0x58, 0xb6, 0x43,
+	0x58, 0x8d, 0x22, 0x93, 0x58, 0x96, 0xc5, 0xbb, 0x2c, 0x4b, 0xb1, 0x82, 0x81, 0xaa, 0x70, 0xc4,
+	0xb4, 0x5c, 0x52, 0xeb, 0x3a, 0xa4, 0xba, 0x6e, 0x76, 0x6e, 0x5e, 0xaf, 0xb2, 0xed, 0x7a, 0x93,
+	0xa9, 0xd8, 0xa1, 0xca, 0xe3, 0x82, 0xd9, 0x91, 0xe5, 0x24, 0x24, 0x9c, 0x5c, 0x17, 0x9d, 0x86,
+	0x11, 0xd3, 0x62, 0x09, 0xca, 0x2b, 0x86, 0xd7, 0x74, 0xa7, 0x87, 0x58, 0x33, 0x26, 0xe8, 0xd1,
+	0x71, 0x59, 0x29, 0xc7, 0x21, 0x2c, 0x5a, 0x4b, 0xa4, 0x35, 0xf3, 0x5a, 0xa5, 0xa0, 0xd6, 0xd2,
+	0x7d, 0xb5, 0x96, 0x8a, 0x95, 0x90, 0x69, 0x04, 0x59, 0x32, 0x8d, 0x50, 0x07, 0x46, 0x14, 0x25,
+	0xec, 0x4e, 0x8f, 0x30, 0xbd, 0x75, 0x31, 0xb5, 0xa3, 0x20, 0xa6, 0x92, 0x78, 0x8b, 0x95, 0x62,
+	0x17, 0x87, 0x38, 0xe8, 0x1f, 0xe7, 0xa0, 0xc8, 0x73, 0x72, 0xd1, 0x99, 0x48, 0xe2, 0xeb, 0xe3,
+	0xb1, 0xc4, 0xd7, 0xe1, 0xa4, 0xfc, 0x65, 0x1d, 0x8a, 0xa6, 0xeb, 0x76, 0xc3, 0x67, 0xbf, 0x65,
+	0x56, 0x82, 0x05, 0x84, 0x65, 0x12, 0xd8, 0xd6, 0x9a, 0xd9, 0x10, 0x11, 0xbf, 0x5d, 0x9a, 0x13,
+	0x9c, 0xc7, 0x02, 0xa3, 0x88, 0x05, 0x65, 0xca, 0xc3, 0xee, 0x7a, 0x9d, 0xae, 0x1f, 0x12, 0xda,
+	0x13, 0x1e, 0x37, 0x18, 0x45, 0x2c, 0x28, 0xeb, 0xdf, 0xd7, 0x60, 0x9c, 0x8f, 0xc1, 0x42, 0x93,
+	0xd4, 0xd6, 0xab, 0x1e, 0xe9, 0xa0, 0xe3, 0x50, 0xe8, 0xba, 0xc4, 0x8d, 0xba, 0x95, 0x6e, 0xb9,
+	0xc4, 0xc5, 0x0c, 0xa2, 0xf4, 0x3e, 0xb7, 0x5f, 0xbd, 0xd7, 0xcf, 0x83, 0x32, 0x39, 0x2c, 0xa9,
+	0x9c, 0xe7, 0x56, 0x73, 0xa3, 0x2e, 0x1f, 0xe8, 0x7b, 0x8e, 0xb5, 0x89, 0x7d, 0x38, 0xcb, 0x0d,
+	0x66, 0x9e, 0x9f, 0x2c, 0x9b, 0x44, 0x38, 0xf2, 0x9b, 0x4b, 0x15, 0xf9, 0xdd, 0x21, 0x4b, 0x21,
+	0x08, 0x63, 0x16, 0x1e, 0x1a, 0xc6, 0xdc, 0x4d, 0x56, 0x30, 0xeb, 0x67, 0x3f, 0x21, 0xc7, 0xff,
+	0xa3, 0xc1, 0xe5, 0x5f, 0x68, 0x30, 0x95, 0x94, 0xf2, 0x93, 0x65, 0xaa, 0xa9, 0x51, 0xd3, 0x32,
+	0xbc, 0x35, 0xdb, 0x69, 0x47, 0x33, 0xda, 0x57, 0x44, 0x39, 0x96, 0x18, 0xc8, 0x01, 0x70, 0x7c,
+	0x33, 0xca, 0xf7, 0x46, 0x5e, 0xde, 0x5d, 0x66, 0x82, 0x9a, 0x6c, 0xec, 0x53, 0xc6, 0x0a, 0x17,
+	0xfd, 0x27, 0x03, 0x30, 0xc9, 0xaa, 0xf4, 0x6b, 0xf2, 0xf4, 0x23, 0xcd, 0x1d, 0x78, 0x8c, 0xf9,
+	0x49, 0xe3, 0xa6, 0x04, 0x17, 0xf0, 0xf3, 0xa2, 0xfe, 0x63, 0xcb, 0x89, 0x58, 0x0f, 0x7a, 0x42,
+	0x70, 0x0f, 0xba, 0x71, 0x0b, 0x00, 0xbe, 0x7a, 0x16, 0x80, 0x2a, 0x6c, 0x83, 0x3b, 0x0a, 0x5b,
+	0x4f, 0x7b, 0x61, 0x68, 0x17, 0xf6, 0x42, 0x7c, 0x0f, 0x2f, 0x65, 0xda, 0xc3, 0x17, 0x61, 0x22,
+	0x88, 0xdf, 0xf0, 0x5d, 0x98, 0xd9, 0x6a, 0xca, 0x48, 0x2f, 0x45, 0xe0, 0x38, 0x56, 0x43, 0xff,
+	0xcf, 0x1c, 0x0c, 0x2b, 0x9e, 0xed, 0x2c, 0xd2, 0x2c, 0xf4, 0x6c, 0x6e, 0x47, 0x3d, 0x9b, 0xcf,
+	0x94, 0x2e, 0x52, 0x48, 0x9d, 0x2e, 0xb2, 0x99, 0xa4, 0xa1, 0x2b, 0x99, 0x5d, 0xfc, 0xfd, 0x5c,
+	0xce, 0xdc, 0xad, 0xc2, 0xfc, 0xa5, 0x06, 0x33, 0xbd, 0xb3, 0x0a, 0xb3, 0xcc, 0x42, 0x74, 0xf8,
+	0x72, 0xa9, 0x87, 0xef, 0x7e, 0x82, 0x0a, 0x5d, 0xdc, 0x8b, 0x5c, 0x9b, 0x1d, 0x15, 0xe9, 0xbf,
+	0x16, 0xe0, 0xa8, 0x52, 0xb1, 0x5f, 0x75, 0x6a, 0xc0, 0xa4, 0xdb, 0xe3, 0xec, 0xf8, 0xbc, 0xef,
+	0xc1, 0xc8, 0xa2, 0x10, 0xe3, 0xd4, 0xe2, 0xba, 0x30, 0xff, 0xd5, 0xd3, 0x85, 0x51, 0x09, 0x1a,
+	0x4c, 0x2d, 0x41, 0x8f, 0xa2, 0x5e, 0xd4, 0xff, 0x2c, 0x07, 0x83, 0x2b, 0x8e, 0xcd, 0xd2, 0x4c,
+	0xf7, 0x3f, 0x99, 0xe7, 0x56, 0x9f, 0xa9, 0xf8, 0x94, 0x14, 0x37, 0xad, 0x59, 0x2a, 0xfe, 0x50,
+	0x38, 0x0d, 0x5f, 0xc9, 0xe8, 0xc8, 0x67, 0x71, 0x00, 0x0b, 0xc2, 0x3b, 0x64, 0x74, 0xfc, 0x55,
+	0x0e, 0x46, 0x43, 0x4d, 0x78, 0x84, 0xaf, 0x2c, 0x44, 0xc6, 0x29, 0xe1, 0xca, 0x02, 0x32, 0x22,
+	0x63, 0x75, 0xa1, 0x1f, 0xe2, 0x0f, 0x1f, 0xb1, 0x7f, 0xd0, 0x60, 0x32, 0x84, 0x7f, 0x00, 0x39,
+	0x12, 0xdf, 0x0c, 0xe7, 0x48, 0x3c, 0xdf, 0x47, 0xaf, 0x7a, 0x64, 0x4a, 0xbc, 0x9b, 0x8b, 0xf4,
+	0x86, 0x0e, 0x26, 0xfa, 0x2d, 0x98, 0xec, 0xf8, 0x97, 0x28, 0xd8, 0x5d, 0x73, 0x93, 0xf8, 0x19,
+	0x3c, 0x67, 0x32, 0xde, 0x30, 0xe1, 0x57, 0xd5, 0x15, 0x37, 0x72, 0x94, 0x2e, 0x8e, 0xb3, 0x42,
+	0x2e, 0x94, 0x1c, 0xe1, 0x54, 0xf5, 0xfb, 0x9c, 0xf2, 0x2a, 0x70, 0xc4, 0x25, 0x2b, 0xfa, 0x2e,
+	0x75, 0x6c, 0x04, 0xcc, 0xee, 0xba, 0x8a, 0x9f, 0xfa, 0xbf, 0x6b, 0x70, 0x38, 0x41, 0x10, 0x50,
+	0x0d, 0xa0, 0x66, 0x5b, 0x75, 0x93, 0x5b, 0x16, 0x9a, 0xc8, 0xa3, 0x48, 0x35, 0xb9, 0x0b, 0x7e,
+	0xbd, 0x60, 0x45, 0xc8, 0x22, 0x17, 0x2b, 0x64, 0x51, 0x3b, 0xde, 0xe3, 0x33, 0x7d, 0xf5, 0x38,
+	0x5d, 0x5f, 0x3f, 0xd1, 0x60, 0x58, 0xf4, 0xf5, 0x91, 0x4d, 0xf1, 0x11, 0xed, 0xeb, 0x21, 0xb8,
+	0x5f, 0x68, 0x30, 0xa2, 0xa8, 0x38, 0x17, 0x35, 0x01, 0xde, 0x36, 0x1c, 0xd2, 0xb4, 0xa5, 0x67,
+	0x24, 0x75, 0xba, 0xc2, 0x6b, 0x7e, 0x3d, 0x46, 0x29, 0x98, 0x2b, 0x59, 0xee, 0x62, 0x85, 0x36,
+	0xfa, 0xa6, 0x92, 0x79, 0xc0, 0xf5, 0x63, 0x2a, 0x2e, 0x2c, 0x12, 0xc7, 0x39, 0xa8, 0xba, 0x45,
+	0xc9, 0x57, 0xd0, 0x3f, 0xd3, 0xa4, 0x36, 0x4e, 0x14, 0xbe, 0xfc, 0xfe, 0x08, 0x5f, 0x15, 0x06,
+	0xa8, 0x72, 0xf3, 0x2f, 0xc0, 0x9f, 0xca, 0xbc, 0xc1, 0xb8, 0xe2, 0x12, 0x14, 0xfd, 0x89, 0x39,
+	0x2d, 0xfd, 0x87, 0x39, 0x28, 0xc9, 0xc5, 0x7e, 0xe0, 0xbb, 0xef, 0xf3, 0x19, 0xd5, 0x54, 0xcf,
+	0x1d, 0xe5, 0xcd, 0xc8, 0x8e, 0x92, 0x55, 0xff, 0xed, 0xb0, 0x9b, 0xfc, 0x1d, 0x9f, 0x71, 0x8e,
+	0x7b, 0x00, 0x4b, 0xf1, 0x66, 0x78, 0x29, 0xce, 0x67, 0xec, 0x4d, 0x8f, 0xc5, 0xf8, 0x4e, 0x0e,
+	0xc6, 0x23, 0x1a, 0x1f, 0x3d, 0xc1, 0x84, 0xaa, 0xe1, 0xe7, 0xbe, 0xc9, 0x8a, 0x22, 0x20, 0xcd,
+	0x60, 0x68, 0x83, 0xda, 0xd4, 0xd2, 0x00, 0xb7, 0x1d, 0x31, 0xc8, 0x2f, 0xf6, 0xb5, 0xc9, 0xf8,
+	0x44, 0xf8, 0xdb, 0x23, 0x55, 0x95, 0x2e, 0x0e, 0xb3, 0x61, 0x17, 0x7e, 0xbb, 0x9e, 0x2d, 0x09,
+	0x88, 0xd7, 0x0b, 0x98, 0xf0, 0x28, 0x6f, 0x8f, 0x94, 0x13, 0x70, 0x70, 0x62, 0x4d, 0xfd, 0x2f,
+	0x34, 0x38, 0xda, 0xa3, 0x3d, 0x29, 0xb2, 0x00, 0x5b, 0x30, 0xca, 0x22, 0x69, 0x72, 0x1c, 0x7c,
+	0x29, 0x4e, 0x37, 0xf3, 0x6a, 0x55, 0xde, 0xfb, 0x50, 0x11, 0x0e, 0x13, 0xd7, 0x3f, 0xcf, 0x01,
+	0x92, 0x6d, 0xcd, 0x92, 0xac, 0xf8, 0x26, 0x0c, 0x8a, 0x20, 0xd1, 0xee, 0x92, 0x57, 0x2b, 0xc3,
+	0x6a, 0xfe, 0xae, 0x4f, 0x13, 0xbd, 0xbe, 0x37, 0x6b, 0x0d, 0xe2, 0xeb, 0x0c, 0xdd, 0x01, 0x58,
+	0x33, 0x2d, 0xd3, 0x6d, 0xf6, 0x79, 0x07, 0x88, 0x1d, 0x9a, 0xae, 0x48, 0x0a, 0x58, 0xa1, 0xa6,
+	0xff, 0x71, 0x4e, 0x59, 0xc3, 0xcc, 0x7e, 0x4a, 0x25, 0xfb, 0x4f, 0x87, 0x07, 0xb3, 0x14, 0x4f,
+	0x6c, 0x96, 0x03, 0x73, 0x07, 0x0a, 0x1b, 0x86, 0xe3, 0x27, 0x45, 0xa6, 0xbc, 0xd3, 0x19, 0xbf,
+	0x23, 0x11, 0xcc, 0xe9, 0x6d, 0xc3, 0x71, 0x31, 0xa3, 0x49, 0x6d, 0x4b, 0xd7, 0x23, 0x1d, 0x7f,
+	0x73, 0xc9, 0xac, 0x38, 0x3d, 0xd2, 0x51, 0x3b, 0x48, 0x3a, 0x6c, 0x07, 0x20, 0x1d, 0x57, 0xff,
+	0x60, 0x50, 0xd1, 0x0a, 0x62, 0x3f, 0x7b, 0x09, 0x50, 0xcb, 0x70, 0xbd, 0x6b, 0x86, 0x55, 0xa7,
+	0x6b, 0x89, 0xac, 0x39, 0xc4, 0x6d, 0x8a, 0x93, 0xf0, 0x8c, 0xa0, 0x82, 0xae, 0xc7, 0x30, 0x70,
+	0x42, 0x2d, 0x74, 0xc6, 0x7f, 0x2e, 0x8a, 0x8f, 0xf2, 0x6c, 0xe8, 0xb9, 0xa8, 0x07, 0x5b, 0xb3,
+	0x63, 0xc1, 0x7a, 0x54, 0x1e, 0x90, 0xca, 0xf0, 0xf8, 0x8d, 0x2a, 0xef, 0x03, 0xfb, 0x20, 0xef,
+	0xdf, 0x85, 0xc9, 0xb5, 0x68, 0xa6, 0xbb, 0xb8, 0xa6, 0x78, 0xae, 0xcf, 0x44, 0xf9, 0xca, 0x91,
+	0xed, 0x20, 0x9f, 0x39, 0x28, 0xc6, 0x71, 0x46, 0xc8, 0xf6, 0x9f, 0xdc, 0x61, 0x71, 0x25, 0x1e,
+	0xa4, 0x4c, 0xbd, 0xe6, 0x22, 0x11, 0xa9, 0xe8, 0x63, 0x3b, 0x9c, 0x24, 0x0e, 0x31, 0x88, 0xac,
+	0xc1, 0xe2, 0x5e, 0xae, 0x41, 0x74, 0x46, 0x26, 0x3d, 0xd2, 0xe6, 0x88, 0xdc, 0x95, 0x68, 0xba,
+	0x22, 0x05, 0x61, 0x15, 0x0f, 0xbd, 0xaf, 0xc1, 0x11, 0x2a, 0xac, 0x4b, 0xf7, 0x49, 0xad, 0xeb,
+	0x29, 0xcf, 0x16, 0x88, 0x8b, 0x19, 0x97, 0xd2, 0x9a, 0x76, 0x09, 0x24, 0x02, 0x9f, 0x47, 0x22,
+	0x18, 0x27, 0x33, 0x46, 0xf7, 0xb8, 0x31, 0x46, 0x98, 0xab, 0x7d, 0xf7, 0x81, 0x3b, 0x69, 0x98,
+	0x71, 0xbd, 0xe3, 0x11, 0xfd, 0x87, 0x05, 0x55, 0x5d, 0xa5, 0x0b, 0x27, 0xde, 0x81, 0x82, 0x67,
+	0xb8, 0xeb, 0x62, 0x15, 0xbc, 0xd0, 0xc7, 0x2b, 0x03, 0xc1, 0x5a, 0x60, 0xfe, 0x0d, 0x56, 0xc4,
+	0x68, 0xa2, 0x19, 0xc8, 0x19, 0x6e, 0x34, 0xc7, 0xaa, 0xec, 0xe2, 0x9c, 0xe1, 0xb2, 0xfc, 0xab,
+	0x35, 0xe1, 0x85, 0x0a, 0xf2, 0xaf, 0xd6, 0x70, 0xce, 0x5c, 0x43, 0x65, 0x18, 0xaf, 0xd9, 0x96,
+	0x67, 0x5a, 0x5d, 0x72, 0xc3, 0x5a, 0x72, 0x1c, 0xdb, 0x11, 0xbe, 0xa6, 0xa3, 0x02, 0x71, 0x7c,
+	0x21, 0x0c, 0xc6, 0x51, 0x7c, 0xf4, 0x3a, 0x0c, 0x38, 0xc4, 0x73, 0x36, 0xc5, 0x86, 0x70, 0xbe,
+	0x0f, 0xdd, 0x87, 0x69, 0x7d, 0x3e, 0xca, 0xec, 0x27, 0xe6, 0x14, 0xa5, 0xca, 0x2e, 0xee, 0x83,
+	0xca, 0x0e, 0x82, 0xbb, 0xf9, 0x7d, 0x0b, 0xee, 0x7e, 0xac, 0x29, 0x36, 0x82, 0xec, 0x28, 0xba,
+	0x05, 0x83, 0x9e, 0xd9, 0x26, 0x76, 0xd7, 0xcb, 0x66, 0x9c, 0xca, 0x44, 0x6a, 0xa6, 0x09, 0x6f,
+	0x72, 0x12, 0xd8, 0xa7, 0x85, 0x2e, 0xc3, 0x18, 0xa1, 0x33, 0x72, 0xb3, 0x49, 0x35, 0xbb, 0xdd,
+	0xe2, 0x96, 0xd8, 0x68, 0xe0, 0xe8, 0x5b, 0x0a, 0x41, 0x71, 0x04, 0x9b, 0xbd, 0xfc, 0xf6, 0x15,
+	0x7a, 0x79, 0x43, 0xf8, 0x98, 0x0e, 0xf4, 0xc9, 0x8d, 0xbe, 0x7d, 0x4c, 0x3b, 0xbe, 0xb5, 0xf1,
+	0x06, 0x3c, 0x96, 0xac, 0x0a, 0xf6, 0xe4, 0xb9, 0xc6, 0xcf, 0xa2, 0x63, 0xc5, 0x2c, 0x30, 0x7f,
+	0xf9, 0x69, 0xfb, 0x69, 0x31, 0xe5, 0xf6, 0xda, 0x62, 0x72, 0xd4, 0xae, 0x88, 0xc7, 0x2d, 0xd1,
+	0x9b, 0x42, 0xce, 0xb4, 0x2c, 0x4f, 0xe2, 0xc5, 0xc8, 0xf4, 0x94, 0xb5, 0x7f, 0xd4, 0xe0, 0x48,
+	0x22, 0xb6, 0x1c, 0xc3, 0xdc, 0x7e, 0x8e, 0xa1, 0xb6, 0xd7, 0x63, 0xf8, 0x99, 0x06, 0xe3, 0x91,
+	0x3c, 0x64, 0xf4, 0x14, 0x14, 0x1d, 0x62, 0xb8, 0xf2, 0x06, 0x9c, 0x3c, 0x8d, 0x63, 0x56, 0x8a,
+	0x05, 0x94, 0x3f, 0x3b, 0xc6, 0xab, 0x56, 0x36, 0xa3, 0x41, 0x79, 0x2c, 0x21, 0x58, 0xc1, 0xa2,
+	0x56, 0x8d, 0xff, 0xaf, 0xec, 0x09, 0x85, 0x9c, 0xd9, 0xaa, 0xc1, 0x92, 0x02, 0x56, 0xa8, 0xe9,
+	0xbf, 0xd4, 0x60, 0xd0, 0xbf, 0xd2, 0xfd, 0x38, 0xe4, 0xbb, 0x4e, 0x2b, 0x7a, 0x23, 0xff, 0x16,
+	0xbe, 0x8e, 0x69, 0x79, 0x96, 0xc7, 0xd5, 0x4c, 0x45, 0x8f, 0xe4, 0xb3, 0x98, 0x39, 0x07, 0x7c,
+	0xcf, 0xfb, 0x23, 0x0d, 0x66, 0x7a, 0x3f, 0x7b, 0xb2, 0xd3, 0x80, 0x10, 0xe5, 0x22, 0x16, 0x97,
+	0xe0, 0x73, 0x7d, 0xde, 0x6b, 0x7f, 0xe8, 0x95, 0xac, 0x3b, 0x30, 0xa9, 0xb4, 0xf1, 0x1a, 0x31,
+	0xea, 0xc4, 0xd9, 0xab, 0xbb, 0xe8, 0x6f, 0xc3, 0x61, 0x85, 0xb6, 0xb4, 0x10, 0x77, 0xa6, 0x7e,
+	0x19, 0xc6, 0xd6, 0x1c, 0xbb, 0x1d, 0xac, 0x45, 0xc1, 0x46, 0x6e, 0xa7, 0x57, 0x42, 0x50, 0x1c,
+	0xc1, 0xd6, 0x3f, 0x2c, 0xc2, 0x51, 0x85, 0x73, 0x28, 0x28, 0xbb, 0xc3, 0xb0, 0xaf, 0xb2, 0x2c,
+	0xb0, 0x7a, 0xe0, 0xc6, 0x3e, 0x97, 0xf9, 0x7d, 0x1b, 0x3e, 0x88, 0xa1, 0xf4, 0x31, 0x4a, 0x0f,
+	0xfb, 0x84, 0x7b, 0xc7, 0x1a, 0xf3, 0xbb, 0x88, 0x35, 0xde, 0x86, 0xc7, 0xfc, 0x47, 0xf7, 0xc2,
+	0xa3, 0x23, 0x4e, 0xa7, 0xc7, 0xfc, 0xe4, 0x9a, 0xdb, 0x89, 0x58, 0xb8, 0x47, 0x6d, 0xd4, 0x50,
+	0x56, 0x1b, 0x4f, 0x4b, 0xb8, 0x90, 0x79, 0x44, 0xd2, 0xbc, 0xb6, 0x87, 0x1a, 0x49, 0x21, 0x70,
+	0x9e, 0x33, 0x76, 0xe1, 0x61, 0x21, 0xf0, 0x6f, 0xa8, 0x33, 0x9d, 0x26, 0x10, 0x9e, 0x14, 0xcb,
+	0x1e, 0xcc, 0x1c, 0xcb, 0xbe, 0x04, 0xa3, 0x2c, 0x4e, 0xed, 0x0f, 0xa7, 0xb8, 0xa8, 0x20, 0xc3,
+	0xe9, 0x65, 0x15, 0x88, 0xc3, 0xb8, 0xe8, 0x22, 0x8c, 0xf1, 0xa8, 0xb5, 0xac, 0x5d, 0x0a, 0x1e,
+	0x2c, 0x5e, 0x0e, 0x41, 0x70, 0x04, 0x73, 0xb7, 0x09, 0xb3, 0xfa, 0x7f, 0xe5, 0x61, 0x02, 0x93,
+	0x8e, 0x1d, 0x5a, 0x15, 0x2b, 0xfe, 0xa3, 0x5b, 0x19, 0xfc, 0x56, 0x91, 0x84, 0xf9, 0xca, 0x60,
+	0xe8, 0xb5, 0x2d, 0x6a, 0x8f, 0xb5, 0x7d, 0x27, 0x45, 0xea, 0x65, 0x14, 0xcb, 0x49, 0xe3, 0x47,
+	0x13, 0x9e, 0xdd, 0xc6, 0x09, 0x52, 0xca, 0xec, 0x66, 0xac, 0xd8, 0xac, 0xce, 0x65, 0xb8, 0x63,
+	0x1b, 0xa7, 0xcc, 0x8a, 0x31, 0x27, 0x88, 0x3a, 0x30, 0xac, 0x5c, 0x86, 0x15, 0xa7, 0xaa, 0x17,
+	0x33, 0x67, 0xe1, 0x84, 0xb8, 0xb0, 0x47, 0x98, 0xd4, 0xd4, 0x12, 0x95, 0x05, 0xe5, 0xe8, 0x04,
+	0xe2, 0x2b, 0xce, 0xa7, 0x2f, 0x66, 0x5e, 0x60, 0x71, 0x8e, 0x0a, 0x10, 0xab, 0x2c, 0xf4, 0xef,
+	0xe7, 0x80, 0xfb, 0xf1, 0x0e, 0xe0, 0x88, 0xf1, 0x1b, 0xa1, 0x23, 0xc6, 0x7c, 0x96, 0x38, 0x53,
+	0xaf, 0x78, 0x46, 0xd4, 0xc7, 0x7a, 0x32, 0x63, 0xf0, 0xea, 0x21, 0xb1, 0x8c, 0xbf, 0xd1, 0xa0,
+	0xc4, 0xf0, 0x0e, 0xe0, 0xb4, 0xb2, 0x12, 0x3e, 0xad, 0x3c, 0x93, 0xa1, 0x17, 0x3d, 0x4e, 0x29,
+	0x9f, 0x0f, 0x88, 0xd6, 0x4b, 0x0f, 0x6e, 0xd3, 0x70, 0xea, 0x42, 0xf9, 0x07, 0xa6, 0x26, 0x2d,
+	0xc4, 0x1c, 0x26, 0x0d, 0xe4, 0xc1, 0x7d, 0x30, 0x90, 0xbf, 0xcd, 0xaf, 0x2e, 0x13, 0x37, 0x30,
+	0x63, 0xc5, 0xf6, 0x71, 0x3a, 0xa3, 0x0f, 0x92, 0x11, 0x09, 0x54, 0x33, 0x8e, 0x50, 0xc5, 0x31,
+	0x3e, 0xe8, 0xbb, 0x4a, 0xf8, 0xdf, 0x3f, 0x11, 0x08, 0x7f, 0xdd, 0xb9, 0x3e, 0x8f, 0x1f, 0xdc,
+	0x2f, 0x19, 0x2b, 0xc6, 0x71, 0x46, 0xa8, 0x09, 0x23, 0xea, 0xb3, 0x1a, 0x42, 0x4e, 0x4f, 0x65,
+	0x7f, 0xbf, 0x83, 0x5f, 0x44, 0x50, 0x4b, 0x70, 0x88, 0x32, 0xea, 0xc0, 0x98, 0x11, 0x7a, 0x51,
+	0x5f, 0xbc, 0xc1, 0x70, 0x3a, 0xdb, 0x33, 0xee, 0x22, 0xc5, 0x81, 0xed, 0x3d, 0xe1, 0x32, 0x1c,
+	0xa1, 0x4f, 0xfb, 0x66, 0x28, 0xef, 0x69, 0x8b, 0x07, 0x7d, 0x52, 0xf6, 0x4d, 0x7d, 0x89, 0x9b,
+	0xf7, 0x4d, 0x2d, 0xc1, 0x21, 0xca, 0xfa, 0x7b, 0x1a, 0x40, 0x10, 0x71, 0xa6, 0xf2, 0x5c, 0xb3,
+	0xbb, 0x16, 0x0f, 0x35, 0xe4, 0x03, 0x79, 0x5e, 0xa0, 0x85, 0x98, 0xc3, 0xa8, 0x6e, 0xe0, 0x0e,
+	0x5b, 0xb1, 0x60, 0x4f, 0x66, 0xf1, 0x05, 0x47, 0x22, 0xdb, 0xbc, 0x10, 0x0b, 0x82, 0xfa, 0x3b,
+	0x45, 0x18, 0x56, 0x74, 0x48, 0x24, 0xae, 0x3d, 0xba, 0x3f, 0x71, 0xed, 0xe4, 0x60, 0xc3, 0x70,
+	0x5f, 0xc1, 0x06, 0x97, 0x9a, 0xd4, 0x6c, 0x79, 0xf8, 0xef, 0xca, 0x14, 0xb2, 0x98, 0xb7, 0x71,
+	0x47, 0x3d, 0xe2, 0x76, 0xb8, 0x4a, 0x12, 0x47, 0x58, 0x70, 0x3b, 0x9e, 0xdf, 0xa1, 0xee, 0xb6,
+	0xdb, 0x86, 0xb3, 0xc9, 0x6e, 0xe7, 0x84, 0xec, 0x78, 0x15, 0x8a, 0x23, 0xd8, 0x68, 0x45, 0x4e,
+	0x28, 0x17, 0xec, 0x67, 0xb3, 0x4c, 0x28, 0x77, 0x0b, 0x86, 0xe7, 0x91, 0x0e, 0xa9, 0xbd, 0xca,
+	0xbc, 0x8a, 0xf5, 0xab, 0xfc, 0x6b, 0x30, 0x74, 0x89, 0x16, 0x99, 0x50, 0xc9, 0x21, 0xbd, 0x11,
+	0xc3, 0xc0, 0x09, 0xb5, 0xa8, 0x8a, 0x13, 0xbe, 0x78, 0xa9, 0x17, 0x44, 0xf4, 0x23, 0xab, 0x23,
+	0x36, 0x70, 0x2e, 0xb3, 0x77, 0x1a, 0x16, 0x22, 0x54, 0x71, 0x8c, 0x0f, 0x7a, 0x0b, 0x46, 0xe9,
+	0x24, 0x07, 0x8c, 0x61, 0x97, 0x8c, 0x45, 0xd4, 0x55, 0x21, 0x89, 0xc3, 0x1c, 0xf4, 0x2f, 0xf2,
+	0x90, 0x1c, 0x09, 0x08, 0x5e, 0x01, 0xd3, 0x1e, 0xf2, 0x0a, 0xd8, 0x6b, 0x50, 0x72, 0x3d, 0xc3,
+	0xf1, 0xfa, 0xfc, 0xb4, 0x08, 0x7b, 0x81, 0xae, 0xea, 0x13, 0xc0, 0x01, 0xad, 0x48, 0x58, 0x26,
+	0xbf, 0xa7, 0x61, 0x99, 0x53, 0x00, 0xcc, 0x53, 0xcb, 0xd4, 0x0c, 0xdb, 0x4b, 0x47, 0x95, 0xe7,
+	0x87, 0x24, 0x04, 0x2b, 0x58, 0xe8, 0x45, 0x69, 0xa1, 0xf0, 0x0c, 0xd7, 0xff, 0x1f, 0xbb, 0x13,
+	0x76, 0x38, 0xe4, 0x07, 0x8a, 0x44, 0x7a, 0x33, 0xdc, 0xa7, 0x4e, 0x88, 0x20, 0x0c, 0x66, 0x8b,
+	0x20, 0xe8, 0x5f, 0x68, 0x30, 0xc1, 0xbc, 0xe3, 0x5d, 0xcb, 0x22, 0xce, 0x4a, 0xab, 0xdb, 0x30,
+	0x0f, 0x22, 0xd9, 0xe5, 0x8d, 0x90, 0x61, 0x78, 0x31, 0x7d, 0x94, 0x4a, 0x6d, 0x67, 0x4f, 0x97,
+	0xe0, 0x4f, 0x34, 0x98, 0x8a, 0x22, 0x1f, 0x80, 0x4d, 0x77, 0x37, 0x6c, 0xd3, 0x9d, 0xed, 0xaf,
+	0x57, 0x3d, 0xcc, 0xbb, 0xbf, 0xcd, 0xc5, 0xfb, 0xc4, 0x2c, 0xbd, 0x1d, 0xfc, 0x19, 0x3d, 0x7d,
+	0x0d, 0xb9, 0x5d, 0xf8, 0x1a, 0x66, 0xd5, 0x98, 0x7b, 0xc9, 0x8f, 0xd1, 0x05, 0x4e, 0x4c, 0x35,
+	0xcc, 0x52, 0xd8, 0xd7, 0x30, 0xcb, 0x40, 0xa6, 0x30, 0xcb, 0x7f, 0xe7, 0x20, 0x64, 0x4f, 0xa1,
+	0x77, 0x35, 0x98, 0x34, 0x22, 0x5f, 0x63, 0xf2, 0x7d, 0xba, 0xbf, 0x96, 0xed, 0x13, 0x59, 0xb1,
+	0x8f, 0x39, 0x05, 0x39, 0xa3, 0x51, 0x14, 0x17, 0xc7, 0x99, 0xa2, 0xdf, 0xd5, 0xe0, 0xb0, 0x11,
+	0xff, 0xdc, 0x96, 0x58, 0x22, 0x17, 0xfa, 0xfe, 0x5e, 0x57, 0xe5, 0xe8, 0xf6, 0xd6, 0x6c, 0xd2,
+	0x87, 0xc8, 0x70, 0x12, 0x3b, 0x74, 0x17, 0x0a, 0x86, 0xd3, 0xf0, 0xb3, 0x29, 0xb2, 0xb3, 0xf5,
+	0xbf, 0xa2, 0x16, 0x2c, 0xcc, 0xb2, 0xd3, 0x70, 0x31, 0x23, 0xaa, 0xff, 0x3c, 0x0f, 0x13, 0xd1,
+	0x17, 0xea, 0xc4, 0x1b, 0x15, 0x85, 0xc4, 0x37, 0x2a, 0xe8, 0xce, 0xc2, 0xf2, 0x89, 0xa2, 0xef,
+	0x4b, 0xb2, 0xb4, 0x20, 0x0e, 0x93, 0x3b, 0x0b, 0x7b, 0x1e, 0x69, 0x60, 0x17, 0x3b, 0x0b, 0x7b,
+	0x13, 0x29, 0xa0, 0x85, 0xce, 0x87, 0x13, 0x34, 0xf4, 0x68, 0x82, 0xc6, 0xa4, 0xda, 0x97, 0x7e,
+	0x73, 0x34, 0xda, 0x30, 0xac, 0xcc, 0x83, 0xd8, 0xbf, 0x2e, 0x66, 0x1e, 0xf7, 0x40, 0xec, 0xc6,
+	0xf9, 0x6d, 0x9f, 0x00, 0xa2, 0xd2, 0x0f, 0x76, 0x4b, 0x36, 0x5a, 0xbb, 0x4a, 0x62, 0x60, 0xc3,
+	0xa5, 0x50, 0xd3, 0xff, 0x45, 0x83, 0xd1, 0xd0, 0x63, 0x31, 0x94, 0x9b, 0xff, 0xc6, 0x51, 0xff,
+	0xdf, 0xaf, 0xba, 0x2d, 0x29, 0x60, 0x85, 0x1a, 0xfa, 0x16, 0x0c, 0xb7, 0x6c, 0xab, 0x41, 0x5c,
+	0xaf, 0x6a, 0x1b, 0xeb, 0x62, 0x9d, 0x64, 0xd5, 0x33, 0xd3, 0xdb, 0x5b, 0xb3, 0x53, 0xd7, 0x39,
+	0x99, 0x05, 0xbb, 0xdd, 0x69, 0x11, 0x8f, 0xbf, 0x86, 0x85, 0x55, 0xe2, 0x2c, 0x19, 0x54, 0x66,
+	0xd3, 0x3e, 0xaa, 0xc9, 0xa0, 0x41, 0x1a, 0xf0, 0x1e, 0x27, 0x83, 0x86, 0xf2, 0x8b, 0x77, 0x48,
+	0x06, 0x95, 0xb8, 0x8f, 0x6c, 0x32, 0xa8, 0x6c, 0x61, 0x8f, 0x9d, 0xf6, 0xbd, 0x82, 0xd2, 0x8b,
+	0xb0, 0x33, 0x25, 0xf7, 0x10, 0x67, 0xca, 0x1b, 0x30, 0x64, 0x5a, 0x1e, 0x71, 0x36, 0x8c, 0x56,
+	0x9f, 0x7b, 0x9e, 0xec, 0xea, 0xb2, 0xa0, 0x83, 0x25, 0x45, 0xd4, 0x82, 0x23, 0x6b, 0xe1, 0x27,
+	0x32, 0xc5, 0x89, 0x9f, 0x5f, 0x48, 0x3c, 0xeb, 0x6f, 0xe3, 0x57, 0x92, 0x90, 0x1e, 0xf4, 0x02,
+	0xe0, 0x64, 0xa2, 0xe8, 0x03, 0x0d, 0x8e, 0xae, 0x25, 0xbf, 0xc8, 0x99, 0xcd, 0x45, 0xd9, 0xe3,
+	0x59, 0xcf, 0xca, 0xd7, 0xb7, 0xb7, 0x66, 0x7b, 0xbd, 0xf9, 0x89, 0x7b, 0xb1, 0x46, 0x2e, 0x8c,
+	0xba, 0x8a, 0xa3, 0xd3, 0xdf, 0xa8, 0xcf, 0xa6, 0x75, 0x97, 0x86, 0x7d, 0xde, 0xca, 0xcd, 0x37,
+	0x95, 0x28, 0x0e, 0xf3, 0xd0, 0xdf, 0xd7, 0x60, 0x2c, 0x9c, 0x60, 0xff, 0xbf, 0xee, 0x8c, 0xf8,
+	0x22, 0x0f, 0xe3, 0x91, 0x35, 0x19, 0x71, 0x48, 0x94, 0x0e, 0xd2, 0x21, 0x51, 0xec, 0xcb, 0x21,
+	0x91, 0x7c, 0x12, 0x2f, 0xf4, 0x75, 0x12, 0xbf, 0xc4, 0x4f, 0xc3, 0x42, 0xa0, 0x96, 0x17, 0xa3,
+	0xb1, 0x98, 0xeb, 0x2a, 0x10, 0x87, 0x71, 0x99, 0xe1, 0x55, 0x8f, 0x7f, 0xed, 0x44, 0x1c, 0xe5,
+	0x2f, 0x64, 0x0d, 0xba, 0x4a, 0x02, 0xdc, 0xf0, 0x4a, 0x00, 0xe0, 0x24, 0x76, 0xba, 0x07, 0xe3,
+	0xd1, 0xf7, 0x98, 0x52, 0xa5, 0x97, 0x74, 0x0c, 0xcf, 0x7f, 0x00, 0x48, 0x62, 0xac, 0x18, 0x5e,
+	0x13, 0x33, 0x88, 0x7f, 0x3c, 0x28, 0x24, 0x1f, 0x0f, 0xf4, 0x0f, 0x35, 0x38, 0x92, 0x78, 0xe7,
+	0x28, 0x05, 0xf3, 0x7b, 0x50, 0xe4, 0x63, 0x23, 0xb6, 0xa9, 0x4b, 0xa9, 0xc3, 0x46, 0xf1, 0xb7,
+	0xa7, 0xb8, 0xb3, 0x86, 0x83, 0xb0, 0x20, 0x5b, 0x79, 0xe9, 0xd3, 0x2f, 0x8f, 0x1d, 0xfa, 0xf1,
+	0x97, 0xc7, 0x0e, 0xfd, 0xf4, 0xcb, 0x63, 0x87, 0xde, 0xd9, 0x3e, 0xa6, 0x7d, 0xba, 0x7d, 0x4c,
+	0xfb, 0xf1, 0xf6, 0x31, 0xed, 0xa7, 0xdb, 0xc7, 0xb4, 0x7f, 0xdb, 0x3e, 0xa6, 0xbd, 0xff, 0x8b,
+	0x63, 0x87, 0xee, 0x3c, 0x99, 0xe6, 0xeb, 0xca, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x61, 0x85,
+	0xc8, 0xcd, 0x84, 0x79, 0x00, 0x00,
 }
 
 func (m *AnalysisRunArgument) Marshal() (dAtA []byte, err error) {
@@ -2718,6 +3425,98 @@ func (m *AnalysisTemplateReference) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedAt != nil {
+		{
+			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Approver)
+	copy(dAtA[i:], m.Approver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Approver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovalPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.ExcludeCommitAuthors {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.EligibleRoles) > 0 {
+		for iNdEx := len(m.EligibleRoles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EligibleRoles[iNdEx])
+			copy(dAtA[i:], m.EligibleRoles[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.EligibleRoles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EligibleGroups) > 0 {
+		for iNdEx := len(m.EligibleGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EligibleGroups[iNdEx])
+			copy(dAtA[i:], m.EligibleGroups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.EligibleGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequiredApprovals))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ApprovedStage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2738,6 +3537,20 @@ func (m *ApprovedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.ApprovedAt != nil {
 		{
 			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -2881,7 +3694,7 @@ func (m *ArgoCDAppSyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Chart) Marshal() (dAtA []byte, err error) {
+func (m *AutoRollback) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2891,35 +3704,28 @@ func (m *Chart) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Chart) MarshalTo(dAtA []byte) (int, error) {
+func (m *AutoRollback) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Chart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AutoRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Version)
-	copy(dAtA[i:], m.Version)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.RepoURL)
-	copy(dAtA[i:], m.RepoURL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	if m.Enabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *ChartDiscoveryResult) Marshal() (dAtA []byte, err error) {
+func (m *Chart) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2929,28 +3735,62 @@ func (m *ChartDiscoveryResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChartDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *Chart) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChartDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Chart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Versions) > 0 {
-		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Versions[iNdEx])
-			copy(dAtA[i:], m.Versions[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Versions[iNdEx])))
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
-	i -= len(m.SemverConstraint)
-	copy(dAtA[i:], m.SemverConstraint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.AppVersion)
+	copy(dAtA[i:], m.AppVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppVersion)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
 	i--
 	dAtA[i] = 0x1a
 	i -= len(m.Name)
@@ -2966,7 +3806,7 @@ func (m *ChartDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChartSubscription) Marshal() (dAtA []byte, err error) {
+func (m *ChartDependency) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2976,38 +3816,35 @@ func (m *ChartSubscription) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChartSubscription) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChartDependency) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChartSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChartDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
-	i--
-	dAtA[i] = 0x20
-	i -= len(m.SemverConstraint)
-	copy(dAtA[i:], m.SemverConstraint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i -= len(m.Repository)
+	copy(dAtA[i:], m.Repository)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repository)))
 	i--
 	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.RepoURL)
-	copy(dAtA[i:], m.RepoURL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ClusterPromotionTask) Marshal() (dAtA []byte, err error) {
+func (m *ChartDiscoveryResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3017,7 +3854,190 @@ func (m *ClusterPromotionTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClusterPromotionTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChartDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.AppVersionConstraint)
+	copy(dAtA[i:], m.AppVersionConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppVersionConstraint)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.SemverConstraint)
+	copy(dAtA[i:], m.SemverConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChartSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChartSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.AppVersionConstraint)
+	copy(dAtA[i:], m.AppVersionConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppVersionConstraint)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.SemverConstraint)
+	copy(dAtA[i:], m.SemverConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChartVersionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChartVersionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartVersionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.AppVersion)
+	copy(dAtA[i:], m.AppVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppVersion)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterPromotionTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterPromotionTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -3152,6 +4172,34 @@ func (m *DiscoveredArtifacts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OCIArtifacts) > 0 {
+		for iNdEx := len(m.OCIArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OCIArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.DiscoveredAt.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3227,6 +4275,14 @@ func (m *DiscoveredCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PullRequestURL)
+	copy(dAtA[i:], m.PullRequestURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PullRequestURL)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PullRequestNumber))
+	i--
+	dAtA[i] = 0x40
 	if m.CreatorDate != nil {
 		{
 			size, err := m.CreatorDate.MarshalToSizedBuffer(dAtA[:i])
@@ -3292,6 +4348,16 @@ func (m *DiscoveredImageReference) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SourceCommit)
+	copy(dAtA[i:], m.SourceCommit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceCommit)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.SourceRepoURL)
+	copy(dAtA[i:], m.SourceRepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceRepoURL)))
+	i--
+	dAtA[i] = 0x32
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -3346,7 +4412,7 @@ func (m *DiscoveredImageReference) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ExpressionVariable) Marshal() (dAtA []byte, err error) {
+func (m *DiscoveredOCIArtifactReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3356,30 +4422,71 @@ func (m *ExpressionVariable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExpressionVariable) MarshalTo(dAtA []byte) (int, error) {
+func (m *DiscoveredOCIArtifactReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExpressionVariable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DiscoveredOCIArtifactReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.ArtifactType)
+	copy(dAtA[i:], m.ArtifactType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactType)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Freight) Marshal() (dAtA []byte, err error) {
+func (m *DiscoveredRelease) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3389,20 +4496,133 @@ func (m *Freight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Freight) MarshalTo(dAtA []byte) (int, error) {
+func (m *DiscoveredRelease) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Freight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DiscoveredRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpressionVariable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpressionVariable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpressionVariable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Freight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Freight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Freight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.OCIArtifacts) > 0 {
+		for iNdEx := len(m.OCIArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OCIArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
 		i -= size
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
@@ -3550,6 +4770,34 @@ func (m *FreightCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreightCreationCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreightCreationCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreightCreationCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FreightList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3650,6 +4898,34 @@ func (m *FreightReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OCIArtifacts) > 0 {
+		for iNdEx := len(m.OCIArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OCIArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3830,6 +5106,47 @@ func (m *FreightStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RejectedFor) > 0 {
+		keysForRejectedFor := make([]string, 0, len(m.RejectedFor))
+		for k := range m.RejectedFor {
+			keysForRejectedFor = append(keysForRejectedFor, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRejectedFor)
+		for iNdEx := len(keysForRejectedFor) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.RejectedFor[string(keysForRejectedFor[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForRejectedFor[iNdEx])
+			copy(dAtA[i:], keysForRejectedFor[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForRejectedFor[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Rejected != nil {
+		{
+			size, err := m.Rejected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Metadata) > 0 {
 		keysForMetadata := make([]string, 0, len(m.Metadata))
 		for k := range m.Metadata {
@@ -3969,6 +5286,14 @@ func (m *GitCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PullRequestURL)
+	copy(dAtA[i:], m.PullRequestURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PullRequestURL)))
+	i--
+	dAtA[i] = 0x52
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PullRequestNumber))
+	i--
+	dAtA[i] = 0x48
 	i -= len(m.Committer)
 	copy(dAtA[i:], m.Committer)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Committer)))
@@ -4082,6 +5407,48 @@ func (m *GitHubWebhookReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GitPullRequestFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitPullRequestFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitPullRequestFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.BaseBranch)
+	copy(dAtA[i:], m.BaseBranch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BaseBranch)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GitSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4103,6 +5470,36 @@ func (m *GitSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	i--
+	if m.FreightPerBranch {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x78
+	i -= len(m.BranchSelectionStrategy)
+	copy(dAtA[i:], m.BranchSelectionStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BranchSelectionStrategy)))
+	i--
+	dAtA[i] = 0x72
+	i -= len(m.BranchPattern)
+	copy(dAtA[i:], m.BranchPattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BranchPattern)))
+	i--
+	dAtA[i] = 0x6a
+	if m.PullRequests != nil {
+		{
+			size, err := m.PullRequests.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	i--
 	if m.StrictSemvers {
 		dAtA[i] = 1
 	} else {
@@ -4323,6 +5720,16 @@ func (m *Image) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SourceCommit)
+	copy(dAtA[i:], m.SourceCommit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceCommit)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.SourceRepoURL)
+	copy(dAtA[i:], m.SourceRepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceRepoURL)))
+	i--
+	dAtA[i] = 0x32
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -4437,6 +5844,11 @@ func (m *ImageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ExpressionFilter)
+	copy(dAtA[i:], m.ExpressionFilter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExpressionFilter)))
+	i--
+	dAtA[i] = 0x5a
 	i--
 	if m.StrictSemvers {
 		dAtA[i] = 1
@@ -4498,7 +5910,7 @@ func (m *ImageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
+func (m *OCIArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4508,30 +5920,220 @@ func (m *Project) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+func (m *OCIArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OCIArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.ArtifactType)
+	copy(dAtA[i:], m.ArtifactType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactType)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIArtifactDiscoveryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIArtifactDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIArtifactDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.References) > 0 {
+		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.References[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.ArtifactType)
+	copy(dAtA[i:], m.ArtifactType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactType)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIArtifactSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIArtifactSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIArtifactSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
+	i--
+	dAtA[i] = 0x48
+	i--
+	if m.InsecureSkipTLSVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.ArtifactType)
+	copy(dAtA[i:], m.ArtifactType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactType)))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.IgnoreTags) > 0 {
+		for iNdEx := len(m.IgnoreTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoreTags[iNdEx])
+			copy(dAtA[i:], m.IgnoreTags[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoreTags[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.AllowTags)
+	copy(dAtA[i:], m.AllowTags)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowTags)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.SemverConstraint)
+	copy(dAtA[i:], m.SemverConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i--
+	dAtA[i] = 0x22
+	i--
+	if m.StrictSemvers {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.SelectionStrategy)
+	copy(dAtA[i:], m.SelectionStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SelectionStrategy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -5716,7 +7318,7 @@ func (m *PromotionTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
+func (m *RejectedFreight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5726,19 +7328,19 @@ func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
+func (m *RejectedFreight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RejectedFreight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Chart != nil {
+	if m.RejectedAt != nil {
 		{
-			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RejectedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5748,34 +7350,20 @@ func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Git != nil {
-		{
-			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
+	i -= len(m.RejectedBy)
+	copy(dAtA[i:], m.RejectedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RejectedBy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Stage) Marshal() (dAtA []byte, err error) {
+func (m *Release) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5785,50 +7373,54 @@ func (m *Stage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Stage) MarshalTo(dAtA []byte) (int, error) {
+func (m *Release) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Release) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageList) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseFeedDiscoveryResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5838,20 +7430,20 @@ func (m *StageList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseFeedDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseFeedDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5862,20 +7454,15 @@ func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageSpec) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseFeedHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5885,77 +7472,30 @@ func (m *StageSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseFeedHeader) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseFeedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Vars) > 0 {
-		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.PromotionTemplate != nil {
-		{
-			size, err := m.PromotionTemplate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequestedFreight) > 0 {
-		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
-	dAtA[i] = 0x22
-	if m.Verification != nil {
-		{
-			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageStats) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseFeedMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5965,33 +7505,30 @@ func (m *StageStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseFeedMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseFeedMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i -= len(m.FromExpression)
+	copy(dAtA[i:], m.FromExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromExpression)))
 	i--
-	dAtA[i] = 0x10
-	{
-		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageStatus) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseFeedSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6001,20 +7538,47 @@ func (m *StageStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseFeedSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseFeedSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
+	i--
+	dAtA[i] = 0x50
+	if len(m.IgnoreVersions) > 0 {
+		for iNdEx := len(m.IgnoreVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoreVersions[iNdEx])
+			copy(dAtA[i:], m.IgnoreVersions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoreVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.AllowVersions)
+	copy(dAtA[i:], m.AllowVersions)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowVersions)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.SemverConstraint)
+	copy(dAtA[i:], m.SemverConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.SelectionStrategy)
+	copy(dAtA[i:], m.SelectionStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SelectionStrategy)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6022,62 +7586,26 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0x2a
 		}
 	}
-	i -= len(m.FreightSummary)
-	copy(dAtA[i:], m.FreightSummary)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
+	i -= len(m.VersionsFromExpression)
+	copy(dAtA[i:], m.VersionsFromExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VersionsFromExpression)))
 	i--
-	dAtA[i] = 0x62
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	dAtA[i] = 0x22
 	i--
-	dAtA[i] = 0x5a
-	if m.LastPromotion != nil {
-		{
-			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Health != nil {
-		{
-			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.CurrentPromotion != nil {
-		{
-			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if m.InsecureSkipTLSVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
 	i--
-	dAtA[i] = 0x30
-	if len(m.FreightHistory) > 0 {
-		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x18
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6085,13 +7613,18 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
+func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6101,40 +7634,43 @@ func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StepExecutionMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.ContinueOnError {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.ReleaseFeed != nil {
+		{
+			size, err := m.ReleaseFeed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	i--
-	dAtA[i] = 0x38
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i--
-	dAtA[i] = 0x2a
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorCount))
-	i--
-	dAtA[i] = 0x20
-	if m.FinishedAt != nil {
+	if m.OCIArtifact != nil {
 		{
-			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OCIArtifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Chart != nil {
+		{
+			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6144,9 +7680,9 @@ func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.StartedAt != nil {
+	if m.Image != nil {
 		{
-			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6156,15 +7692,22 @@ func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	i -= len(m.Alias)
-	copy(dAtA[i:], m.Alias)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if m.Git != nil {
+		{
+			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Verification) Marshal() (dAtA []byte, err error) {
+func (m *Stage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6174,46 +7717,73 @@ func (m *Verification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
+func (m *Stage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.AnalysisRunMetadata != nil {
-		{
-			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.AnalysisTemplates) > 0 {
-		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StageList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StageList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6221,13 +7791,23 @@ func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
+func (m *StageSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6237,24 +7817,19 @@ func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Actor)
-	copy(dAtA[i:], m.Actor)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
-	i--
-	dAtA[i] = 0x3a
-	if m.FinishTime != nil {
+	if m.AutoRollback != nil {
 		{
-			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AutoRollback.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6262,11 +7837,11 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x4a
 	}
-	if m.StartTime != nil {
+	if m.ApprovalPolicy != nil {
 		{
-			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ApprovalPolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6274,61 +7849,25 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x42
 	}
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
-	i--
-	dAtA[i] = 0x22
-	if m.AnalysisRun != nil {
-		{
-			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Vars) > 0 {
+		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LongestCompletedSoak != nil {
+	if m.PromotionTemplate != nil {
 		{
-			size, err := m.LongestCompletedSoak.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PromotionTemplate.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6336,11 +7875,30 @@ func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if m.VerifiedAt != nil {
+	if len(m.RequestedFreight) > 0 {
+		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i--
+	dAtA[i] = 0x22
+	if m.Verification != nil {
 		{
-			size, err := m.VerifiedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6348,12 +7906,12 @@ func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Warehouse) Marshal() (dAtA []byte, err error) {
+func (m *StageStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6363,38 +7921,21 @@ func (m *Warehouse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x10
 	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6406,7 +7947,7 @@ func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+func (m *StageStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6416,20 +7957,20 @@ func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6437,23 +7978,76 @@ func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x6a
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	i -= len(m.FreightSummary)
+	copy(dAtA[i:], m.FreightSummary)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x5a
+	if m.LastPromotion != nil {
+		{
+			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CurrentPromotion != nil {
+		{
+			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x30
+	if len(m.FreightHistory) > 0 {
+		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
+func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6463,54 +8057,70 @@ func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *StepExecutionMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	if m.ContinueOnError {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x22
-	i -= len(m.FreightCreationPolicy)
-	copy(dAtA[i:], m.FreightCreationPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
+	dAtA[i] = 0x38
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	dAtA[i] = 0x32
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
 	i--
-	dAtA[i] = 0x12
-	if len(m.Subscriptions) > 0 {
-		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorCount))
+	i--
+	dAtA[i] = 0x20
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
+	i -= len(m.Alias)
+	copy(dAtA[i:], m.Alias)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseStats) Marshal() (dAtA []byte, err error) {
+func (m *StepRunnerPlugin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6520,21 +8130,28 @@ func (m *WarehouseStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *StepRunnerPlugin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StepRunnerPlugin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
-	dAtA[i] = 0x10
+	dAtA[i] = 0x12
 	{
-		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6546,7 +8163,7 @@ func (m *WarehouseStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+func (m *StepRunnerPluginList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6556,20 +8173,20 @@ func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *StepRunnerPluginList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StepRunnerPluginList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6577,38 +8194,23 @@ func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x12
 		}
 	}
-	i -= len(m.LastFreightID)
-	copy(dAtA[i:], m.LastFreightID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
-	i--
-	dAtA[i] = 0x42
-	if m.DiscoveredArtifacts != nil {
-		{
-			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
 	i--
-	dAtA[i] = 0x32
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
-	i--
-	dAtA[i] = 0x20
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WebhookReceiver) Marshal() (dAtA []byte, err error) {
+func (m *StepRunnerPluginSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6618,35 +8220,57 @@ func (m *WebhookReceiver) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WebhookReceiver) MarshalTo(dAtA []byte) (int, error) {
+func (m *StepRunnerPluginSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WebhookReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StepRunnerPluginSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorThreshold))
+	i--
+	dAtA[i] = 0x28
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Steps[iNdEx])
+			copy(dAtA[i:], m.Steps[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Steps[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
+	if m.InsecureSkipTLSVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
 	i -= len(m.URL)
 	copy(dAtA[i:], m.URL)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
-	dAtA[i] = 0x22
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
+func (m *Verification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6656,618 +8280,724 @@ func (m *WebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WebhookReceiverConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GitHub != nil {
-		{
-			size, err := m.GitHub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AnalysisRunMetadata != nil {
+		{
+			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
+	if len(m.AnalysisTemplates) > 0 {
+		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AnalysisRunArgument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisRunMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i -= len(m.Actor)
+	copy(dAtA[i:], m.Actor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
+	i--
+	dAtA[i] = 0x3a
+	if m.FinishTime != nil {
+		{
+			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0x22
+	if m.AnalysisRun != nil {
+		{
+			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunReference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AnalysisTemplateReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApprovedStage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ApprovedAt != nil {
-		l = m.ApprovedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.LongestCompletedSoak != nil {
+		{
+			size, err := m.LongestCompletedSoak.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.VerifiedAt != nil {
+		{
+			size, err := m.VerifiedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ArgoCDAppHealthStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Warehouse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArgoCDAppStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.HealthStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SyncStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ArgoCDAppSyncStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Revisions) > 0 {
-		for _, s := range m.Revisions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *Chart) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ChartDiscoveryResult) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Versions) > 0 {
-		for _, s := range m.Versions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ChartSubscription) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClusterPromotionTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClusterPromotionTaskList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentStage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Since != nil {
-		l = m.Since.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DiscoveredArtifacts) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Git) > 0 {
-		for _, e := range m.Git {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.FreightCreationCriteria != nil {
+		{
+			size, err := m.FreightCreationCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.FreightCreationPolicy)
+	copy(dAtA[i:], m.FreightCreationPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = m.DiscoveredAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DiscoveredCommit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Subject)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Author)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Committer)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatorDate != nil {
-		l = m.CreatorDate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *WarehouseStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DiscoveredImageReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Digest)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *WarehouseStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarehouseStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	return n
+	i -= len(m.LastFreightID)
+	copy(dAtA[i:], m.LastFreightID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
+	i--
+	dAtA[i] = 0x42
+	if m.DiscoveredArtifacts != nil {
+		{
+			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
 }
 
-func (m *ExpressionVariable) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WebhookReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Freight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WebhookReceiverConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookReceiverConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookReceiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.GitHub != nil {
+		{
+			size, err := m.GitHub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AnalysisRunArgument) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Alias)
+	var l int
+	_ = l
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Origin.Size()
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightCollection) Size() (n int) {
+func (m *AnalysisRunMetadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Freight) > 0 {
-		for k, v := range m.Freight {
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
 			_ = k
 			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.VerificationHistory) > 0 {
-		for _, e := range m.VerificationHistory {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightList) Size() (n int) {
+func (m *AnalysisRunReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *FreightOrigin) Size() (n int) {
+func (m *AnalysisTemplateReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightReference) Size() (n int) {
+func (m *Approval) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Approver)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightRequest) Size() (n int) {
+func (m *ApprovalPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Sources.Size()
-	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RequiredApprovals))
+	if len(m.EligibleGroups) > 0 {
+		for _, s := range m.EligibleGroups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.EligibleRoles) > 0 {
+		for _, s := range m.EligibleRoles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
-func (m *FreightSources) Size() (n int) {
+func (m *ApprovedStage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
-	if len(m.Stages) > 0 {
-		for _, s := range m.Stages {
-			l = len(s)
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.RequiredSoakTime != nil {
-		l = m.RequiredSoakTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.AvailabilityStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightStatus) Size() (n int) {
+func (m *ArgoCDAppHealthStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.VerifiedIn) > 0 {
-		for k, v := range m.VerifiedIn {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.ApprovedFor) > 0 {
-		for k, v := range m.ApprovedFor {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.CurrentlyIn) > 0 {
-		for k, v := range m.CurrentlyIn {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GitCommit) Size() (n int) {
+func (m *ArgoCDAppStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
+	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Author)
+	l = m.HealthStatus.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Committer)
+	l = m.SyncStatus.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GitDiscoveryResult) Size() (n int) {
+func (m *ArgoCDAppSyncStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
+	l = len(m.Status)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *GitHubWebhookReceiver) Size() (n int) {
+func (m *AutoRollback) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SecretRef.Size()
-	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
-func (m *GitSubscription) Size() (n int) {
+func (m *Chart) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7275,101 +9005,100 @@ func (m *GitSubscription) Size() (n int) {
 	_ = l
 	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CommitSelectionStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
+	l = len(m.Version)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AllowTags)
+	l = len(m.AppVersion)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.IgnoreTags) > 0 {
-		for _, s := range m.IgnoreTags {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	n += 2
-	if len(m.IncludePaths) > 0 {
-		for _, s := range m.IncludePaths {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.ExcludePaths) > 0 {
-		for _, s := range m.ExcludePaths {
-			l = len(s)
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
-	n += 2
 	return n
 }
 
-func (m *Health) Size() (n int) {
+func (m *ChartDependency) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Status)
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Repository)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Issues) > 0 {
-		for _, s := range m.Issues {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *HealthCheckStep) Size() (n int) {
+func (m *ChartDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Uses)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.AppVersionConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *HealthStats) Size() (n int) {
+func (m *ChartSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.Healthy))
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	l = len(m.AppVersionConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Image) Size() (n int) {
+func (m *ChartVersionMetadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
+	l = len(m.Version)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Digest)
+	l = len(m.AppVersion)
 	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Annotations) > 0 {
 		for k, v := range m.Annotations {
@@ -7379,346 +9108,367 @@ func (m *Image) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ImageDiscoveryResult) Size() (n int) {
+func (m *ClusterPromotionTask) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Platform)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.References) > 0 {
-		for _, e := range m.References {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *ImageSubscription) Size() (n int) {
+func (m *ClusterPromotionTaskList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ImageSelectionStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AllowTags)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.IgnoreTags) > 0 {
-		for _, s := range m.IgnoreTags {
-			l = len(s)
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.Platform)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
-	n += 2
 	return n
 }
 
-func (m *Project) Size() (n int) {
+func (m *CurrentStage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Spec != nil {
-		l = m.Spec.Size()
+	if m.Since != nil {
+		l = m.Since.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ProjectConfig) Size() (n int) {
+func (m *DiscoveredArtifacts) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	if len(m.Git) > 0 {
+		for _, e := range m.Git {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.DiscoveredAt.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OCIArtifacts) > 0 {
+		for _, e := range m.OCIArtifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ProjectConfigList) Size() (n int) {
+func (m *DiscoveredCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Committer)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatorDate != nil {
+		l = m.CreatorDate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.PullRequestNumber))
+	l = len(m.PullRequestURL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ProjectConfigSpec) Size() (n int) {
+func (m *DiscoveredImageReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PromotionPolicies) > 0 {
-		for _, e := range m.PromotionPolicies {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.WebhookReceivers) > 0 {
-		for _, e := range m.WebhookReceivers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SourceRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SourceCommit)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ProjectConfigStatus) Size() (n int) {
+func (m *DiscoveredOCIArtifactReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ArtifactType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.WebhookReceivers) > 0 {
-		for _, e := range m.WebhookReceivers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *ProjectList) Size() (n int) {
+func (m *DiscoveredRelease) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Version)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
 	return n
 }
 
-func (m *ProjectStats) Size() (n int) {
+func (m *ExpressionVariable) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Warehouses.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Stages.Size()
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ProjectStatus) Size() (n int) {
+func (m *Freight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *Promotion) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
+	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Alias)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = m.Origin.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OCIArtifacts) > 0 {
+		for _, e := range m.OCIArtifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *PromotionList) Size() (n int) {
+func (m *FreightCollection) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Freight) > 0 {
+		for k, v := range m.Freight {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.VerificationHistory) > 0 {
+		for _, e := range m.VerificationHistory {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PromotionPolicy) Size() (n int) {
+func (m *FreightCreationCriteria) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Stage)
+	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.StageSelector != nil {
-		l = m.StageSelector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *PromotionPolicySelector) Size() (n int) {
+func (m *FreightList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.LabelSelector != nil {
-		l = m.LabelSelector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *PromotionReference) Size() (n int) {
+func (m *FreightOrigin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Freight != nil {
-		l = m.Freight.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *PromotionSpec) Size() (n int) {
+func (m *FreightReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Stage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Freight)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *PromotionStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.LastHandledRefresh)
+	l = m.Origin.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Freight != nil {
-		l = m.Freight.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FreightCollection != nil {
-		l = m.FreightCollection.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.HealthChecks) > 0 {
-		for _, e := range m.HealthChecks {
+	if len(m.OCIArtifacts) > 0 {
+		for _, e := range m.OCIArtifacts {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 1 + sovGenerated(uint64(m.CurrentStep))
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.StepExecutionMetadata) > 0 {
-		for _, e := range m.StepExecutionMetadata {
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7726,77 +9476,135 @@ func (m *PromotionStatus) Size() (n int) {
 	return n
 }
 
-func (m *PromotionStep) Size() (n int) {
+func (m *FreightRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Uses)
+	l = m.Origin.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.As)
+	l = m.Sources.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Retry != nil {
-		l = m.Retry.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FreightSources) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
-			l = e.Size()
+	var l int
+	_ = l
+	n += 2
+	if len(m.Stages) > 0 {
+		for _, s := range m.Stages {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.If)
+	if m.RequiredSoakTime != nil {
+		l = m.RequiredSoakTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.AvailabilityStrategy)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
 	return n
 }
 
-func (m *PromotionStepRetry) Size() (n int) {
+func (m *FreightStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Timeout != nil {
-		l = m.Timeout.Size()
+	if len(m.VerifiedIn) > 0 {
+		for k, v := range m.VerifiedIn {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ApprovedFor) > 0 {
+		for k, v := range m.ApprovedFor {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.CurrentlyIn) > 0 {
+		for k, v := range m.CurrentlyIn {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Rejected != nil {
+		l = m.Rejected.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 1 + sovGenerated(uint64(m.ErrorThreshold))
+	if len(m.RejectedFor) > 0 {
+		for k, v := range m.RejectedFor {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *PromotionTask) Size() (n int) {
+func (m *GitCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Committer)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.PullRequestNumber))
+	l = len(m.PullRequestURL)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PromotionTaskList) Size() (n int) {
+func (m *GitDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7804,249 +9612,252 @@ func (m *PromotionTaskList) Size() (n int) {
 	return n
 }
 
-func (m *PromotionTaskReference) Size() (n int) {
+func (m *GitHubWebhookReceiver) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
+	l = m.SecretRef.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PromotionTaskSpec) Size() (n int) {
+func (m *GitPullRequestFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BaseBranch)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *PromotionTemplate) Size() (n int) {
+func (m *GitSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Spec.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *PromotionTemplateSpec) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.CommitSelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
+	n += 2
+	if len(m.IncludePaths) > 0 {
+		for _, s := range m.IncludePaths {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
-			l = e.Size()
+	if len(m.ExcludePaths) > 0 {
+		for _, s := range m.ExcludePaths {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	n += 2
+	if m.PullRequests != nil {
+		l = m.PullRequests.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.BranchPattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BranchSelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
-func (m *RepoSubscription) Size() (n int) {
+func (m *Health) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Git != nil {
-		l = m.Git.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Issues) > 0 {
+		for _, s := range m.Issues {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.Image != nil {
-		l = m.Image.Size()
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Chart != nil {
-		l = m.Chart.Size()
+	if m.Output != nil {
+		l = m.Output.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Stage) Size() (n int) {
+func (m *HealthCheckStep) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.Uses)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *StageList) Size() (n int) {
+func (m *HealthStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	n += 1 + sovGenerated(uint64(m.Healthy))
 	return n
 }
 
-func (m *StageSpec) Size() (n int) {
+func (m *Image) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Verification != nil {
-		l = m.Verification.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Shard)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.RequestedFreight) > 0 {
-		for _, e := range m.RequestedFreight {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.PromotionTemplate != nil {
-		l = m.PromotionTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SourceRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SourceCommit)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *StageStats) Size() (n int) {
+func (m *ImageDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Health.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Count))
+	l = len(m.Platform)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.References) > 0 {
+		for _, e := range m.References {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *StageStatus) Size() (n int) {
+func (m *ImageSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.FreightHistory) > 0 {
-		for _, e := range m.FreightHistory {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	if m.CurrentPromotion != nil {
-		l = m.CurrentPromotion.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Health != nil {
-		l = m.Health.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.LastPromotion != nil {
-		l = m.LastPromotion.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.LastHandledRefresh)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FreightSummary)
+	l = len(m.GitRepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
+	l = len(m.ImageSelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Platform)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	n += 2
+	l = len(m.ExpressionFilter)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *StepExecutionMetadata) Size() (n int) {
+func (m *OCIArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Alias)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.StartedAt != nil {
-		l = m.StartedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	n += 1 + sovGenerated(uint64(m.ErrorCount))
-	l = len(m.Status)
+	l = len(m.Tag)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.Digest)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
+	l = len(m.ArtifactType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *Verification) Size() (n int) {
+func (m *OCIArtifactDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AnalysisTemplates) > 0 {
-		for _, e := range m.AnalysisTemplates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.AnalysisRunMetadata != nil {
-		l = m.AnalysisRunMetadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ArtifactType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.References) > 0 {
+		for _, e := range m.References {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -8054,53 +9865,52 @@ func (m *Verification) Size() (n int) {
 	return n
 }
 
-func (m *VerificationInfo) Size() (n int) {
+func (m *OCIArtifactSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.SelectionStrategy)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.AnalysisRun != nil {
-		l = m.AnalysisRun.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ID)
+	n += 2
+	l = len(m.SemverConstraint)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.StartTime != nil {
-		l = m.StartTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishTime != nil {
-		l = m.FinishTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	l = len(m.Actor)
+	l = len(m.ArtifactType)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
 	return n
 }
 
-func (m *VerifiedStage) Size() (n int) {
+func (m *Project) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.VerifiedAt != nil {
-		l = m.VerifiedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.LongestCompletedSoak != nil {
-		l = m.LongestCompletedSoak.Size()
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Spec != nil {
+		l = m.Spec.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Warehouse) Size() (n int) {
+func (m *ProjectConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8115,7 +9925,7 @@ func (m *Warehouse) Size() (n int) {
 	return n
 }
 
-func (m *WarehouseList) Size() (n int) {
+func (m *ProjectConfigList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8132,56 +9942,58 @@ func (m *WarehouseList) Size() (n int) {
 	return n
 }
 
-func (m *WarehouseSpec) Size() (n int) {
+func (m *ProjectConfigSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Subscriptions) > 0 {
-		for _, e := range m.Subscriptions {
+	if len(m.PromotionPolicies) > 0 {
+		for _, e := range m.PromotionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.WebhookReceivers) > 0 {
+		for _, e := range m.WebhookReceivers {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.Shard)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FreightCreationPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Interval.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WarehouseStats) Size() (n int) {
+func (m *ProjectConfigStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Health.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Count))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.WebhookReceivers) > 0 {
+		for _, e := range m.WebhookReceivers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *WarehouseStatus) Size() (n int) {
+func (m *ProjectList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	l = len(m.LastHandledRefresh)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.DiscoveredArtifacts != nil {
-		l = m.DiscoveredArtifacts.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.LastFreightID)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -8189,1282 +10001,7251 @@ func (m *WarehouseStatus) Size() (n int) {
 	return n
 }
 
-func (m *WebhookReceiver) Size() (n int) {
+func (m *ProjectStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
+	l = m.Warehouses.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.URL)
+	l = m.Stages.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WebhookReceiverConfig) Size() (n int) {
+func (m *ProjectStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.GitHub != nil {
-		l = m.GitHub.Size()
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AnalysisRunArgument) String() string {
-	if this == nil {
-		return "nil"
+func (m *Promotion) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisRunArgument{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AnalysisRunMetadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+
+func (m *PromotionList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	return n
+}
+
+func (m *PromotionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	var l int
+	_ = l
+	l = len(m.Stage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.StageSelector != nil {
+		l = m.StageSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&AnalysisRunMetadata{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AnalysisRunReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionPolicySelector) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisRunReference{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AnalysisTemplateReference) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LabelSelector != nil {
+		l = m.LabelSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&AnalysisTemplateReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ApprovedStage) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ApprovedStage{`,
-		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArgoCDAppHealthStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Freight != nil {
+		l = m.Freight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArgoCDAppHealthStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArgoCDAppStatus) String() string {
-	if this == nil {
-		return "nil"
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArgoCDAppStatus{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`HealthStatus:` + strings.Replace(strings.Replace(this.HealthStatus.String(), "ArgoCDAppHealthStatus", "ArgoCDAppHealthStatus", 1), `&`, ``, 1) + `,`,
-		`SyncStatus:` + strings.Replace(strings.Replace(this.SyncStatus.String(), "ArgoCDAppSyncStatus", "ArgoCDAppSyncStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArgoCDAppSyncStatus) String() string {
-	if this == nil {
-		return "nil"
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArgoCDAppSyncStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Chart) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Chart{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChartDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Stage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Freight)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ChartDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChartSubscription) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ChartSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ClusterPromotionTask) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ClusterPromotionTask{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ClusterPromotionTaskList) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Freight != nil {
+		l = m.Freight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems := "[]ClusterPromotionTask{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterPromotionTask", "ClusterPromotionTask", 1), `&`, ``, 1) + ","
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ClusterPromotionTaskList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CurrentStage) String() string {
-	if this == nil {
-		return "nil"
+	if m.FreightCollection != nil {
+		l = m.FreightCollection.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&CurrentStage{`,
-		`Since:` + strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.HealthChecks) > 0 {
+		for _, e := range m.HealthChecks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.CurrentStep))
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.StepExecutionMetadata) > 0 {
+		for _, e := range m.StepExecutionMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *DiscoveredArtifacts) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionStep) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForGit := "[]GitDiscoveryResult{"
-	for _, f := range this.Git {
-		repeatedStringForGit += strings.Replace(strings.Replace(f.String(), "GitDiscoveryResult", "GitDiscoveryResult", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Uses)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.As)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForGit += "}"
-	repeatedStringForImages := "[]ImageDiscoveryResult{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ImageDiscoveryResult", "ImageDiscoveryResult", 1), `&`, ``, 1) + ","
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]ChartDiscoveryResult{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "ChartDiscoveryResult", "ChartDiscoveryResult", 1), `&`, ``, 1) + ","
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&DiscoveredArtifacts{`,
-		`Git:` + repeatedStringForGit + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`DiscoveredAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DiscoveredAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.If)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *DiscoveredCommit) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionStepRetry) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DiscoveredCommit{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
-		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
-		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
-		`CreatorDate:` + strings.Replace(fmt.Sprintf("%v", this.CreatorDate), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.ErrorThreshold))
+	return n
 }
-func (this *DiscoveredImageReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTask) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PromotionTaskList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&DiscoveredImageReference{`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExpressionVariable) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTaskReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ExpressionVariable{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Freight) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTaskSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForCommits := "[]GitCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCommits += "}"
-	repeatedStringForImages := "[]Image{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]Chart{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *PromotionTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&Freight{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "FreightStatus", "FreightStatus", 1), `&`, ``, 1) + `,`,
-		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *FreightCollection) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVerificationHistory := "[]VerificationInfo{"
-	for _, f := range this.VerificationHistory {
-		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForVerificationHistory += "}"
-	keysForFreight := make([]string, 0, len(this.Freight))
-	for k := range this.Freight {
-		keysForFreight = append(keysForFreight, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFreight)
-	mapStringForFreight := "map[string]FreightReference{"
-	for _, k := range keysForFreight {
-		mapStringForFreight += fmt.Sprintf("%v: %v,", k, this.Freight[k])
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForFreight += "}"
-	s := strings.Join([]string{`&FreightCollection{`,
-		`Freight:` + mapStringForFreight + `,`,
-		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RejectedFreight) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Freight{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Freight", "Freight", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RejectedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RejectedAt != nil {
+		l = m.RejectedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&FreightList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Release) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForCommits := "[]GitCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForCommits += "}"
-	repeatedStringForImages := "[]Image{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *ReleaseFeedDiscoveryResult) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]Chart{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&FreightReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReleaseFeedHeader) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FreightRequest{`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`Sources:` + strings.Replace(strings.Replace(this.Sources.String(), "FreightSources", "FreightSources", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *FreightSources) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReleaseFeedMetadata) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FreightSources{`,
-		`Direct:` + fmt.Sprintf("%v", this.Direct) + `,`,
-		`Stages:` + fmt.Sprintf("%v", this.Stages) + `,`,
-		`RequiredSoakTime:` + strings.Replace(fmt.Sprintf("%v", this.RequiredSoakTime), "Duration", "v1.Duration", 1) + `,`,
-		`AvailabilityStrategy:` + fmt.Sprintf("%v", this.AvailabilityStrategy) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FromExpression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *FreightStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReleaseFeedSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForVerifiedIn := make([]string, 0, len(this.VerifiedIn))
-	for k := range this.VerifiedIn {
-		keysForVerifiedIn = append(keysForVerifiedIn, k)
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForVerifiedIn)
-	mapStringForVerifiedIn := "map[string]VerifiedStage{"
-	for _, k := range keysForVerifiedIn {
-		mapStringForVerifiedIn += fmt.Sprintf("%v: %v,", k, this.VerifiedIn[k])
+	n += 2
+	l = len(m.VersionsFromExpression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForVerifiedIn += "}"
-	keysForApprovedFor := make([]string, 0, len(this.ApprovedFor))
-	for k := range this.ApprovedFor {
-		keysForApprovedFor = append(keysForApprovedFor, k)
+	l = len(m.SelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowVersions)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreVersions) > 0 {
+		for _, s := range m.IgnoreVersions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForApprovedFor)
-	mapStringForApprovedFor := "map[string]ApprovedStage{"
-	for _, k := range keysForApprovedFor {
-		mapStringForApprovedFor += fmt.Sprintf("%v: %v,", k, this.ApprovedFor[k])
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	return n
+}
+
+func (m *RepoSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForApprovedFor += "}"
-	keysForCurrentlyIn := make([]string, 0, len(this.CurrentlyIn))
-	for k := range this.CurrentlyIn {
-		keysForCurrentlyIn = append(keysForCurrentlyIn, k)
+	var l int
+	_ = l
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCurrentlyIn)
-	mapStringForCurrentlyIn := "map[string]CurrentStage{"
-	for _, k := range keysForCurrentlyIn {
-		mapStringForCurrentlyIn += fmt.Sprintf("%v: %v,", k, this.CurrentlyIn[k])
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForCurrentlyIn += "}"
-	keysForMetadata := make([]string, 0, len(this.Metadata))
-	for k := range this.Metadata {
-		keysForMetadata = append(keysForMetadata, k)
+	if m.Chart != nil {
+		l = m.Chart.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
-	mapStringForMetadata := "map[string]v11.JSON{"
-	for _, k := range keysForMetadata {
-		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	if m.OCIArtifact != nil {
+		l = m.OCIArtifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForMetadata += "}"
-	s := strings.Join([]string{`&FreightStatus{`,
-		`VerifiedIn:` + mapStringForVerifiedIn + `,`,
-		`ApprovedFor:` + mapStringForApprovedFor + `,`,
-		`CurrentlyIn:` + mapStringForCurrentlyIn + `,`,
-		`Metadata:` + mapStringForMetadata + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitCommit) String() string {
-	if this == nil {
-		return "nil"
+	if m.ReleaseFeed != nil {
+		l = m.ReleaseFeed.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&GitCommit{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
-		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GitDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForCommits := "[]DiscoveredCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "DiscoveredCommit", "DiscoveredCommit", 1), `&`, ``, 1) + ","
+
+func (m *Stage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForCommits += "}"
-	s := strings.Join([]string{`&GitDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitHubWebhookReceiver) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GitHubWebhookReceiver{`,
-		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "LocalObjectReference", "v12.LocalObjectReference", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitSubscription) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GitSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`CommitSelectionStrategy:` + fmt.Sprintf("%v", this.CommitSelectionStrategy) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
-		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
-		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
-		`IncludePaths:` + fmt.Sprintf("%v", this.IncludePaths) + `,`,
-		`ExcludePaths:` + fmt.Sprintf("%v", this.ExcludePaths) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Health) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Health{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Issues:` + fmt.Sprintf("%v", this.Issues) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
-		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "JSON", "v11.JSON", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HealthCheckStep) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&HealthCheckStep{`,
-		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *HealthStats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HealthStats{`,
-		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Image) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	l = len(m.Shard)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RequestedFreight) > 0 {
+		for _, e := range m.RequestedFreight {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.PromotionTemplate != nil {
+		l = m.PromotionTemplate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&Image{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ImageDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForReferences := "[]DiscoveredImageReference{"
-	for _, f := range this.References {
-		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredImageReference", "DiscoveredImageReference", 1), `&`, ``, 1) + ","
+	if m.ApprovalPolicy != nil {
+		l = m.ApprovalPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForReferences += "}"
-	s := strings.Join([]string{`&ImageDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
-		`References:` + repeatedStringForReferences + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ImageSubscription) String() string {
-	if this == nil {
-		return "nil"
+	if m.AutoRollback != nil {
+		l = m.AutoRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ImageSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`ImageSelectionStrategy:` + fmt.Sprintf("%v", this.ImageSelectionStrategy) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
-		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
-		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
-		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Project) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Project{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(this.Spec.String(), "ProjectConfigSpec", "ProjectConfigSpec", 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Count))
+	return n
 }
-func (this *ProjectConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ProjectConfig{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectConfigSpec", "ProjectConfigSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectConfigStatus", "ProjectConfigStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProjectConfigList) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.FreightHistory) > 0 {
+		for _, e := range m.FreightHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems := "[]ProjectConfig{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ProjectConfig", "ProjectConfig", 1), `&`, ``, 1) + ","
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if m.CurrentPromotion != nil {
+		l = m.CurrentPromotion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ProjectConfigList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProjectConfigSpec) String() string {
-	if this == nil {
-		return "nil"
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPromotionPolicies := "[]PromotionPolicy{"
-	for _, f := range this.PromotionPolicies {
-		repeatedStringForPromotionPolicies += strings.Replace(strings.Replace(f.String(), "PromotionPolicy", "PromotionPolicy", 1), `&`, ``, 1) + ","
+	if m.LastPromotion != nil {
+		l = m.LastPromotion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPromotionPolicies += "}"
-	repeatedStringForWebhookReceivers := "[]WebhookReceiverConfig{"
-	for _, f := range this.WebhookReceivers {
-		repeatedStringForWebhookReceivers += strings.Replace(strings.Replace(f.String(), "WebhookReceiverConfig", "WebhookReceiverConfig", 1), `&`, ``, 1) + ","
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FreightSummary)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForWebhookReceivers += "}"
-	s := strings.Join([]string{`&ProjectConfigSpec{`,
-		`PromotionPolicies:` + repeatedStringForPromotionPolicies + `,`,
-		`WebhookReceivers:` + repeatedStringForWebhookReceivers + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ProjectConfigStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StepExecutionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = len(m.Alias)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForConditions += "}"
-	repeatedStringForWebhookReceivers := "[]WebhookReceiver{"
-	for _, f := range this.WebhookReceivers {
-		repeatedStringForWebhookReceivers += strings.Replace(strings.Replace(f.String(), "WebhookReceiver", "WebhookReceiver", 1), `&`, ``, 1) + ","
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWebhookReceivers += "}"
-	s := strings.Join([]string{`&ProjectConfigStatus{`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`WebhookReceivers:` + repeatedStringForWebhookReceivers + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 1 + sovGenerated(uint64(m.ErrorCount))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *ProjectList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Project{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+
+func (m *StepRunnerPlugin) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ProjectList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ProjectStats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StepRunnerPluginList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ProjectStats{`,
-		`Warehouses:` + strings.Replace(strings.Replace(this.Warehouses.String(), "WarehouseStats", "WarehouseStats", 1), `&`, ``, 1) + `,`,
-		`Stages:` + strings.Replace(strings.Replace(this.Stages.String(), "StageStats", "StageStats", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *ProjectStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StepRunnerPluginSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Steps) > 0 {
+		for _, s := range m.Steps {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&ProjectStatus{`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`Stats:` + strings.Replace(this.Stats.String(), "ProjectStats", "ProjectStats", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Promotion) String() string {
-	if this == nil {
-		return "nil"
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Promotion{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionSpec", "PromotionSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 1 + sovGenerated(uint64(m.ErrorThreshold))
+	return n
 }
-func (this *PromotionList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Verification) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Promotion{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Promotion", "Promotion", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.AnalysisTemplates) > 0 {
+		for _, e := range m.AnalysisTemplates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PromotionList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionPolicy) String() string {
-	if this == nil {
-		return "nil"
+	if m.AnalysisRunMetadata != nil {
+		l = m.AnalysisRunMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PromotionPolicy{`,
-		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
-		`AutoPromotionEnabled:` + fmt.Sprintf("%v", this.AutoPromotionEnabled) + `,`,
-		`StageSelector:` + strings.Replace(this.StageSelector.String(), "PromotionPolicySelector", "PromotionPolicySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionPolicySelector) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&PromotionPolicySelector{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PromotionReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *VerificationInfo) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PromotionReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
-		`Status:` + strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionSpec) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AnalysisRun != nil {
+		l = m.AnalysisRun.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForSteps := "[]PromotionStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForSteps += "}"
-	repeatedStringForVars := "[]ExpressionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	if m.FinishTime != nil {
+		l = m.FinishTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForVars += "}"
-	s := strings.Join([]string{`&PromotionSpec{`,
-		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
-		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`Vars:` + repeatedStringForVars + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Actor)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *PromotionStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *VerifiedStage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForHealthChecks := "[]HealthCheckStep{"
-	for _, f := range this.HealthChecks {
-		repeatedStringForHealthChecks += strings.Replace(strings.Replace(f.String(), "HealthCheckStep", "HealthCheckStep", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.VerifiedAt != nil {
+		l = m.VerifiedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForHealthChecks += "}"
-	repeatedStringForStepExecutionMetadata := "[]StepExecutionMetadata{"
-	for _, f := range this.StepExecutionMetadata {
-		repeatedStringForStepExecutionMetadata += strings.Replace(strings.Replace(f.String(), "StepExecutionMetadata", "StepExecutionMetadata", 1), `&`, ``, 1) + ","
+	if m.LongestCompletedSoak != nil {
+		l = m.LongestCompletedSoak.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForStepExecutionMetadata += "}"
-	s := strings.Join([]string{`&PromotionStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`FreightCollection:` + strings.Replace(this.FreightCollection.String(), "FreightCollection", "FreightCollection", 1) + `,`,
-		`HealthChecks:` + repeatedStringForHealthChecks + `,`,
-		`CurrentStep:` + fmt.Sprintf("%v", this.CurrentStep) + `,`,
-		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "JSON", "v11.JSON", 1) + `,`,
-		`StepExecutionMetadata:` + repeatedStringForStepExecutionMetadata + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PromotionStep) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Warehouse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVars := "[]ExpressionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WarehouseList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVars += "}"
-	s := strings.Join([]string{`&PromotionStep{`,
-		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
-		`As:` + fmt.Sprintf("%v", this.As) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
-		`Retry:` + strings.Replace(this.Retry.String(), "PromotionStepRetry", "PromotionStepRetry", 1) + `,`,
-		`Task:` + strings.Replace(this.Task.String(), "PromotionTaskReference", "PromotionTaskReference", 1) + `,`,
-		`Vars:` + repeatedStringForVars + `,`,
-		`If:` + fmt.Sprintf("%v", this.If) + `,`,
-		`ContinueOnError:` + fmt.Sprintf("%v", this.ContinueOnError) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *PromotionStepRetry) String() string {
+
+func (m *WarehouseSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Shard)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FreightCreationPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Interval.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FreightCreationCriteria != nil {
+		l = m.FreightCreationCriteria.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WarehouseStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Count))
+	return n
+}
+
+func (m *WarehouseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DiscoveredArtifacts != nil {
+		l = m.DiscoveredArtifacts.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.LastFreightID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WebhookReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebhookReceiverConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.GitHub != nil {
+		l = m.GitHub.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AnalysisRunArgument) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PromotionStepRetry{`,
-		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
-		`ErrorThreshold:` + fmt.Sprintf("%v", this.ErrorThreshold) + `,`,
+	s := strings.Join([]string{`&AnalysisRunArgument{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PromotionTask) String() string {
+func (this *AnalysisRunMetadata) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PromotionTask{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&AnalysisRunMetadata{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PromotionTaskList) String() string {
+func (this *AnalysisRunReference) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]PromotionTask{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "PromotionTask", "PromotionTask", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PromotionTaskList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
+	s := strings.Join([]string{`&AnalysisRunReference{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PromotionTaskReference) String() string {
+func (this *AnalysisTemplateReference) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PromotionTaskReference{`,
+	s := strings.Join([]string{`&AnalysisTemplateReference{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PromotionTaskSpec) String() string {
+func (this *Approval) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForVars := "[]ExpressionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForVars += "}"
-	repeatedStringForSteps := "[]PromotionStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForSteps += "}"
-	s := strings.Join([]string{`&PromotionTaskSpec{`,
-		`Vars:` + repeatedStringForVars + `,`,
-		`Steps:` + repeatedStringForSteps + `,`,
+	s := strings.Join([]string{`&Approval{`,
+		`Approver:` + fmt.Sprintf("%v", this.Approver) + `,`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PromotionTemplate) String() string {
+func (this *ApprovalPolicy) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PromotionTemplate{`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTemplateSpec", "PromotionTemplateSpec", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&ApprovalPolicy{`,
+		`RequiredApprovals:` + fmt.Sprintf("%v", this.RequiredApprovals) + `,`,
+		`EligibleGroups:` + fmt.Sprintf("%v", this.EligibleGroups) + `,`,
+		`EligibleRoles:` + fmt.Sprintf("%v", this.EligibleRoles) + `,`,
+		`ExcludeCommitAuthors:` + fmt.Sprintf("%v", this.ExcludeCommitAuthors) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PromotionTemplateSpec) String() string {
+func (this *ApprovedStage) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForSteps := "[]PromotionStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForSteps += "}"
-	repeatedStringForVars := "[]ExpressionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	repeatedStringForApprovals := "[]Approval{"
+	for _, f := range this.Approvals {
+		repeatedStringForApprovals += strings.Replace(strings.Replace(f.String(), "Approval", "Approval", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForVars += "}"
-	s := strings.Join([]string{`&PromotionTemplateSpec{`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`Vars:` + repeatedStringForVars + `,`,
+	repeatedStringForApprovals += "}"
+	s := strings.Join([]string{`&ApprovedStage{`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`Approvals:` + repeatedStringForApprovals + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RepoSubscription) String() string {
+func (this *ArgoCDAppHealthStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RepoSubscription{`,
-		`Git:` + strings.Replace(this.Git.String(), "GitSubscription", "GitSubscription", 1) + `,`,
-		`Image:` + strings.Replace(this.Image.String(), "ImageSubscription", "ImageSubscription", 1) + `,`,
-		`Chart:` + strings.Replace(this.Chart.String(), "ChartSubscription", "ChartSubscription", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Stage) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Stage{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StageSpec", "StageSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "StageStatus", "StageStatus", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&ArgoCDAppHealthStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageList) String() string {
+func (this *ArgoCDAppStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Stage{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Stage", "Stage", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&StageList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
+	s := strings.Join([]string{`&ArgoCDAppStatus{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`HealthStatus:` + strings.Replace(strings.Replace(this.HealthStatus.String(), "ArgoCDAppHealthStatus", "ArgoCDAppHealthStatus", 1), `&`, ``, 1) + `,`,
+		`SyncStatus:` + strings.Replace(strings.Replace(this.SyncStatus.String(), "ArgoCDAppSyncStatus", "ArgoCDAppSyncStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageSpec) String() string {
+func (this *ArgoCDAppSyncStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForRequestedFreight := "[]FreightRequest{"
-	for _, f := range this.RequestedFreight {
-		repeatedStringForRequestedFreight += strings.Replace(strings.Replace(f.String(), "FreightRequest", "FreightRequest", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForRequestedFreight += "}"
-	repeatedStringForVars := "[]ExpressionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForVars += "}"
-	s := strings.Join([]string{`&StageSpec{`,
-		`Verification:` + strings.Replace(this.Verification.String(), "Verification", "Verification", 1) + `,`,
-		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
-		`RequestedFreight:` + repeatedStringForRequestedFreight + `,`,
-		`PromotionTemplate:` + strings.Replace(this.PromotionTemplate.String(), "PromotionTemplate", "PromotionTemplate", 1) + `,`,
-		`Vars:` + repeatedStringForVars + `,`,
+	s := strings.Join([]string{`&ArgoCDAppSyncStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageStats) String() string {
+func (this *AutoRollback) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&StageStats{`,
-		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStats", "HealthStats", 1), `&`, ``, 1) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+	s := strings.Join([]string{`&AutoRollback{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageStatus) String() string {
+func (this *Chart) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForFreightHistory := "[]*FreightCollection{"
-	for _, f := range this.FreightHistory {
-		repeatedStringForFreightHistory += strings.Replace(f.String(), "FreightCollection", "FreightCollection", 1) + ","
+	repeatedStringForDependencies := "[]ChartDependency{"
+	for _, f := range this.Dependencies {
+		repeatedStringForDependencies += strings.Replace(strings.Replace(f.String(), "ChartDependency", "ChartDependency", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForFreightHistory += "}"
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	repeatedStringForDependencies += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&StageStatus{`,
-		`FreightHistory:` + repeatedStringForFreightHistory + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`CurrentPromotion:` + strings.Replace(this.CurrentPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
-		`Health:` + strings.Replace(this.Health.String(), "Health", "Health", 1) + `,`,
-		`LastPromotion:` + strings.Replace(this.LastPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`FreightSummary:` + fmt.Sprintf("%v", this.FreightSummary) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&Chart{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`AppVersion:` + fmt.Sprintf("%v", this.AppVersion) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Dependencies:` + repeatedStringForDependencies + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StepExecutionMetadata) String() string {
+func (this *ChartDependency) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&StepExecutionMetadata{`,
-		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
-		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`ErrorCount:` + fmt.Sprintf("%v", this.ErrorCount) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`ContinueOnError:` + fmt.Sprintf("%v", this.ContinueOnError) + `,`,
+	s := strings.Join([]string{`&ChartDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Repository:` + fmt.Sprintf("%v", this.Repository) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Verification) String() string {
+func (this *ChartDiscoveryResult) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForAnalysisTemplates := "[]AnalysisTemplateReference{"
-	for _, f := range this.AnalysisTemplates {
-		repeatedStringForAnalysisTemplates += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateReference", "AnalysisTemplateReference", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForAnalysisTemplates += "}"
-	repeatedStringForArgs := "[]AnalysisRunArgument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	repeatedStringForMetadata := "[]ChartVersionMetadata{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(strings.Replace(f.String(), "ChartVersionMetadata", "ChartVersionMetadata", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&Verification{`,
-		`AnalysisTemplates:` + repeatedStringForAnalysisTemplates + `,`,
-		`AnalysisRunMetadata:` + strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1) + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&ChartDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
+		`AppVersionConstraint:` + fmt.Sprintf("%v", this.AppVersionConstraint) + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VerificationInfo) String() string {
+func (this *ChartSubscription) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&VerificationInfo{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`AnalysisRun:` + strings.Replace(this.AnalysisRun.String(), "AnalysisRunReference", "AnalysisRunReference", 1) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1) + `,`,
-		`FinishTime:` + strings.Replace(fmt.Sprintf("%v", this.FinishTime), "Time", "v1.Time", 1) + `,`,
-		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+	s := strings.Join([]string{`&ChartSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`AppVersionConstraint:` + fmt.Sprintf("%v", this.AppVersionConstraint) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VerifiedStage) String() string {
+func (this *ChartVersionMetadata) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&VerifiedStage{`,
-		`VerifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.VerifiedAt), "Time", "v1.Time", 1) + `,`,
-		`LongestCompletedSoak:` + strings.Replace(fmt.Sprintf("%v", this.LongestCompletedSoak), "Duration", "v1.Duration", 1) + `,`,
+	repeatedStringForDependencies := "[]ChartDependency{"
+	for _, f := range this.Dependencies {
+		repeatedStringForDependencies += strings.Replace(strings.Replace(f.String(), "ChartDependency", "ChartDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependencies += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ChartVersionMetadata{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`AppVersion:` + fmt.Sprintf("%v", this.AppVersion) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Dependencies:` + repeatedStringForDependencies + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Warehouse) String() string {
+func (this *ClusterPromotionTask) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Warehouse{`,
+	s := strings.Join([]string{`&ClusterPromotionTask{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WarehouseSpec", "WarehouseSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WarehouseStatus", "WarehouseStatus", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseList) String() string {
+func (this *ClusterPromotionTaskList) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Warehouse{"
+	repeatedStringForItems := "[]ClusterPromotionTask{"
 	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Warehouse", "Warehouse", 1), `&`, ``, 1) + ","
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterPromotionTask", "ClusterPromotionTask", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WarehouseList{`,
+	s := strings.Join([]string{`&ClusterPromotionTaskList{`,
 		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseSpec) String() string {
+func (this *CurrentStage) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForSubscriptions := "[]RepoSubscription{"
-	for _, f := range this.Subscriptions {
-		repeatedStringForSubscriptions += strings.Replace(strings.Replace(f.String(), "RepoSubscription", "RepoSubscription", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForSubscriptions += "}"
-	s := strings.Join([]string{`&WarehouseSpec{`,
-		`Subscriptions:` + repeatedStringForSubscriptions + `,`,
-		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
-		`FreightCreationPolicy:` + fmt.Sprintf("%v", this.FreightCreationPolicy) + `,`,
-		`Interval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&CurrentStage{`,
+		`Since:` + strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseStats) String() string {
+func (this *DiscoveredArtifacts) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WarehouseStats{`,
-		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStats", "HealthStats", 1), `&`, ``, 1) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+	repeatedStringForGit := "[]GitDiscoveryResult{"
+	for _, f := range this.Git {
+		repeatedStringForGit += strings.Replace(strings.Replace(f.String(), "GitDiscoveryResult", "GitDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGit += "}"
+	repeatedStringForImages := "[]ImageDiscoveryResult{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ImageDiscoveryResult", "ImageDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]ChartDiscoveryResult{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "ChartDiscoveryResult", "ChartDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForOCIArtifacts := "[]OCIArtifactDiscoveryResult{"
+	for _, f := range this.OCIArtifacts {
+		repeatedStringForOCIArtifacts += strings.Replace(strings.Replace(f.String(), "OCIArtifactDiscoveryResult", "OCIArtifactDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOCIArtifacts += "}"
+	repeatedStringForReleases := "[]ReleaseFeedDiscoveryResult{"
+	for _, f := range this.Releases {
+		repeatedStringForReleases += strings.Replace(strings.Replace(f.String(), "ReleaseFeedDiscoveryResult", "ReleaseFeedDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReleases += "}"
+	s := strings.Join([]string{`&DiscoveredArtifacts{`,
+		`Git:` + repeatedStringForGit + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`DiscoveredAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DiscoveredAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`OCIArtifacts:` + repeatedStringForOCIArtifacts + `,`,
+		`Releases:` + repeatedStringForReleases + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseStatus) String() string {
+func (this *DiscoveredCommit) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
-	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&WarehouseStatus{`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`DiscoveredArtifacts:` + strings.Replace(this.DiscoveredArtifacts.String(), "DiscoveredArtifacts", "DiscoveredArtifacts", 1) + `,`,
-		`LastFreightID:` + fmt.Sprintf("%v", this.LastFreightID) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
+	s := strings.Join([]string{`&DiscoveredCommit{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
+		`CreatorDate:` + strings.Replace(fmt.Sprintf("%v", this.CreatorDate), "Time", "v1.Time", 1) + `,`,
+		`PullRequestNumber:` + fmt.Sprintf("%v", this.PullRequestNumber) + `,`,
+		`PullRequestURL:` + fmt.Sprintf("%v", this.PullRequestURL) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WebhookReceiver) String() string {
+func (this *DiscoveredImageReference) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WebhookReceiver{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&DiscoveredImageReference{`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`SourceRepoURL:` + fmt.Sprintf("%v", this.SourceRepoURL) + `,`,
+		`SourceCommit:` + fmt.Sprintf("%v", this.SourceCommit) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WebhookReceiverConfig) String() string {
+func (this *DiscoveredOCIArtifactReference) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WebhookReceiverConfig{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`GitHub:` + strings.Replace(this.GitHub.String(), "GitHubWebhookReceiver", "GitHubWebhookReceiver", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&DiscoveredOCIArtifactReference{`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`ArtifactType:` + fmt.Sprintf("%v", this.ArtifactType) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *DiscoveredRelease) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&DiscoveredRelease{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExpressionVariable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExpressionVariable{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Freight) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]GitCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	repeatedStringForImages := "[]Image{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]Chart{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForOCIArtifacts := "[]OCIArtifact{"
+	for _, f := range this.OCIArtifacts {
+		repeatedStringForOCIArtifacts += strings.Replace(strings.Replace(f.String(), "OCIArtifact", "OCIArtifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOCIArtifacts += "}"
+	repeatedStringForReleases := "[]Release{"
+	for _, f := range this.Releases {
+		repeatedStringForReleases += strings.Replace(strings.Replace(f.String(), "Release", "Release", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReleases += "}"
+	s := strings.Join([]string{`&Freight{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "FreightStatus", "FreightStatus", 1), `&`, ``, 1) + `,`,
+		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`OCIArtifacts:` + repeatedStringForOCIArtifacts + `,`,
+		`Releases:` + repeatedStringForReleases + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightCollection) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVerificationHistory := "[]VerificationInfo{"
+	for _, f := range this.VerificationHistory {
+		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVerificationHistory += "}"
+	keysForFreight := make([]string, 0, len(this.Freight))
+	for k := range this.Freight {
+		keysForFreight = append(keysForFreight, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFreight)
+	mapStringForFreight := "map[string]FreightReference{"
+	for _, k := range keysForFreight {
+		mapStringForFreight += fmt.Sprintf("%v: %v,", k, this.Freight[k])
+	}
+	mapStringForFreight += "}"
+	s := strings.Join([]string{`&FreightCollection{`,
+		`Freight:` + mapStringForFreight + `,`,
+		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightCreationCriteria) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightCreationCriteria{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Freight{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Freight", "Freight", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&FreightList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]GitCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	repeatedStringForImages := "[]Image{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]Chart{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForOCIArtifacts := "[]OCIArtifact{"
+	for _, f := range this.OCIArtifacts {
+		repeatedStringForOCIArtifacts += strings.Replace(strings.Replace(f.String(), "OCIArtifact", "OCIArtifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOCIArtifacts += "}"
+	repeatedStringForReleases := "[]Release{"
+	for _, f := range this.Releases {
+		repeatedStringForReleases += strings.Replace(strings.Replace(f.String(), "Release", "Release", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReleases += "}"
+	s := strings.Join([]string{`&FreightReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`OCIArtifacts:` + repeatedStringForOCIArtifacts + `,`,
+		`Releases:` + repeatedStringForReleases + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightRequest{`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`Sources:` + strings.Replace(strings.Replace(this.Sources.String(), "FreightSources", "FreightSources", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightSources) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightSources{`,
+		`Direct:` + fmt.Sprintf("%v", this.Direct) + `,`,
+		`Stages:` + fmt.Sprintf("%v", this.Stages) + `,`,
+		`RequiredSoakTime:` + strings.Replace(fmt.Sprintf("%v", this.RequiredSoakTime), "Duration", "v1.Duration", 1) + `,`,
+		`AvailabilityStrategy:` + fmt.Sprintf("%v", this.AvailabilityStrategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForVerifiedIn := make([]string, 0, len(this.VerifiedIn))
+	for k := range this.VerifiedIn {
+		keysForVerifiedIn = append(keysForVerifiedIn, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVerifiedIn)
+	mapStringForVerifiedIn := "map[string]VerifiedStage{"
+	for _, k := range keysForVerifiedIn {
+		mapStringForVerifiedIn += fmt.Sprintf("%v: %v,", k, this.VerifiedIn[k])
+	}
+	mapStringForVerifiedIn += "}"
+	keysForApprovedFor := make([]string, 0, len(this.ApprovedFor))
+	for k := range this.ApprovedFor {
+		keysForApprovedFor = append(keysForApprovedFor, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForApprovedFor)
+	mapStringForApprovedFor := "map[string]ApprovedStage{"
+	for _, k := range keysForApprovedFor {
+		mapStringForApprovedFor += fmt.Sprintf("%v: %v,", k, this.ApprovedFor[k])
+	}
+	mapStringForApprovedFor += "}"
+	keysForCurrentlyIn := make([]string, 0, len(this.CurrentlyIn))
+	for k := range this.CurrentlyIn {
+		keysForCurrentlyIn = append(keysForCurrentlyIn, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCurrentlyIn)
+	mapStringForCurrentlyIn := "map[string]CurrentStage{"
+	for _, k := range keysForCurrentlyIn {
+		mapStringForCurrentlyIn += fmt.Sprintf("%v: %v,", k, this.CurrentlyIn[k])
+	}
+	mapStringForCurrentlyIn += "}"
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]v11.JSON{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	keysForRejectedFor := make([]string, 0, len(this.RejectedFor))
+	for k := range this.RejectedFor {
+		keysForRejectedFor = append(keysForRejectedFor, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRejectedFor)
+	mapStringForRejectedFor := "map[string]RejectedFreight{"
+	for _, k := range keysForRejectedFor {
+		mapStringForRejectedFor += fmt.Sprintf("%v: %v,", k, this.RejectedFor[k])
+	}
+	mapStringForRejectedFor += "}"
+	s := strings.Join([]string{`&FreightStatus{`,
+		`VerifiedIn:` + mapStringForVerifiedIn + `,`,
+		`ApprovedFor:` + mapStringForApprovedFor + `,`,
+		`CurrentlyIn:` + mapStringForCurrentlyIn + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`Rejected:` + strings.Replace(this.Rejected.String(), "RejectedFreight", "RejectedFreight", 1) + `,`,
+		`RejectedFor:` + mapStringForRejectedFor + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitCommit) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := strings.Join([]string{`&GitCommit{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
+		`PullRequestNumber:` + fmt.Sprintf("%v", this.PullRequestNumber) + `,`,
+		`PullRequestURL:` + fmt.Sprintf("%v", this.PullRequestURL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]DiscoveredCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "DiscoveredCommit", "DiscoveredCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	s := strings.Join([]string{`&GitDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitHubWebhookReceiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitHubWebhookReceiver{`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "LocalObjectReference", "v12.LocalObjectReference", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitPullRequestFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitPullRequestFilter{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`BaseBranch:` + fmt.Sprintf("%v", this.BaseBranch) + `,`,
+		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`CommitSelectionStrategy:` + fmt.Sprintf("%v", this.CommitSelectionStrategy) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`IncludePaths:` + fmt.Sprintf("%v", this.IncludePaths) + `,`,
+		`ExcludePaths:` + fmt.Sprintf("%v", this.ExcludePaths) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
+		`PullRequests:` + strings.Replace(this.PullRequests.String(), "GitPullRequestFilter", "GitPullRequestFilter", 1) + `,`,
+		`BranchPattern:` + fmt.Sprintf("%v", this.BranchPattern) + `,`,
+		`BranchSelectionStrategy:` + fmt.Sprintf("%v", this.BranchSelectionStrategy) + `,`,
+		`FreightPerBranch:` + fmt.Sprintf("%v", this.FreightPerBranch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Health) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Health{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Issues:` + fmt.Sprintf("%v", this.Issues) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
+		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "JSON", "v11.JSON", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthCheckStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthCheckStep{`,
+		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthStats{`,
+		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Image) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&Image{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`SourceRepoURL:` + fmt.Sprintf("%v", this.SourceRepoURL) + `,`,
+		`SourceCommit:` + fmt.Sprintf("%v", this.SourceCommit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReferences := "[]DiscoveredImageReference{"
+	for _, f := range this.References {
+		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredImageReference", "DiscoveredImageReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReferences += "}"
+	s := strings.Join([]string{`&ImageDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`References:` + repeatedStringForReferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`ImageSelectionStrategy:` + fmt.Sprintf("%v", this.ImageSelectionStrategy) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
+		`ExpressionFilter:` + fmt.Sprintf("%v", this.ExpressionFilter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&OCIArtifact{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`ArtifactType:` + fmt.Sprintf("%v", this.ArtifactType) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIArtifactDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReferences := "[]DiscoveredOCIArtifactReference{"
+	for _, f := range this.References {
+		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredOCIArtifactReference", "DiscoveredOCIArtifactReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReferences += "}"
+	s := strings.Join([]string{`&OCIArtifactDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`ArtifactType:` + fmt.Sprintf("%v", this.ArtifactType) + `,`,
+		`References:` + repeatedStringForReferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIArtifactSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIArtifactSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`SelectionStrategy:` + fmt.Sprintf("%v", this.SelectionStrategy) + `,`,
+		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`ArtifactType:` + fmt.Sprintf("%v", this.ArtifactType) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Project) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Project{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(this.Spec.String(), "ProjectConfigSpec", "ProjectConfigSpec", 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectConfig{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectConfigSpec", "ProjectConfigSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectConfigStatus", "ProjectConfigStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectConfigList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ProjectConfig{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ProjectConfig", "ProjectConfig", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ProjectConfigList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectConfigSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPromotionPolicies := "[]PromotionPolicy{"
+	for _, f := range this.PromotionPolicies {
+		repeatedStringForPromotionPolicies += strings.Replace(strings.Replace(f.String(), "PromotionPolicy", "PromotionPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPromotionPolicies += "}"
+	repeatedStringForWebhookReceivers := "[]WebhookReceiverConfig{"
+	for _, f := range this.WebhookReceivers {
+		repeatedStringForWebhookReceivers += strings.Replace(strings.Replace(f.String(), "WebhookReceiverConfig", "WebhookReceiverConfig", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWebhookReceivers += "}"
+	s := strings.Join([]string{`&ProjectConfigSpec{`,
+		`PromotionPolicies:` + repeatedStringForPromotionPolicies + `,`,
+		`WebhookReceivers:` + repeatedStringForWebhookReceivers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectConfigStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForWebhookReceivers := "[]WebhookReceiver{"
+	for _, f := range this.WebhookReceivers {
+		repeatedStringForWebhookReceivers += strings.Replace(strings.Replace(f.String(), "WebhookReceiver", "WebhookReceiver", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWebhookReceivers += "}"
+	s := strings.Join([]string{`&ProjectConfigStatus{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`WebhookReceivers:` + repeatedStringForWebhookReceivers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Project{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ProjectList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectStats{`,
+		`Warehouses:` + strings.Replace(strings.Replace(this.Warehouses.String(), "WarehouseStats", "WarehouseStats", 1), `&`, ``, 1) + `,`,
+		`Stages:` + strings.Replace(strings.Replace(this.Stages.String(), "StageStats", "StageStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&ProjectStatus{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`Stats:` + strings.Replace(this.Stats.String(), "ProjectStats", "ProjectStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Promotion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Promotion{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionSpec", "PromotionSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Promotion{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Promotion", "Promotion", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PromotionList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionPolicy{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`AutoPromotionEnabled:` + fmt.Sprintf("%v", this.AutoPromotionEnabled) + `,`,
+		`StageSelector:` + strings.Replace(this.StageSelector.String(), "PromotionPolicySelector", "PromotionPolicySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionPolicySelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionPolicySelector{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]PromotionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	repeatedStringForVars := "[]ExpressionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	s := strings.Join([]string{`&PromotionSpec{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHealthChecks := "[]HealthCheckStep{"
+	for _, f := range this.HealthChecks {
+		repeatedStringForHealthChecks += strings.Replace(strings.Replace(f.String(), "HealthCheckStep", "HealthCheckStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHealthChecks += "}"
+	repeatedStringForStepExecutionMetadata := "[]StepExecutionMetadata{"
+	for _, f := range this.StepExecutionMetadata {
+		repeatedStringForStepExecutionMetadata += strings.Replace(strings.Replace(f.String(), "StepExecutionMetadata", "StepExecutionMetadata", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStepExecutionMetadata += "}"
+	s := strings.Join([]string{`&PromotionStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`FreightCollection:` + strings.Replace(this.FreightCollection.String(), "FreightCollection", "FreightCollection", 1) + `,`,
+		`HealthChecks:` + repeatedStringForHealthChecks + `,`,
+		`CurrentStep:` + fmt.Sprintf("%v", this.CurrentStep) + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "JSON", "v11.JSON", 1) + `,`,
+		`StepExecutionMetadata:` + repeatedStringForStepExecutionMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVars := "[]ExpressionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	s := strings.Join([]string{`&PromotionStep{`,
+		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
+		`As:` + fmt.Sprintf("%v", this.As) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
+		`Retry:` + strings.Replace(this.Retry.String(), "PromotionStepRetry", "PromotionStepRetry", 1) + `,`,
+		`Task:` + strings.Replace(this.Task.String(), "PromotionTaskReference", "PromotionTaskReference", 1) + `,`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`If:` + fmt.Sprintf("%v", this.If) + `,`,
+		`ContinueOnError:` + fmt.Sprintf("%v", this.ContinueOnError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionStepRetry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionStepRetry{`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
+		`ErrorThreshold:` + fmt.Sprintf("%v", this.ErrorThreshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTask{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTaskList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]PromotionTask{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "PromotionTask", "PromotionTask", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PromotionTaskList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTaskReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTaskReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTaskSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVars := "[]ExpressionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	repeatedStringForSteps := "[]PromotionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&PromotionTaskSpec{`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTemplate{`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTemplateSpec", "PromotionTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]PromotionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	repeatedStringForVars := "[]ExpressionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	s := strings.Join([]string{`&PromotionTemplateSpec{`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RejectedFreight) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RejectedFreight{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`RejectedBy:` + fmt.Sprintf("%v", this.RejectedBy) + `,`,
+		`RejectedAt:` + strings.Replace(fmt.Sprintf("%v", this.RejectedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Release) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&Release{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseFeedDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReleases := "[]DiscoveredRelease{"
+	for _, f := range this.Releases {
+		repeatedStringForReleases += strings.Replace(strings.Replace(f.String(), "DiscoveredRelease", "DiscoveredRelease", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReleases += "}"
+	s := strings.Join([]string{`&ReleaseFeedDiscoveryResult{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Releases:` + repeatedStringForReleases + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseFeedHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseFeedHeader{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseFeedMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseFeedMetadata{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseFeedSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]ReleaseFeedHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "ReleaseFeedHeader", "ReleaseFeedHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	repeatedStringForMetadata := "[]ReleaseFeedMetadata{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(strings.Replace(f.String(), "ReleaseFeedMetadata", "ReleaseFeedMetadata", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&ReleaseFeedSubscription{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`VersionsFromExpression:` + fmt.Sprintf("%v", this.VersionsFromExpression) + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`SelectionStrategy:` + fmt.Sprintf("%v", this.SelectionStrategy) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowVersions:` + fmt.Sprintf("%v", this.AllowVersions) + `,`,
+		`IgnoreVersions:` + fmt.Sprintf("%v", this.IgnoreVersions) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepoSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepoSubscription{`,
+		`Git:` + strings.Replace(this.Git.String(), "GitSubscription", "GitSubscription", 1) + `,`,
+		`Image:` + strings.Replace(this.Image.String(), "ImageSubscription", "ImageSubscription", 1) + `,`,
+		`Chart:` + strings.Replace(this.Chart.String(), "ChartSubscription", "ChartSubscription", 1) + `,`,
+		`OCIArtifact:` + strings.Replace(this.OCIArtifact.String(), "OCIArtifactSubscription", "OCIArtifactSubscription", 1) + `,`,
+		`ReleaseFeed:` + strings.Replace(this.ReleaseFeed.String(), "ReleaseFeedSubscription", "ReleaseFeedSubscription", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Stage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Stage{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StageSpec", "StageSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "StageStatus", "StageStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Stage{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Stage", "Stage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&StageList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequestedFreight := "[]FreightRequest{"
+	for _, f := range this.RequestedFreight {
+		repeatedStringForRequestedFreight += strings.Replace(strings.Replace(f.String(), "FreightRequest", "FreightRequest", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRequestedFreight += "}"
+	repeatedStringForVars := "[]ExpressionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "ExpressionVariable", "ExpressionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	s := strings.Join([]string{`&StageSpec{`,
+		`Verification:` + strings.Replace(this.Verification.String(), "Verification", "Verification", 1) + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`RequestedFreight:` + repeatedStringForRequestedFreight + `,`,
+		`PromotionTemplate:` + strings.Replace(this.PromotionTemplate.String(), "PromotionTemplate", "PromotionTemplate", 1) + `,`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`ApprovalPolicy:` + strings.Replace(this.ApprovalPolicy.String(), "ApprovalPolicy", "ApprovalPolicy", 1) + `,`,
+		`AutoRollback:` + strings.Replace(this.AutoRollback.String(), "AutoRollback", "AutoRollback", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StageStats{`,
+		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStats", "HealthStats", 1), `&`, ``, 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFreightHistory := "[]*FreightCollection{"
+	for _, f := range this.FreightHistory {
+		repeatedStringForFreightHistory += strings.Replace(f.String(), "FreightCollection", "FreightCollection", 1) + ","
+	}
+	repeatedStringForFreightHistory += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&StageStatus{`,
+		`FreightHistory:` + repeatedStringForFreightHistory + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`CurrentPromotion:` + strings.Replace(this.CurrentPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
+		`Health:` + strings.Replace(this.Health.String(), "Health", "Health", 1) + `,`,
+		`LastPromotion:` + strings.Replace(this.LastPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`FreightSummary:` + fmt.Sprintf("%v", this.FreightSummary) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepExecutionMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepExecutionMetadata{`,
+		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`ErrorCount:` + fmt.Sprintf("%v", this.ErrorCount) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ContinueOnError:` + fmt.Sprintf("%v", this.ContinueOnError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepRunnerPlugin) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepRunnerPlugin{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StepRunnerPluginSpec", "StepRunnerPluginSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepRunnerPluginList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]StepRunnerPlugin{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "StepRunnerPlugin", "StepRunnerPlugin", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&StepRunnerPluginList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepRunnerPluginSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepRunnerPluginSpec{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`Steps:` + fmt.Sprintf("%v", this.Steps) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
+		`ErrorThreshold:` + fmt.Sprintf("%v", this.ErrorThreshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Verification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAnalysisTemplates := "[]AnalysisTemplateReference{"
+	for _, f := range this.AnalysisTemplates {
+		repeatedStringForAnalysisTemplates += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateReference", "AnalysisTemplateReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalysisTemplates += "}"
+	repeatedStringForArgs := "[]AnalysisRunArgument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&Verification{`,
+		`AnalysisTemplates:` + repeatedStringForAnalysisTemplates + `,`,
+		`AnalysisRunMetadata:` + strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerificationInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerificationInfo{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`AnalysisRun:` + strings.Replace(this.AnalysisRun.String(), "AnalysisRunReference", "AnalysisRunReference", 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1) + `,`,
+		`FinishTime:` + strings.Replace(fmt.Sprintf("%v", this.FinishTime), "Time", "v1.Time", 1) + `,`,
+		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifiedStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifiedStage{`,
+		`VerifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.VerifiedAt), "Time", "v1.Time", 1) + `,`,
+		`LongestCompletedSoak:` + strings.Replace(fmt.Sprintf("%v", this.LongestCompletedSoak), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Warehouse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Warehouse{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WarehouseSpec", "WarehouseSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WarehouseStatus", "WarehouseStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Warehouse{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Warehouse", "Warehouse", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WarehouseList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubscriptions := "[]RepoSubscription{"
+	for _, f := range this.Subscriptions {
+		repeatedStringForSubscriptions += strings.Replace(strings.Replace(f.String(), "RepoSubscription", "RepoSubscription", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSubscriptions += "}"
+	s := strings.Join([]string{`&WarehouseSpec{`,
+		`Subscriptions:` + repeatedStringForSubscriptions + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`FreightCreationPolicy:` + fmt.Sprintf("%v", this.FreightCreationPolicy) + `,`,
+		`Interval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`FreightCreationCriteria:` + strings.Replace(this.FreightCreationCriteria.String(), "FreightCreationCriteria", "FreightCreationCriteria", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WarehouseStats{`,
+		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStats", "HealthStats", 1), `&`, ``, 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&WarehouseStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`DiscoveredArtifacts:` + strings.Replace(this.DiscoveredArtifacts.String(), "DiscoveredArtifacts", "DiscoveredArtifacts", 1) + `,`,
+		`LastFreightID:` + fmt.Sprintf("%v", this.LastFreightID) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookReceiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookReceiver{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookReceiverConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookReceiverConfig{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`GitHub:` + strings.Replace(this.GitHub.String(), "GitHubWebhookReceiver", "GitHubWebhookReceiver", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Approval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
+			}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovalPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovalPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovalPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+			}
+			m.RequiredApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredApprovals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EligibleGroups = append(m.EligibleGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleRoles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EligibleRoles = append(m.EligibleRoles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeCommitAuthors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeCommitAuthors = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
+			}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, ChartDependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersionConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersionConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, ChartVersionMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersionConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersionConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartVersionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartVersionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartVersionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, ChartDependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterPromotionTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterPromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterPromotionTaskList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterPromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterPromotionTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &v1.Time{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Git = append(m.Git, GitDiscoveryResult{})
+			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, ImageDiscoveryResult{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, ChartDiscoveryResult{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DiscoveredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OCIArtifacts = append(m.OCIArtifacts, OCIArtifactDiscoveryResult{})
+			if err := m.OCIArtifacts[len(m.OCIArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, ReleaseFeedDiscoveryResult{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatorDate == nil {
+				m.CreatorDate = &v1.Time{}
+			}
+			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestNumber", wireType)
+			}
+			m.PullRequestNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullRequestNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequestURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredOCIArtifactReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredOCIArtifactReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredOCIArtifactReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpressionVariable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpressionVariable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+func (m *Freight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9474,30 +17255,165 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9507,27 +17423,28 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9555,61 +17472,44 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9636,107 +17536,98 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.OCIArtifacts = append(m.OCIArtifacts, OCIArtifact{})
+			if err := m.OCIArtifacts[len(m.OCIArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, Release{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9763,11 +17654,11 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Freight == nil {
+				m.Freight = make(map[string]FreightReference)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &FreightReference{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -9816,7 +17707,7 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -9826,24 +17717,26 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &FreightReference{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9859,7 +17752,272 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Freight[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
+			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightCreationCriteria) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightCreationCriteria: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightCreationCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Freight{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9882,7 +18040,7 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9905,15 +18063,15 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9941,7 +18099,7 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9975,38 +18133,6 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10028,7 +18154,7 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+func (m *FreightReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10051,10 +18177,10 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10091,9 +18217,9 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10103,77 +18229,164 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.OCIArtifacts = append(m.OCIArtifacts, OCIArtifact{})
+			if err := m.OCIArtifacts[len(m.OCIArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10200,10 +18413,8 @@ func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApprovedAt == nil {
-				m.ApprovedAt = &v1.Time{}
-			}
-			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Releases = append(m.Releases, Release{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10228,7 +18439,7 @@ func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
+func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10251,17 +18462,17 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10271,29 +18482,30 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10303,23 +18515,24 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10342,7 +18555,7 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
+func (m *FreightSources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10365,17 +18578,17 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10385,27 +18598,15 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Direct = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10433,11 +18634,11 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSoakTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10464,15 +18665,18 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RequiredSoakTime == nil {
+				m.RequiredSoakTime = &v1.Duration{}
+			}
+			if err := m.RequiredSoakTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10482,24 +18686,23 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AvailabilityStrategy = FreightAvailabilityStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10522,7 +18725,7 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
+func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10541,21 +18744,150 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerifiedIn == nil {
+				m.VerifiedIn = make(map[string]VerifiedStage)
+			}
+			var mapkey string
+			mapvalue := &VerifiedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &VerifiedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.VerifiedIn[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10565,29 +18897,126 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			if m.ApprovedFor == nil {
+				m.ApprovedFor = make(map[string]ApprovedStage)
+			}
+			var mapkey string
+			mapvalue := &ApprovedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ApprovedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ApprovedFor[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentlyIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10597,29 +19026,126 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.CurrentlyIn == nil {
+				m.CurrentlyIn = make(map[string]CurrentStage)
+			}
+			var mapkey string
+			mapvalue := &CurrentStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &CurrentStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CurrentlyIn[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10629,79 +19155,126 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Chart) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]v11.JSON)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			mapvalue := &v11.JSON{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.JSON{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Metadata[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10711,29 +19284,33 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if m.Rejected == nil {
+				m.Rejected = &RejectedFreight{}
+			}
+			if err := m.Rejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedFor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10743,55 +19320,120 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if m.RejectedFor == nil {
+				m.RejectedFor = make(map[string]RejectedFreight)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &RejectedFreight{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RejectedFreight{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.RejectedFor[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10814,7 +19456,7 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *GitCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10837,10 +19479,10 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10877,7 +19519,7 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10905,11 +19547,11 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10937,11 +19579,11 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10969,61 +19611,11 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11051,11 +19643,11 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11083,11 +19675,11 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11115,82 +19707,13 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			m.Committer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
-			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterPromotionTask: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterPromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestNumber", wireType)
 			}
-			var msglen int
+			m.PullRequestNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11200,30 +19723,16 @@ func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PullRequestNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11233,24 +19742,23 @@ func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PullRequestURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11273,7 +19781,7 @@ func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
+func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11296,17 +19804,17 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterPromotionTaskList: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterPromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11316,28 +19824,27 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11364,8 +19871,8 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterPromotionTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, DiscoveredCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11390,7 +19897,7 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentStage) Unmarshal(dAtA []byte) error {
+func (m *GitHubWebhookReceiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11413,15 +19920,15 @@ func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentStage: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitHubWebhookReceiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentStage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitHubWebhookReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11448,10 +19955,7 @@ func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Since == nil {
-				m.Since = &v1.Time{}
-			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11476,7 +19980,7 @@ func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
+func (m *GitPullRequestFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11499,17 +20003,17 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitPullRequestFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitPullRequestFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11519,31 +20023,29 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Git = append(m.Git, GitDiscoveryResult{})
-			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11553,65 +20055,29 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, ImageDiscoveryResult{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BaseBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Charts = append(m.Charts, ChartDiscoveryResult{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11621,24 +20087,23 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DiscoveredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11661,7 +20126,7 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
+func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11684,15 +20149,15 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11720,11 +20185,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11752,11 +20217,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11784,11 +20249,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11816,11 +20281,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11848,11 +20313,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11880,13 +20345,13 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11896,81 +20361,15 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreatorDate == nil {
-				m.CreatorDate = &v1.Time{}
-			}
-			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11998,11 +20397,11 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12030,13 +20429,13 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
+			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
-			var stringLen uint64
+			m.DiscoveryLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12046,29 +20445,16 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12078,31 +20464,15 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+			m.StrictSemvers = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12129,157 +20499,16 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.PullRequests == nil {
+				m.PullRequests = &GitPullRequestFilter{}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.PullRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExpressionVariable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpressionVariable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchPattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12307,11 +20536,11 @@ func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BranchPattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchSelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12339,8 +20568,28 @@ func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.BranchSelectionStrategy = BranchSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightPerBranch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FreightPerBranch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12362,7 +20611,7 @@ func (m *ExpressionVariable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Freight) Unmarshal(dAtA []byte) error {
+func (m *Health) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12385,50 +20634,17 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
+			return fmt.Errorf("proto: Health: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12438,31 +20654,29 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = HealthState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12472,29 +20686,27 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12521,14 +20733,16 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &v11.JSON{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12555,13 +20769,66 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Output == nil {
+				m.Output = &v11.JSON{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthCheckStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthCheckStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12589,11 +20856,11 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.Uses = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12620,7 +20887,10 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &v11.JSON{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12645,7 +20915,7 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightCollection) Unmarshal(dAtA []byte) error {
+func (m *HealthStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12668,17 +20938,17 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
 			}
-			var msglen int
+			m.Healthy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12688,126 +20958,66 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Healthy |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = make(map[string]FreightReference)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Image) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var mapkey string
-			mapvalue := &FreightReference{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &FreightReference{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Freight[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12817,29 +21027,27 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
-			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12867,63 +21075,45 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12933,28 +21123,27 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12981,64 +21170,107 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Freight{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13066,11 +21298,11 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
+			m.SourceRepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCommit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13098,7 +21330,7 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SourceCommit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13121,7 +21353,7 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightReference) Unmarshal(dAtA []byte) error {
+func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13144,15 +21376,15 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13180,13 +21412,13 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13196,97 +21428,27 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13313,7 +21475,8 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.References = append(m.References, DiscoveredImageReference{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13338,7 +21501,7 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightRequest) Unmarshal(dAtA []byte) error {
+func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13361,17 +21524,17 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13381,30 +21544,29 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13414,80 +21576,93 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightSources) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13497,15 +21672,27 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Direct = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13533,13 +21720,13 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSoakTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13549,31 +21736,86 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredSoakTime == nil {
-				m.RequiredSoakTime = &v1.Duration{}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			if err := m.RequiredSoakTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictSemvers = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpressionFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13601,7 +21843,7 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailabilityStrategy = FreightAvailabilityStrategy(dAtA[iNdEx:postIndex])
+			m.ExpressionFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13624,7 +21866,7 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightStatus) Unmarshal(dAtA []byte) error {
+func (m *OCIArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13647,17 +21889,17 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13667,124 +21909,123 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerifiedIn == nil {
-				m.VerifiedIn = make(map[string]VerifiedStage)
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var mapkey string
-			mapvalue := &VerifiedStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &VerifiedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.VerifiedIn[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13811,11 +22052,11 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApprovedFor == nil {
-				m.ApprovedFor = make(map[string]ApprovedStage)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &ApprovedStage{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -13864,7 +22105,7 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -13874,26 +22115,24 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &ApprovedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13909,13 +22148,63 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ApprovedFor[mapkey] = *mapvalue
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIArtifactDiscoveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIArtifactDiscoveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIArtifactDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentlyIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13925,124 +22214,59 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentlyIn == nil {
-				m.CurrentlyIn = make(map[string]CurrentStage)
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactType", wireType)
 			}
-			var mapkey string
-			mapvalue := &CurrentStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &CurrentStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.CurrentlyIn[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14069,105 +22293,10 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]v11.JSON)
-			}
-			var mapkey string
-			mapvalue := &v11.JSON{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.JSON{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.References = append(m.References, DiscoveredOCIArtifactReference{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14190,7 +22319,7 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitCommit) Unmarshal(dAtA []byte) error {
+func (m *OCIArtifactSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14213,10 +22342,10 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIArtifactSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIArtifactSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14253,7 +22382,7 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14281,11 +22410,31 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.SelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictSemvers = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14313,11 +22462,11 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14345,11 +22494,11 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14377,11 +22526,11 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14409,13 +22558,13 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.ArtifactType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14425,24 +22574,31 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14464,7 +22620,7 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14487,17 +22643,17 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14507,27 +22663,28 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14544,74 +22701,26 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commits = append(m.Commits, DiscoveredCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitHubWebhookReceiver) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Spec == nil {
+				m.Spec = &ProjectConfigSpec{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitHubWebhookReceiver: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitHubWebhookReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14638,7 +22747,7 @@ func (m *GitHubWebhookReceiver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14663,7 +22772,7 @@ func (m *GitHubWebhookReceiver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitSubscription) Unmarshal(dAtA []byte) error {
+func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14686,17 +22795,17 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14706,29 +22815,30 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14738,29 +22848,30 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14770,61 +22881,80 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectConfigList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectConfigList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14834,29 +22964,30 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTags = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14866,49 +22997,81 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, ProjectConfig{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 8:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectConfigSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectConfigSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14918,29 +23081,31 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
+			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
+			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14950,63 +23115,26 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
-			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverConfig{})
+			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.StrictSemvers = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15028,7 +23156,7 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Health) Unmarshal(dAtA []byte) error {
+func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15051,17 +23179,17 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Health: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectConfigStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectConfigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15071,29 +23199,31 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = HealthState(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15103,27 +23233,79 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
+			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiver{})
+			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15150,16 +23332,13 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v11.JSON{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15186,10 +23365,8 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &v11.JSON{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15214,7 +23391,7 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
+func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15237,17 +23414,17 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthCheckStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthCheckStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warehouses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15257,27 +23434,28 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uses = string(dAtA[iNdEx:postIndex])
+			if err := m.Warehouses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15304,10 +23482,7 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v11.JSON{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15332,7 +23507,7 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStats) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15351,21 +23526,55 @@ func (m *HealthStats) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			m.Healthy = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15375,11 +23584,28 @@ func (m *HealthStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Healthy |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProjectStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15401,7 +23627,7 @@ func (m *HealthStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Image) Unmarshal(dAtA []byte) error {
+func (m *Promotion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15424,17 +23650,17 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15444,29 +23670,30 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15476,29 +23703,30 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15508,29 +23736,80 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15540,27 +23819,28 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15587,103 +23867,10 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Items = append(m.Items, Promotion{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15706,7 +23893,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15729,15 +23916,15 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15765,13 +23952,13 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15781,27 +23968,15 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AutoPromotionEnabled = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StageSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15828,8 +24003,10 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, DiscoveredImageReference{})
-			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StageSelector == nil {
+				m.StageSelector = &PromotionPolicySelector{}
+			}
+			if err := m.StageSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15854,7 +24031,7 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
+func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15877,15 +24054,15 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionPolicySelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionPolicySelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15913,13 +24090,13 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15929,27 +24106,81 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15977,13 +24208,13 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15993,29 +24224,33 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			if m.Freight == nil {
+				m.Freight = &FreightReference{}
+			}
+			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16025,27 +24260,117 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTags = string(dAtA[iNdEx:postIndex])
+			if m.Status == nil {
+				m.Status = &PromotionStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16073,11 +24398,11 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16105,13 +24430,13 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
+			m.Freight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16121,36 +24446,31 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16160,12 +24480,26 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StrictSemvers = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16187,7 +24521,7 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16210,17 +24544,17 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16230,28 +24564,91 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16278,16 +24675,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &ProjectConfigSpec{}
+			if m.Freight == nil {
+				m.Freight = &FreightReference{}
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16314,63 +24711,52 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.FreightCollection == nil {
+				m.FreightCollection = &FreightCollection{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16397,13 +24783,33 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HealthChecks = append(m.HealthChecks, HealthCheckStep{})
+			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+			}
+			m.CurrentStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentStep |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16430,13 +24836,16 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &v11.JSON{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepExecutionMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16463,7 +24872,8 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StepExecutionMetadata = append(m.StepExecutionMetadata, StepExecutionMetadata{})
+			if err := m.StepExecutionMetadata[len(m.StepExecutionMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16488,7 +24898,7 @@ func (m *ProjectConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
+func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16511,17 +24921,17 @@ func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfigList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfigList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16531,30 +24941,29 @@ func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uses = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field As", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16564,79 +24973,27 @@ func (m *ProjectConfigList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ProjectConfig{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.As = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfigSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfigSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16663,14 +25020,16 @@ func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
-			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &v11.JSON{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16697,64 +25056,52 @@ func (m *ProjectConfigSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiverConfig{})
-			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retry == nil {
+				m.Retry = &PromotionStepRetry{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Task == nil {
+				m.Task = &PromotionTaskReference{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectConfigStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectConfigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16781,16 +25128,48 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field If", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.If = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16800,26 +25179,12 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WebhookReceivers = append(m.WebhookReceivers, WebhookReceiver{})
-			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ContinueOnError = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16841,7 +25206,7 @@ func (m *ProjectConfigStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16864,15 +25229,15 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStepRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStepRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16899,15 +25264,18 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorThreshold", wireType)
 			}
-			var msglen int
+			m.ErrorThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16917,26 +25285,11 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ErrorThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16958,7 +25311,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectStats) Unmarshal(dAtA []byte) error {
+func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16981,15 +25334,15 @@ func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warehouses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17016,13 +25369,13 @@ func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Warehouses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17049,7 +25402,7 @@ func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17074,7 +25427,7 @@ func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
+func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17097,15 +25450,15 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTaskList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17132,14 +25485,13 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17166,10 +25518,8 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProjectStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, PromotionTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17194,7 +25544,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Promotion) Unmarshal(dAtA []byte) error {
+func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17217,17 +25567,17 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTaskReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTaskReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17237,63 +25587,29 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17303,24 +25619,23 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17343,7 +25658,7 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionList) Unmarshal(dAtA []byte) error {
+func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17366,15 +25681,15 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTaskSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTaskSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17401,13 +25716,14 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17434,8 +25750,8 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Promotion{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17460,7 +25776,7 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
+func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17483,67 +25799,15 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AutoPromotionEnabled = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StageSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17570,10 +25834,7 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StageSelector == nil {
-				m.StageSelector = &PromotionPolicySelector{}
-			}
-			if err := m.StageSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17598,7 +25859,7 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
+func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17621,17 +25882,17 @@ func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionPolicySelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTemplateSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionPolicySelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17641,27 +25902,29 @@ func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17688,10 +25951,8 @@ func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v1.LabelSelector{}
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17716,7 +25977,7 @@ func (m *PromotionPolicySelector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionReference) Unmarshal(dAtA []byte) error {
+func (m *RejectedFreight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17739,15 +26000,15 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: RejectedFreight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RejectedFreight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17775,13 +26036,13 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17791,67 +26052,27 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = &FreightReference{}
-			}
-			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RejectedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &PromotionStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17878,10 +26099,10 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
+			if m.RejectedAt == nil {
+				m.RejectedAt = &v1.Time{}
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RejectedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17906,7 +26127,7 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
+func (m *Release) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17929,15 +26150,15 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Release: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Release: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17965,11 +26186,11 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17997,13 +26218,190 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freight = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseFeedDiscoveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseFeedDiscoveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseFeedDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18013,29 +26411,27 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18062,8 +26458,8 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Releases = append(m.Releases, DiscoveredRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18088,7 +26484,7 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
+func (m *ReleaseFeedHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18111,15 +26507,15 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseFeedHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseFeedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18147,43 +26543,11 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18211,174 +26575,63 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Freight == nil {
-				m.Freight = &FreightReference{}
-			}
-			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FreightCollection == nil {
-				m.FreightCollection = &FreightCollection{}
-			}
-			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthChecks = append(m.HealthChecks, HealthCheckStep{})
-			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseFeedMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.CurrentStep = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentStep |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseFeedMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseFeedMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18388,33 +26641,29 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &v11.JSON{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepExecutionMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromExpression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18424,25 +26673,23 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StepExecutionMetadata = append(m.StepExecutionMetadata, StepExecutionMetadata{})
-			if err := m.StepExecutionMetadata[len(m.StepExecutionMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18465,7 +26712,7 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionStep) Unmarshal(dAtA []byte) error {
+func (m *ReleaseFeedSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18488,15 +26735,15 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseFeedSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseFeedSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18524,13 +26771,13 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uses = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field As", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18540,29 +26787,31 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.As = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, ReleaseFeedHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18572,33 +26821,17 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = &v11.JSON{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.InsecureSkipTLSVerify = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionsFromExpression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18608,31 +26841,27 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &PromotionStepRetry{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VersionsFromExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18659,18 +26888,16 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &PromotionTaskReference{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Metadata = append(m.Metadata, ReleaseFeedMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18680,29 +26907,27 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SelectionStrategy = ReleaseFeedSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field If", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18730,13 +26955,13 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.If = string(dAtA[iNdEx:postIndex])
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowVersions", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18746,67 +26971,29 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ContinueOnError = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStepRetry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStepRetry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AllowVersions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreVersions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18816,33 +27003,29 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timeout == nil {
-				m.Timeout = &v1.Duration{}
-			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IgnoreVersions = append(m.IgnoreVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
-			m.ErrorThreshold = 0
+			m.DiscoveryLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18852,7 +27035,7 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorThreshold |= uint32(b&0x7F) << shift
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18878,7 +27061,7 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTask) Unmarshal(dAtA []byte) error {
+func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18901,15 +27084,51 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitSubscription{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18936,13 +27155,16 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Image == nil {
+				m.Image = &ImageSubscription{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18969,63 +27191,16 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Chart == nil {
+				m.Chart = &ChartSubscription{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Chart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifact", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19052,13 +27227,16 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OCIArtifact == nil {
+				m.OCIArtifact = &OCIArtifactSubscription{}
+			}
+			if err := m.OCIArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseFeed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19085,8 +27263,10 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, PromotionTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReleaseFeed == nil {
+				m.ReleaseFeed = &ReleaseFeedSubscription{}
+			}
+			if err := m.ReleaseFeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19111,7 +27291,7 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
+func (m *Stage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19134,17 +27314,17 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: Stage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Stage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19154,29 +27334,30 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19186,23 +27367,57 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19225,7 +27440,7 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
+func (m *StageList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19248,15 +27463,15 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19283,14 +27498,13 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19317,8 +27531,8 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Stage{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19343,7 +27557,7 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
+func (m *StageSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19366,15 +27580,15 @@ func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19401,63 +27615,48 @@ func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Verification == nil {
+				m.Verification = &Verification{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTemplateSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shard = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedFreight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19484,14 +27683,14 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RequestedFreight = append(m.RequestedFreight, FreightRequest{})
+			if err := m.RequestedFreight[len(m.RequestedFreight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19518,64 +27717,16 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.PromotionTemplate == nil {
+				m.PromotionTemplate = &PromotionTemplate{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.PromotionTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoSubscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19602,16 +27753,14 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitSubscription{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, ExpressionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19638,16 +27787,16 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSubscription{}
+			if m.ApprovalPolicy == nil {
+				m.ApprovalPolicy = &ApprovalPolicy{}
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApprovalPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19674,10 +27823,10 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Chart == nil {
-				m.Chart = &ChartSubscription{}
+			if m.AutoRollback == nil {
+				m.AutoRollback = &AutoRollback{}
 			}
-			if err := m.Chart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutoRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19702,7 +27851,7 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Stage) Unmarshal(dAtA []byte) error {
+func (m *StageStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19725,15 +27874,15 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stage: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19760,48 +27909,15 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19811,25 +27927,11 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19851,7 +27953,7 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StageList) Unmarshal(dAtA []byte) error {
+func (m *StageStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19874,15 +27976,15 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StageList: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19909,13 +28011,33 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FreightHistory = append(m.FreightHistory, &FreightCollection{})
+			if err := m.FreightHistory[len(m.FreightHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPromotion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19942,64 +28064,16 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Stage{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StageSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CurrentPromotion == nil {
+				m.CurrentPromotion = &PromotionReference{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StageSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
+			if err := m.CurrentPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20026,18 +28100,18 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Verification == nil {
-				m.Verification = &Verification{}
+			if m.Health == nil {
+				m.Health = &Health{}
 			}
-			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPromotion", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20047,29 +28121,33 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shard = string(dAtA[iNdEx:postIndex])
+			if m.LastPromotion == nil {
+				m.LastPromotion = &PromotionReference{}
+			}
+			if err := m.LastPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedFreight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20079,31 +28157,29 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedFreight = append(m.RequestedFreight, FreightRequest{})
-			if err := m.RequestedFreight[len(m.RequestedFreight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromotionTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightSummary", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20113,31 +28189,27 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PromotionTemplate == nil {
-				m.PromotionTemplate = &PromotionTemplate{}
-			}
-			if err := m.PromotionTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FreightSummary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20164,8 +28236,8 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, ExpressionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20190,7 +28262,7 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StageStats) Unmarshal(dAtA []byte) error {
+func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20213,17 +28285,17 @@ func (m *StageStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StageStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepExecutionMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepExecutionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20233,30 +28305,29 @@ func (m *StageStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20266,64 +28337,31 @@ func (m *StageStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StageStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StageStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20350,35 +28388,18 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreightHistory = append(m.FreightHistory, &FreightCollection{})
-			if err := m.FreightHistory[len(m.FreightHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPromotion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
 			}
-			var msglen int
+			m.ErrorCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20388,33 +28409,16 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ErrorCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CurrentPromotion == nil {
-				m.CurrentPromotion = &PromotionReference{}
-			}
-			if err := m.CurrentPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20424,33 +28428,29 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &Health{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = PromotionStepStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPromotion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20460,33 +28460,29 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastPromotion == nil {
-				m.LastPromotion = &PromotionReference{}
-			}
-			if err := m.LastPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20496,29 +28492,67 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.ContinueOnError = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StepRunnerPlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepRunnerPlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepRunnerPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20528,27 +28562,28 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreightSummary = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20575,8 +28610,7 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20601,7 +28635,7 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
+func (m *StepRunnerPluginList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20624,17 +28658,17 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StepExecutionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepRunnerPluginList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StepExecutionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepRunnerPluginList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20644,27 +28678,28 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20691,18 +28726,66 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, StepRunnerPlugin{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StepRunnerPluginSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepRunnerPluginSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepRunnerPluginSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20712,33 +28795,29 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			m.ErrorCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20748,14 +28827,15 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorCount |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20783,13 +28863,13 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = PromotionStepStatus(dAtA[iNdEx:postIndex])
+			m.Steps = append(m.Steps, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20799,29 +28879,33 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorThreshold", wireType)
 			}
-			var v int
+			m.ErrorThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20831,12 +28915,11 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ErrorThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ContinueOnError = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21846,6 +29929,42 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightCreationCriteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FreightCreationCriteria == nil {
+				m.FreightCreationCriteria = &FreightCreationCriteria{}
+			}
+			if err := m.FreightCreationCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41,6 +41,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&PromotionList{},
 		&PromotionTask{},
 		&PromotionTaskList{},
+		&StepRunnerPlugin{},
+		&StepRunnerPluginList{},
 		&Warehouse{},
 		&WarehouseList{},
 	)
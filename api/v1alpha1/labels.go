@@ -8,6 +8,7 @@ const (
 	CredentialTypeLabelValueGit   = "git"
 	CredentialTypeLabelValueHelm  = "helm"
 	CredentialTypeLabelValueImage = "image"
+	CredentialTypeLabelValueHTTP  = "http"
 	CredentialTypeLabelGeneric    = "generic"
 
 	// Kargo core API
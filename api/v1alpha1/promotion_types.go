@@ -408,6 +408,23 @@ func (s StepExecutionMetadataList) HasFailures() bool {
 	return false
 }
 
+// FirstFailure returns the index of the first StepExecutionMetadata in the
+// list with a status of PromotionStepStatusErrored or
+// PromotionStepStatusFailed. Steps configured to continue on error are
+// ignored. If there are no such failures, -1 is returned.
+func (s StepExecutionMetadataList) FirstFailure() int {
+	for i, stepExecMeta := range s {
+		if stepExecMeta.ContinueOnError {
+			continue
+		}
+		switch stepExecMeta.Status {
+		case PromotionStepStatusErrored, PromotionStepStatusFailed:
+			return i
+		}
+	}
+	return -1
+}
+
 // StepExecutionMetadata tracks metadata pertaining to the execution of
 // a promotion step.
 type StepExecutionMetadata struct {
@@ -251,3 +251,46 @@ func TestStepExecutionMetadataList_HasFailures(t *testing.T) {
 		})
 	}
 }
+
+func TestStepExecutionMetadataList_FirstFailure(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata StepExecutionMetadataList
+		expected int
+	}{
+		{
+			name: "no errors/failures at all",
+			metadata: StepExecutionMetadataList{
+				{Status: PromotionStepStatusSucceeded},
+				{Status: PromotionStepStatusSkipped},
+			},
+			expected: -1,
+		},
+		{
+			name: "has an error",
+			metadata: StepExecutionMetadataList{
+				{Status: PromotionStepStatusSucceeded},
+				{Status: PromotionStepStatusErrored},
+				{Status: PromotionStepStatusFailed},
+			},
+			expected: 1,
+		},
+		{
+			name: "has a failure after an error with continueOnError == true",
+			metadata: StepExecutionMetadataList{
+				{Status: PromotionStepStatusSucceeded},
+				{
+					ContinueOnError: true,
+					Status:          PromotionStepStatusErrored,
+				},
+				{Status: PromotionStepStatusFailed},
+			},
+			expected: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.metadata.FirstFailure())
+		})
+	}
+}
@@ -161,6 +161,14 @@ func (s *Stage) IsFreightAvailable(freight *Freight) bool {
 	if s == nil || freight == nil || s.Namespace != freight.Namespace {
 		return false
 	}
+	if freight.IsRejectedFor(s.Name) {
+		return false
+	}
+	if policy := s.Spec.ApprovalPolicy; policy != nil {
+		// When the Stage has an ApprovalPolicy, Freight only becomes available
+		// to it once it has received the required number of approvals.
+		return freight.GetApprovalCount(s.Name) >= policy.GetRequiredApprovals()
+	}
 	if freight.IsApprovedFor(s.Name) {
 		return true
 	}
@@ -225,6 +233,72 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty" protobuf:"bytes,3,opt,name=verification"`
+	// ApprovalPolicy optionally describes the manual approvals Freight must
+	// receive before it may be promoted to this Stage. When specified, Freight
+	// becomes available to the Stage only once the policy has been satisfied,
+	// regardless of whether it has been verified in any upstream Stage.
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty" protobuf:"bytes,8,opt,name=approvalPolicy"`
+	// AutoRollback optionally describes whether the Stage should automatically
+	// roll back to the most recently verified Freight when verification of its
+	// current Freight fails.
+	AutoRollback *AutoRollback `json:"autoRollback,omitempty" protobuf:"bytes,9,opt,name=autoRollback"`
+}
+
+// AutoRollback describes whether a Stage should automatically roll back to the
+// most recently verified Freight when verification of its current Freight
+// fails.
+type AutoRollback struct {
+	// Enabled indicates whether automatic rollback is enabled for the Stage.
+	// When enabled and verification of the Stage's current Freight fails, a
+	// Promotion of the most recent Freight in the Stage's Freight history that
+	// was successfully verified is created automatically. To prevent rollback
+	// loops, a Stage whose current Freight was itself the result of an
+	// automatic rollback will not be rolled back again.
+	Enabled bool `json:"enabled,omitempty" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// ApprovalPolicy describes the manual approvals Freight must receive before it
+// may be promoted to a Stage.
+type ApprovalPolicy struct {
+	// RequiredApprovals is the number of approvals from distinct users that
+	// Freight must receive before it becomes available to the Stage. If not
+	// specified, a single approval is required.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	RequiredApprovals int32 `json:"requiredApprovals,omitempty" protobuf:"varint,1,opt,name=requiredApprovals"`
+	// EligibleGroups is an optional list of OIDC groups. When non-empty (or when
+	// EligibleRoles is non-empty), only users belonging to at least one of these
+	// groups, or mapped to at least one of the EligibleRoles, may approve
+	// Freight for the Stage.
+	EligibleGroups []string `json:"eligibleGroups,omitempty" protobuf:"bytes,2,rep,name=eligibleGroups"`
+	// EligibleRoles is an optional list of names of Kargo roles in the Stage's
+	// Project. When non-empty (or when EligibleGroups is non-empty), only users
+	// mapped to at least one of these roles, or belonging to at least one of the
+	// EligibleGroups, may approve Freight for the Stage.
+	EligibleRoles []string `json:"eligibleRoles,omitempty" protobuf:"bytes,3,rep,name=eligibleRoles"`
+	// ExcludeCommitAuthors enforces separation of duties. When true, users whose
+	// email address matches that of the author or committer of any commit
+	// referenced by the Freight may not approve it for the Stage. Users without
+	// an email address may not approve the Freight, and neither may anyone if
+	// the email address of any commit's author or committer is unknown.
+	ExcludeCommitAuthors bool `json:"excludeCommitAuthors,omitempty" protobuf:"varint,4,opt,name=excludeCommitAuthors"`
+}
+
+// GetRequiredApprovals returns the number of approvals from distinct users
+// that Freight must receive to satisfy the ApprovalPolicy. Values less than
+// one are treated as one.
+func (a *ApprovalPolicy) GetRequiredApprovals() int {
+	if a == nil || a.RequiredApprovals < 1 {
+		return 1
+	}
+	return int(a.RequiredApprovals)
+}
+
+// HasEligibilityRestrictions returns true if the ApprovalPolicy restricts
+// which users may approve Freight based on their groups or roles.
+func (a *ApprovalPolicy) HasEligibilityRestrictions() bool {
+	return a != nil && (len(a.EligibleGroups) > 0 || len(a.EligibleRoles) > 0)
 }
 
 // FreightRequest expresses a Stage's need for Freight having originated from a
@@ -403,6 +477,10 @@ type FreightReference struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,4,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,9,rep,name=ociArtifacts"`
+	// Releases describes specific versions from release feeds.
+	Releases []Release `json:"releases,omitempty" protobuf:"bytes,10,rep,name=releases"`
 }
 
 // FreightCollection is a collection of FreightReferences, each of which
@@ -486,6 +564,17 @@ func (f *FreightCollection) HasNonTerminalVerification() bool {
 	return false
 }
 
+// HasSuccessfulVerification returns true if the FreightCollection has at least
+// one successful verification.
+func (f *FreightCollection) HasSuccessfulVerification() bool {
+	for _, v := range f.VerificationHistory {
+		if v.Phase == VerificationPhaseSuccessful {
+			return true
+		}
+	}
+	return false
+}
+
 // FreightHistory is a linear list of FreightCollection items. The list is
 // ordered by the time at which the FreightCollection was recorded, with the
 // most recent (current) FreightCollection at the top of the list.
@@ -534,6 +623,14 @@ type Image struct {
 	Digest string `json:"digest,omitempty" protobuf:"bytes,4,opt,name=digest"`
 	// Annotations is a map of arbitrary metadata for the image.
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,5,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// SourceRepoURL is the URL of the repository containing the source code the
+	// image was built from, as indicated by the image's
+	// org.opencontainers.image.source annotation or label.
+	SourceRepoURL string `json:"sourceRepoURL,omitempty" protobuf:"bytes,6,opt,name=sourceRepoURL"`
+	// SourceCommit identifies the commit in the repository specified by
+	// SourceRepoURL that the image was built from, as indicated by the image's
+	// org.opencontainers.image.revision annotation or label.
+	SourceCommit string `json:"sourceCommit,omitempty" protobuf:"bytes,7,opt,name=sourceCommit"`
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -549,7 +646,9 @@ func (i *Image) DeepEquals(other *Image) bool {
 		i.GitRepoURL == other.GitRepoURL &&
 		i.Tag == other.Tag &&
 		i.Digest == other.Digest &&
-		maps.Equal(i.Annotations, other.Annotations)
+		maps.Equal(i.Annotations, other.Annotations) &&
+		i.SourceRepoURL == other.SourceRepoURL &&
+		i.SourceCommit == other.SourceCommit
 }
 
 // Chart describes a specific version of a Helm chart.
@@ -565,6 +664,14 @@ type Chart struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
 	// Version specifies a particular version of the chart.
 	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// AppVersion is the version of the application packaged by the chart, as
+	// specified in the chart's Chart.yaml file.
+	AppVersion string `json:"appVersion,omitempty" protobuf:"bytes,4,opt,name=appVersion"`
+	// Annotations are the annotations specified in the chart's Chart.yaml file.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,5,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Dependencies are the dependencies specified in the chart's Chart.yaml
+	// file.
+	Dependencies []ChartDependency `json:"dependencies,omitempty" protobuf:"bytes,6,rep,name=dependencies"`
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -578,7 +685,78 @@ func (c *Chart) DeepEquals(other *Chart) bool {
 	}
 	return c.RepoURL == other.RepoURL &&
 		c.Name == other.Name &&
-		c.Version == other.Version
+		c.Version == other.Version &&
+		c.AppVersion == other.AppVersion &&
+		maps.Equal(c.Annotations, other.Annotations) &&
+		slices.Equal(c.Dependencies, other.Dependencies)
+}
+
+// ChartDependency describes a dependency of a Helm chart, as specified in the
+// chart's Chart.yaml file.
+type ChartDependency struct {
+	// Name is the name of the dependency.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Version is the version or version range of the dependency.
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// Repository is the URL of the repository containing the dependency.
+	Repository string `json:"repository,omitempty" protobuf:"bytes,3,opt,name=repository"`
+}
+
+// OCIArtifact describes a specific version of an OCI artifact other than a
+// container image or Helm chart.
+type OCIArtifact struct {
+	// RepoURL describes the repository in which the artifact can be found.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag identifies a specific version of the artifact in the repository
+	// specified by RepoURL.
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Digest identifies a specific version of the artifact in the repository
+	// specified by RepoURL. This is a more precise identifier than Tag.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	// ArtifactType is the type of the artifact.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,4,opt,name=artifactType"`
+	// Annotations is a map of arbitrary metadata for the artifact.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,5,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided OCIArtifact. I.e., all fields must be equal.
+func (o *OCIArtifact) DeepEquals(other *OCIArtifact) bool {
+	if o == nil && other == nil {
+		return true
+	}
+	if o == nil || other == nil {
+		return false
+	}
+	return o.RepoURL == other.RepoURL &&
+		o.Tag == other.Tag &&
+		o.Digest == other.Digest &&
+		o.ArtifactType == other.ArtifactType &&
+		maps.Equal(o.Annotations, other.Annotations)
+}
+
+// Release describes a specific version obtained from a release feed.
+type Release struct {
+	// URL is the URL of the release feed the version was obtained from.
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Version is the version of the release.
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// Metadata is a map of metadata extracted for the release.
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,3,rep,name=metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided Release. I.e., all fields must be equal.
+func (r *Release) DeepEquals(other *Release) bool {
+	if r == nil && other == nil {
+		return true
+	}
+	if r == nil || other == nil {
+		return false
+	}
+	return r.URL == other.URL &&
+		r.Version == other.Version &&
+		maps.Equal(r.Metadata, other.Metadata)
 }
 
 // Health describes the health of a Stage.
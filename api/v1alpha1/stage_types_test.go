@@ -63,6 +63,84 @@ func TestStage_IsFreightAvailable(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name:  "freight is rejected for stage",
+			stage: &Stage{ObjectMeta: testStageMeta},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Status: FreightStatus{
+					ApprovedFor: map[string]ApprovedStage{
+						testStage: {},
+					},
+					RejectedFor: map[string]RejectedFreight{
+						testStage: {Reason: "broken"},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name:  "freight is rejected for all stages",
+			stage: &Stage{ObjectMeta: testStageMeta},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Status: FreightStatus{
+					ApprovedFor: map[string]ApprovedStage{
+						testStage: {},
+					},
+					Rejected: &RejectedFreight{Reason: "broken"},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "stage has approval policy that is not yet satisfied",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					ApprovalPolicy: &ApprovalPolicy{RequiredApprovals: 2},
+					RequestedFreight: []FreightRequest{{
+						Origin:  testOrigin,
+						Sources: FreightSources{Direct: true},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Status: FreightStatus{
+					ApprovedFor: map[string]ApprovedStage{
+						testStage: {
+							Approvals: []Approval{{Approver: "alice"}},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "stage has approval policy that is satisfied",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					ApprovalPolicy: &ApprovalPolicy{RequiredApprovals: 2},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Status: FreightStatus{
+					ApprovedFor: map[string]ApprovedStage{
+						testStage: {
+							Approvals: []Approval{
+								{Approver: "alice"},
+								{Approver: "bob"},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
 		{
 			name: "stage accepts freight direct from origin",
 			stage: &Stage{
@@ -880,6 +958,18 @@ func TestImageDeepEquals(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "source commits differ",
+			a: &Image{
+				RepoURL:      "fake-url",
+				SourceCommit: "foo",
+			},
+			b: &Image{
+				RepoURL:      "fake-url",
+				SourceCommit: "bar",
+			},
+			expectedResult: false,
+		},
 		{
 			name: "perfect match",
 			a: &Image{
@@ -963,18 +1053,254 @@ func TestChartDeepEquals(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "app versions differ",
+			a: &Chart{
+				RepoURL:    "fake-url",
+				Name:       "fake-name",
+				Version:    "v1.0.0",
+				AppVersion: "1.0.0",
+			},
+			b: &Chart{
+				RepoURL:    "fake-url",
+				Name:       "fake-name",
+				Version:    "v1.0.0",
+				AppVersion: "2.0.0",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "annotations differ",
+			a: &Chart{
+				RepoURL:     "fake-url",
+				Name:        "fake-name",
+				Version:     "v1.0.0",
+				Annotations: map[string]string{"foo": "bar"},
+			},
+			b: &Chart{
+				RepoURL:     "fake-url",
+				Name:        "fake-name",
+				Version:     "v1.0.0",
+				Annotations: map[string]string{"foo": "baz"},
+			},
+			expectedResult: false,
+		},
+		{
+			name: "dependencies differ",
+			a: &Chart{
+				RepoURL:      "fake-url",
+				Name:         "fake-name",
+				Version:      "v1.0.0",
+				Dependencies: []ChartDependency{{Name: "redis", Version: "1.0.0"}},
+			},
+			b: &Chart{
+				RepoURL:      "fake-url",
+				Name:         "fake-name",
+				Version:      "v1.0.0",
+				Dependencies: []ChartDependency{{Name: "redis", Version: "2.0.0"}},
+			},
+			expectedResult: false,
+		},
 		{
 			name: "perfect match",
 			a: &Chart{
-				RepoURL: "fake-url",
-				Name:    "fake-name",
-				Version: "v1.0.0",
+				RepoURL:      "fake-url",
+				Name:         "fake-name",
+				Version:      "v1.0.0",
+				AppVersion:   "1.0.0",
+				Annotations:  map[string]string{"foo": "bar"},
+				Dependencies: []ChartDependency{{Name: "redis", Version: "1.0.0"}},
 			},
 			b: &Chart{
+				RepoURL:      "fake-url",
+				Name:         "fake-name",
+				Version:      "v1.0.0",
+				AppVersion:   "1.0.0",
+				Annotations:  map[string]string{"foo": "bar"},
+				Dependencies: []ChartDependency{{Name: "redis", Version: "1.0.0"}},
+			},
+			expectedResult: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.a.DeepEquals(testCase.b))
+			require.Equal(t, testCase.expectedResult, testCase.b.DeepEquals(testCase.a))
+		})
+	}
+}
+
+func TestOCIArtifactDeepEquals(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a              *OCIArtifact
+		b              *OCIArtifact
+		expectedResult bool
+	}{
+		{
+			name:           "a and b both nil",
+			expectedResult: true,
+		},
+		{
+			name:           "only a is nil",
+			b:              &OCIArtifact{},
+			expectedResult: false,
+		},
+		{
+			name:           "only b is nil",
+			a:              &OCIArtifact{},
+			expectedResult: false,
+		},
+		{
+			name: "repo URLs differ",
+			a: &OCIArtifact{
+				RepoURL: "foo",
+			},
+			b: &OCIArtifact{
+				RepoURL: "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "digests differ",
+			a: &OCIArtifact{
 				RepoURL: "fake-url",
-				Name:    "fake-name",
+				Tag:     "v1.0.0",
+				Digest:  "fake-digest",
+			},
+			b: &OCIArtifact{
+				RepoURL: "fake-url",
+				Tag:     "v1.0.0",
+				Digest:  "different-fake-digest",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "artifact types differ",
+			a: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Digest:       "fake-digest",
+				ArtifactType: "application/vnd.example.foo.v1",
+			},
+			b: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Digest:       "fake-digest",
+				ArtifactType: "application/vnd.example.bar.v1",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "annotations differ",
+			a: &OCIArtifact{
+				RepoURL:     "fake-url",
+				Digest:      "fake-digest",
+				Annotations: map[string]string{"foo": "bar"},
+			},
+			b: &OCIArtifact{
+				RepoURL:     "fake-url",
+				Digest:      "fake-digest",
+				Annotations: map[string]string{"foo": "baz"},
+			},
+			expectedResult: false,
+		},
+		{
+			name: "perfect match",
+			a: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Tag:          "v1.0.0",
+				Digest:       "fake-digest",
+				ArtifactType: "application/vnd.example.foo.v1",
+				Annotations:  map[string]string{"foo": "bar"},
+			},
+			b: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Tag:          "v1.0.0",
+				Digest:       "fake-digest",
+				ArtifactType: "application/vnd.example.foo.v1",
+				Annotations:  map[string]string{"foo": "bar"},
+			},
+			expectedResult: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.a.DeepEquals(testCase.b))
+			require.Equal(t, testCase.expectedResult, testCase.b.DeepEquals(testCase.a))
+		})
+	}
+}
+
+func TestReleaseDeepEquals(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a              *Release
+		b              *Release
+		expectedResult bool
+	}{
+		{
+			name:           "a and b both nil",
+			expectedResult: true,
+		},
+		{
+			name:           "only a is nil",
+			b:              &Release{},
+			expectedResult: false,
+		},
+		{
+			name:           "only b is nil",
+			a:              &Release{},
+			expectedResult: false,
+		},
+		{
+			name: "URLs differ",
+			a: &Release{
+				URL: "https://foo.example.com",
+			},
+			b: &Release{
+				URL: "https://bar.example.com",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "versions differ",
+			a: &Release{
+				URL:     "https://fake-url",
 				Version: "v1.0.0",
 			},
+			b: &Release{
+				URL:     "https://fake-url",
+				Version: "v1.1.0",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "metadata differs",
+			a: &Release{
+				URL:      "https://fake-url",
+				Version:  "v1.0.0",
+				Metadata: map[string]string{"foo": "bar"},
+			},
+			b: &Release{
+				URL:      "https://fake-url",
+				Version:  "v1.0.0",
+				Metadata: map[string]string{"foo": "baz"},
+			},
+			expectedResult: false,
+		},
+		{
+			name: "perfect match",
+			a: &Release{
+				URL:      "https://fake-url",
+				Version:  "v1.0.0",
+				Metadata: map[string]string{"foo": "bar"},
+			},
+			b: &Release{
+				URL:      "https://fake-url",
+				Version:  "v1.0.0",
+				Metadata: map[string]string{"foo": "bar"},
+			},
 			expectedResult: true,
 		},
 	}
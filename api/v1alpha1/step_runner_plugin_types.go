@@ -0,0 +1,72 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:resource:scope=Cluster,shortName={steprunnerplugin,steprunnerplugins}
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name=URL,type=string,JSONPath=`.spec.url`
+// +kubebuilder:printcolumn:name=Steps,type=string,JSONPath=`.spec.steps`
+// +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// StepRunnerPlugin registers an out-of-process plugin that runs one or more
+// custom promotion steps. Kargo invokes the plugin using the step runner
+// plugin protocol defined in the api/plugin package whenever a promotion step
+// uses one of the steps the plugin provides.
+type StepRunnerPlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec describes the plugin and the promotion steps it provides.
+	//
+	// +kubebuilder:validation:Required
+	Spec StepRunnerPluginSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// StepRunnerPluginSpec describes a step runner plugin and the promotion steps
+// it provides.
+type StepRunnerPluginSpec struct {
+	// URL is the base URL at which the plugin serves the step runner plugin
+	// protocol. URLs using the http scheme are served over unencrypted HTTP/2.
+	// URLs using the https scheme are served over TLS.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the plugin over TLS.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,2,opt,name=insecureSkipTLSVerify"`
+	// Steps is the list of names of the promotion steps provided by the plugin.
+	// These are the names that can be referenced by the uses field of a
+	// promotion step. The names of built-in steps are reserved and can not be
+	// provided by a plugin.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:items:MaxLength=63
+	Steps []string `json:"steps" protobuf:"bytes,3,rep,name=steps"`
+	// Timeout is the default soft maximum interval in which a step provided by
+	// the plugin that returns a Running status (which will be retried) may try
+	// to succeed. It can be overridden per step using the retry field of the
+	// step. When left unspecified, such steps are retried indefinitely.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
+	// ErrorThreshold is the default number of consecutive times a step
+	// provided by the plugin must fail (for any reason) before retries are
+	// abandoned and the entire Promotion is marked as failed. It can be
+	// overridden per step using the retry field of the step. When left
+	// unspecified, a step provided by the plugin is not retried after it
+	// fails.
+	ErrorThreshold uint32 `json:"errorThreshold,omitempty" protobuf:"varint,5,opt,name=errorThreshold"`
+}
+
+// +kubebuilder:object:root=true
+
+// StepRunnerPluginList contains a list of StepRunnerPlugins.
+type StepRunnerPluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []StepRunnerPlugin `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
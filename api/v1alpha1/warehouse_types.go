@@ -2,16 +2,26 @@ package v1alpha1
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-// +kubebuilder:validation:Enum={Lexical,NewestFromBranch,NewestTag,SemVer}
+// +kubebuilder:validation:Enum={Lexical,NewestFromBranch,NewestTag,PullRequest,SemVer}
 type CommitSelectionStrategy string
 
 const (
 	CommitSelectionStrategyLexical          CommitSelectionStrategy = "Lexical"
 	CommitSelectionStrategyNewestFromBranch CommitSelectionStrategy = "NewestFromBranch"
 	CommitSelectionStrategyNewestTag        CommitSelectionStrategy = "NewestTag"
+	CommitSelectionStrategyPullRequest      CommitSelectionStrategy = "PullRequest"
 	CommitSelectionStrategySemVer           CommitSelectionStrategy = "SemVer"
 )
 
+// +kubebuilder:validation:Enum={Lexical,NewestCommit,SemVer}
+type BranchSelectionStrategy string
+
+const (
+	BranchSelectionStrategyLexical      BranchSelectionStrategy = "Lexical"
+	BranchSelectionStrategyNewestCommit BranchSelectionStrategy = "NewestCommit"
+	BranchSelectionStrategySemVer       BranchSelectionStrategy = "SemVer"
+)
+
 // +kubebuilder:validation:Enum={Digest,Lexical,NewestBuild,SemVer}
 type ImageSelectionStrategy string
 
@@ -78,6 +88,14 @@ type WarehouseSpec struct {
 	// +kubebuilder:default=Automatic
 	// +kubebuilder:validation:Optional
 	FreightCreationPolicy FreightCreationPolicy `json:"freightCreationPolicy" protobuf:"bytes,3,opt,name=freightCreationPolicy"`
+	// FreightCreationCriteria defines criteria that the latest discovered
+	// artifacts must satisfy for Freight to be created from them automatically.
+	// This field is optional and has no effect unless FreightCreationPolicy is
+	// "Automatic". When left unspecified, Freight is created from any new
+	// combination of artifacts.
+	//
+	// +kubebuilder:validation:Optional
+	FreightCreationCriteria *FreightCreationCriteria `json:"freightCreationCriteria,omitempty" protobuf:"bytes,5,opt,name=freightCreationCriteria"`
 	// Subscriptions describes sources of artifacts to be included in Freight
 	// produced by this Warehouse.
 	//
@@ -96,8 +114,24 @@ const (
 	FreightCreationPolicyManual FreightCreationPolicy = "Manual"
 )
 
+// FreightCreationCriteria defines criteria that the latest discovered artifacts
+// must satisfy for a Warehouse to automatically create Freight from them.
+type FreightCreationCriteria struct {
+	// Expression is an expression that must evaluate to true for Freight to be
+	// created automatically from the latest discovered artifacts. The
+	// commitFrom(), imageFrom(), chartFrom(), ociArtifactFrom(), and
+	// releaseFrom() functions may be used within the expression to reference
+	// the artifacts that would be included in the Freight. For example:
+	// `imageFrom("example.com/app").Tag == commitFrom("https://github.com/example/app.git").Tag`
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+}
+
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
-// container image repository, or a Helm chart repository.
+// container image repository, a Helm chart repository, a repository of other
+// OCI artifacts, or an HTTP release feed.
 type RepoSubscription struct {
 	// Git describes a subscriptions to a Git repository.
 	Git *GitSubscription `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
@@ -105,6 +139,13 @@ type RepoSubscription struct {
 	Image *ImageSubscription `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
 	// Chart describes a subscription to a Helm chart repository.
 	Chart *ChartSubscription `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
+	// OCIArtifact describes a subscription to a repository of OCI artifacts,
+	// such as Terraform modules, policy bundles, or WASM plugins, within an OCI
+	// registry.
+	OCIArtifact *OCIArtifactSubscription `json:"ociArtifact,omitempty" protobuf:"bytes,4,opt,name=ociArtifact"`
+	// ReleaseFeed describes a subscription to an HTTP endpoint that lists
+	// versions of some artifact, such as an internal release API.
+	ReleaseFeed *ReleaseFeedSubscription `json:"releaseFeed,omitempty" protobuf:"bytes,5,opt,name=releaseFeed"`
 }
 
 // GitSubscription defines a subscription to a Git repository.
@@ -123,8 +164,10 @@ type GitSubscription struct {
 	// Accepted values:
 	//
 	// - "NewestFromBranch": Selects the latest commit on the branch specified
-	//   by the Branch field or the default branch if none is specified. This is
-	//   the default strategy.
+	//   by the Branch field or the default branch if none is specified. When
+	//   the BranchPattern field is specified instead, the latest commit on each
+	//   matching branch is selected, with branches ordered according to the
+	//   BranchSelectionStrategy field. This is the default strategy.
 	//
 	// - "SemVer": Selects the commit referenced by the the semantically greatest
 	//   tag. The SemverConstraint field can optionally be used to narrow the set
@@ -139,6 +182,12 @@ type GitSubscription struct {
 	//   tag. The AllowTags and IgnoreTags fields can optionally be used to
 	//   narrow the set of tags eligible for selection.
 	//
+	// - "PullRequest": Selects the head commits of open pull requests (or
+	//   equivalent, e.g. GitLab merge requests), most recently updated first.
+	//   Pull requests opened from forks are never selected. The PullRequests
+	//   field can optionally be used to narrow the set of pull requests eligible
+	//   for selection.
+	//
 	// +kubebuilder:default=NewestFromBranch
 	CommitSelectionStrategy CommitSelectionStrategy `json:"commitSelectionStrategy,omitempty" protobuf:"bytes,2,opt,name=commitSelectionStrategy"`
 	// Branch references a particular branch of the repository. The value in this
@@ -153,6 +202,50 @@ type GitSubscription struct {
 	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([a-zA-Z0-9._\/-]*[a-zA-Z0-9_-])?$`
 	// +akuity:test-kubebuilder-pattern=Branch
 	Branch string `json:"branch,omitempty" protobuf:"bytes,3,opt,name=branch"`
+	// BranchPattern selects the branches of the repository to subscribe to by
+	// name, as an alternative to the Branch field. Glob patterns (optionally
+	// prefixed with "glob:"; ex. "release/*") and regular expressions (prefixed
+	// with "regex:" or "regexp:"; ex. "regexp:^release/v?[0-9]+$") are
+	// supported. The value in this field only has any effect when the
+	// CommitSelectionStrategy is NewestFromBranch or left unspecified. This
+	// field is optional and is mutually exclusive with the Branch field.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=255
+	BranchPattern string `json:"branchPattern,omitempty" protobuf:"bytes,13,opt,name=branchPattern"`
+	// BranchSelectionStrategy specifies how the branches matched by the
+	// BranchPattern field are ordered. Unless the FreightPerBranch field is
+	// true, the latest commit of the first branch in this order is the one used
+	// when new Freight is created automatically. The value in this field only
+	// has any effect when the BranchPattern field is specified. When left unspecified, the field is implicitly treated as if
+	// its value were "NewestCommit".
+	//
+	// Accepted values:
+	//
+	// - "NewestCommit": Orders branches by the commit date of their latest
+	//   commit, newest first. This results in Freight being produced for the
+	//   latest commit on any matching branch.
+	//
+	// - "SemVer": Orders branches by the semantic version embedded in the last
+	//   segment of their names (ex. "1.2" for "release/1.2"), greatest first.
+	//   Branches without a semantic version are ignored. The SemverConstraint
+	//   field can optionally be used to narrow the set of branches eligible for
+	//   selection.
+	//
+	// - "Lexical": Orders branches by name, lexicographically greatest first.
+	//
+	// +kubebuilder:validation:Optional
+	BranchSelectionStrategy BranchSelectionStrategy `json:"branchSelectionStrategy,omitempty" protobuf:"bytes,14,opt,name=branchSelectionStrategy"`
+	// FreightPerBranch specifies whether new Freight should be created
+	// automatically for the latest commit of every branch matched by the
+	// BranchPattern field, rather than only for that of the first branch in the
+	// order specified by the BranchSelectionStrategy field. Each such Freight
+	// otherwise contains the same artifacts as the Freight built from the latest
+	// artifacts. This field only has any effect when the BranchPattern field is
+	// specified.
+	//
+	// +kubebuilder:validation:Optional
+	FreightPerBranch bool `json:"freightPerBranch,omitempty" protobuf:"varint,15,opt,name=freightPerBranch"`
 	// StrictSemvers specifies whether only "strict" semver tags should be
 	// considered. A "strict" semver tag is one containing ALL of major, minor,
 	// and patch version components. This is enabled by default, but only has any
@@ -165,11 +258,13 @@ type GitSubscription struct {
 	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,11,opt,name=strictSemvers"`
 	// SemverConstraint specifies constraints on what new tagged commits are
 	// considered in determining the newest commit of interest. The value in this
-	// field only has any effect when the CommitSelectionStrategy is SemVer. This
-	// field is optional. When left unspecified, there will be no constraints,
-	// which means the latest semantically tagged commit will always be used. Care
-	// should be taken with leaving this field unspecified, as it can lead to the
-	// unanticipated rollout of breaking changes.
+	// field only has any effect when the CommitSelectionStrategy is SemVer or
+	// the BranchSelectionStrategy is SemVer, in which case it constrains the
+	// versions embedded in branch names instead. This field is optional. When
+	// left unspecified, there will be no constraints, which means the latest
+	// semantically tagged commit will always be used. Care should be taken with
+	// leaving this field unspecified, as it can lead to the unanticipated
+	// rollout of breaking changes.
 	//
 	// +kubebuilder:validation:Optional
 	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,4,opt,name=semverConstraint"`
@@ -232,6 +327,39 @@ type GitSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,10,opt,name=discoveryLimit"`
+	// PullRequests specifies how pull requests are discovered and which of them
+	// are eligible for selection. The value in this field only has any effect
+	// when the CommitSelectionStrategy is PullRequest. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	PullRequests *GitPullRequestFilter `json:"pullRequests,omitempty" protobuf:"bytes,12,opt,name=pullRequests"`
+}
+
+// GitPullRequestFilter specifies how pull requests are discovered for a
+// GitSubscription and which of them are eligible for selection.
+type GitPullRequestFilter struct {
+	// Provider is the name of the Git hosting provider to query for pull
+	// requests. This field is optional. When left unspecified, Kargo will try
+	// to infer the provider from the repository URL.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum={azure,bitbucket,gitea,github,gitlab}
+	Provider string `json:"provider,omitempty" protobuf:"bytes,1,opt,name=provider"`
+	// BaseBranch limits selection to pull requests targeting the specified
+	// branch. This field is optional. When left unspecified, pull requests
+	// targeting any branch are eligible for selection.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([a-zA-Z0-9._\/-]*[a-zA-Z0-9_-])?$`
+	// +akuity:test-kubebuilder-pattern=Branch
+	BaseBranch string `json:"baseBranch,omitempty" protobuf:"bytes,2,opt,name=baseBranch"`
+	// Labels limits selection to pull requests having all of the specified
+	// labels. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
 }
 
 // ImageSubscription defines a subscription to an image repository.
@@ -341,6 +469,20 @@ type ImageSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,9,opt,name=discoveryLimit"`
+	// ExpressionFilter is an optional expression that each image must satisfy to
+	// be considered when determining the newest version of an image. It is
+	// evaluated after filtering images based on the AllowTags and IgnoreTags
+	// fields and must evaluate to a boolean. Within the expression, the tag and
+	// digest variables hold the image's tag and digest, and the annotation(key)
+	// and label(key) functions return the value of one of the image's manifest
+	// annotations or config labels, respectively, or an empty string if it has
+	// no such annotation or label. The createdAt() and age() functions return
+	// the time at which the image was built and the time that has elapsed since
+	// then. Labels of images with manifests for multiple platforms are only
+	// available when Platform is specified.
+	//
+	// +kubebuilder:validation:Optional
+	ExpressionFilter string `json:"expressionFilter,omitempty" protobuf:"bytes,11,opt,name=expressionFilter"`
 }
 
 // ChartSubscription defines a subscription to a Helm chart repository.
@@ -372,9 +514,18 @@ type ChartSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,3,opt,name=semverConstraint"`
+	// AppVersionConstraint specifies constraints on the application versions
+	// (the appVersion field of Chart.yaml) of permissible chart versions. When
+	// specified, chart versions whose appVersion is not a semantic version are
+	// ignored. This field is optional.
+	// More info: https://github.com/masterminds/semver#checking-version-constraints
+	//
+	// +kubebuilder:validation:Optional
+	AppVersionConstraint string `json:"appVersionConstraint,omitempty" protobuf:"bytes,5,opt,name=appVersionConstraint"`
 	// DiscoveryLimit is an optional limit on the number of chart versions that
 	// can be discovered for this subscription. The limit is applied after
-	// filtering charts based on the SemverConstraint field.
+	// filtering charts based on the SemverConstraint and AppVersionConstraint
+	// fields.
 	// When left unspecified, the field is implicitly treated as if its value
 	// were "20". The upper limit for this field is 100.
 	//
@@ -384,6 +535,193 @@ type ChartSubscription struct {
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,4,opt,name=discoveryLimit"`
 }
 
+// OCIArtifactSubscription defines a subscription to a repository of OCI
+// artifacts within an OCI registry.
+type OCIArtifactSubscription struct {
+	// RepoURL specifies the URL of the artifact repository to subscribe to. The
+	// value in this field MUST NOT include a tag or digest. This field is
+	// required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	// +akuity:test-kubebuilder-pattern=ImageRepoURL
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// version of the artifact specified by the RepoURL field. This field is
+	// optional. When left unspecified, the field is implicitly treated as if its
+	// value were "SemVer". The accepted values and their meaning are the same
+	// as for the ImageSelectionStrategy field of an ImageSubscription, except
+	// that "NewestBuild" relies upon the org.opencontainers.image.created
+	// annotation of each artifact's manifest.
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy ImageSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,2,opt,name=selectionStrategy"`
+	// StrictSemvers specifies whether only "strict" semver tags should be
+	// considered. A "strict" semver tag is one containing ALL of major, minor,
+	// and patch version components. This is enabled by default, but only has any
+	// effect when the SelectionStrategy is SemVer.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,3,opt,name=strictSemvers"`
+	// SemverConstraint specifies constraints on what new artifact versions are
+	// permissible. The value in this field only has any effect when the
+	// SelectionStrategy is SemVer or left unspecified, except when the
+	// SelectionStrategy is Digest, in which case it specifies the (mutable) tag
+	// whose digest should be selected. This field is optional.
+	// More info: https://github.com/masterminds/semver#checking-version-constraints
+	//
+	// +kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,4,opt,name=semverConstraint"`
+	// AllowTags is a regular expression that can optionally be used to limit the
+	// tags that are considered in determining the newest version of an artifact.
+	// This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowTags string `json:"allowTags,omitempty" protobuf:"bytes,5,opt,name=allowTags"`
+	// IgnoreTags is a list of tags that must be ignored when determining the
+	// newest version of an artifact. No regular expressions or glob patterns are
+	// supported yet. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreTags []string `json:"ignoreTags,omitempty" protobuf:"bytes,6,rep,name=ignoreTags"`
+	// ArtifactType optionally limits the artifacts that are considered in
+	// determining the newest version of an artifact to those of the specified
+	// type. An artifact's type is the artifactType of its manifest or, if that
+	// is not set, the media type of its config.
+	//
+	// +kubebuilder:validation:Optional
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,7,opt,name=artifactType"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the repository. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,8,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of artifact references
+	// that can be discovered for this subscription. The limit is applied after
+	// filtering artifacts based on the AllowTags, IgnoreTags, and ArtifactType
+	// fields. When left unspecified, the field is implicitly treated as if its
+	// value were "20". The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,9,opt,name=discoveryLimit"`
+}
+
+// ReleaseFeedSubscription defines a subscription to an HTTP endpoint that
+// lists versions of some artifact.
+type ReleaseFeedSubscription struct {
+	// URL is the URL of the HTTP endpoint to poll. Credentials of type "http"
+	// whose repoURL matches this URL are used, if any are found. This field is
+	// required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^https?://(\w+([\.-]\w+)*@)?\w+([\.-]\w+)*(:[\d]+)?(/.*)?$`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Headers is a list of additional headers to include in requests to the
+	// endpoint. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Headers []ReleaseFeedHeader `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the endpoint. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,3,opt,name=insecureSkipTLSVerify"`
+	// VersionsFromExpression is an expr-lang expression that extracts a list of
+	// versions from the endpoint's response. The expression has access to a
+	// response object with status, headers, header(), and body fields. If the
+	// response has a JSON content type, its body is parsed. The expression must
+	// evaluate to a list of strings. This field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	VersionsFromExpression string `json:"versionsFromExpression" protobuf:"bytes,4,opt,name=versionsFromExpression"`
+	// Metadata is a list of rules for extracting additional metadata about each
+	// version from the endpoint's response. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	Metadata []ReleaseFeedMetadata `json:"metadata,omitempty" protobuf:"bytes,5,rep,name=metadata"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// version from the list of versions. This field is optional. When left
+	// unspecified, the field is implicitly treated as if its value were
+	// "SemVer".
+	//
+	// Accepted values:
+	//
+	// - "SemVer": Selects the version with the greatest semantic version,
+	//   optionally constrained by the SemverConstraint field. Versions that are
+	//   not valid semantic versions are ignored.
+	// - "Lexical": Selects the lexically greatest version. This is useful when
+	//   versions are, for instance, date-based.
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy ReleaseFeedSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,6,opt,name=selectionStrategy"`
+	// SemverConstraint specifies constraints on what new versions are
+	// permissible. The value in this field only has any effect when the
+	// SelectionStrategy is SemVer or left unspecified. This field is optional.
+	// More info: https://github.com/masterminds/semver#checking-version-constraints
+	//
+	// +kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,7,opt,name=semverConstraint"`
+	// AllowVersions is a regular expression that can optionally be used to
+	// limit the versions that are considered in determining the newest version.
+	// This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowVersions string `json:"allowVersions,omitempty" protobuf:"bytes,8,opt,name=allowVersions"`
+	// IgnoreVersions is a list of versions that must be ignored when
+	// determining the newest version. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreVersions []string `json:"ignoreVersions,omitempty" protobuf:"bytes,9,rep,name=ignoreVersions"`
+	// DiscoveryLimit is an optional limit on the number of versions that can be
+	// discovered for this subscription. The limit is applied after filtering
+	// versions based on the AllowVersions and IgnoreVersions fields. When left
+	// unspecified, the field is implicitly treated as if its value were "20".
+	// The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,10,opt,name=discoveryLimit"`
+}
+
+// ReleaseFeedHeader represents a header to include in requests to a release
+// feed.
+type ReleaseFeedHeader struct {
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the header.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+}
+
+// ReleaseFeedMetadata describes a rule for extracting metadata about a version
+// from a release feed's response.
+type ReleaseFeedMetadata struct {
+	// Name is the key under which the metadata is recorded.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// FromExpression is an expr-lang expression that extracts the metadata
+	// value. In addition to the response object, the expression has access to
+	// the version the metadata is being extracted for. Results that are not
+	// strings are converted to JSON.
+	//
+	// +kubebuilder:validation:MinLength=1
+	FromExpression string `json:"fromExpression" protobuf:"bytes,2,opt,name=fromExpression"`
+}
+
+// ReleaseFeedSelectionStrategy defines how a ReleaseFeedSubscription
+// determines which version is the newest.
+//
+// +kubebuilder:validation:Enum={SemVer,Lexical}
+type ReleaseFeedSelectionStrategy string
+
+const (
+	ReleaseFeedSelectionStrategySemVer  ReleaseFeedSelectionStrategy = "SemVer"
+	ReleaseFeedSelectionStrategyLexical ReleaseFeedSelectionStrategy = "Lexical"
+)
+
 // WarehouseStatus describes a Warehouse's most recently observed state.
 type WarehouseStatus struct {
 	// Conditions contains the last observations of the Warehouse's current
@@ -440,6 +778,16 @@ type DiscoveredArtifacts struct {
 	//
 	// +optional
 	Charts []ChartDiscoveryResult `json:"charts,omitempty" protobuf:"bytes,3,rep,name=charts"`
+	// OCIArtifacts holds the artifact references discovered by the Warehouse
+	// for the OCI artifact subscriptions.
+	//
+	// +optional
+	OCIArtifacts []OCIArtifactDiscoveryResult `json:"ociArtifacts,omitempty" protobuf:"bytes,5,rep,name=ociArtifacts"`
+	// Releases holds the versions discovered by the Warehouse for the release
+	// feed subscriptions.
+	//
+	// +optional
+	Releases []ReleaseFeedDiscoveryResult `json:"releases,omitempty" protobuf:"bytes,6,rep,name=releases"`
 }
 
 // GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -483,6 +831,14 @@ type DiscoveredCommit struct {
 	// CreatorDate is the commit creation date as specified by the commit, or
 	// the tagger date if the commit belongs to an annotated tag.
 	CreatorDate *metav1.Time `json:"creatorDate,omitempty" protobuf:"bytes,7,opt,name=creatorDate"`
+	// PullRequestNumber is the number of the pull request whose head is this
+	// commit. This field is only populated when the CommitSelectionStrategy of
+	// the GitSubscription is PullRequest.
+	PullRequestNumber int64 `json:"pullRequestNumber,omitempty" protobuf:"varint,8,opt,name=pullRequestNumber"`
+	// PullRequestURL is the URL of the pull request whose head is this commit.
+	// This field is only populated when the CommitSelectionStrategy of the
+	// GitSubscription is PullRequest.
+	PullRequestURL string `json:"pullRequestURL,omitempty" protobuf:"bytes,9,opt,name=pullRequestURL"`
 }
 
 // ImageDiscoveryResult represents the result of an image discovery operation
@@ -534,6 +890,14 @@ type DiscoveredImageReference struct {
 	// CreatedAt is the time the image was created. This field is optional, and
 	// not populated for every ImageSelectionStrategy.
 	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,4,opt,name=createdAt"`
+	// SourceRepoURL is the URL of the repository containing the source code the
+	// image was built from. This field is optional, and only populated if the
+	// image has an org.opencontainers.image.source annotation or label.
+	SourceRepoURL string `json:"sourceRepoURL,omitempty" protobuf:"bytes,6,opt,name=sourceRepoURL"`
+	// SourceCommit identifies the commit the image was built from. This field is
+	// optional, and only populated if the image has an
+	// org.opencontainers.image.revision annotation or label.
+	SourceCommit string `json:"sourceCommit,omitempty" protobuf:"bytes,7,opt,name=sourceCommit"`
 }
 
 // ChartDiscoveryResult represents the result of a chart discovery operation for
@@ -557,6 +921,109 @@ type ChartDiscoveryResult struct {
 	//
 	// +optional
 	Versions []string `json:"versions" protobuf:"bytes,4,rep,name=versions"`
+	// AppVersionConstraint is the application version constraint for which
+	// versions were discovered. This field is optional, and only populated if
+	// the ChartSubscription specifies an AppVersionConstraint.
+	AppVersionConstraint string `json:"appVersionConstraint,omitempty" protobuf:"bytes,5,opt,name=appVersionConstraint"`
+	// Metadata is a list of the metadata of the discovered versions, as
+	// specified in their Chart.yaml files. Each element describes the version
+	// identified by its Version field. Unless the ChartSubscription specifies
+	// an AppVersionConstraint, only the metadata of the latest version is
+	// retrieved.
+	//
+	// +optional
+	Metadata []ChartVersionMetadata `json:"metadata,omitempty" protobuf:"bytes,6,rep,name=metadata"`
+}
+
+// ChartVersionMetadata represents the metadata of a specific version of a Helm
+// chart, as specified in the chart's Chart.yaml file.
+type ChartVersionMetadata struct {
+	// Version is the version of the chart.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// AppVersion is the version of the application packaged by the chart.
+	AppVersion string `json:"appVersion,omitempty" protobuf:"bytes,2,opt,name=appVersion"`
+	// Annotations are the annotations of the chart.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,3,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Dependencies are the dependencies of the chart.
+	Dependencies []ChartDependency `json:"dependencies,omitempty" protobuf:"bytes,4,rep,name=dependencies"`
+}
+
+// OCIArtifactDiscoveryResult represents the result of an artifact discovery
+// operation for an OCIArtifactSubscription.
+type OCIArtifactDiscoveryResult struct {
+	// RepoURL is the repository URL of the artifact, as specified in the
+	// OCIArtifactSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// ArtifactType is the artifact type constraint of the
+	// OCIArtifactSubscription for which references were discovered. This field
+	// is optional, and only populated if the OCIArtifactSubscription specifies
+	// an ArtifactType.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,2,opt,name=artifactType"`
+	// References is a list of artifact references discovered by the Warehouse
+	// for the OCIArtifactSubscription. An empty list indicates that the
+	// discovery operation was successful, but no artifacts matching the
+	// OCIArtifactSubscription criteria were found.
+	//
+	// +optional
+	References []DiscoveredOCIArtifactReference `json:"references" protobuf:"bytes,3,rep,name=references"`
+}
+
+// DiscoveredOCIArtifactReference represents an artifact reference discovered
+// by a Warehouse for an OCIArtifactSubscription.
+type DiscoveredOCIArtifactReference struct {
+	// Tag is the tag of the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[\w.\-\_]+$`
+	// +akuity:test-kubebuilder-pattern=Tag
+	Tag string `json:"tag" protobuf:"bytes,1,opt,name=tag"`
+	// Digest is the digest of the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]+$`
+	// +akuity:test-kubebuilder-pattern=Digest
+	Digest string `json:"digest" protobuf:"bytes,2,opt,name=digest"`
+	// ArtifactType is the type of the artifact.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,3,opt,name=artifactType"`
+	// Annotations is a map of key-value pairs that provide additional
+	// information about the artifact.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,4,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// CreatedAt is the time the artifact was created, as indicated by its
+	// org.opencontainers.image.created annotation. This field is optional.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,5,opt,name=createdAt"`
+}
+
+// ReleaseFeedDiscoveryResult represents the result of a version discovery
+// operation for a ReleaseFeedSubscription.
+type ReleaseFeedDiscoveryResult struct {
+	// URL is the URL of the release feed, as specified in the
+	// ReleaseFeedSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Releases is a list of releases discovered by the Warehouse for the
+	// ReleaseFeedSubscription. An empty list indicates that the discovery
+	// operation was successful, but no versions matching the
+	// ReleaseFeedSubscription criteria were found.
+	//
+	// +optional
+	Releases []DiscoveredRelease `json:"releases" protobuf:"bytes,2,rep,name=releases"`
+}
+
+// DiscoveredRelease represents a release discovered by a Warehouse for a
+// ReleaseFeedSubscription.
+type DiscoveredRelease struct {
+	// Version is the version of the release.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// Metadata is a map of metadata extracted for the release.
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,2,rep,name=metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 // +kubebuilder:object:root=true
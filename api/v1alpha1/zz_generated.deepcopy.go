@@ -84,6 +84,50 @@ func (in *AnalysisTemplateReference) DeepCopy() *AnalysisTemplateReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalPolicy) DeepCopyInto(out *ApprovalPolicy) {
+	*out = *in
+	if in.EligibleGroups != nil {
+		in, out := &in.EligibleGroups, &out.EligibleGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EligibleRoles != nil {
+		in, out := &in.EligibleRoles, &out.EligibleRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalPolicy.
+func (in *ApprovalPolicy) DeepCopy() *ApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovedStage) DeepCopyInto(out *ApprovedStage) {
 	*out = *in
@@ -91,6 +135,13 @@ func (in *ApprovedStage) DeepCopyInto(out *ApprovedStage) {
 		in, out := &in.ApprovedAt, &out.ApprovedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]Approval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovedStage.
@@ -155,9 +206,36 @@ func (in *ArgoCDAppSyncStatus) DeepCopy() *ArgoCDAppSyncStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollback) DeepCopyInto(out *AutoRollback) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollback.
+func (in *AutoRollback) DeepCopy() *AutoRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ChartDependency, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Chart.
@@ -170,6 +248,21 @@ func (in *Chart) DeepCopy() *Chart {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartDependency) DeepCopyInto(out *ChartDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartDependency.
+func (in *ChartDependency) DeepCopy() *ChartDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartDiscoveryResult) DeepCopyInto(out *ChartDiscoveryResult) {
 	*out = *in
@@ -178,6 +271,13 @@ func (in *ChartDiscoveryResult) DeepCopyInto(out *ChartDiscoveryResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]ChartVersionMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartDiscoveryResult.
@@ -205,6 +305,33 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartVersionMetadata) DeepCopyInto(out *ChartVersionMetadata) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ChartDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartVersionMetadata.
+func (in *ChartVersionMetadata) DeepCopy() *ChartVersionMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartVersionMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterPromotionTask) DeepCopyInto(out *ClusterPromotionTask) {
 	*out = *in
@@ -307,6 +434,20 @@ func (in *DiscoveredArtifacts) DeepCopyInto(out *DiscoveredArtifacts) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifactDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]ReleaseFeedDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredArtifacts.
@@ -364,6 +505,54 @@ func (in *DiscoveredImageReference) DeepCopy() *DiscoveredImageReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredOCIArtifactReference) DeepCopyInto(out *DiscoveredOCIArtifactReference) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredOCIArtifactReference.
+func (in *DiscoveredOCIArtifactReference) DeepCopy() *DiscoveredOCIArtifactReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredOCIArtifactReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredRelease) DeepCopyInto(out *DiscoveredRelease) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredRelease.
+func (in *DiscoveredRelease) DeepCopy() *DiscoveredRelease {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredRelease)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpressionVariable) DeepCopyInto(out *ExpressionVariable) {
 	*out = *in
@@ -400,7 +589,23 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 	if in.Charts != nil {
 		in, out := &in.Charts, &out.Charts
 		*out = make([]Chart, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]Release, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	in.Status.DeepCopyInto(&out.Status)
 }
@@ -452,6 +657,21 @@ func (in *FreightCollection) DeepCopy() *FreightCollection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightCreationCriteria) DeepCopyInto(out *FreightCreationCriteria) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightCreationCriteria.
+func (in *FreightCreationCriteria) DeepCopy() *FreightCreationCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightCreationCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in FreightHistory) DeepCopyInto(out *FreightHistory) {
 	{
@@ -543,7 +763,23 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 	if in.Charts != nil {
 		in, out := &in.Charts, &out.Charts
 		*out = make([]Chart, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]Release, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -623,6 +859,18 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Rejected != nil {
+		in, out := &in.Rejected, &out.Rejected
+		*out = new(RejectedFreight)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RejectedFor != nil {
+		in, out := &in.RejectedFor, &out.RejectedFor
+		*out = make(map[string]RejectedFreight, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]v1.JSON, len(*in))
@@ -695,6 +943,26 @@ func (in *GitHubWebhookReceiver) DeepCopy() *GitHubWebhookReceiver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitPullRequestFilter) DeepCopyInto(out *GitPullRequestFilter) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitPullRequestFilter.
+func (in *GitPullRequestFilter) DeepCopy() *GitPullRequestFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(GitPullRequestFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 	*out = *in
@@ -713,6 +981,11 @@ func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PullRequests != nil {
+		in, out := &in.PullRequests, &out.PullRequests
+		*out = new(GitPullRequestFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSubscription.
@@ -854,6 +1127,70 @@ func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactDiscoveryResult) DeepCopyInto(out *OCIArtifactDiscoveryResult) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]DiscoveredOCIArtifactReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactDiscoveryResult.
+func (in *OCIArtifactDiscoveryResult) DeepCopy() *OCIArtifactDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSubscription) DeepCopyInto(out *OCIArtifactSubscription) {
+	*out = *in
+	if in.IgnoreTags != nil {
+		in, out := &in.IgnoreTags, &out.IgnoreTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSubscription.
+func (in *OCIArtifactSubscription) DeepCopy() *OCIArtifactSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -1477,6 +1814,129 @@ func (in *PromotionTemplateSpec) DeepCopy() *PromotionTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RejectedFreight) DeepCopyInto(out *RejectedFreight) {
+	*out = *in
+	if in.RejectedAt != nil {
+		in, out := &in.RejectedAt, &out.RejectedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RejectedFreight.
+func (in *RejectedFreight) DeepCopy() *RejectedFreight {
+	if in == nil {
+		return nil
+	}
+	out := new(RejectedFreight)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Release) DeepCopyInto(out *Release) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Release.
+func (in *Release) DeepCopy() *Release {
+	if in == nil {
+		return nil
+	}
+	out := new(Release)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseFeedDiscoveryResult) DeepCopyInto(out *ReleaseFeedDiscoveryResult) {
+	*out = *in
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]DiscoveredRelease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseFeedDiscoveryResult.
+func (in *ReleaseFeedDiscoveryResult) DeepCopy() *ReleaseFeedDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseFeedDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseFeedHeader) DeepCopyInto(out *ReleaseFeedHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseFeedHeader.
+func (in *ReleaseFeedHeader) DeepCopy() *ReleaseFeedHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseFeedHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseFeedMetadata) DeepCopyInto(out *ReleaseFeedMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseFeedMetadata.
+func (in *ReleaseFeedMetadata) DeepCopy() *ReleaseFeedMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseFeedMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseFeedSubscription) DeepCopyInto(out *ReleaseFeedSubscription) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]ReleaseFeedHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]ReleaseFeedMetadata, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoreVersions != nil {
+		in, out := &in.IgnoreVersions, &out.IgnoreVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseFeedSubscription.
+func (in *ReleaseFeedSubscription) DeepCopy() *ReleaseFeedSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseFeedSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	*out = *in
@@ -1495,6 +1955,16 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 		*out = new(ChartSubscription)
 		**out = **in
 	}
+	if in.OCIArtifact != nil {
+		in, out := &in.OCIArtifact, &out.OCIArtifact
+		*out = new(OCIArtifactSubscription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReleaseFeed != nil {
+		in, out := &in.ReleaseFeed, &out.ReleaseFeed
+		*out = new(ReleaseFeedSubscription)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoSubscription.
@@ -1591,6 +2061,16 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(Verification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ApprovalPolicy != nil {
+		in, out := &in.ApprovalPolicy, &out.ApprovalPolicy
+		*out = new(ApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollback)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -1711,6 +2191,89 @@ func (in StepExecutionMetadataList) DeepCopy() StepExecutionMetadataList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepRunnerPlugin) DeepCopyInto(out *StepRunnerPlugin) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepRunnerPlugin.
+func (in *StepRunnerPlugin) DeepCopy() *StepRunnerPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(StepRunnerPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StepRunnerPlugin) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepRunnerPluginList) DeepCopyInto(out *StepRunnerPluginList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StepRunnerPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepRunnerPluginList.
+func (in *StepRunnerPluginList) DeepCopy() *StepRunnerPluginList {
+	if in == nil {
+		return nil
+	}
+	out := new(StepRunnerPluginList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StepRunnerPluginList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepRunnerPluginSpec) DeepCopyInto(out *StepRunnerPluginSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepRunnerPluginSpec.
+func (in *StepRunnerPluginSpec) DeepCopy() *StepRunnerPluginSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StepRunnerPluginSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Verification) DeepCopyInto(out *Verification) {
 	*out = *in
@@ -1877,6 +2440,11 @@ func (in *WarehouseList) DeepCopyObject() runtime.Object {
 func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 	*out = *in
 	out.Interval = in.Interval
+	if in.FreightCreationCriteria != nil {
+		in, out := &in.FreightCreationCriteria, &out.FreightCreationCriteria
+		*out = new(FreightCreationCriteria)
+		**out = **in
+	}
 	if in.Subscriptions != nil {
 		in, out := &in.Subscriptions, &out.Subscriptions
 		*out = make([]RepoSubscription, len(*in))
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgokubernetes "k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,6 +22,9 @@ import (
 	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	fluxhelm "github.com/akuity/kargo/internal/controller/flux/api/helm/v2"
+	fluxkustomize "github.com/akuity/kargo/internal/controller/flux/api/kustomize/v1"
+	fluxsource "github.com/akuity/kargo/internal/controller/flux/api/source/v1"
 	"github.com/akuity/kargo/internal/controller/promotions"
 	"github.com/akuity/kargo/internal/controller/stages"
 	"github.com/akuity/kargo/internal/controller/warehouses"
@@ -28,11 +32,13 @@ import (
 	credsdb "github.com/akuity/kargo/internal/credentials/kubernetes"
 	"github.com/akuity/kargo/internal/health"
 	healthCheckers "github.com/akuity/kargo/internal/health/checker/builtin"
+	"github.com/akuity/kargo/internal/image"
 	"github.com/akuity/kargo/internal/indexer"
 	"github.com/akuity/kargo/internal/logging"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/promotion"
 	promotionStepRunners "github.com/akuity/kargo/internal/promotion/runner/builtin"
+	promotionPlugins "github.com/akuity/kargo/internal/promotion/runner/plugin"
 	"github.com/akuity/kargo/internal/server/kubernetes"
 	"github.com/akuity/kargo/internal/types"
 	pkgPromotion "github.com/akuity/kargo/pkg/promotion"
@@ -50,6 +56,8 @@ type controllerOptions struct {
 	ArgoCDKubeConfig    string
 	ArgoCDNamespaceOnly bool
 
+	FluxEnabled bool
+
 	MetricsBindAddress string
 	PprofBindAddress   string
 
@@ -89,6 +97,8 @@ func (o *controllerOptions) complete() {
 	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
 	o.ArgoCDNamespaceOnly = types.MustParseBool(os.GetEnv("ARGOCD_WATCH_ARGOCD_NAMESPACE_ONLY", "false"))
 
+	o.FluxEnabled = types.MustParseBool(os.GetEnv("FLUX_INTEGRATION_ENABLED", "true"))
+
 	o.MetricsBindAddress = os.GetEnv("METRICS_BIND_ADDRESS", "0")
 	o.PprofBindAddress = os.GetEnv("PPROF_BIND_ADDRESS", "")
 }
@@ -120,6 +130,25 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error initializing Argo CD Application controller manager: %w", err)
 	}
 
+	fluxClient, err := o.setupFluxClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing Flux client: %w", err)
+	}
+
+	imageMetadataCache, closeImageMetadataCache, err := image.NewMetadataCache(
+		image.MetadataCacheConfigFromEnv(),
+		kargoMgr.GetClient(),
+	)
+	if err != nil {
+		return fmt.Errorf("error initializing image metadata cache: %w", err)
+	}
+	defer func() {
+		if err := closeImageMetadataCache(); err != nil {
+			o.Logger.Error(err, "error closing image metadata cache")
+		}
+	}()
+	image.SetMetadataCache(imageMetadataCache)
+
 	credentialsDB := credsdb.NewDatabase(
 		ctx,
 		kargoMgr.GetClient(),
@@ -130,6 +159,7 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		ctx,
 		kargoMgr,
 		argocdMgr,
+		fluxClient,
 		credentialsDB,
 		stagesReconcilerCfg,
 	); err != nil {
@@ -327,9 +357,59 @@ func (o *controllerOptions) setupArgoCDManager(ctx context.Context) (manager.Man
 	)
 }
 
+// setupFluxClient returns a client for interacting with Flux resources in the
+// cluster the controller is running in. Flux resources are only read and
+// patched on demand, so the client is not backed by a cache. If Flux
+// integration is disabled or Flux is not installed, nil is returned.
+func (o *controllerOptions) setupFluxClient(ctx context.Context) (client.Client, error) {
+	if !o.FluxEnabled {
+		o.Logger.Info("Flux integration is disabled")
+		return nil, nil
+	}
+
+	restCfg, err := kubernetes.GetRestConfig(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for Flux client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	restCfg.ContentType = runtime.ContentTypeJSON
+
+	var exists bool
+	if exists, err = fluxExists(ctx, restCfg); !exists || err != nil {
+		// If we are unable to determine if Flux is installed, we will return
+		// an error and fail to start the controller. Note this will only
+		// happen if we get an inconclusive response from the API server (e.g.
+		// due to network issues), and not if Flux is not installed.
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine if Flux is installed: %w", err)
+		}
+		o.Logger.Info(
+			"Flux integration was enabled, but no Flux CRDs were found. " +
+				"Proceeding without Flux integration.",
+		)
+		return nil, nil
+	}
+
+	o.Logger.Info("Flux integration is enabled")
+
+	scheme := runtime.NewScheme()
+	if err = fluxkustomize.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Flux Kustomize API to Flux client scheme: %w", err)
+	}
+	if err = fluxhelm.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Flux Helm API to Flux client scheme: %w", err)
+	}
+	if err = fluxsource.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Flux Source API to Flux client scheme: %w", err)
+	}
+
+	return client.New(restCfg, client.Options{Scheme: scheme})
+}
+
 func (o *controllerOptions) setupReconcilers(
 	ctx context.Context,
 	kargoMgr, argocdMgr manager.Manager,
+	fluxClient client.Client,
 	credentialsDB credentials.Database,
 	stagesReconcilerCfg stages.ReconcilerConfig,
 ) error {
@@ -338,8 +418,20 @@ func (o *controllerOptions) setupReconcilers(
 		argoCDClient = argocdMgr.GetClient()
 	}
 
-	promotionStepRunners.Initialize(kargoMgr.GetClient(), argoCDClient, credentialsDB)
-	healthCheckers.Initialize(argoCDClient)
+	kubeClient, err := clientgokubernetes.NewForConfig(kargoMgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("error creating Kubernetes client: %w", err)
+	}
+
+	promotionStepRunners.Initialize(
+		kargoMgr.GetClient(),
+		argoCDClient,
+		fluxClient,
+		kubeClient,
+		credentialsDB,
+	)
+	promotionPlugins.Initialize(kargoMgr.GetClient())
+	healthCheckers.Initialize(argoCDClient, fluxClient)
 
 	sharedIndexer := indexer.NewSharedFieldIndexer(kargoMgr.GetFieldIndexer())
 
@@ -349,6 +441,7 @@ func (o *controllerOptions) setupReconcilers(
 		argocdMgr,
 		promotion.NewSimpleEngine(kargoMgr.GetClient(), promotion.DefaultExprDataCacheFn),
 		promotions.ReconcilerConfigFromEnv(),
+		sharedIndexer,
 	); err != nil {
 		return fmt.Errorf("error setting up Promotions reconciler: %w", err)
 	}
@@ -154,8 +154,12 @@ function main() {
   { msg "Generating .pb.go and .connect.go files from service.proto"; } 2> /dev/null
   buf generate . --path api/service
 
+  { msg "Generating .pb.go and .connect.go files from step_runner.proto"; } 2> /dev/null
+  buf generate . --path api/plugin
+
   { msg "Generating TypeScript bindings for UI..."; } 2> /dev/null
   buf generate . --path api \
+    --exclude-path api/plugin \
     --include-imports \
     --template=buf.ui.gen.yaml
 }
@@ -60,6 +60,31 @@ func NewFreightApprovedEventAnnotations(actor string, f *kargoapi.Freight, stage
 	return annotations
 }
 
+// NewFreightRejectedEventAnnotations returns annotations for an Event
+// recording the rejection of Freight. If no Stage name is provided, the
+// Freight was rejected for all Stages.
+func NewFreightRejectedEventAnnotations(
+	actor string,
+	f *kargoapi.Freight,
+	stageName string,
+	reason string,
+) map[string]string {
+	annotations := map[string]string{
+		kargoapi.AnnotationKeyEventProject:           f.Namespace,
+		kargoapi.AnnotationKeyEventFreightCreateTime: f.CreationTimestamp.Format(time.RFC3339),
+		kargoapi.AnnotationKeyEventFreightAlias:      f.Alias,
+		kargoapi.AnnotationKeyEventFreightName:       f.Name,
+		kargoapi.AnnotationKeyEventRejectionReason:   reason,
+	}
+	if stageName != "" {
+		annotations[kargoapi.AnnotationKeyEventStageName] = stageName
+	}
+	if actor != "" {
+		annotations[kargoapi.AnnotationKeyEventActor] = actor
+	}
+	return annotations
+}
+
 func formatOIDCUsername(oidcUsername string) string {
 	return fmt.Sprintf("%s:%s", os.Getenv("OIDC_USERNAME_CLAIM"), oidcUsername)
 }
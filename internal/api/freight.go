@@ -19,7 +19,8 @@ import (
 // GenerateFreightID deterministically calculates a piece of Freight's ID based
 // on its contents and returns it.
 func GenerateFreightID(f *kargoapi.Freight) string {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	size := len(f.Commits) + len(f.Images) + len(f.Charts) + len(f.OCIArtifacts) +
+		len(f.Releases)
 	artifacts := make([]string, 0, size)
 	for _, commit := range f.Commits {
 		if commit.Tag != "" {
@@ -64,6 +65,20 @@ func GenerateFreightID(f *kargoapi.Freight) string {
 			),
 		)
 	}
+	for _, artifact := range f.OCIArtifacts {
+		artifacts = append(
+			artifacts,
+			// As with images, incorporate BOTH tag and digest, since either may be
+			// reassigned independently of the other.
+			fmt.Sprintf("%s:%s@%s", artifact.RepoURL, artifact.Tag, artifact.Digest),
+		)
+	}
+	for _, release := range f.Releases {
+		artifacts = append(
+			artifacts,
+			fmt.Sprintf("%s:%s", release.URL, release.Version),
+		)
+	}
 	slices.Sort(artifacts)
 	return fmt.Sprintf(
 		"%x",
@@ -41,6 +41,19 @@ func TestGenerateFreightID(t *testing.T) {
 				Version: "fake-chart-version",
 			},
 		},
+		OCIArtifacts: []kargoapi.OCIArtifact{
+			{
+				RepoURL: "fake-artifact-repo",
+				Tag:     "fake-artifact-tag",
+				Digest:  "fake-artifact-digest",
+			},
+		},
+		Releases: []kargoapi.Release{
+			{
+				URL:     "https://fake-release-feed",
+				Version: "fake-release-version",
+			},
+		},
 	}
 	id := GenerateFreightID(&freight)
 	expected := id
@@ -51,6 +64,12 @@ func TestGenerateFreightID(t *testing.T) {
 	// Changing anything should change the result
 	freight.Commits[0].ID = "a-different-fake-commit"
 	require.NotEqual(t, expected, GenerateFreightID(&freight))
+	expected = GenerateFreightID(&freight)
+	freight.OCIArtifacts[0].Digest = "a-different-fake-digest"
+	require.NotEqual(t, expected, GenerateFreightID(&freight))
+	expected = GenerateFreightID(&freight)
+	freight.Releases[0].Version = "a-different-fake-version"
+	require.NotEqual(t, expected, GenerateFreightID(&freight))
 }
 
 // TODO(krancour): If we move our actual indexers to this package, we can use
@@ -47,6 +47,9 @@ func GetStage(
 //  2. Any Freight that is verified in upstream Stages matching configured
 //     AvailabilityStrategy (with any applicable soak time elapsed)
 //  3. Any Freight that is approved for the Stage
+//
+// Freight that has been rejected for the Stage is never included. If the Stage
+// has an ApprovalPolicy, only Freight satisfying that policy is included.
 func ListFreightAvailableToStage(
 	ctx context.Context,
 	c client.Client,
@@ -76,7 +79,12 @@ func ListFreightAvailableToStage(
 		}
 		// Get applicable Freight from the Warehouse
 		var listOpts *ListWarehouseFreightOptions
-		if !req.Sources.Direct {
+		if s.Spec.ApprovalPolicy != nil {
+			// Only Freight that has been approved for the Stage can satisfy its
+			// approval policy. Whether the policy has actually been satisfied is
+			// determined below.
+			listOpts = &ListWarehouseFreightOptions{ApprovedFor: s.Name}
+		} else if !req.Sources.Direct {
 			listOpts = &ListWarehouseFreightOptions{
 				ApprovedFor:          s.Name,
 				VerifiedIn:           req.Sources.Stages,
@@ -90,7 +98,15 @@ func ListFreightAvailableToStage(
 		if err != nil {
 			return nil, err
 		}
-		availableFreight = append(availableFreight, freightFromWarehouse...)
+		for _, f := range freightFromWarehouse {
+			if f.IsRejectedFor(s.Name) {
+				continue
+			}
+			if s.Spec.ApprovalPolicy != nil && !s.IsFreightAvailable(&f) {
+				continue
+			}
+			availableFreight = append(availableFreight, f)
+		}
 	}
 
 	// Sort and de-dupe the available Freight
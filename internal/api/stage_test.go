@@ -226,6 +226,33 @@ func TestListFreightAvailableToStage(t *testing.T) {
 						},
 					},
 				},
+				&kargoapi.Freight{ // Not available because rejected for the Stage
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-6",
+					},
+					Origin: testWarehouse1Origin,
+					Status: kargoapi.FreightStatus{
+						RejectedFor: map[string]kargoapi.RejectedFreight{
+							testStage: {Reason: "broken"},
+						},
+					},
+				},
+				&kargoapi.Freight{ // Not available because rejected for all Stages
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-7",
+					},
+					Origin: testWarehouse2Origin,
+					Status: kargoapi.FreightStatus{
+						Rejected: &kargoapi.RejectedFreight{Reason: "broken"},
+						VerifiedIn: map[string]kargoapi.VerifiedStage{
+							testStage: {
+								LongestCompletedSoak: &metav1.Duration{Duration: 2 * time.Hour},
+							},
+						},
+					},
+				},
 			},
 			assertions: func(t *testing.T, freight []kargoapi.Freight, err error) {
 				require.NoError(t, err)
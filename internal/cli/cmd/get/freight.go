@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
@@ -14,6 +15,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
@@ -36,6 +38,7 @@ type getFreightOptions struct {
 	Names   []string
 	Aliases []string
 	Origins []string
+	Watch   bool
 }
 
 func newGetFreightCommand(
@@ -52,7 +55,7 @@ func newGetFreightCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:   "freight [--project=project] [--name=name | --alias=alias] [--no-headers]",
+		Use:   "freight [--project=project] [--name=name | --alias=alias] [--no-headers] [--watch]",
 		Short: "Display one or many pieces of freight",
 		Args:  option.NoArgs,
 		Example: templates.Example(`
@@ -71,6 +74,9 @@ kargo get freight --project=my-project --name=abc1234
 # Get a single piece of freight by alias
 kargo get freight --project=my-project --alias=wonky-wombat
 
+# Watch all freight in my-project for changes
+kargo get freight --project=my-project --watch
+
 # List all freight in the default project
 kargo config set-project my-project
 kargo get freight
@@ -113,6 +119,7 @@ func (o *getFreightOptions) addFlags(cmd *cobra.Command) {
 	option.Names(cmd.Flags(), &o.Names, "The name of a piece of freight to get.")
 	option.Aliases(cmd.Flags(), &o.Aliases, "The alias of a piece of freight to get.")
 	option.Origins(cmd.Flags(), &o.Origins, "The origin of the freight to get.")
+	option.Watch(cmd.Flags(), &o.Watch, "After listing the freight, watch for changes.")
 
 	// Origin and name/alias are mutually exclusive
 	cmd.MarkFlagsMutuallyExclusive(option.NameFlag, option.OriginFlag)
@@ -137,6 +144,10 @@ func (o *getFreightOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Watch {
+		return o.watch(ctx, kargoSvcCli)
+	}
+
 	if len(o.Names) == 0 && len(o.Aliases) == 0 {
 		var resp *connect.Response[v1alpha1.QueryFreightResponse]
 		if resp, err = kargoSvcCli.QueryFreight(
@@ -198,6 +209,45 @@ func (o *getFreightOptions) run(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// watch streams the freight from the server and prints it to the console as it
+// changes.
+func (o *getFreightOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	stream, err := kargoSvcCli.WatchFreight(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.WatchFreightRequest{
+				Project: o.Project,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("watch freight: %w", err)
+	}
+	if err = watchObjects(
+		stream,
+		(*v1alpha1.WatchFreightResponse).GetFreight,
+		o.matches,
+		o.PrintFlags,
+		o.IOStreams,
+		o.NoHeaders,
+	); err != nil {
+		return fmt.Errorf("watch freight: %w", err)
+	}
+	return nil
+}
+
+// matches returns true if the given piece of freight matches the names,
+// aliases, or origins specified in the options.
+func (o *getFreightOptions) matches(freight *kargoapi.Freight) bool {
+	if len(o.Names) > 0 || len(o.Aliases) > 0 {
+		return slices.Contains(o.Names, freight.Name) || slices.Contains(o.Aliases, freight.Alias)
+	}
+	return len(o.Origins) == 0 || slices.Contains(o.Origins, freight.Origin.Name)
+}
+
 func newFreightTable(list *metav1.List) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
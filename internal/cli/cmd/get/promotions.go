@@ -15,6 +15,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
@@ -36,6 +37,7 @@ type getPromotionsOptions struct {
 	Project string
 	Stage   string
 	Names   []string
+	Watch   bool
 }
 
 func newGetPromotionsCommand(
@@ -51,7 +53,7 @@ func newGetPromotionsCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "promotions [--project=project] [--stage=stage] [NAME ...] [--no-headers]",
+		Use:     "promotions [--project=project] [--stage=stage] [NAME ...] [--no-headers] [--watch]",
 		Aliases: []string{"promotion", "promos", "promo"},
 		Short:   "Display one or many promotions",
 		Example: templates.Example(`
@@ -67,6 +69,9 @@ kargo get promotions --project=my-project --stage=qa
 # Get a specific promotion in my-project
 kargo get promotion --project=my-project abc1234
 
+# Watch all promotions for the QA stage in my-project for changes
+kargo get promotions --project=my-project --stage=qa --watch
+
 # List all promotions in the default project
 kargo config set-project my-project
 kargo get promotions
@@ -112,6 +117,7 @@ func (o *getPromotionsOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Stage,
 		"The stage for which to list promotions. If not set, all stages will be listed.",
 	)
+	option.Watch(cmd.Flags(), &o.Watch, "After listing the promotions, watch for changes.")
 }
 
 // complete sets the options from the command arguments.
@@ -135,6 +141,10 @@ func (o *getPromotionsOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Watch {
+		return o.watch(ctx, kargoSvcCli)
+	}
+
 	if len(o.Names) == 0 {
 		var resp *connect.Response[v1alpha1.ListPromotionsResponse]
 		if resp, err = kargoSvcCli.ListPromotions(
@@ -176,6 +186,39 @@ func (o *getPromotionsOptions) run(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// watch streams the promotions from the server and prints them to the console
+// as they change.
+func (o *getPromotionsOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	stream, err := kargoSvcCli.WatchPromotions(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.WatchPromotionsRequest{
+				Project: o.Project,
+				Stage:   &o.Stage,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("watch promotions: %w", err)
+	}
+	if err = watchObjects(
+		stream,
+		(*v1alpha1.WatchPromotionsResponse).GetPromotion,
+		func(promo *kargoapi.Promotion) bool {
+			return len(o.Names) == 0 || slices.Contains(o.Names, promo.Name)
+		},
+		o.PrintFlags,
+		o.IOStreams,
+		o.NoHeaders,
+	); err != nil {
+		return fmt.Errorf("watch promotions: %w", err)
+	}
+	return nil
+}
+
 func newPromotionTable(list *metav1.List) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
@@ -15,6 +15,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
@@ -36,6 +37,7 @@ type getStagesOptions struct {
 
 	Project string
 	Names   []string
+	Watch   bool
 }
 
 func newGetStagesCommand(
@@ -51,7 +53,7 @@ func newGetStagesCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "stages [--project=project] [NAME ...] [--no-headers]",
+		Use:     "stages [--project=project] [NAME ...] [--no-headers] [--watch]",
 		Aliases: []string{"stage"},
 		Short:   "Display one or many stages",
 		Example: templates.Example(`
@@ -64,6 +66,9 @@ kargo get stages --project=my-project -o json
 # Get the QA stage in my-project
 kargo get stage --project=my-project qa
 
+# Watch all stages in my-project for changes
+kargo get stages --project=my-project --watch
+
 # List all stages in the default project
 kargo config set-project my-project
 kargo get stages
@@ -101,6 +106,7 @@ func (o *getStagesOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Project, o.Config.Project,
 		"The project for which to list stages. If not set, the default project will be used.",
 	)
+	option.Watch(cmd.Flags(), &o.Watch, "After listing the stages, watch for changes.")
 }
 
 // complete sets the options from the command arguments.
@@ -124,6 +130,10 @@ func (o *getStagesOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Watch {
+		return o.watch(ctx, kargoSvcCli)
+	}
+
 	if len(o.Names) == 0 {
 		var resp *connect.Response[v1alpha1.ListStagesResponse]
 		if resp, err = kargoSvcCli.ListStages(
@@ -164,6 +174,35 @@ func (o *getStagesOptions) run(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// watch streams the stages from the server and prints them to the console as
+// they change.
+func (o *getStagesOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	req := &v1alpha1.WatchStagesRequest{Project: o.Project}
+	if len(o.Names) == 1 {
+		req.Name = o.Names[0]
+	}
+	stream, err := kargoSvcCli.WatchStages(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("watch stages: %w", err)
+	}
+	if err = watchObjects(
+		stream,
+		(*v1alpha1.WatchStagesResponse).GetStage,
+		func(stage *kargoapi.Stage) bool {
+			return len(o.Names) == 0 || slices.Contains(o.Names, stage.Name)
+		},
+		o.PrintFlags,
+		o.IOStreams,
+		o.NoHeaders,
+	); err != nil {
+		return fmt.Errorf("watch stages: %w", err)
+	}
+	return nil
+}
+
 func newStageTable(list *metav1.List) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
@@ -15,6 +15,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
@@ -35,6 +36,7 @@ type getWarehousesOptions struct {
 
 	Project string
 	Names   []string
+	Watch   bool
 }
 
 func newGetWarehousesCommand(
@@ -50,7 +52,7 @@ func newGetWarehousesCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "warehouses [--project=project] [NAME ...] [--no-headers]",
+		Use:     "warehouses [--project=project] [NAME ...] [--no-headers] [--watch]",
 		Aliases: []string{"warehouse"},
 		Short:   "Display one or many warehouses",
 		Example: templates.Example(`
@@ -63,6 +65,9 @@ kargo get warehouses --project=my-project -o json
 # Get a specific warehouse in my-project
 kargo get warehouse --project=my-project my-warehouse
 
+# Watch all warehouses in my-project for changes
+kargo get warehouses --project=my-project --watch
+
 # List all warehouses in the default project
 kargo config set-project my-project
 kargo get warehouses
@@ -101,6 +106,7 @@ func (o *getWarehousesOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Project, o.Config.Project,
 		"The project for which to list Warehouses. If not set, the default project will be used.",
 	)
+	option.Watch(cmd.Flags(), &o.Watch, "After listing the Warehouses, watch for changes.")
 }
 
 // complete sets the options from the command arguments.
@@ -124,6 +130,10 @@ func (o *getWarehousesOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Watch {
+		return o.watch(ctx, kargoSvcCli)
+	}
+
 	if len(o.Names) == 0 {
 		var resp *connect.Response[v1alpha1.ListWarehousesResponse]
 		if resp, err = kargoSvcCli.ListWarehouses(
@@ -164,6 +174,35 @@ func (o *getWarehousesOptions) run(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// watch streams the warehouses from the server and prints them to the console
+// as they change.
+func (o *getWarehousesOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	req := &v1alpha1.WatchWarehousesRequest{Project: o.Project}
+	if len(o.Names) == 1 {
+		req.Name = o.Names[0]
+	}
+	stream, err := kargoSvcCli.WatchWarehouses(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("watch warehouses: %w", err)
+	}
+	if err = watchObjects(
+		stream,
+		(*v1alpha1.WatchWarehousesResponse).GetWarehouse,
+		func(warehouse *kargoapi.Warehouse) bool {
+			return len(o.Names) == 0 || slices.Contains(o.Names, warehouse.Name)
+		},
+		o.PrintFlags,
+		o.IOStreams,
+		o.NoHeaders,
+	); err != nil {
+		return fmt.Errorf("watch warehouses: %w", err)
+	}
+	return nil
+}
+
 func newWarehouseTable(list *metav1.List) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
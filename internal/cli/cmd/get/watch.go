@@ -0,0 +1,76 @@
+package get
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+// watchObjects prints the objects received from the given watch stream as they
+// are received, until the stream ends. The object contained in each response
+// is obtained using the given function. Objects for which the given filter
+// function returns false are not printed.
+//
+// When using the default output format, each object is printed as a table row
+// and headers are only printed once. When using the JSON output format, each
+// object is printed on a single line.
+func watchObjects[Res any, T runtime.Object](
+	stream *connect.ServerStreamForClient[Res],
+	objectFn func(*Res) T,
+	filterFn func(T) bool,
+	flags *genericclioptions.PrintFlags,
+	streams genericiooptions.IOStreams,
+	noHeaders bool,
+) error {
+	defer stream.Close()
+	for stream.Receive() {
+		obj := objectFn(stream.Msg())
+		if filterFn != nil && !filterFn(obj) {
+			continue
+		}
+		if err := printWatchedObject(obj, flags, streams, noHeaders); err != nil {
+			return err
+		}
+		noHeaders = true
+	}
+	if err := stream.Err(); err != nil && connect.CodeOf(err) != connect.CodeCanceled {
+		return err
+	}
+	return nil
+}
+
+// printWatchedObject prints a single object received from a watch stream.
+// When using the JSON output format, the object is printed on a single line so
+// that the output of a watch can be consumed as JSON lines. Otherwise, the
+// object is printed the same way as it would be by printObjects.
+func printWatchedObject[T runtime.Object](
+	obj T,
+	flags *genericclioptions.PrintFlags,
+	streams genericiooptions.IOStreams,
+	noHeaders bool,
+) error {
+	if flags.OutputFormat == nil || *flags.OutputFormat != "json" {
+		return printObjects([]T{obj}, flags, streams, noHeaders)
+	}
+
+	printer, err := flags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("new printer: %w", err)
+	}
+	var buf bytes.Buffer
+	if err = printer.PrintObj(obj, &buf); err != nil {
+		return err
+	}
+	var line bytes.Buffer
+	if err = json.Compact(&line, buf.Bytes()); err != nil {
+		return fmt.Errorf("compact JSON: %w", err)
+	}
+	line.WriteByte('\n')
+	_, err = streams.Out.Write(line.Bytes())
+	return err
+}
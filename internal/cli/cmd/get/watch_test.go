@@ -0,0 +1,113 @@
+package get
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+)
+
+type fakeWatchStagesHandler struct {
+	svcv1alpha1connect.UnimplementedKargoServiceHandler
+	stages []*kargoapi.Stage
+}
+
+func (h *fakeWatchStagesHandler) WatchStages(
+	_ context.Context,
+	_ *connect.Request[v1alpha1.WatchStagesRequest],
+	stream *connect.ServerStream[v1alpha1.WatchStagesResponse],
+) error {
+	for _, stage := range h.stages {
+		if err := stream.Send(&v1alpha1.WatchStagesResponse{
+			Stage: stage,
+			Type:  "ADDED",
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Test_watchObjects(t *testing.T) {
+	stages := []*kargoapi.Stage{
+		{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "fake-project"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fake-project"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "fake-project"}},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(svcv1alpha1connect.NewKargoServiceHandler(&fakeWatchStagesHandler{stages: stages}))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	kargoSvcCli := svcv1alpha1connect.NewKargoServiceClient(server.Client(), server.URL)
+
+	testCases := []struct {
+		name         string
+		outputFormat string
+		assertions   func(*testing.T, string)
+	}{
+		{
+			name: "table",
+			assertions: func(t *testing.T, out string) {
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				require.Len(t, lines, 3)
+				require.True(t, strings.HasPrefix(lines[0], "NAME"))
+				require.True(t, strings.HasPrefix(lines[1], "dev "))
+				require.True(t, strings.HasPrefix(lines[2], "prod "))
+			},
+		},
+		{
+			name:         "JSON lines",
+			outputFormat: "json",
+			assertions: func(t *testing.T, out string) {
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				require.Len(t, lines, 2)
+				require.Contains(t, lines[0], `"name":"dev"`)
+				require.Contains(t, lines[0], `"kind":"Stage"`)
+				require.Contains(t, lines[1], `"name":"prod"`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stream, err := kargoSvcCli.WatchStages(
+				context.Background(),
+				connect.NewRequest(&v1alpha1.WatchStagesRequest{Project: "fake-project"}),
+			)
+			require.NoError(t, err)
+
+			flags := genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme())
+			if testCase.outputFormat != "" {
+				flags.OutputFormat = &testCase.outputFormat
+				flags.OutputFlagSpecified = func() bool { return true }
+			}
+			out := &bytes.Buffer{}
+
+			err = watchObjects(
+				stream,
+				(*v1alpha1.WatchStagesResponse).GetStage,
+				func(stage *kargoapi.Stage) bool {
+					return stage.Name != "test"
+				},
+				flags,
+				genericiooptions.IOStreams{Out: out},
+				false,
+			)
+			require.NoError(t, err)
+			testCase.assertions(t, out.String())
+		})
+	}
+}
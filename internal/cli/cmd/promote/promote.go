@@ -36,6 +36,9 @@ type promotionOptions struct {
 	Stage          string
 	DownstreamFrom string
 	Abort          bool
+	Undo           bool
+	Retry          string
+	FromStart      bool
 	Wait           bool
 }
 
@@ -48,7 +51,7 @@ func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra
 
 	cmd := &cobra.Command{
 		Use: "promote [--project=project] (--freight=freight | --freight-alias=alias | --name=name) " +
-			"[(--stage=stage | --downstream-from=stage) | --abort]",
+			"[(--stage=stage | --downstream-from=stage) | --abort | --undo] | --retry=promotion [--from-start]",
 		Short: "Promote a piece of freight",
 		Args:  option.NoArgs,
 		// nolint: lll
@@ -59,6 +62,9 @@ kargo promote --project=my-project --freight=abc123 --stage=qa
 # Promote a piece of freight specified by alias to the QA stage
 kargo promote --project=my-project --freight-alias=wonky-wombat --stage=qa
 
+# Promote a piece of freight to the QA stage and wait for the promotion to complete
+kargo promote --project=my-project --freight=abc123 --stage=qa --wait
+
 # Promote a piece of freight specified by name to stages immediately downstream from the QA stage
 kargo promote --project=my-project --freight=abc123 --downstream-from=qa
 
@@ -68,6 +74,15 @@ kargo promote --project=my-project --freight-alias=wonky-wombat --downstream-fro
 # Abort a Promotion by name
 kargo promote --project=my-project --name=my-promotion --abort
 
+# Undo the last Promotion of a stage by reverting the commits it made
+kargo promote --project=my-project --name=my-promotion --undo
+
+# Retry a failed Promotion, resuming from the step at which it stopped
+kargo promote --project=my-project --retry=my-promotion
+
+# Retry a failed Promotion from its first step
+kargo promote --project=my-project --retry=my-promotion --from-start
+
 # Promote a piece of freight specified by name to the QA stage in the default project
 kargo config set-project my-project
 kargo promote --freight=abc123 --stage=qa
@@ -113,7 +128,7 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	)
 	option.Freight(cmd.Flags(), &o.FreightName, "The name of piece of freight to promote.")
 	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of piece of freight to promote.")
-	option.Name(cmd.Flags(), &o.Promotion, "The name of a promotion. Only used when aborting a promotion.")
+	option.Name(cmd.Flags(), &o.Promotion, "The name of a promotion. Only used when aborting or undoing a promotion.")
 	option.Stage(
 		cmd.Flags(), &o.Stage,
 		fmt.Sprintf(
@@ -131,15 +146,32 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	option.Abort(cmd.Flags(), &o.Abort, false, fmt.Sprintf(
 		"Abort a non-terminal promotion. If set, --%s must be set.", option.NameFlag,
 	))
-	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
-
-	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
-	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
+	option.Undo(cmd.Flags(), &o.Undo, false, fmt.Sprintf(
+		"Undo the last successful promotion of a stage by reverting the commits it made and promoting "+
+			"the freight that preceded it. If set, --%s must be set.", option.NameFlag,
+	))
+	option.Retry(
+		cmd.Flags(), &o.Retry,
+		"The name of a failed or errored promotion to retry. By default, the new promotion resumes from "+
+			"the step at which the retried promotion stopped.",
+	)
+	option.FromStart(cmd.Flags(), &o.FromStart, false, fmt.Sprintf(
+		"Rerun all steps of the retried promotion instead of resuming. Only used with --%s.", option.RetryFlag,
+	))
+	option.Wait(
+		cmd.Flags(), &o.Wait, false,
+		"Wait for the promotion(s) to complete. Exits with a non-zero status if any promotion does not succeed.",
+	)
 
-	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
-	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
+	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag, option.RetryFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.NameFlag, option.RetryFlag)
 
-	cmd.MarkFlagsRequiredTogether(option.NameFlag, option.AbortFlag)
+	cmd.MarkFlagsOneRequired(
+		option.StageFlag, option.DownstreamFromFlag, option.AbortFlag, option.UndoFlag, option.RetryFlag,
+	)
+	cmd.MarkFlagsMutuallyExclusive(
+		option.StageFlag, option.DownstreamFromFlag, option.AbortFlag, option.UndoFlag, option.RetryFlag,
+	)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -151,11 +183,25 @@ func (o *promotionOptions) validate() error {
 	if o.Project == "" {
 		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
 	}
-	if o.Abort {
+	if o.FromStart && o.Retry == "" {
+		errs = append(errs, fmt.Errorf("%s may only be set when retrying a promotion", option.FromStartFlag))
+	}
+	switch {
+	case o.Retry != "":
+	case o.Abort:
 		if o.Promotion == "" {
 			errs = append(errs, fmt.Errorf("%s is required when aborting a promotion", option.NameFlag))
 		}
-	} else {
+	case o.Undo:
+		if o.Promotion == "" {
+			errs = append(errs, fmt.Errorf("%s is required when undoing a promotion", option.NameFlag))
+		}
+	case o.Promotion != "":
+		errs = append(
+			errs,
+			fmt.Errorf("%s may only be set when aborting or undoing a promotion", option.NameFlag),
+		)
+	default:
 		if o.FreightName == "" && o.FreightAlias == "" {
 			errs = append(
 				errs,
@@ -198,6 +244,55 @@ func (o *promotionOptions) run(ctx context.Context) error {
 			return fmt.Errorf("abort promotion: %w", err)
 		}
 		return nil
+	case o.Retry != "":
+		res, err := kargoSvcCli.RetryPromotion(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.RetryPromotionRequest{
+					Project:   o.Project,
+					Name:      o.Retry,
+					FromStart: o.FromStart,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("retry promotion: %w", err)
+		}
+		promo := res.Msg.GetPromotion()
+		if o.Wait {
+			if promo, err = waitForPromotion(ctx, kargoSvcCli, promo); err != nil {
+				return fmt.Errorf("wait for promotion: %w", err)
+			}
+		}
+		_ = printer.PrintObj(promo, o.IOStreams.Out)
+		if o.Wait {
+			return checkPromotionSucceeded(promo)
+		}
+		return nil
+	case o.Undo:
+		res, err := kargoSvcCli.UndoPromotion(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.UndoPromotionRequest{
+					Project: o.Project,
+					Name:    o.Promotion,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("undo promotion: %w", err)
+		}
+		promo := res.Msg.GetPromotion()
+		if o.Wait {
+			if promo, err = waitForPromotion(ctx, kargoSvcCli, promo); err != nil {
+				return fmt.Errorf("wait for promotion: %w", err)
+			}
+		}
+		_ = printer.PrintObj(promo, o.IOStreams.Out)
+		if o.Wait {
+			return checkPromotionSucceeded(promo)
+		}
+		return nil
 	case o.Stage != "":
 		res, err := kargoSvcCli.PromoteToStage(
 			ctx,
@@ -213,12 +308,16 @@ func (o *promotionOptions) run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("promote stage: %w", err)
 		}
+		promo := res.Msg.GetPromotion()
 		if o.Wait {
-			if err = waitForPromotion(ctx, kargoSvcCli, res.Msg.GetPromotion()); err != nil {
+			if promo, err = waitForPromotion(ctx, kargoSvcCli, promo); err != nil {
 				return fmt.Errorf("wait for promotion: %w", err)
 			}
 		}
-		_ = printer.PrintObj(res.Msg.GetPromotion(), o.IOStreams.Out)
+		_ = printer.PrintObj(promo, o.IOStreams.Out)
+		if o.Wait {
+			return checkPromotionSucceeded(promo)
+		}
 		return nil
 	case o.DownstreamFrom != "":
 		res, err := kargoSvcCli.PromoteDownstream(
@@ -235,41 +334,57 @@ func (o *promotionOptions) run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("promote stage subscribers: %w", err)
 		}
+		promos := res.Msg.GetPromotions()
 		if o.Wait {
-			if err = waitForPromotions(ctx, kargoSvcCli, res.Msg.GetPromotions()...); err != nil {
+			if promos, err = waitForPromotions(ctx, kargoSvcCli, promos...); err != nil {
 				return fmt.Errorf("wait for promotions: %w", err)
 			}
 		}
-		for _, p := range res.Msg.GetPromotions() {
+		errs := make([]error, 0, len(promos))
+		for _, p := range promos {
 			_ = printer.PrintObj(p, o.IOStreams.Out)
+			if o.Wait {
+				errs = append(errs, checkPromotionSucceeded(p))
+			}
 		}
-		return nil
+		return errors.Join(errs...)
 	}
 	return nil
 }
 
+// waitForPromotions waits for all the given promotions to reach a terminal
+// phase. It returns the promotions in their terminal state, in the same order
+// as they were given.
 func waitForPromotions(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
 	p ...*kargoapi.Promotion,
-) error {
+) ([]*kargoapi.Promotion, error) {
+	res := make([]*kargoapi.Promotion, len(p))
 	g, ctx := errgroup.WithContext(ctx)
-	for _, promo := range p {
+	for i, promo := range p {
 		g.Go(func() error {
-			return waitForPromotion(ctx, kargoSvcCli, promo)
+			var err error
+			res[i], err = waitForPromotion(ctx, kargoSvcCli, promo)
+			return err
 		})
 	}
-	return g.Wait()
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
+// waitForPromotion waits for the given promotion to reach a terminal phase and
+// returns the promotion in its terminal state.
 func waitForPromotion(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
 	p *kargoapi.Promotion,
-) error {
+) (*kargoapi.Promotion, error) {
 	if p == nil || p.Status.Phase.IsTerminal() {
 		// No need to wait for a promotion that is already terminal.
-		return nil
+		return p, nil
 	}
 
 	res, err := kargoSvcCli.WatchPromotion(ctx, connect.NewRequest(&v1alpha1.WatchPromotionRequest{
@@ -277,7 +392,7 @@ func waitForPromotion(
 		Name:    p.Name,
 	}))
 	if err != nil {
-		return fmt.Errorf("watch promotion: %w", err)
+		return nil, fmt.Errorf("watch promotion: %w", err)
 	}
 	defer func() {
 		if conn, connErr := res.Conn(); connErr == nil {
@@ -287,13 +402,25 @@ func waitForPromotion(
 	for {
 		if !res.Receive() {
 			if err = res.Err(); err != nil {
-				return fmt.Errorf("watch promotion: %w", err)
+				return nil, fmt.Errorf("watch promotion: %w", err)
 			}
-			return errors.New("unexpected end of watch stream")
+			return nil, errors.New("unexpected end of watch stream")
 		}
-		msg := res.Msg()
-		if msg.GetPromotion().Status.Phase.IsTerminal() {
-			return nil
+		if promo := res.Msg().GetPromotion(); promo.Status.Phase.IsTerminal() {
+			return promo, nil
 		}
 	}
 }
+
+// checkPromotionSucceeded returns an error if the given promotion did not
+// succeed, so that waiting for a promotion which fails results in a non-zero
+// exit code.
+func checkPromotionSucceeded(p *kargoapi.Promotion) error {
+	if p == nil || p.Status.Phase == kargoapi.PromotionPhaseSucceeded {
+		return nil
+	}
+	if p.Status.Message != "" {
+		return fmt.Errorf("promotion %q finished in phase %s: %s", p.Name, p.Status.Phase, p.Status.Message)
+	}
+	return fmt.Errorf("promotion %q finished in phase %s", p.Name, p.Status.Phase)
+}
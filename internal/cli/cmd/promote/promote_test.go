@@ -0,0 +1,57 @@
+package promote
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_checkPromotionSucceeded(t *testing.T) {
+	testCases := []struct {
+		name        string
+		promo       *kargoapi.Promotion
+		expectedErr string
+	}{
+		{
+			name: "nil promotion",
+		},
+		{
+			name: "succeeded",
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded},
+			},
+		},
+		{
+			name: "failed with message",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseFailed,
+					Message: "something went wrong",
+				},
+			},
+			expectedErr: `promotion "fake-promotion" finished in phase Failed: something went wrong`,
+		},
+		{
+			name: "aborted",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status:     kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseAborted},
+			},
+			expectedErr: `promotion "fake-promotion" finished in phase Aborted`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkPromotionSucceeded(testCase.promo)
+			if testCase.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, testCase.expectedErr)
+		})
+	}
+}
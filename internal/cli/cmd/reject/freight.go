@@ -0,0 +1,141 @@
+package reject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+type rejectFreightOptions struct {
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Name    string
+	Alias   string
+	Stage   string
+	Reason  string
+}
+
+func newRejectFreightCommand(cfg config.CLIConfig) *cobra.Command {
+	cmdOpts := &rejectFreightOptions{
+		Config: cfg,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "freight [--project=project] (NAME | --alias=alias) [--stage=stage] --reason=reason",
+		Short: "Reject a piece of freight for all stages or for a specific stage",
+		Args:  option.MaximumNArgs(1),
+		Example: templates.Example(`
+# Reject a piece of freight specified by name for all stages
+kargo reject freight --project=my-project abc1234 --reason="Breaks checkout"
+
+# Reject a piece of freight specified by alias for all stages
+kargo reject freight --project=my-project --alias=wonky-wombat --reason="Breaks checkout"
+
+# Reject a piece of freight specified by name for the prod stage only
+kargo reject freight --project=my-project abc1234 --stage=prod --reason="Fails load tests"
+
+# Reject a piece of freight specified by name in the default project
+kargo config set-project my-project
+kargo reject freight abc1234 --reason="Breaks checkout"
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	return cmd
+}
+
+// addFlags adds the flags for the reject freight options to the provided
+// command.
+func (o *rejectFreightOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the freight belongs to. If not set, the default project will be used.",
+	)
+	option.Alias(cmd.Flags(), &o.Alias, "The alias of the freight to reject.")
+	option.Stage(
+		cmd.Flags(), &o.Stage,
+		"The stage for which to reject the freight. If not set, the freight will be rejected for all stages.",
+	)
+	option.Reason(cmd.Flags(), &o.Reason, "The reason for rejecting the freight.")
+
+	if err := cmd.MarkFlagRequired(option.ReasonFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", option.ReasonFlag, err))
+	}
+}
+
+// complete sets the options from the command arguments.
+func (o *rejectFreightOptions) complete(args []string) {
+	if len(args) > 0 {
+		o.Name = strings.TrimSpace(args[0])
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *rejectFreightOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if (o.Name == "") == (o.Alias == "") {
+		errs = append(
+			errs,
+			fmt.Errorf("exactly one of name or %s is required", option.AliasFlag),
+		)
+	}
+	if strings.TrimSpace(o.Reason) == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ReasonFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run rejects the freight based on the options.
+func (o *rejectFreightOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	if _, err = kargoSvcCli.RejectFreight(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.RejectFreightRequest{
+				Project: o.Project,
+				Name:    o.Name,
+				Alias:   o.Alias,
+				Stage:   o.Stage,
+				Reason:  o.Reason,
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("reject freight: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package reject
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject SUBCOMMAND",
+		Short: "Reject a resource",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Reject a piece of freight for all stages
+kargo reject freight --project=my-project abc1234 --reason="Breaks checkout"
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newRejectFreightCommand(cfg))
+
+	return cmd
+}
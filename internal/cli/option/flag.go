@@ -80,6 +80,9 @@ const (
 	// ProjectShortFlag is the short flag name for the project flag.
 	ProjectShortFlag = "p"
 
+	// ReasonFlag is the flag name for the reason flag.
+	ReasonFlag = "reason"
+
 	// RecursiveFlag is the flag name for the recursive flag.
 	RecursiveFlag = "recursive"
 	// RecursiveShortFlag is the short flag name for the recursive flag.
@@ -118,8 +121,22 @@ const (
 	// WaitFlag is the flag name for the wait flag.
 	WaitFlag = "wait"
 
+	// WatchFlag is the flag name for the watch flag.
+	WatchFlag = "watch"
+	// WatchShortFlag is the short flag name for the watch flag.
+	WatchShortFlag = "w"
+
 	// AbortFlag is the flag name for the abort flag.
 	AbortFlag = "abort"
+
+	// UndoFlag is the flag name for the undo flag.
+	UndoFlag = "undo"
+
+	// RetryFlag is the flag name for the retry flag.
+	RetryFlag = "retry"
+
+	// FromStartFlag is the flag name for the from-start flag.
+	FromStartFlag = "from-start"
 )
 
 // Alias adds the AliasFlag to the provided flag set.
@@ -248,6 +265,11 @@ func Project(fs *pflag.FlagSet, project *string, defaultProject, usage string) {
 	fs.StringVarP(project, ProjectFlag, ProjectShortFlag, defaultProject, usage)
 }
 
+// Reason adds the ReasonFlag to the provided flag set.
+func Reason(fs *pflag.FlagSet, reason *string, usage string) {
+	fs.StringVar(reason, ReasonFlag, "", usage)
+}
+
 // Recursive adds the RecursiveFlag and RecursiveShortFlag to the provided flag
 // set.
 func Recursive(fs *pflag.FlagSet, recursive *bool) {
@@ -312,7 +334,27 @@ func Wait(fs *pflag.FlagSet, wait *bool, defaultWait bool, usage string) {
 	fs.BoolVar(wait, WaitFlag, defaultWait, usage)
 }
 
+// Watch adds the WatchFlag to the provided flag set.
+func Watch(fs *pflag.FlagSet, watch *bool, usage string) {
+	fs.BoolVarP(watch, WatchFlag, WatchShortFlag, false, usage)
+}
+
 // Abort adds the AbortFlag to the provided flag set.
 func Abort(fs *pflag.FlagSet, abort *bool, defaultAbort bool, usage string) {
 	fs.BoolVar(abort, AbortFlag, defaultAbort, usage)
 }
+
+// Undo adds the UndoFlag to the provided flag set.
+func Undo(fs *pflag.FlagSet, undo *bool, defaultUndo bool, usage string) {
+	fs.BoolVar(undo, UndoFlag, defaultUndo, usage)
+}
+
+// Retry adds the RetryFlag to the provided flag set.
+func Retry(fs *pflag.FlagSet, promotion *string, usage string) {
+	fs.StringVar(promotion, RetryFlag, "", usage)
+}
+
+// FromStart adds the FromStartFlag to the provided flag set.
+func FromStart(fs *pflag.FlagSet, fromStart *bool, defaultFromStart bool, usage string) {
+	fs.BoolVar(fromStart, FromStartFlag, defaultFromStart, usage)
+}
@@ -0,0 +1,7 @@
+package v2
+
+// This package reproduces just enough of
+// github.com/fluxcd/helm-controller/api/v2 to support Kargo without having to
+// incur undesired dependencies on Flux, which has transitive dependencies on
+// Kubernetes and can sometimes hold us back from upgrading important Kubernetes
+// packages.
@@ -0,0 +1,26 @@
+// +kubebuilder:object:generate=true
+// +groupName=helm.toolkit.fluxcd.io
+package v2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	GroupVersion = schema.GroupVersion{
+		Group:   "helm.toolkit.fluxcd.io",
+		Version: "v2",
+	}
+
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(GroupVersion, &HelmRelease{}, &HelmReleaseList{})
+	metav1.AddToGroupVersion(scheme, GroupVersion)
+	return nil
+}
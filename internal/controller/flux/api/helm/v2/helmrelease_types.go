@@ -0,0 +1,64 @@
+package v2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+)
+
+const HelmReleaseKind = "HelmRelease"
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+type HelmRelease struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              HelmReleaseSpec   `json:"spec,omitempty"`
+	Status            HelmReleaseStatus `json:"status,omitempty"`
+}
+
+type HelmReleaseSpec struct {
+	Chart    *HelmChartTemplate             `json:"chart,omitempty"`
+	ChartRef *CrossNamespaceSourceReference `json:"chartRef,omitempty"`
+	Suspend  bool                           `json:"suspend,omitempty"`
+}
+
+type HelmChartTemplate struct {
+	Spec HelmChartTemplateSpec `json:"spec"`
+}
+
+type HelmChartTemplateSpec struct {
+	Chart     string                        `json:"chart"`
+	Version   string                        `json:"version,omitempty"`
+	SourceRef CrossNamespaceObjectReference `json:"sourceRef"`
+}
+
+type CrossNamespaceObjectReference struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+type CrossNamespaceSourceReference struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+type HelmReleaseStatus struct {
+	meta.ReconcileRequestStatus `json:",inline"`
+	ObservedGeneration          int64              `json:"observedGeneration,omitempty"`
+	Conditions                  []metav1.Condition `json:"conditions,omitempty"`
+	LastAttemptedRevision       string             `json:"lastAttemptedRevision,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+type HelmReleaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HelmRelease `json:"items"`
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+)
+
+const KustomizationKind = "Kustomization"
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+type Kustomization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KustomizationSpec   `json:"spec,omitempty"`
+	Status            KustomizationStatus `json:"status,omitempty"`
+}
+
+type KustomizationSpec struct {
+	SourceRef CrossNamespaceSourceReference `json:"sourceRef"`
+	Path      string                        `json:"path,omitempty"`
+	Suspend   bool                          `json:"suspend,omitempty"`
+}
+
+type CrossNamespaceSourceReference struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+type KustomizationStatus struct {
+	meta.ReconcileRequestStatus `json:",inline"`
+	ObservedGeneration          int64              `json:"observedGeneration,omitempty"`
+	Conditions                  []metav1.Condition `json:"conditions,omitempty"`
+	LastAppliedRevision         string             `json:"lastAppliedRevision,omitempty"`
+	LastAttemptedRevision       string             `json:"lastAttemptedRevision,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+type KustomizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Kustomization `json:"items"`
+}
@@ -0,0 +1,5 @@
+// +kubebuilder:object:generate=true
+
+// Package meta reproduces just enough of github.com/fluxcd/pkg/apis/meta to
+// support Kargo without having to incur undesired dependencies on Flux.
+package meta
@@ -0,0 +1,25 @@
+package meta
+
+const (
+	// ReconcileRequestAnnotation is the annotation used to request the
+	// reconciliation of a Flux resource outside of its regular interval. Its
+	// value is an arbitrary token, by convention a RFC3339Nano timestamp, which
+	// is reflected in ReconcileRequestStatus.LastHandledReconcileAt once the
+	// request has been handled.
+	ReconcileRequestAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+	// ReadyCondition indicates whether a Flux resource has been reconciled
+	// successfully.
+	ReadyCondition = "Ready"
+	// ReconcilingCondition indicates a Flux resource is being reconciled.
+	ReconcilingCondition = "Reconciling"
+	// StalledCondition indicates the reconciliation of a Flux resource has
+	// failed in a way that can not be resolved without intervention.
+	StalledCondition = "Stalled"
+)
+
+// ReconcileRequestStatus is a struct to embed in the status of Flux resources
+// to reflect the last handled reconciliation request.
+type ReconcileRequestStatus struct {
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+}
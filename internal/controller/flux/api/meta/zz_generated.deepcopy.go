@@ -0,0 +1,22 @@
+//go:build !ignore_autogenerated
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package meta
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileRequestStatus) DeepCopyInto(out *ReconcileRequestStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileRequestStatus.
+func (in *ReconcileRequestStatus) DeepCopy() *ReconcileRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,8 @@
+package v1
+
+type Artifact struct {
+	Path     string `json:"path"`
+	URL      string `json:"url"`
+	Revision string `json:"revision"`
+	Digest   string `json:"digest,omitempty"`
+}
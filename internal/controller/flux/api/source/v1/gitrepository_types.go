@@ -0,0 +1,48 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+)
+
+const GitRepositoryKind = "GitRepository"
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+type GitRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GitRepositorySpec   `json:"spec,omitempty"`
+	Status            GitRepositoryStatus `json:"status,omitempty"`
+}
+
+type GitRepositorySpec struct {
+	URL       string            `json:"url"`
+	Reference *GitRepositoryRef `json:"ref,omitempty"`
+	Suspend   bool              `json:"suspend,omitempty"`
+}
+
+type GitRepositoryRef struct {
+	Branch string `json:"branch,omitempty"`
+	Tag    string `json:"tag,omitempty"`
+	SemVer string `json:"semver,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Commit string `json:"commit,omitempty"`
+}
+
+type GitRepositoryStatus struct {
+	meta.ReconcileRequestStatus `json:",inline"`
+	ObservedGeneration          int64              `json:"observedGeneration,omitempty"`
+	Conditions                  []metav1.Condition `json:"conditions,omitempty"`
+	Artifact                    *Artifact          `json:"artifact,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+type GitRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitRepository `json:"items"`
+}
@@ -0,0 +1,32 @@
+// +kubebuilder:object:generate=true
+// +groupName=source.toolkit.fluxcd.io
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	GroupVersion = schema.GroupVersion{
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1",
+	}
+
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(
+		GroupVersion,
+		&GitRepository{},
+		&GitRepositoryList{},
+		&OCIRepository{},
+		&OCIRepositoryList{},
+	)
+	metav1.AddToGroupVersion(scheme, GroupVersion)
+	return nil
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+)
+
+const OCIRepositoryKind = "OCIRepository"
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+type OCIRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OCIRepositorySpec   `json:"spec,omitempty"`
+	Status            OCIRepositoryStatus `json:"status,omitempty"`
+}
+
+type OCIRepositorySpec struct {
+	URL       string            `json:"url"`
+	Reference *OCIRepositoryRef `json:"ref,omitempty"`
+	Suspend   bool              `json:"suspend,omitempty"`
+}
+
+type OCIRepositoryRef struct {
+	Digest       string `json:"digest,omitempty"`
+	SemVer       string `json:"semver,omitempty"`
+	SemverFilter string `json:"semverFilter,omitempty"`
+	Tag          string `json:"tag,omitempty"`
+}
+
+type OCIRepositoryStatus struct {
+	meta.ReconcileRequestStatus `json:",inline"`
+	ObservedGeneration          int64              `json:"observedGeneration,omitempty"`
+	Conditions                  []metav1.Condition `json:"conditions,omitempty"`
+	Artifact                    *Artifact          `json:"artifact,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+type OCIRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OCIRepository `json:"items"`
+}
@@ -159,6 +159,25 @@ with a body
 		require.True(t, exists)
 	})
 
+	t.Run("can list branches", func(t *testing.T) {
+		var branches []BranchMetadata
+		branches, err = rep.ListBranches()
+		require.NoError(t, err)
+		require.Len(t, branches, 1)
+		require.Equal(t, "master", branches[0].Branch)
+		require.Equal(t, lastCommitID, branches[0].ID)
+		require.NotEmpty(t, branches[0].Subject)
+		require.False(t, branches[0].CommitDate.IsZero())
+	})
+
+	t.Run("can list branch commits", func(t *testing.T) {
+		var commits []CommitMetadata
+		commits, err = rep.ListBranchCommits("master", 0, 0)
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+		require.Equal(t, lastCommitID, commits[0].ID)
+	})
+
 	testBranch := fmt.Sprintf("test-branch-%s", uuid.NewString())
 	err = rep.CreateChildBranch(testBranch)
 	require.NoError(t, err)
@@ -182,6 +201,49 @@ with a body
 		require.False(t, hasDiffs)
 	})
 
+	t.Run("can revert a commit", func(t *testing.T) {
+		err = rep.Revert(lastCommitID, &RevertOptions{
+			Author: &User{
+				Name:  "Revert Author",
+				Email: "revert@example.com",
+			},
+		})
+		require.NoError(t, err)
+		var revertCommitID string
+		revertCommitID, err = rep.LastCommitID()
+		require.NoError(t, err)
+		require.NotEqual(t, lastCommitID, revertCommitID)
+		_, err = os.Stat(fmt.Sprintf("%s/%s", rep.Dir(), "test.txt"))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("aborts a revert that fails", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(fmt.Sprintf("%s/%s", rep.Dir(), "conflict.txt"), []byte("foo"), 0600))
+		require.NoError(t, rep.AddAllAndCommit("add conflict.txt", nil))
+		var addCommitID string
+		addCommitID, err = rep.LastCommitID()
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(fmt.Sprintf("%s/%s", rep.Dir(), "conflict.txt"), []byte("bar"), 0600))
+		require.NoError(t, rep.AddAllAndCommit("update conflict.txt", nil))
+		var updateCommitID string
+		updateCommitID, err = rep.LastCommitID()
+		require.NoError(t, err)
+
+		// Reverting the addition of the file conflicts with its update
+		err = rep.Revert(addCommitID, nil)
+		require.ErrorContains(t, err, "error reverting commit")
+		var hasDiffs bool
+		hasDiffs, err = rep.HasDiffs()
+		require.NoError(t, err)
+		require.False(t, hasDiffs)
+		_, err = os.Stat(fmt.Sprintf("%s/.git/REVERT_HEAD", rep.Dir()))
+		require.True(t, os.IsNotExist(err))
+		var headCommitID string
+		headCommitID, err = rep.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, updateCommitID, headCommitID)
+	})
+
 	t.Run("can create an orphaned branch", func(t *testing.T) {
 		testBranch := fmt.Sprintf("test-branch-%s", uuid.NewString())
 		err = rep.CreateOrphanedBranch(testBranch)
@@ -64,18 +64,33 @@ type WorkTree interface {
 	// IsRebasing returns a bool indicating whether the working tree is currently
 	// in the middle of a rebase operation.
 	IsRebasing() (bool, error)
+	// IsShallow returns a bool indicating whether the local repository is a
+	// shallow clone, i.e. whether part of the history of the remote repository
+	// is missing from it.
+	IsShallow() (bool, error)
 	// LastCommitID returns the ID (sha) of the most recent commit to the current
 	// branch.
 	LastCommitID() (string, error)
 	// ListTags returns a slice of tags in the repository with metadata such as
 	// commit ID, creator date, and subject.
 	ListTags() ([]TagMetadata, error)
+	// ListBranches returns a slice of the branches of the remote repository,
+	// as last fetched, with metadata about the latest commit on each. Branches
+	// are ordered by the commit date of their latest commit, newest first.
+	ListBranches() ([]BranchMetadata, error)
+	// ListBranchCommits returns a slice of commits in the specified branch of
+	// the remote repository, as last fetched, with metadata such as commit ID,
+	// commit date, and subject.
+	ListBranchCommits(branch string, limit, skip uint) ([]CommitMetadata, error)
 	// ListCommits returns a slice of commits in the current branch with
 	// metadata such as commit ID, commit date, and subject.
 	ListCommits(limit, skip uint) ([]CommitMetadata, error)
 	// CommitMessage returns the text of the most recent commit message associated
 	// with the specified commit ID.
 	CommitMessage(id string) (string, error)
+	// CommitExists returns a bool indicating whether the specified commit exists
+	// in the local repository.
+	CommitExists(id string) (bool, error)
 	// Push pushes from the local repository to the remote repository.
 	Push(*PushOptions) error
 	// RefsHaveDiffs returns whether there is a diff between two commits/branches
@@ -85,6 +100,11 @@ type WorkTree interface {
 	RemoteBranchExists(branch string) (bool, error)
 	// ResetHard performs a hard reset on the working tree.
 	ResetHard() error
+	// Revert creates a new commit that reverts the changes introduced by the
+	// specified commit. Merge commits are reverted relative to their first
+	// parent. If the commit cannot be reverted, e.g. due to conflicts, the
+	// revert is aborted and the working tree is left unchanged.
+	Revert(commitID string, opts *RevertOptions) error
 	// URL returns the remote URL of the repository.
 	URL() string
 }
@@ -258,6 +278,18 @@ func (w *workTree) CommitMessage(id string) (string, error) {
 	return string(msgBytes), nil
 }
 
+func (w *workTree) CommitExists(id string) (bool, error) {
+	_, err := libExec.Exec(w.buildGitCommand("rev-parse", "--verify", "--quiet", id+"^{commit}"))
+	if err == nil {
+		return true, nil
+	}
+	var execErr *libExec.ExitError
+	if errors.As(err, &execErr) && execErr.ExitCode == 1 {
+		return false, nil
+	}
+	return false, fmt.Errorf("error checking existence of commit %q: %w", id, err)
+}
+
 func (w *workTree) CreateChildBranch(branch string) error {
 	if _, err := libExec.Exec(w.buildGitCommand(
 		"checkout",
@@ -369,6 +401,14 @@ func (w *workTree) IsRebasing() (bool, error) {
 	return false, nil
 }
 
+func (w *workTree) IsShallow() (bool, error) {
+	res, err := libExec.Exec(w.buildGitCommand("rev-parse", "--is-shallow-repository"))
+	if err != nil {
+		return false, fmt.Errorf("error determining whether repository is shallow: %w", err)
+	}
+	return strings.TrimSpace(string(res)) == "true", nil
+}
+
 func (w *workTree) LastCommitID() (string, error) {
 	shaBytes, err := libExec.Exec(w.buildGitCommand("rev-parse", "HEAD"))
 	if err != nil {
@@ -392,6 +432,16 @@ type CommitMetadata struct {
 }
 
 func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
+	return w.listCommits("", limit, skip)
+}
+
+func (w *workTree) ListBranchCommits(branch string, limit, skip uint) ([]CommitMetadata, error) {
+	return w.listCommits(remoteBranchRefPrefix+branch, limit, skip)
+}
+
+// listCommits lists commits reachable from the specified revision, or from
+// HEAD if no revision is specified.
+func (w *workTree) listCommits(rev string, limit, skip uint) ([]CommitMetadata, error) {
 	args := []string{
 		"log",
 		// This format is designed to output the following fields, separated by
@@ -410,6 +460,9 @@ func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
 	if skip > 0 {
 		args = append(args, fmt.Sprintf("--skip=%d", skip))
 	}
+	if rev != "" {
+		args = append(args, rev, "--")
+	}
 
 	commitsBytes, err := libExec.Exec(w.buildGitCommand(args...))
 	if err != nil {
@@ -442,6 +495,78 @@ func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
 	return commits, nil
 }
 
+// remoteBranchRefPrefix is the prefix of the refs of remote-tracking branches
+// for the "origin" remote.
+const remoteBranchRefPrefix = "refs/remotes/origin/"
+
+// BranchMetadata represents metadata associated with a Git branch.
+type BranchMetadata struct {
+	// Branch is the name of the branch.
+	Branch string
+	// CommitMetadata is the metadata of the latest commit on the branch.
+	CommitMetadata
+}
+
+func (w *workTree) ListBranches() ([]BranchMetadata, error) {
+	// This format is designed to output the following fields, separated by
+	// `|*|`:
+	//
+	// - full ref name
+	// - commit ID
+	// - commit date
+	// - author name and email
+	// - committer name and email
+	// - subject
+	//
+	// nolint: lll
+	const branchFormat = `%(refname)|*|%(objectname)|*|%(committerdate:iso8601)|*|%(authorname) %(authoremail)|*|%(committername) %(committeremail)|*|%(contents:subject)`
+
+	branchesBytes, err := libExec.Exec(w.buildGitCommand(
+		"for-each-ref",
+		"--sort=-committerdate",
+		"--format="+branchFormat,
+		strings.TrimSuffix(remoteBranchRefPrefix, "/"),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error listing branches for repo %q: %w", w.url, err)
+	}
+
+	var branches []BranchMetadata
+	scanner := bufio.NewScanner(bytes.NewReader(branchesBytes))
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		parts := bytes.SplitN(line, []byte("|*|"), 6)
+		if len(parts) != 6 {
+			return nil, fmt.Errorf("unexpected number of fields: %q", line)
+		}
+
+		branch := strings.TrimPrefix(string(parts[0]), remoteBranchRefPrefix)
+		// The symbolic ref pointing to the remote's default branch is not a
+		// branch in its own right.
+		if branch == "HEAD" {
+			continue
+		}
+
+		commitDate, err := time.Parse("2006-01-02 15:04:05 -0700", string(parts[2]))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing commit date %q: %w", parts[2], err)
+		}
+
+		branches = append(branches, BranchMetadata{
+			Branch: branch,
+			CommitMetadata: CommitMetadata{
+				ID:         string(parts[1]),
+				CommitDate: commitDate,
+				Author:     string(parts[3]),
+				Committer:  string(parts[4]),
+				Subject:    string(parts[5]),
+			},
+		})
+	}
+
+	return branches, nil
+}
+
 // TagMetadata represents metadata associated with a Git tag.
 type TagMetadata struct {
 	// Tag is the name of the tag.
@@ -611,3 +736,77 @@ func (w *workTree) ResetHard() error {
 	}
 	return nil
 }
+
+// RevertOptions represents options for reverting a commit in a git repository.
+type RevertOptions struct {
+	// Author is the author of the revert commit. If nil, the default author
+	// already configured in the git repository will be used.
+	Author *User
+}
+
+func (w *workTree) Revert(commitID string, opts *RevertOptions) error {
+	if opts == nil {
+		opts = &RevertOptions{}
+	}
+
+	var homeDir string
+	if opts.Author != nil {
+		// This author information is specific to this commit, so we will override
+		// repository-level author information by creating a temporary home
+		// directory, configuring the author information "globally" within it, and
+		// then ensuring the git revert command uses that home directory.
+		var err error
+		if homeDir, err = os.MkdirTemp(w.homeDir, ""); err != nil {
+			return fmt.Errorf(
+				"error creating virtual home directory %q for revert command: %w",
+				homeDir, err,
+			)
+		}
+		defer func() {
+			if cleanErr := os.RemoveAll(homeDir); cleanErr != nil {
+				logging.LoggerFromContext(context.TODO()).
+					Error(cleanErr, "error removing virtual home directory", "path", homeDir)
+			}
+		}()
+		if err = w.setupAuthor(homeDir, opts.Author); err != nil {
+			return fmt.Errorf(
+				"error setting up author information for revert command: %w", err,
+			)
+		}
+	}
+
+	// Determine whether the commit is a merge commit. The output of this
+	// command is the commit ID followed by the IDs of all its parents.
+	res, err := libExec.Exec(
+		w.buildGitCommand("rev-list", "--parents", "-n", "1", commitID),
+	)
+	if err != nil {
+		return fmt.Errorf("error obtaining parents of commit %q: %w", commitID, err)
+	}
+
+	cmdTokens := []string{"revert", "--no-edit"}
+	if len(strings.Fields(string(res))) > 2 {
+		// Merge commits can only be reverted relative to one of their parents.
+		// The first parent is the branch the merge was made into.
+		cmdTokens = append(cmdTokens, "-m", "1")
+	}
+	cmdTokens = append(cmdTokens, commitID)
+
+	cmd := w.buildGitCommand(cmdTokens...)
+	if homeDir != "" {
+		// Override the home directory set by b.buildGitCommand().
+		w.setCmdHome(cmd, homeDir)
+	}
+	if _, err = libExec.Exec(cmd); err != nil {
+		// Abort the revert so that the working tree is not left with conflicts
+		// or an in-progress revert.
+		if _, abortErr := libExec.Exec(w.buildGitCommand("revert", "--abort")); abortErr != nil {
+			return fmt.Errorf(
+				"error reverting commit %q: %w",
+				commitID, errors.Join(err, fmt.Errorf("error aborting revert: %w", abortErr)),
+			)
+		}
+		return fmt.Errorf("error reverting commit %q: %w", commitID, err)
+	}
+	return nil
+}
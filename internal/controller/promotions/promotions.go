@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +44,13 @@ type ReconcilerConfig struct {
 	ShardName               string `envconfig:"SHARD_NAME"`
 	APIServerBaseURL        string `envconfig:"API_SERVER_BASE_URL"`
 	MaxConcurrentReconciles int    `envconfig:"MAX_CONCURRENT_PROMOTION_RECONCILES" default:"4"`
+	// RetainedWorkDirTTL is how long the working directory of a Promotion that
+	// failed or errored is retained for a retry of the Promotion to resume from.
+	RetainedWorkDirTTL time.Duration `envconfig:"RETAINED_PROMOTION_WORK_DIR_TTL" default:"24h"`
+	// MaxRetainedWorkDirs is the maximum number of working directories of
+	// Promotions that failed or errored that are retained. When exceeded, the
+	// oldest are removed first.
+	MaxRetainedWorkDirs int `envconfig:"MAX_RETAINED_PROMOTION_WORK_DIRS" default:"20"`
 }
 
 func (c ReconcilerConfig) Name() string {
@@ -98,7 +107,19 @@ func SetupReconcilerWithManager(
 	argocdMgr manager.Manager,
 	promoEngine promotion.Engine,
 	cfg ReconcilerConfig,
+	sharedIndexer client.FieldIndexer,
 ) error {
+	// This index is used to find the Promotions of a Stage whose retained
+	// working directories are no longer needed.
+	if err := sharedIndexer.IndexField(
+		ctx,
+		&kargoapi.Promotion{},
+		indexer.PromotionsByStageField,
+		indexer.PromotionsByStage,
+	); err != nil {
+		return fmt.Errorf("error setting up index for Promotions by Stage: %w", err)
+	}
+
 	// Index running Promotions by Argo CD Applications
 	if err := kargoMgr.GetFieldIndexer().IndexField(
 		ctx,
@@ -143,6 +164,29 @@ func SetupReconcilerWithManager(
 		return fmt.Errorf("unable to watch Stages: %w", err)
 	}
 
+	// Remove the retained working directories of deleted Promotions. Deletions
+	// are otherwise ignored by this controller.
+	if err = c.Watch(
+		source.Kind(
+			kargoMgr.GetCache(),
+			&kargoapi.Promotion{},
+			&PromotionDeletedHandler[*kargoapi.Promotion]{},
+		),
+	); err != nil {
+		return fmt.Errorf("unable to watch Promotions: %w", err)
+	}
+
+	// Working directories that were retained for longer than the configured TTL
+	// or in excess of the configured maximum are removed periodically.
+	if err = kargoMgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		wait.UntilWithContext(ctx, func(context.Context) {
+			pruneExpiredWorkDirs(ctx, cfg.RetainedWorkDirTTL, cfg.MaxRetainedWorkDirs)
+		}, retainedWorkDirsPruneInterval)
+		return nil
+	})); err != nil {
+		return fmt.Errorf("unable to add pruner of retained working directories: %w", err)
+	}
+
 	// If Argo CD integration is disabled, this manager will be nil, and we won't
 	// care about this watch anyway.
 	if argocdMgr != nil {
@@ -165,6 +209,8 @@ func SetupReconcilerWithManager(
 	logging.LoggerFromContext(ctx).Info(
 		"Initialized Promotion reconciler",
 		"maxConcurrentReconciles", cfg.MaxConcurrentReconciles,
+		"retainedWorkDirTTL", cfg.RetainedWorkDirTTL,
+		"maxRetainedWorkDirs", cfg.MaxRetainedWorkDirs,
 	)
 
 	return nil
@@ -245,8 +291,19 @@ func (r *reconciler) Reconcile(
 	// If the Promotion does not have a Phase, it must be new and (initially)
 	// pending. Mark it as such.
 	if promo.Status.Phase == "" {
+		// If the Promotion retries a previous Promotion, it picks up where the
+		// previous Promotion left off.
+		retryStatus, err := r.getRetryStatus(ctx, promo)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 			status.Phase = kargoapi.PromotionPhasePending
+			if retryStatus != nil {
+				status.CurrentStep = retryStatus.CurrentStep
+				status.StepExecutionMetadata = retryStatus.StepExecutionMetadata
+				status.State = retryStatus.State
+			}
 		}); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -417,7 +474,7 @@ func (r *reconciler) Reconcile(
 	// If the promotion is still running, we'll need to periodically check on
 	// it.
 	if newStatus.Phase == kargoapi.PromotionPhaseRunning {
-		return ctrl.Result{RequeueAfter: calculateRequeueInterval(promo)}, nil
+		return ctrl.Result{RequeueAfter: calculateRequeueInterval(ctx, promo)}, nil
 	}
 	return ctrl.Result{}, nil
 }
@@ -448,11 +505,13 @@ func (r *reconciler) promote(
 	logger = logger.WithValues("targetFreight", targetFreight.Name)
 
 	targetFreightRef := kargoapi.FreightReference{
-		Name:    targetFreight.Name,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
-		Origin:  targetFreight.Origin,
+		Name:         targetFreight.Name,
+		Commits:      targetFreight.Commits,
+		Images:       targetFreight.Images,
+		Charts:       targetFreight.Charts,
+		OCIArtifacts: targetFreight.OCIArtifacts,
+		Releases:     targetFreight.Releases,
+		Origin:       targetFreight.Origin,
 	}
 
 	// Make a deep copy of the Promotion to pass to the promotion steps execution
@@ -481,7 +540,7 @@ func (r *reconciler) promote(
 
 	promoCtx := promotion.Context{
 		UIBaseURL:             r.cfg.APIServerBaseURL,
-		WorkDir:               filepath.Join(os.TempDir(), "promotion-"+string(workingPromo.UID)),
+		WorkDir:               getWorkDir(workingPromo.UID),
 		Project:               stageNamespace,
 		Stage:                 stageName,
 		Promotion:             workingPromo.Name,
@@ -493,6 +552,11 @@ func (r *reconciler) promote(
 		Vars:                  workingPromo.Spec.Vars,
 		Actor:                 parseCreateActorAnnotation(&promo),
 	}
+	if promoCtx.StartFromStep > 0 {
+		// A retried Promotion resumes from the step at which the Promotion it
+		// retries stopped. This requires the working directory of that Promotion.
+		r.adoptRetrySourceWorkDir(ctx, &promo, promoCtx.WorkDir)
+	}
 	if err := os.Mkdir(promoCtx.WorkDir, 0o700); err == nil {
 		// If we're working with a fresh directory, we should start the promotion
 		// process again from the beginning, but we DON'T clear shared state. This
@@ -501,10 +565,22 @@ func (r *reconciler) promote(
 		promoCtx.StartFromStep = 0
 		promoCtx.StepExecutionMetadata = nil
 		workingPromo.Status.HealthChecks = nil
+		// Working directories retained for retrying earlier Promotions of the
+		// same Stage are no longer needed.
+		r.pruneRetainedWorkDirs(ctx, &promo)
 	} else if !os.IsExist(err) {
 		return nil, fmt.Errorf("error creating working directory: %w", err)
 	}
 	defer func() {
+		// The working directory of a Promotion that failed or errored is retained
+		// so that a retry of the Promotion can resume where it left off.
+		switch workingPromo.Status.Phase {
+		case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+			if r.cfg.MaxRetainedWorkDirs > 0 {
+				retainWorkDir(ctx, workingPromo.UID)
+				return
+			}
+		}
 		if workingPromo.Status.Phase.IsTerminal() {
 			if err := os.RemoveAll(promoCtx.WorkDir); err != nil {
 				logger.Error(err, "could not remove working directory")
@@ -592,6 +668,197 @@ func (r *reconciler) buildTargetFreightCollection(
 	return freightCol
 }
 
+// getRetryStatus returns the initial status of a Promotion that retries a
+// previous Promotion, as indicated by the AnnotationKeyRetry annotation. The
+// returned status carries over the shared state of the previous Promotion and
+// points at the step at which the previous Promotion stopped. If the
+// Promotion does not retry a previous Promotion, or if the previous Promotion
+// no longer exists, nil is returned.
+func (r *reconciler) getRetryStatus(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*kargoapi.PromotionStatus, error) {
+	srcName, ok := promo.Annotations[kargoapi.AnnotationKeyRetry]
+	if !ok || srcName == "" {
+		return nil, nil
+	}
+	logger := logging.LoggerFromContext(ctx)
+	srcPromo, err := api.GetPromotion(ctx, r.kargoClient, types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      srcName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if srcPromo == nil {
+		logger.Info(
+			"Promotion to retry not found; starting from the first step",
+			"retriedPromotion", srcName,
+		)
+		return nil, nil
+	}
+	step := srcPromo.Status.StepExecutionMetadata.FirstFailure()
+	if step < 0 {
+		step = int(srcPromo.Status.CurrentStep)
+	}
+	if step <= 0 || step >= len(promo.Spec.Steps) {
+		return &kargoapi.PromotionStatus{
+			State: srcPromo.Status.State.DeepCopy(),
+		}, nil
+	}
+	return &kargoapi.PromotionStatus{
+		CurrentStep:           int64(step),
+		StepExecutionMetadata: srcPromo.Status.StepExecutionMetadata[:step].DeepCopy(),
+		State:                 srcPromo.Status.State.DeepCopy(),
+	}, nil
+}
+
+// adoptRetrySourceWorkDir moves the retained working directory of the
+// Promotion retried by the given Promotion to the given working directory. It
+// does nothing if the given Promotion does not retry a previous Promotion, if
+// the working directory already exists, or if the working directory of the
+// previous Promotion was not retained. In the latter case, the Promotion will
+// start over from the first step.
+func (r *reconciler) adoptRetrySourceWorkDir(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	workDir string,
+) {
+	srcName, ok := promo.Annotations[kargoapi.AnnotationKeyRetry]
+	if !ok || srcName == "" {
+		return
+	}
+	if _, err := os.Stat(workDir); !os.IsNotExist(err) {
+		return
+	}
+	logger := logging.LoggerFromContext(ctx).WithValues("retriedPromotion", srcName)
+	srcPromo, err := api.GetPromotion(ctx, r.kargoClient, types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      srcName,
+	})
+	if err != nil {
+		logger.Error(err, "could not get Promotion to retry")
+		return
+	}
+	if srcPromo == nil {
+		return
+	}
+	if err = os.Rename(getRetainedWorkDir(srcPromo.UID), workDir); err != nil {
+		if !os.IsNotExist(err) {
+			logger.Error(err, "could not adopt working directory of Promotion to retry")
+		}
+		return
+	}
+	logger.Debug("adopted working directory of Promotion to retry")
+}
+
+// pruneRetainedWorkDirs removes the working directories retained for any
+// Promotions of the same Stage as the given Promotion that failed or errored.
+func (r *reconciler) pruneRetainedWorkDirs(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) {
+	logger := logging.LoggerFromContext(ctx)
+	promos := &kargoapi.PromotionList{}
+	if err := r.kargoClient.List(
+		ctx,
+		promos,
+		client.InNamespace(promo.Namespace),
+		client.MatchingFields{indexer.PromotionsByStageField: promo.Spec.Stage},
+	); err != nil {
+		logger.Error(err, "could not list Promotions to prune working directories")
+		return
+	}
+	for _, p := range promos.Items {
+		if p.Name == promo.Name {
+			continue
+		}
+		switch p.Status.Phase {
+		case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+			if err := os.RemoveAll(getRetainedWorkDir(p.UID)); err != nil {
+				logger.Error(
+					err, "could not remove retained working directory",
+					"promotion", p.Name,
+				)
+			}
+		}
+	}
+}
+
+// retainedWorkDirsPruneInterval is the interval at which retained working
+// directories are checked against the configured TTL and maximum.
+const retainedWorkDirsPruneInterval = 10 * time.Minute
+
+// retainWorkDir moves the working directory of the Promotion with the given
+// UID to where working directories are retained for retries. The modification
+// time of the retained working directory records when it was retained.
+func retainWorkDir(ctx context.Context, uid types.UID) {
+	logger := logging.LoggerFromContext(ctx)
+	retainedDir := getRetainedWorkDir(uid)
+	if err := os.Rename(getWorkDir(uid), retainedDir); err != nil {
+		logger.Error(err, "could not retain working directory")
+		return
+	}
+	now := time.Now()
+	if err := os.Chtimes(retainedDir, now, now); err != nil {
+		logger.Error(err, "could not record retention time of working directory")
+	}
+}
+
+// pruneExpiredWorkDirs removes retained working directories that were retained
+// longer ago than the given TTL. If more than max working directories remain,
+// the oldest of them are removed as well.
+func pruneExpiredWorkDirs(ctx context.Context, ttl time.Duration, maxDirs int) {
+	logger := logging.LoggerFromContext(ctx)
+	dirs, err := filepath.Glob(getRetainedWorkDir("*"))
+	if err != nil {
+		logger.Error(err, "could not list retained working directories")
+		return
+	}
+	type retainedDir struct {
+		path       string
+		retainedAt time.Time
+	}
+	retained := make([]retainedDir, 0, len(dirs))
+	for _, dir := range dirs {
+		fi, err := os.Stat(dir)
+		if err != nil {
+			continue
+		}
+		retained = append(retained, retainedDir{path: dir, retainedAt: fi.ModTime()})
+	}
+	// Newest first
+	slices.SortFunc(retained, func(a, b retainedDir) int {
+		return b.retainedAt.Compare(a.retainedAt)
+	})
+	for i, dir := range retained {
+		if i < maxDirs && time.Since(dir.retainedAt) < ttl {
+			continue
+		}
+		if err = os.RemoveAll(dir.path); err != nil {
+			logger.Error(
+				err, "could not remove retained working directory",
+				"path", dir.path,
+			)
+			continue
+		}
+		logger.Debug("removed retained working directory", "path", dir.path)
+	}
+}
+
+// getWorkDir returns the path of the working directory of the Promotion with
+// the given UID.
+func getWorkDir(uid types.UID) string {
+	return filepath.Join(os.TempDir(), "promotion-"+string(uid))
+}
+
+// getRetainedWorkDir returns the path at which the working directory of the
+// Promotion with the given UID is retained after the Promotion failed or
+// errored.
+func getRetainedWorkDir(uid types.UID) string {
+	return filepath.Join(os.TempDir(), "retained-promotion-"+string(uid))
+}
+
 // terminatePromotion terminates the given Promotion with a message indicating
 // that it was terminated on user request. It does nothing if the Promotion is
 // already in a terminal phase.
@@ -678,9 +945,11 @@ func parseCreateActorAnnotation(promo *kargoapi.Promotion) string {
 
 var defaultRequeueInterval = 5 * time.Minute
 
-func calculateRequeueInterval(p *kargoapi.Promotion) time.Duration {
+func calculateRequeueInterval(ctx context.Context, p *kargoapi.Promotion) time.Duration {
 	step := p.Spec.Steps[p.Status.CurrentStep]
-	runner := promotion.GetStepRunner(step.Uses)
+	// If the runner can not be looked up, the timeout of the step falls back to
+	// the system-wide default, which is good enough for calculating an interval.
+	runner, _ := promotion.LookupStepRunner(ctx, step.Uses)
 
 	timeout := (&promotion.Step{
 		Retry: step.Retry,
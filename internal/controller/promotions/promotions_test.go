@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/indexer"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 	"github.com/akuity/kargo/internal/promotion"
 	pkgPromotion "github.com/akuity/kargo/pkg/promotion"
@@ -498,6 +502,265 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 	}
 }
 
+func Test_reconciler_getRetryStatus(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	testState := &apiextensionsv1.JSON{Raw: []byte(`{"template":{"foo":"bar"}}`)}
+	testSteps := []kargoapi.PromotionStep{
+		{As: "clone", Uses: "git-clone"},
+		{As: "template", Uses: "helm-template"},
+		{As: "push", Uses: "git-push"},
+	}
+
+	tests := []struct {
+		name       string
+		promo      *kargoapi.Promotion
+		objects    []client.Object
+		assertions func(*testing.T, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name: "not a retry",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Nil(t, status)
+			},
+		},
+		{
+			name: "retried Promotion not found",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyRetry: "failed-promo",
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Nil(t, status)
+			},
+		},
+		{
+			name: "resumes from failed step",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyRetry: "failed-promo",
+					},
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: testSteps,
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "failed-promo",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Steps: testSteps,
+					},
+					Status: kargoapi.PromotionStatus{
+						Phase:       kargoapi.PromotionPhaseErrored,
+						CurrentStep: 2,
+						StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+							{Alias: "clone", Status: kargoapi.PromotionStepStatusSucceeded},
+							{Alias: "template", Status: kargoapi.PromotionStepStatusSucceeded},
+							{Alias: "push", Status: kargoapi.PromotionStepStatusErrored},
+						},
+						State: testState,
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, status)
+				require.Equal(t, int64(2), status.CurrentStep)
+				require.Len(t, status.StepExecutionMetadata, 2)
+				require.Equal(t, "template", status.StepExecutionMetadata[1].Alias)
+				require.Equal(t, testState, status.State)
+			},
+		},
+		{
+			name: "first step failed",
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyRetry: "failed-promo",
+					},
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: testSteps,
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "failed-promo",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Steps: testSteps,
+					},
+					Status: kargoapi.PromotionStatus{
+						Phase:       kargoapi.PromotionPhaseFailed,
+						CurrentStep: 2,
+						StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+							{Alias: "clone", Status: kargoapi.PromotionStepStatusFailed},
+						},
+						State: testState,
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, status)
+				require.Zero(t, status.CurrentStep)
+				require.Empty(t, status.StepExecutionMetadata)
+				require.Equal(t, testState, status.State)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &reconciler{
+				kargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(tt.objects...).
+					Build(),
+			}
+			status, err := r.getRetryStatus(context.Background(), tt.promo)
+			tt.assertions(t, status, err)
+		})
+	}
+}
+
+func Test_reconciler_adoptRetrySourceWorkDir(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	srcPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "failed-promo",
+			UID:       "failed-promo-uid",
+		},
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+			UID:       "fake-promo-uid",
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyRetry: "failed-promo",
+			},
+		},
+	}
+
+	srcWorkDir := getRetainedWorkDir(srcPromo.UID)
+	require.NoError(t, os.Mkdir(srcWorkDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(srcWorkDir, "rendered.yaml"), []byte("foo"), 0o600))
+
+	r := &reconciler{
+		kargoClient: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(srcPromo).
+			Build(),
+	}
+	workDir := getWorkDir(promo.UID)
+	r.adoptRetrySourceWorkDir(context.Background(), promo, workDir)
+
+	_, err := os.Stat(srcWorkDir)
+	require.True(t, os.IsNotExist(err))
+	content, err := os.ReadFile(filepath.Join(workDir, "rendered.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(content))
+}
+
+func Test_reconciler_pruneRetainedWorkDirs(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	newPromo := func(name, stage string, phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      name,
+				UID:       types.UID(name + "-uid"),
+			},
+			Spec:   kargoapi.PromotionSpec{Stage: stage},
+			Status: kargoapi.PromotionStatus{Phase: phase},
+		}
+	}
+	promo := newPromo("fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning)
+	failedPromo := newPromo("failed-promo", "fake-stage", kargoapi.PromotionPhaseFailed)
+	otherStagePromo := newPromo("other-promo", "other-stage", kargoapi.PromotionPhaseErrored)
+	for _, p := range []*kargoapi.Promotion{failedPromo, otherStagePromo} {
+		require.NoError(t, os.Mkdir(getRetainedWorkDir(p.UID), 0o700))
+	}
+
+	r := &reconciler{
+		kargoClient: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(promo, failedPromo, otherStagePromo).
+			WithIndex(
+				&kargoapi.Promotion{},
+				indexer.PromotionsByStageField,
+				indexer.PromotionsByStage,
+			).
+			Build(),
+	}
+	r.pruneRetainedWorkDirs(context.Background(), promo)
+
+	require.NoDirExists(t, getRetainedWorkDir(failedPromo.UID))
+	require.DirExists(t, getRetainedWorkDir(otherStagePromo.UID))
+}
+
+func Test_pruneExpiredWorkDirs(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	retain := func(uid types.UID, age time.Duration) string {
+		dir := getRetainedWorkDir(uid)
+		require.NoError(t, os.Mkdir(dir, 0o700))
+		retainedAt := time.Now().Add(-age)
+		require.NoError(t, os.Chtimes(dir, retainedAt, retainedAt))
+		return dir
+	}
+	newest := retain("newest", time.Minute)
+	newer := retain("newer", 2*time.Minute)
+	older := retain("older", 3*time.Minute)
+	expired := retain("expired", 2*time.Hour)
+	// Working directories of running Promotions are never pruned
+	running := getWorkDir("running")
+	require.NoError(t, os.Mkdir(running, 0o700))
+	require.NoError(t, os.Chtimes(running, time.Now(), time.Now().Add(-2*time.Hour)))
+
+	pruneExpiredWorkDirs(context.Background(), time.Hour, 2)
+
+	require.DirExists(t, newest)
+	require.DirExists(t, newer)
+	require.NoDirExists(t, older)
+	require.NoDirExists(t, expired)
+	require.DirExists(t, running)
+}
+
 func Test_parseCreateActorAnnotation(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -673,7 +936,7 @@ func Test_calculateRequeueInterval(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(t, calculateRequeueInterval(testCase.promo))
+			testCase.assertions(t, calculateRequeueInterval(context.Background(), testCase.promo))
 		})
 	}
 }
@@ -3,6 +3,7 @@ package promotions
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
@@ -175,3 +176,54 @@ func (u *PromotionAcknowledgedByStageHandler[T]) Update(
 		)
 	}
 }
+
+// PromotionDeletedHandler is an event handler that removes the retained
+// working directory, if any, of a Promotion when it is deleted. It never
+// enqueues anything for reconciliation.
+type PromotionDeletedHandler[T any] struct{}
+
+// Create implements TypedEventHandler.
+func (p *PromotionDeletedHandler[T]) Create(
+	context.Context,
+	event.TypedCreateEvent[T],
+	workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	// No-op
+}
+
+// Delete implements TypedEventHandler.
+func (p *PromotionDeletedHandler[T]) Delete(
+	ctx context.Context,
+	e event.TypedDeleteEvent[T],
+	_ workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	promo, ok := any(e.Object).(*kargoapi.Promotion)
+	if !ok || promo == nil {
+		return
+	}
+	if err := os.RemoveAll(getRetainedWorkDir(promo.UID)); err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "could not remove retained working directory of deleted Promotion",
+			"namespace", promo.Namespace,
+			"promotion", promo.Name,
+		)
+	}
+}
+
+// Generic implements TypedEventHandler.
+func (p *PromotionDeletedHandler[T]) Generic(
+	context.Context,
+	event.TypedGenericEvent[T],
+	workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	// No-op
+}
+
+// Update implements TypedEventHandler.
+func (p *PromotionDeletedHandler[T]) Update(
+	context.Context,
+	event.TypedUpdateEvent[T],
+	workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	// No-op
+}
@@ -3,6 +3,7 @@ package promotions
 import (
 	"context"
 	"errors"
+	"os"
 	"strings"
 	"testing"
 
@@ -243,3 +244,28 @@ func TestUpdatedArgoCDAppHandler_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotionDeletedHandler_Delete(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+			UID:       "fake-promo-uid",
+		},
+	}
+	require.NoError(t, os.Mkdir(getRetainedWorkDir(promo.UID), 0o700))
+
+	wq := workqueue.NewTypedRateLimitingQueue(
+		workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+	)
+	(&PromotionDeletedHandler[*kargoapi.Promotion]{}).Delete(
+		context.Background(),
+		event.TypedDeleteEvent[*kargoapi.Promotion]{Object: promo},
+		wq,
+	)
+
+	require.NoDirExists(t, getRetainedWorkDir(promo.UID))
+	require.Zero(t, wq.Len())
+}
@@ -448,6 +448,16 @@ func (r *RegularStageReconciler) reconcile(
 				return status, err
 			},
 		},
+		{
+			name: "rolling back Freight",
+			reconcile: func() (kargoapi.StageStatus, error) {
+				status, err := r.rollbackFreight(ctx, stage)
+				if err != nil {
+					err = fmt.Errorf("failed to roll back Freight: %w", err)
+				}
+				return status, err
+			},
+		},
 		{
 			name: "auto-promoting Freight",
 			reconcile: func() (kargoapi.StageStatus, error) {
@@ -1728,6 +1738,282 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	return newStatus, nil
 }
 
+// rollbackFreight automatically promotes the most recently verified Freight in
+// the Stage's Freight history if the Stage has automatic rollback enabled and
+// verification of its current Freight has failed. To prevent rollback loops,
+// no rollback is performed if the current Freight was itself the result of an
+// automatic rollback.
+func (r *RegularStageReconciler) rollbackFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (kargoapi.StageStatus, error) {
+	logger := logging.LoggerFromContext(ctx)
+	newStatus := *stage.Status.DeepCopy()
+
+	if stage.Spec.AutoRollback == nil || !stage.Spec.AutoRollback.Enabled {
+		return newStatus, nil
+	}
+
+	promotions := &kargoapi.PromotionList{}
+	if err := r.client.List(
+		ctx,
+		promotions,
+		client.InNamespace(stage.Namespace),
+		client.MatchingFieldsSelector{
+			Selector: fields.OneTermEqualSelector(
+				indexer.PromotionsByStageField,
+				stage.Name,
+			),
+		},
+	); err != nil {
+		return newStatus, fmt.Errorf(
+			"error listing Promotions for Stage %q in namespace %q: %w",
+			stage.Name, stage.Namespace, err,
+		)
+	}
+
+	// A rollback that was only partially initiated must be completed even if
+	// the Stage has since moved on from the Freight that failed verification,
+	// so this happens before any of the checks below.
+	if err := r.resumeRollback(ctx, stage, promotions.Items); err != nil {
+		return newStatus, err
+	}
+
+	// If we are currently promoting Freight, then we are not in a stable state
+	// and should wait until the promotion is complete.
+	if stage.Status.CurrentPromotion != nil {
+		return newStatus, nil
+	}
+
+	curFreight := stage.Status.FreightHistory.Current()
+	if curFreight == nil {
+		return newStatus, nil
+	}
+	failedVerification := curFreight.VerificationHistory.Current()
+	if failedVerification == nil ||
+		failedVerification.Phase != kargoapi.VerificationPhaseFailed {
+		return newStatus, nil
+	}
+
+	logger = logger.WithValues(
+		"freightCollection", curFreight.ID,
+		"verification", failedVerification.ID,
+	)
+
+	for _, promo := range promotions.Items {
+		rollbackOf, isRollback := promo.Annotations[kargoapi.AnnotationKeyRollback]
+		if !isRollback {
+			continue
+		}
+		if rollbackOf == failedVerification.ID {
+			logger.Debug("rollback has already been initiated for failed verification")
+			return newStatus, nil
+		}
+		if lastPromo := stage.Status.LastPromotion; lastPromo != nil &&
+			lastPromo.Name == promo.Name {
+			logger.Info(
+				"current Freight is the result of an automatic rollback: " +
+					"not rolling back again",
+			)
+			return newStatus, nil
+		}
+	}
+
+	// Find the most recent FreightCollection in the history that was verified
+	// successfully and whose Freight is all still available to the Stage.
+	var (
+		targetFreight []kargoapi.Freight
+		targetCol     *kargoapi.FreightCollection
+	)
+	for _, col := range stage.Status.FreightHistory {
+		if col == nil || col.ID == curFreight.ID || !col.HasSuccessfulVerification() {
+			continue
+		}
+		freight, available, err := r.getRollbackFreight(ctx, stage, curFreight, col)
+		if err != nil {
+			return newStatus, err
+		}
+		if available {
+			targetFreight = freight
+			targetCol = col
+			break
+		}
+	}
+	if targetCol == nil {
+		logger.Info("found no previously verified Freight to roll back to")
+		return newStatus, nil
+	}
+
+	return newStatus, r.createRollbackPromotions(
+		ctx,
+		stage,
+		failedVerification.ID,
+		targetCol.ID,
+		targetFreight,
+	)
+}
+
+// resumeRollback creates any Promotions that are missing from the most
+// recently initiated rollback of the Stage, unless other Promotions have been
+// created since. The Promotions of a rollback are created one Freight at a
+// time and the Stage starts to move on from the Freight that failed
+// verification as soon as the first of them runs, so a rollback whose
+// Promotions could not all be created would otherwise never be completed.
+func (r *RegularStageReconciler) resumeRollback(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promotions []kargoapi.Promotion,
+) error {
+	var latest *kargoapi.Promotion
+	for i := range promotions {
+		promo := &promotions[i]
+		if _, isRollback := promo.Annotations[kargoapi.AnnotationKeyRollback]; !isRollback {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&promo.CreationTimestamp) {
+			latest = promo
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	verificationID := latest.Annotations[kargoapi.AnnotationKeyRollback]
+	targetID, ok := latest.Annotations[kargoapi.AnnotationKeyRollbackTarget]
+	if !ok {
+		return nil
+	}
+	created := make(map[string]struct{}, len(promotions))
+	for _, promo := range promotions {
+		if promo.Annotations[kargoapi.AnnotationKeyRollback] == verificationID {
+			created[promo.Spec.Freight] = struct{}{}
+			continue
+		}
+		if promo.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			// The Stage has been promoted since the rollback was initiated
+			return nil
+		}
+	}
+
+	var failedCol, targetCol *kargoapi.FreightCollection
+	for _, col := range stage.Status.FreightHistory {
+		if col == nil {
+			continue
+		}
+		if col.ID == targetID {
+			targetCol = col
+		}
+		if failedCol == nil && slices.ContainsFunc(
+			col.VerificationHistory,
+			func(v kargoapi.VerificationInfo) bool { return v.ID == verificationID },
+		) {
+			failedCol = col
+		}
+	}
+	if failedCol == nil || targetCol == nil {
+		// The rollback has aged out of the Freight history
+		return nil
+	}
+
+	freight, available, err := r.getRollbackFreight(ctx, stage, failedCol, targetCol)
+	if err != nil || !available {
+		return err
+	}
+	missing := slices.DeleteFunc(freight, func(f kargoapi.Freight) bool {
+		_, ok := created[f.Name]
+		return ok
+	})
+	if len(missing) > 0 {
+		logging.LoggerFromContext(ctx).Info(
+			"resuming partially initiated rollback",
+			"verification", verificationID,
+			"freightCollection", targetID,
+		)
+	}
+	return r.createRollbackPromotions(ctx, stage, verificationID, targetID, missing)
+}
+
+// createRollbackPromotions creates a Promotion of each of the provided Freight
+// to roll the Stage back to the FreightCollection with the provided ID after
+// the verification with the provided ID failed.
+func (r *RegularStageReconciler) createRollbackPromotions(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	verificationID string,
+	targetID string,
+	targetFreight []kargoapi.Freight,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+	for _, freight := range targetFreight {
+		promotion, err := kargo.NewPromotionBuilder(r.client).Build(ctx, *stage, freight.Name)
+		if err != nil {
+			return fmt.Errorf(
+				"error building Promotion for Freight %q in namespace %q: %w",
+				freight.Name, stage.Namespace, err,
+			)
+		}
+		promotion.Annotations[kargoapi.AnnotationKeyRollback] = verificationID
+		promotion.Annotations[kargoapi.AnnotationKeyRollbackTarget] = targetID
+		if err = r.client.Create(ctx, promotion); err != nil {
+			return fmt.Errorf(
+				"error creating Promotion for Freight %q in namespace %q: %w",
+				freight.Name, stage.Namespace, err,
+			)
+		}
+		r.eventRecorder.AnnotatedEventf(
+			promotion,
+			kargoEvent.NewPromotionAnnotations(
+				ctx,
+				api.FormatEventControllerActor(r.cfg.Name()),
+				promotion,
+				&freight,
+			),
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonPromotionRollback,
+			"Verification %q of current Freight in Stage %q failed: automatically "+
+				"rolling back to previously verified Freight %q",
+			verificationID,
+			stage.Name,
+			freight.Name,
+		)
+		logger.Debug(
+			"created rollback Promotion resource",
+			"promotion", promotion.Name,
+			"freight", freight.Name,
+		)
+	}
+	return nil
+}
+
+// getRollbackFreight retrieves the Freight from the provided FreightCollection
+// that differs from the Freight in the Stage's current FreightCollection. It
+// returns false if any of it is no longer available to the Stage.
+func (r *RegularStageReconciler) getRollbackFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	curCol *kargoapi.FreightCollection,
+	col *kargoapi.FreightCollection,
+) ([]kargoapi.Freight, bool, error) {
+	var freight []kargoapi.Freight
+	for _, ref := range col.References() {
+		if curRef, ok := curCol.Freight[ref.Origin.String()]; ok && curRef.Name == ref.Name {
+			continue
+		}
+		f, err := api.GetFreight(
+			ctx,
+			r.client,
+			types.NamespacedName{Namespace: stage.Namespace, Name: ref.Name},
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		if f == nil || !stage.IsFreightAvailable(f) {
+			return nil, false, nil
+		}
+		freight = append(freight, *f)
+	}
+	return freight, len(freight) > 0, nil
+}
+
 // autoPromotionAllowed checks if auto-promotion is allowed for the given Stage.
 func (r *RegularStageReconciler) autoPromotionAllowed(
 	ctx context.Context,
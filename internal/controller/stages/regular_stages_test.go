@@ -5713,6 +5713,421 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 	}
 }
 
+func TestRegularStageReconciler_rollbackFreight(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+
+	newCollection := func(
+		freightName string,
+		phase kargoapi.VerificationPhase,
+	) *kargoapi.FreightCollection {
+		col := &kargoapi.FreightCollection{}
+		col.UpdateOrPush(kargoapi.FreightReference{
+			Name:   freightName,
+			Origin: testOrigin,
+		})
+		col.VerificationHistory = []kargoapi.VerificationInfo{{
+			ID:    "verification-" + freightName,
+			Phase: phase,
+		}}
+		return col
+	}
+
+	newStage := func(
+		enabled bool,
+		lastPromotion *kargoapi.PromotionReference,
+		history ...*kargoapi.FreightCollection,
+	) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "test-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin:  testOrigin,
+					Sources: kargoapi.FreightSources{Direct: true},
+				}},
+				PromotionTemplate: &kargoapi.PromotionTemplate{
+					Spec: kargoapi.PromotionTemplateSpec{
+						Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+					},
+				},
+				AutoRollback: &kargoapi.AutoRollback{Enabled: enabled},
+			},
+			Status: kargoapi.StageStatus{
+				LastPromotion:  lastPromotion,
+				FreightHistory: history,
+			},
+		}
+	}
+
+	newFreight := func(name string, rejected bool) *kargoapi.Freight {
+		f := &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      name,
+			},
+			Origin: testOrigin,
+		}
+		if rejected {
+			f.Status.RejectedFor = map[string]kargoapi.RejectedFreight{
+				"test-stage": {Reason: "broken"},
+			}
+		}
+		return f
+	}
+
+	// A rollback of a Stage that requests Freight from two origins, of which
+	// only the Promotion for the first origin was created before the first
+	// Promotion ran.
+	otherOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "other-warehouse",
+	}
+	newMultiOriginCollection := func(
+		names [2]string,
+		verification *kargoapi.VerificationInfo,
+	) *kargoapi.FreightCollection {
+		col := &kargoapi.FreightCollection{}
+		col.UpdateOrPush(
+			kargoapi.FreightReference{Name: names[0], Origin: testOrigin},
+			kargoapi.FreightReference{Name: names[1], Origin: otherOrigin},
+		)
+		if verification != nil {
+			col.VerificationHistory = []kargoapi.VerificationInfo{*verification}
+		}
+		return col
+	}
+	targetCol := newMultiOriginCollection(
+		[2]string{"good-freight", "other-good-freight"},
+		&kargoapi.VerificationInfo{ID: "verification-good", Phase: kargoapi.VerificationPhaseSuccessful},
+	)
+	newPartialRollbackStage := func() *kargoapi.Stage {
+		stage := newStage(
+			true,
+			&kargoapi.PromotionReference{Name: "rollback-promotion"},
+			newMultiOriginCollection([2]string{"good-freight", "other-bad-freight"}, nil),
+			newMultiOriginCollection(
+				[2]string{"bad-freight", "other-bad-freight"},
+				&kargoapi.VerificationInfo{ID: "verification-bad", Phase: kargoapi.VerificationPhaseFailed},
+			),
+			targetCol,
+		)
+		stage.Spec.RequestedFreight = append(
+			stage.Spec.RequestedFreight,
+			kargoapi.FreightRequest{
+				Origin:  otherOrigin,
+				Sources: kargoapi.FreightSources{Direct: true},
+			},
+		)
+		return stage
+	}
+	partialRollbackObjects := func(extra ...client.Object) []client.Object {
+		otherGoodFreight := newFreight("other-good-freight", false)
+		otherGoodFreight.Origin = otherOrigin
+		return append(
+			[]client.Object{
+				newFreight("good-freight", false),
+				otherGoodFreight,
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "rollback-promotion",
+						CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyRollback:       "verification-bad",
+							kargoapi.AnnotationKeyRollbackTarget: targetCol.ID,
+						},
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "test-stage",
+						Freight: "good-freight",
+					},
+				},
+			},
+			extra...,
+		)
+	}
+
+	tests := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		objects    []client.Object
+		assertions func(*testing.T, *fakeevent.EventRecorder, client.Client, error)
+	}{
+		{
+			name: "rollback not enabled",
+			stage: newStage(
+				false,
+				nil,
+				newCollection("bad-freight", kargoapi.VerificationPhaseFailed),
+				newCollection("good-freight", kargoapi.VerificationPhaseSuccessful),
+			),
+			objects: []client.Object{newFreight("good-freight", false)},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Empty(t, promoList.Items)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "verification did not fail",
+			stage: newStage(
+				true,
+				nil,
+				newCollection("new-freight", kargoapi.VerificationPhaseSuccessful),
+				newCollection("good-freight", kargoapi.VerificationPhaseSuccessful),
+			),
+			objects: []client.Object{newFreight("good-freight", false)},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Empty(t, promoList.Items)
+			},
+		},
+		{
+			name: "rolls back to most recent available verified Freight",
+			stage: newStage(
+				true,
+				nil,
+				newCollection("bad-freight", kargoapi.VerificationPhaseFailed),
+				newCollection("unverified-freight", kargoapi.VerificationPhaseFailed),
+				newCollection("rejected-freight", kargoapi.VerificationPhaseSuccessful),
+				newCollection("good-freight", kargoapi.VerificationPhaseSuccessful),
+			),
+			objects: []client.Object{
+				newFreight("unverified-freight", false),
+				newFreight("rejected-freight", true),
+				newFreight("good-freight", false),
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Len(t, promoList.Items, 1)
+				promo := promoList.Items[0]
+				require.Equal(t, "good-freight", promo.Spec.Freight)
+				require.Equal(
+					t,
+					"verification-bad-freight",
+					promo.Annotations[kargoapi.AnnotationKeyRollback],
+				)
+				require.NotEmpty(t, promo.Annotations[kargoapi.AnnotationKeyRollbackTarget])
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPromotionRollback, event.Reason)
+			},
+		},
+		{
+			name: "rollback already initiated",
+			stage: newStage(
+				true,
+				nil,
+				newCollection("bad-freight", kargoapi.VerificationPhaseFailed),
+				newCollection("good-freight", kargoapi.VerificationPhaseSuccessful),
+			),
+			objects: []client.Object{
+				newFreight("good-freight", false),
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "rollback-promotion",
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyRollback: "verification-bad-freight",
+						},
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "test-stage",
+						Freight: "good-freight",
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Len(t, promoList.Items, 1)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "current Freight is the result of a rollback",
+			stage: newStage(
+				true,
+				&kargoapi.PromotionReference{Name: "rollback-promotion"},
+				newCollection("good-freight", kargoapi.VerificationPhaseFailed),
+				newCollection("bad-freight", kargoapi.VerificationPhaseFailed),
+				newCollection("older-freight", kargoapi.VerificationPhaseSuccessful),
+			),
+			objects: []client.Object{
+				newFreight("older-freight", false),
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "rollback-promotion",
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyRollback: "verification-bad-freight",
+						},
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "test-stage",
+						Freight: "good-freight",
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Len(t, promoList.Items, 1)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:    "resumes partially initiated rollback",
+			stage:   newPartialRollbackStage(),
+			objects: partialRollbackObjects(),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Len(t, promoList.Items, 2)
+				var resumed *kargoapi.Promotion
+				for i, promo := range promoList.Items {
+					if promo.Name != "rollback-promotion" {
+						resumed = &promoList.Items[i]
+					}
+				}
+				require.NotNil(t, resumed)
+				require.Equal(t, "other-good-freight", resumed.Spec.Freight)
+				require.Equal(
+					t,
+					"verification-bad",
+					resumed.Annotations[kargoapi.AnnotationKeyRollback],
+				)
+				require.Equal(
+					t,
+					targetCol.ID,
+					resumed.Annotations[kargoapi.AnnotationKeyRollbackTarget],
+				)
+				require.Len(t, recorder.Events, 1)
+			},
+		},
+		{
+			name:  "does not resume rollback after Stage was promoted again",
+			stage: newPartialRollbackStage(),
+			objects: partialRollbackObjects(&kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "fake-project",
+					Name:              "newer-promotion",
+					CreationTimestamp: metav1.Now(),
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage:   "test-stage",
+					Freight: "newer-freight",
+				},
+			}),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Len(t, promoList.Items, 2)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "no verified Freight to roll back to",
+			stage: newStage(
+				true,
+				nil,
+				newCollection("bad-freight", kargoapi.VerificationPhaseFailed),
+				newCollection("other-bad-freight", kargoapi.VerificationPhaseFailed),
+			),
+			objects: []client.Object{newFreight("other-bad-freight", false)},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
+				err error,
+			) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList))
+				require.Empty(t, promoList.Items)
+				require.Empty(t, recorder.Events)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				WithIndex(
+					&kargoapi.Promotion{},
+					indexer.PromotionsByStageField,
+					indexer.PromotionsByStage,
+				).
+				Build()
+			recorder := fakeevent.NewEventRecorder(5)
+
+			r := &RegularStageReconciler{
+				client:        c,
+				eventRecorder: recorder,
+			}
+
+			_, err := r.rollbackFreight(context.Background(), tt.stage)
+			tt.assertions(t, recorder, c, err)
+		})
+	}
+}
+
 func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
@@ -0,0 +1,60 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/expr-lang/expr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	exprfn "github.com/akuity/kargo/internal/expressions/function"
+)
+
+// freightCreationCriteriaSatisfied evaluates the Freight creation criteria of
+// the given Warehouse against the given Freight. It returns true if the
+// Warehouse has no such criteria or if the Freight satisfies them.
+//
+// The Freight's origin must be set before calling this method, as it is used
+// to resolve the artifacts referenced by the criteria expression.
+func (r *reconciler) freightCreationCriteriaSatisfied(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+	freight *kargoapi.Freight,
+) (bool, error) {
+	criteria := warehouse.Spec.FreightCreationCriteria
+	if criteria == nil || criteria.Expression == "" {
+		return true, nil
+	}
+
+	opts := append(
+		exprfn.FreightOperations(
+			ctx,
+			r.client,
+			warehouse.Namespace,
+			[]kargoapi.FreightRequest{{Origin: freight.Origin}},
+			[]kargoapi.FreightReference{{
+				Name:         freight.Name,
+				Origin:       freight.Origin,
+				Commits:      freight.Commits,
+				Images:       freight.Images,
+				Charts:       freight.Charts,
+				OCIArtifacts: freight.OCIArtifacts,
+				Releases:     freight.Releases,
+			}},
+		),
+		expr.AsBool(),
+	)
+	program, err := expr.Compile(criteria.Expression, opts...)
+	if err != nil {
+		return false, fmt.Errorf("error compiling expression: %w", err)
+	}
+	result, err := expr.Run(program, nil)
+	if err != nil {
+		return false, fmt.Errorf("error evaluating expression: %w", err)
+	}
+	satisfied, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to a boolean, got %T", result)
+	}
+	return satisfied, nil
+}
@@ -0,0 +1,125 @@
+package warehouses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestFreightCreationCriteriaSatisfied(t *testing.T) {
+	const (
+		testNamespace = "fake-namespace"
+		testGitRepo   = "https://github.com/example/app.git"
+		testImageRepo = "example.com/app"
+	)
+
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-warehouse",
+			Namespace: testNamespace,
+		},
+		Spec: kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{RepoURL: testGitRepo}},
+				{Image: &kargoapi.ImageSubscription{RepoURL: testImageRepo}},
+			},
+		},
+	}
+
+	newFreight := func(gitTag, imageTag string) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-freight",
+				Namespace: testNamespace,
+			},
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: testWarehouse.Name,
+			},
+			Commits: []kargoapi.GitCommit{{RepoURL: testGitRepo, ID: "fake-commit", Tag: gitTag}},
+			Images:  []kargoapi.Image{{RepoURL: testImageRepo, Tag: imageTag}},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		criteria   *kargoapi.FreightCreationCriteria
+		freight    *kargoapi.Freight
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name:    "no criteria",
+			freight: newFreight("v1.0.0", "v2.0.0"),
+			assertions: func(t *testing.T, satisfied bool, err error) {
+				require.NoError(t, err)
+				require.True(t, satisfied)
+			},
+		},
+		{
+			name: "invalid expression",
+			criteria: &kargoapi.FreightCreationCriteria{
+				Expression: "bogus ==",
+			},
+			freight: newFreight("v1.0.0", "v1.0.0"),
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error compiling expression")
+			},
+		},
+		{
+			name: "error evaluating expression",
+			criteria: &kargoapi.FreightCreationCriteria{
+				Expression: `imageFrom("example.com/other").Tag == "v1.0.0"`,
+			},
+			freight: newFreight("v1.0.0", "v1.0.0"),
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error evaluating expression")
+			},
+		},
+		{
+			name: "criteria not satisfied",
+			criteria: &kargoapi.FreightCreationCriteria{
+				Expression: `imageFrom("` + testImageRepo + `").Tag == commitFrom("` + testGitRepo + `").Tag`,
+			},
+			freight: newFreight("v1.0.0", "v2.0.0"),
+			assertions: func(t *testing.T, satisfied bool, err error) {
+				require.NoError(t, err)
+				require.False(t, satisfied)
+			},
+		},
+		{
+			name: "criteria satisfied",
+			criteria: &kargoapi.FreightCreationCriteria{
+				Expression: `imageFrom("` + testImageRepo + `").Tag == commitFrom("` + testGitRepo + `").Tag`,
+			},
+			freight: newFreight("v1.0.0", "v1.0.0"),
+			assertions: func(t *testing.T, satisfied bool, err error) {
+				require.NoError(t, err)
+				require.True(t, satisfied)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warehouse := testWarehouse.DeepCopy()
+			warehouse.Spec.FreightCreationCriteria = testCase.criteria
+			r := &reconciler{
+				client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(warehouse).Build(),
+			}
+			satisfied, err := r.freightCreationCriteriaSatisfied(
+				context.Background(),
+				warehouse,
+				testCase.freight,
+			)
+			testCase.assertions(t, satisfied, err)
+		})
+	}
+}
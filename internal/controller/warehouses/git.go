@@ -3,6 +3,7 @@ package warehouses
 import (
 	"context"
 	"fmt"
+	"path"
 	"regexp"
 	"slices"
 	"strings"
@@ -19,6 +20,10 @@ import (
 	"github.com/akuity/kargo/internal/pattern"
 )
 
+// branchCommitsPageSize is the number of commits listed at a time when
+// searching a branch for a commit matching a subscription's path filters.
+const branchCommitsPageSize = 20
+
 const (
 	regexpPrefix = "regexp:"
 	regexPrefix  = "regex:"
@@ -82,31 +87,39 @@ func (r *reconciler) discoverCommits(
 			repoLogger.Debug("found no credentials for git repo")
 		}
 
-		// Clone the Git repository.
-		cloneOpts := &git.CloneOptions{
-			Branch:       sub.Branch,
-			SingleBranch: true,
-			Filter:       git.FilterBlobless,
-		}
-		repo, err := r.gitCloneFn(
-			sub.RepoURL,
-			&git.ClientOptions{
-				Credentials:           repoCreds,
-				InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
-			},
-			cloneOpts,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to clone git repo %q: %w", sub.RepoURL, err)
-		}
-		// TODO: repos is a slice of repos that will be iterated and closed
-		// (deleted) when this function returns. Implementations of r.gitCloneFn
-		// used for testing sometimes return a nil repo since we don't have a mock
-		// implementation for the git.Repo interface at present. With as many
-		// methods as it has, it's a bit more expedient to just check that repo
-		// isn't nil before adding it to the slice of repos to be closed.
-		if repo != nil {
-			repos = append(repos, repo)
+		// Pull requests are discovered using the Git hosting provider's API, so
+		// there is no need to clone the repository for them.
+		var repo git.Repo
+		if sub.CommitSelectionStrategy != kargoapi.CommitSelectionStrategyPullRequest {
+			// Clone the Git repository.
+			cloneOpts := &git.CloneOptions{
+				Branch:       sub.Branch,
+				SingleBranch: true,
+				Filter:       git.FilterBlobless,
+			}
+			if sub.BranchPattern != "" {
+				// All branches are needed to find the ones matching the pattern.
+				cloneOpts.SingleBranch = false
+			}
+			if repo, err = r.gitCloneFn(
+				sub.RepoURL,
+				&git.ClientOptions{
+					Credentials:           repoCreds,
+					InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+				},
+				cloneOpts,
+			); err != nil {
+				return nil, fmt.Errorf("failed to clone git repo %q: %w", sub.RepoURL, err)
+			}
+			// TODO: repos is a slice of repos that will be iterated and closed
+			// (deleted) when this function returns. Implementations of r.gitCloneFn
+			// used for testing sometimes return a nil repo since we don't have a
+			// mock implementation for the git.Repo interface at present. With as
+			// many methods as it has, it's a bit more expedient to just check that
+			// repo isn't nil before adding it to the slice of repos to be closed.
+			if repo != nil {
+				repos = append(repos, repo)
+			}
 		}
 
 		// Enrich the logger with additional fields for this subscription.
@@ -143,7 +156,64 @@ func (r *reconciler) discoverCommits(
 					"creatorDate", meta.CreatorDate.Format(time.RFC3339),
 				)
 			}
+		case kargoapi.CommitSelectionStrategyPullRequest:
+			prs, err := r.discoverPullRequestsFn(ctx, sub, creds)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error listing pull requests for git repo %q: %w",
+					sub.RepoURL,
+					err,
+				)
+			}
+
+			for _, pr := range prs {
+				commit := kargoapi.DiscoveredCommit{
+					ID:     pr.HeadSHA,
+					Branch: pr.HeadBranch,
+					// The provider APIs do not expose the head commit's message, so
+					// the pull request's title is used in its place.
+					Subject:           shortenString(pr.Title, 80),
+					Author:            pr.Author,
+					Committer:         pr.Committer,
+					PullRequestNumber: pr.Number,
+					PullRequestURL:    pr.URL,
+				}
+				if lastUpdated := pullRequestLastUpdated(pr.PullRequest); lastUpdated != nil {
+					commit.CreatorDate = &metav1.Time{Time: *lastUpdated}
+				}
+				discovered = append(discovered, commit)
+				repoLogger.Trace(
+					"discovered commit from pull request",
+					"pullRequest", pr.Number,
+					"commit", pr.HeadSHA,
+				)
+			}
 		default:
+			if sub.BranchPattern != "" {
+				branches, err := r.discoverBranchesFn(repo, sub)
+				if err != nil {
+					return nil, fmt.Errorf("error listing branches from git repo %q: %w", sub.RepoURL, err)
+				}
+
+				for _, meta := range branches {
+					discovered = append(discovered, kargoapi.DiscoveredCommit{
+						ID:          meta.ID,
+						Branch:      meta.Branch,
+						Subject:     shortenString(meta.Subject, 80),
+						Author:      meta.Author,
+						Committer:   meta.Committer,
+						CreatorDate: &metav1.Time{Time: meta.CommitDate},
+					})
+					repoLogger.Trace(
+						"discovered commit from branch",
+						"branch", meta.Branch,
+						"commit", meta.ID,
+						"creatorDate", meta.CommitDate.Format(time.RFC3339),
+					)
+				}
+				break
+			}
+
 			commits, err := r.discoverBranchHistoryFn(repo, sub)
 			if err != nil {
 				return nil, fmt.Errorf("error listing commits from git repo %q: %w", sub.RepoURL, err)
@@ -254,6 +324,167 @@ func (r *reconciler) discoverBranchHistory(repo git.Repo, sub kargoapi.GitSubscr
 	return trimSlice(filteredCommits, limit), nil
 }
 
+// discoverBranches returns a list of branches from the given Git repository
+// that match the given subscription's branch pattern, along with the latest
+// commit on each that matches the subscription's path filters. Branches without
+// such a commit are omitted. The list is ordered according to the
+// subscription's branch selection strategy and clipped to the subscription's
+// discovery limit.
+func (r *reconciler) discoverBranches(repo git.Repo, sub kargoapi.GitSubscription) ([]git.BranchMetadata, error) {
+	matcher, err := pattern.ParseGlobPattern(sub.BranchPattern)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing branch pattern %q: %w", sub.BranchPattern, err)
+	}
+
+	branches, err := r.listBranchesFn(repo)
+	if err != nil {
+		return nil, fmt.Errorf("error listing branches from git repo %q: %w", sub.RepoURL, err)
+	}
+	branches = slices.DeleteFunc(branches, func(branch git.BranchMetadata) bool {
+		return !matcher.Matches(branch.Branch)
+	})
+
+	if sub.IncludePaths != nil || sub.ExcludePaths != nil {
+		// Compile include and exclude path selectors.
+		includeSelectors, err := getPathSelectors(sub.IncludePaths)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing include selector: %w", err)
+		}
+		excludeSelectors, err := getPathSelectors(sub.ExcludePaths)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing exclude selector: %w", err)
+		}
+
+		filteredBranches := make([]git.BranchMetadata, 0, len(branches))
+		for _, branch := range branches {
+			meta, err := r.newestBranchCommitMatchingPaths(
+				repo,
+				sub,
+				branch.Branch,
+				includeSelectors,
+				excludeSelectors,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if meta != nil {
+				filteredBranches = append(filteredBranches, git.BranchMetadata{
+					Branch:         branch.Branch,
+					CommitMetadata: *meta,
+				})
+			}
+		}
+		branches = filteredBranches
+	}
+
+	switch sub.BranchSelectionStrategy {
+	case kargoapi.BranchSelectionStrategySemVer:
+		if branches, err = selectSemVerBranches(branches, sub.SemverConstraint); err != nil {
+			return nil, fmt.Errorf("failed to select semver branches: %w", err)
+		}
+	case kargoapi.BranchSelectionStrategyLexical:
+		slices.SortFunc(branches, func(i, j git.BranchMetadata) int {
+			// Sort in reverse lexicographic order
+			return strings.Compare(j.Branch, i.Branch)
+		})
+	default:
+		// Path filtering may have substituted older commits for the latest
+		// commits on some branches, so the branches need to be re-sorted.
+		slices.SortStableFunc(branches, func(i, j git.BranchMetadata) int {
+			return j.CommitDate.Compare(i.CommitDate)
+		})
+	}
+
+	return trimSlice(branches, int(sub.DiscoveryLimit)), nil
+}
+
+// newestBranchCommitMatchingPaths returns the newest commit on the given branch
+// that matches the given path selectors, or nil if there is no such commit.
+func (r *reconciler) newestBranchCommitMatchingPaths(
+	repo git.Repo,
+	sub kargoapi.GitSubscription,
+	branch string,
+	include, exclude pattern.Matcher,
+) (*git.CommitMetadata, error) {
+	for skip := uint(0); ; skip += branchCommitsPageSize {
+		commits, err := r.listBranchCommitsFn(repo, branch, branchCommitsPageSize, skip)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error listing commits from branch %q of git repo %q: %w",
+				branch,
+				sub.RepoURL,
+				err,
+			)
+		}
+		for _, meta := range commits {
+			diffPaths, err := r.getDiffPathsForCommitIDFn(repo, meta.ID)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error getting diff paths for commit %q in git repo %q: %w",
+					meta.ID,
+					sub.RepoURL,
+					err,
+				)
+			}
+			if matchesPathsFilters(include, exclude, diffPaths) {
+				return &meta, nil
+			}
+		}
+		if len(commits) < branchCommitsPageSize {
+			return nil, nil
+		}
+	}
+}
+
+// selectSemVerBranches returns the branches whose names end in a semantic
+// version satisfying the given constraint, sorted by that version in
+// descending order. The version is taken from the last segment of the branch
+// name, e.g. "1.2" for "release/1.2".
+func selectSemVerBranches(branches []git.BranchMetadata, constraint string) ([]git.BranchMetadata, error) {
+	var svConstraint *semver.Constraints
+	if constraint != "" {
+		var err error
+		if svConstraint, err = semver.NewConstraint(constraint); err != nil {
+			return nil, fmt.Errorf("error parsing semver constraint %q: %w", constraint, err)
+		}
+	}
+
+	type semVerBranch struct {
+		git.BranchMetadata
+		*semver.Version
+	}
+
+	var svs []semVerBranch
+	for _, meta := range branches {
+		sv := libSemver.Parse(path.Base(meta.Branch), false)
+		if sv == nil {
+			continue
+		}
+		if svConstraint == nil || svConstraint.Check(sv) {
+			svs = append(svs, semVerBranch{
+				BranchMetadata: meta,
+				Version:        sv,
+			})
+		}
+	}
+
+	slices.SortFunc(svs, func(i, j semVerBranch) int {
+		if comp := j.Compare(i.Version); comp != 0 {
+			return comp
+		}
+		// If the semvers tie, break the tie lexically using the branch names.
+		// This ensures a deterministic comparison of equivalent semvers, e.g.,
+		// release/1.0 and release/v1.0.
+		return strings.Compare(j.Branch, i.Branch)
+	})
+
+	semverBranches := make([]git.BranchMetadata, 0, len(svs))
+	for _, sv := range svs {
+		semverBranches = append(semverBranches, sv.BranchMetadata)
+	}
+	return semverBranches, nil
+}
+
 // discoverTags returns a list of tags from the given Git repository that match
 // the given subscription's tag selection criteria. It returns the list of tags
 // that match the criteria, sorted in descending order. If the list contains
@@ -453,6 +684,18 @@ func (r *reconciler) listTags(repo git.Repo) ([]git.TagMetadata, error) {
 	return repo.ListTags()
 }
 
+func (r *reconciler) listBranches(repo git.Repo) ([]git.BranchMetadata, error) {
+	return repo.ListBranches()
+}
+
+func (r *reconciler) listBranchCommits(
+	repo git.Repo,
+	branch string,
+	limit, skip uint,
+) ([]git.CommitMetadata, error) {
+	return repo.ListBranchCommits(branch, limit, skip)
+}
+
 func (r *reconciler) getDiffPathsForCommitID(repo git.Repo, commitID string) ([]string, error) {
 	return repo.GetDiffPathsForCommitID(commitID)
 }
@@ -467,6 +710,13 @@ func gitDiscoveryLogFields(sub kargoapi.GitSubscription) []any {
 	if sub.Branch != "" {
 		f = append(f, "branch", sub.Branch)
 	}
+	if sub.BranchPattern != "" {
+		f = append(
+			f,
+			"branchPattern", sub.BranchPattern,
+			"branchSelectionStrategy", sub.BranchSelectionStrategy,
+		)
+	}
 	switch sub.CommitSelectionStrategy {
 	case kargoapi.CommitSelectionStrategySemVer:
 		f = append(
@@ -476,6 +726,14 @@ func gitDiscoveryLogFields(sub kargoapi.GitSubscription) []any {
 		)
 	case kargoapi.CommitSelectionStrategyLexical, kargoapi.CommitSelectionStrategyNewestTag:
 		f = append(f, "tagConstrained", sub.AllowTags != "" || len(sub.IgnoreTags) > 0)
+	case kargoapi.CommitSelectionStrategyPullRequest:
+		if sub.PullRequests != nil {
+			f = append(
+				f,
+				"baseBranch", sub.PullRequests.BaseBranch,
+				"labelConstrained", len(sub.PullRequests.Labels) > 0,
+			)
+		}
 	}
 	return f
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +14,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
 )
 
 func TestDiscoverCommits(t *testing.T) {
@@ -167,6 +170,158 @@ func TestDiscoverCommits(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "discovers branches",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(_ string, _ *git.ClientOptions, opts *git.CloneOptions) (git.Repo, error) {
+					if opts.SingleBranch {
+						return nil, errors.New("expected all branches to be cloned")
+					}
+					return nil, nil
+				},
+				discoverBranchesFn: func(git.Repo, kargoapi.GitSubscription) ([]git.BranchMetadata, error) {
+					return []git.BranchMetadata{
+						{Branch: "release/2.0", CommitMetadata: git.CommitMetadata{ID: "abc"}},
+						{Branch: "release/1.0", CommitMetadata: git.CommitMetadata{ID: "xyz"}},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:       "fake-repo",
+					BranchPattern: "release/*",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.GitDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						Commits: []kargoapi.DiscoveredCommit{
+							{ID: "abc", Branch: "release/2.0", CreatorDate: &metav1.Time{}},
+							{ID: "xyz", Branch: "release/1.0", CreatorDate: &metav1.Time{}},
+						},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering branches",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error) {
+					return nil, nil
+				},
+				discoverBranchesFn: func(git.Repo, kargoapi.GitSubscription) ([]git.BranchMetadata, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					BranchPattern: "release/*",
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error listing branches from git repo")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "discovers pull requests",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error) {
+					return nil, errors.New("repository should not be cloned")
+				},
+				discoverPullRequestsFn: func(
+					context.Context,
+					kargoapi.GitSubscription,
+					*credentials.Credentials,
+				) ([]pullRequestHead, error) {
+					return []pullRequestHead{
+						{
+							PullRequest: gitprovider.PullRequest{
+								Number:     2,
+								URL:        "https://fake-repo/pull/2",
+								Title:      "Add a feature",
+								HeadBranch: "feature",
+								HeadSHA:    "abc",
+								CreatedAt:  &time.Time{},
+							},
+							Author:    "Alice <alice@example.com>",
+							Committer: "Bob <bob@example.com>",
+						},
+						{
+							PullRequest: gitprovider.PullRequest{
+								Number:     1,
+								URL:        "https://fake-repo/pull/1",
+								Title:      "Fix a bug",
+								HeadBranch: "fix",
+								HeadSHA:    "xyz",
+							},
+							Author: "Carol <carol@example.com>",
+						},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:                 "fake-repo",
+					CommitSelectionStrategy: kargoapi.CommitSelectionStrategyPullRequest,
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.GitDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						Commits: []kargoapi.DiscoveredCommit{
+							{
+								ID:                "abc",
+								Branch:            "feature",
+								Subject:           "Add a feature",
+								Author:            "Alice <alice@example.com>",
+								Committer:         "Bob <bob@example.com>",
+								CreatorDate:       &metav1.Time{},
+								PullRequestNumber: 2,
+								PullRequestURL:    "https://fake-repo/pull/2",
+							},
+							{
+								ID:                "xyz",
+								Branch:            "fix",
+								Subject:           "Fix a bug",
+								Author:            "Carol <carol@example.com>",
+								PullRequestNumber: 1,
+								PullRequestURL:    "https://fake-repo/pull/1",
+							},
+						},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering pull requests",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverPullRequestsFn: func(
+					context.Context,
+					kargoapi.GitSubscription,
+					*credentials.Credentials,
+				) ([]pullRequestHead, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					CommitSelectionStrategy: kargoapi.CommitSelectionStrategyPullRequest,
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error listing pull requests for git repo")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "discovers for multiple subscriptions",
 			reconciler: &reconciler{
@@ -325,6 +480,195 @@ func TestDiscoverBranchHistory(t *testing.T) {
 	}
 }
 
+func TestDiscoverBranches(t *testing.T) {
+	now := time.Now()
+	testBranches := []git.BranchMetadata{
+		{
+			Branch:         "release/1.10",
+			CommitMetadata: git.CommitMetadata{ID: "abc", CommitDate: now},
+		},
+		{
+			Branch:         "main",
+			CommitMetadata: git.CommitMetadata{ID: "def", CommitDate: now.Add(-time.Hour)},
+		},
+		{
+			Branch:         "release/1.9",
+			CommitMetadata: git.CommitMetadata{ID: "ghi", CommitDate: now.Add(-2 * time.Hour)},
+		},
+		{
+			Branch:         "release/2.0",
+			CommitMetadata: git.CommitMetadata{ID: "jkl", CommitDate: now.Add(-3 * time.Hour)},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.GitSubscription
+		reconciler *reconciler
+		assertions func(*testing.T, []git.BranchMetadata, error)
+	}{
+		{
+			name: "error parsing branch pattern",
+			sub: kargoapi.GitSubscription{
+				BranchPattern: regexpPrefix + "[",
+			},
+			reconciler: &reconciler{},
+			assertions: func(t *testing.T, _ []git.BranchMetadata, err error) {
+				require.ErrorContains(t, err, "error parsing branch pattern")
+			},
+		},
+		{
+			name: "error listing branches",
+			sub: kargoapi.GitSubscription{
+				BranchPattern: "release/*",
+			},
+			reconciler: &reconciler{
+				listBranchesFn: func(git.Repo) ([]git.BranchMetadata, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []git.BranchMetadata, err error) {
+				require.ErrorContains(t, err, "error listing branches")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "newest commit",
+			sub: kargoapi.GitSubscription{
+				BranchPattern:  "release/*",
+				DiscoveryLimit: 2,
+			},
+			reconciler: &reconciler{
+				listBranchesFn: func(git.Repo) ([]git.BranchMetadata, error) {
+					return slices.Clone(testBranches), nil
+				},
+			},
+			assertions: func(t *testing.T, branches []git.BranchMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []git.BranchMetadata{testBranches[0], testBranches[2]}, branches)
+			},
+		},
+		{
+			name: "semver",
+			sub: kargoapi.GitSubscription{
+				BranchPattern:           regexpPrefix + "^release/",
+				BranchSelectionStrategy: kargoapi.BranchSelectionStrategySemVer,
+				SemverConstraint:        "^1.0.0",
+			},
+			reconciler: &reconciler{
+				listBranchesFn: func(git.Repo) ([]git.BranchMetadata, error) {
+					return slices.Clone(testBranches), nil
+				},
+			},
+			assertions: func(t *testing.T, branches []git.BranchMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []git.BranchMetadata{testBranches[0], testBranches[2]}, branches)
+			},
+		},
+		{
+			name: "lexical",
+			sub: kargoapi.GitSubscription{
+				BranchPattern:           "**",
+				BranchSelectionStrategy: kargoapi.BranchSelectionStrategyLexical,
+			},
+			reconciler: &reconciler{
+				listBranchesFn: func(git.Repo) ([]git.BranchMetadata, error) {
+					return slices.Clone(testBranches), nil
+				},
+			},
+			assertions: func(t *testing.T, branches []git.BranchMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]git.BranchMetadata{testBranches[3], testBranches[2], testBranches[0], testBranches[1]},
+					branches,
+				)
+			},
+		},
+		{
+			name: "error listing branch commits",
+			sub: kargoapi.GitSubscription{
+				BranchPattern: "release/*",
+				IncludePaths:  []string{"charts"},
+			},
+			reconciler: &reconciler{
+				listBranchesFn: func(git.Repo) ([]git.BranchMetadata, error) {
+					return slices.Clone(testBranches), nil
+				},
+				listBranchCommitsFn: func(git.Repo, string, uint, uint) ([]git.CommitMetadata, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []git.BranchMetadata, err error) {
+				require.ErrorContains(t, err, "error listing commits from branch")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "with path filters",
+			sub: kargoapi.GitSubscription{
+				BranchPattern: "release/*",
+				IncludePaths:  []string{"charts"},
+			},
+			reconciler: &reconciler{
+				listBranchesFn: func(git.Repo) ([]git.BranchMetadata, error) {
+					return slices.Clone(testBranches), nil
+				},
+				listBranchCommitsFn: func(
+					_ git.Repo,
+					branch string,
+					_ uint,
+					skip uint,
+				) ([]git.CommitMetadata, error) {
+					if skip > 0 {
+						return nil, nil
+					}
+					switch branch {
+					case "release/1.10":
+						return []git.CommitMetadata{
+							{ID: "abc", CommitDate: now},
+							{ID: "old", CommitDate: now.Add(-4 * time.Hour)},
+						}, nil
+					case "release/1.9":
+						return []git.CommitMetadata{
+							{ID: "ghi", CommitDate: now.Add(-2 * time.Hour)},
+						}, nil
+					default:
+						return []git.CommitMetadata{
+							{ID: "jkl", CommitDate: now.Add(-3 * time.Hour)},
+						}, nil
+					}
+				},
+				getDiffPathsForCommitIDFn: func(_ git.Repo, id string) ([]string, error) {
+					switch id {
+					case "old", "jkl":
+						return []string{"charts/foo/values.yaml"}, nil
+					default:
+						return []string{"README.md"}, nil
+					}
+				},
+			},
+			assertions: func(t *testing.T, branches []git.BranchMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []git.BranchMetadata{
+					testBranches[3],
+					{
+						Branch:         "release/1.10",
+						CommitMetadata: git.CommitMetadata{ID: "old", CommitDate: now.Add(-4 * time.Hour)},
+					},
+				}, branches)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			branches, err := testCase.reconciler.discoverBranches(nil, testCase.sub)
+			testCase.assertions(t, branches, err)
+		})
+	}
+}
+
 func TestDiscoverTags(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -790,6 +1134,72 @@ func TestSelectSemVerTags(t *testing.T) {
 	}
 }
 
+func TestSelectSemVerBranches(t *testing.T) {
+	testCases := []struct {
+		name       string
+		constraint string
+		branches   []git.BranchMetadata
+		assertions func(*testing.T, []git.BranchMetadata, error)
+	}{
+		{
+			name:       "error parsing constraint",
+			constraint: "invalid",
+			assertions: func(t *testing.T, _ []git.BranchMetadata, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name: "no semantic versions in branch names",
+			branches: []git.BranchMetadata{
+				{Branch: "main"},
+				{Branch: "release/next"},
+			},
+			assertions: func(t *testing.T, branches []git.BranchMetadata, err error) {
+				require.NoError(t, err)
+				require.Empty(t, branches)
+			},
+		},
+		{
+			name:       "success with constraint",
+			constraint: "<2.0.0",
+			branches: []git.BranchMetadata{
+				{Branch: "release/1.9"},
+				{Branch: "release/2.0"},
+				{Branch: "release/v1.10"},
+				{Branch: "1.0"},
+			},
+			assertions: func(t *testing.T, branches []git.BranchMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []git.BranchMetadata{
+					{Branch: "release/v1.10"},
+					{Branch: "release/1.9"},
+					{Branch: "1.0"},
+				}, branches)
+			},
+		},
+		{
+			name: "success with equivalent versions",
+			branches: []git.BranchMetadata{
+				{Branch: "release/1.0"},
+				{Branch: "release/v1.0"},
+			},
+			assertions: func(t *testing.T, branches []git.BranchMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []git.BranchMetadata{
+					{Branch: "release/v1.0"},
+					{Branch: "release/1.0"},
+				}, branches)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			branches, err := selectSemVerBranches(testCase.branches, testCase.constraint)
+			testCase.assertions(t, branches, err)
+		})
+	}
+}
+
 func TestMatchesPathsFilters(t *testing.T) {
 	testCases := []struct {
 		name         string
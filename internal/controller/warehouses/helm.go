@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Masterminds/semver/v3"
+
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/helm"
@@ -52,9 +54,16 @@ func (r *reconciler) discoverCharts(
 		if sub.SemverConstraint != "" {
 			logger = logger.WithValues("semverConstraint", sub.SemverConstraint)
 		}
+		if sub.AppVersionConstraint != "" {
+			logger = logger.WithValues("appVersionConstraint", sub.AppVersionConstraint)
+		}
+
+		// The same repository is used to discover versions and retrieve their
+		// metadata, so the index of a classic repository is only retrieved once.
+		repo := r.newChartRepositoryFn(sub.RepoURL, sub.Name, helmCreds)
 
 		// Discover versions of the chart based on the semver constraint.
-		versions, err := r.discoverChartVersionsFn(ctx, sub.RepoURL, sub.Name, sub.SemverConstraint, helmCreds)
+		versions, err := repo.DiscoverVersions(ctx, sub.SemverConstraint)
 		if err != nil {
 			if sub.Name == "" {
 				return nil, fmt.Errorf(
@@ -71,21 +80,40 @@ func (r *reconciler) discoverCharts(
 			)
 		}
 
+		// Retrieve the metadata of the discovered versions, which may further
+		// narrow down the versions if an app version constraint is specified.
+		versions, metadata, err := discoverChartMetadata(ctx, repo, sub, versions)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering metadata of chart versions in repository %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+
 		if len(versions) == 0 {
 			results = append(results, kargoapi.ChartDiscoveryResult{
-				RepoURL:          sub.RepoURL,
-				Name:             sub.Name,
-				SemverConstraint: sub.SemverConstraint,
+				RepoURL:              sub.RepoURL,
+				Name:                 sub.Name,
+				SemverConstraint:     sub.SemverConstraint,
+				AppVersionConstraint: sub.AppVersionConstraint,
 			})
 			logger.Debug("discovered no chart versions")
 			continue
 		}
 
+		discoveredMetadata := make([]kargoapi.ChartVersionMetadata, 0, len(metadata))
+		for _, md := range metadata {
+			discoveredMetadata = append(discoveredMetadata, toChartVersionMetadata(md))
+		}
+
 		results = append(results, kargoapi.ChartDiscoveryResult{
-			RepoURL:          sub.RepoURL,
-			Name:             sub.Name,
-			SemverConstraint: sub.SemverConstraint,
-			Versions:         trimSlice(versions, int(sub.DiscoveryLimit)),
+			RepoURL:              sub.RepoURL,
+			Name:                 sub.Name,
+			SemverConstraint:     sub.SemverConstraint,
+			AppVersionConstraint: sub.AppVersionConstraint,
+			Versions:             versions,
+			Metadata:             discoveredMetadata,
 		})
 		logger.Debug(
 			"discovered chart versions",
@@ -96,6 +124,87 @@ func (r *reconciler) discoverCharts(
 	return results, nil
 }
 
+// discoverChartMetadata narrows down the given chart versions, which must be
+// sorted in descending order, to those with an app version satisfying the
+// subscription's app version constraint, if any, and clips them to the
+// subscription's discovery limit. It returns the remaining versions along with
+// the metadata retrieved for them. Evaluating an app version constraint
+// requires the metadata of every version considered. Without one, only the
+// metadata of the latest version, which is the one used when new Freight is
+// created automatically, is retrieved, since retrieving the metadata of a
+// version stored in an OCI registry takes requests of its own.
+func discoverChartMetadata(
+	ctx context.Context,
+	repo helm.ChartRepository,
+	sub *kargoapi.ChartSubscription,
+	versions []string,
+) ([]string, []helm.ChartMetadata, error) {
+	limit := int(sub.DiscoveryLimit)
+	if sub.AppVersionConstraint == "" {
+		versions = trimSlice(versions, limit)
+		metadata, err := repo.GetMetadata(ctx, trimSlice(versions, 1))
+		if err != nil {
+			return nil, nil, err
+		}
+		return versions, metadata, nil
+	}
+
+	constraint, err := semver.NewConstraint(sub.AppVersionConstraint)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error parsing app version constraint %q: %w",
+			sub.AppVersionConstraint,
+			err,
+		)
+	}
+
+	// Retrieve metadata in batches, so no more is retrieved than is needed to
+	// reach the discovery limit.
+	batchSize := limit
+	if batchSize <= 0 {
+		batchSize = len(versions)
+	}
+	var selectedVersions []string
+	var selected []helm.ChartMetadata
+	for start := 0; start < len(versions); start += batchSize {
+		batch := versions[start:min(start+batchSize, len(versions))]
+		metadata, err := repo.GetMetadata(ctx, batch)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, md := range metadata {
+			appVersion, err := semver.NewVersion(md.AppVersion)
+			if err != nil || !constraint.Check(appVersion) {
+				continue
+			}
+			selectedVersions = append(selectedVersions, md.Version)
+			selected = append(selected, md)
+			if limit > 0 && len(selected) >= limit {
+				return selectedVersions, selected, nil
+			}
+		}
+	}
+	return selectedVersions, selected, nil
+}
+
+// toChartVersionMetadata converts the given chart metadata to its API
+// representation.
+func toChartVersionMetadata(md helm.ChartMetadata) kargoapi.ChartVersionMetadata {
+	apiMD := kargoapi.ChartVersionMetadata{
+		Version:     md.Version,
+		AppVersion:  md.AppVersion,
+		Annotations: md.Annotations,
+	}
+	for _, dep := range md.Dependencies {
+		apiMD.Dependencies = append(apiMD.Dependencies, kargoapi.ChartDependency{
+			Name:       dep.Name,
+			Version:    dep.Version,
+			Repository: dep.Repository,
+		})
+	}
+	return apiMD
+}
+
 // trimSlice returns a slice of any type with a maximum length of limit.
 // If the input slice is shorter than limit or limit is less than or equal to
 // zero, the input slice is returned unmodified.
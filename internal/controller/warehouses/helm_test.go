@@ -56,14 +56,23 @@ func TestDiscoverCharts(t *testing.T) {
 			name: "discovers chart versions",
 			reconciler: &reconciler{
 				credentialsDB: &credentials.FakeDB{},
-				discoverChartVersionsFn: func(
-					context.Context,
-					string,
-					string,
-					string,
-					*helm.Credentials,
-				) ([]string, error) {
-					return []string{"1.1.0", "1.0.0"}, nil
+				newChartRepositoryFn: func(string, string, *helm.Credentials) helm.ChartRepository {
+					return &helm.FakeChartRepository{
+						DiscoverVersionsFn: func(context.Context, string) ([]string, error) {
+							return []string{"1.1.0", "1.0.0"}, nil
+						},
+						GetMetadataFn: func(_ context.Context, versions []string) ([]helm.ChartMetadata, error) {
+							metadata := make([]helm.ChartMetadata, 0, len(versions))
+							for _, version := range versions {
+								metadata = append(metadata, helm.ChartMetadata{
+									Version:      version,
+									AppVersion:   "v" + version,
+									Dependencies: []helm.ChartDependency{{Name: "redis"}},
+								})
+							}
+							return metadata, nil
+						},
+					}
 				},
 			},
 			subs: []kargoapi.RepoSubscription{
@@ -79,22 +88,57 @@ func TestDiscoverCharts(t *testing.T) {
 						RepoURL:  "https://example.com",
 						Name:     "fake-chart",
 						Versions: []string{"1.1.0", "1.0.0"},
+						Metadata: []kargoapi.ChartVersionMetadata{
+							{
+								Version:      "1.1.0",
+								AppVersion:   "v1.1.0",
+								Dependencies: []kargoapi.ChartDependency{{Name: "redis"}},
+							},
+						},
 					},
 				}, results)
 			},
 		},
+		{
+			name: "error discovering chart metadata",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				newChartRepositoryFn: func(string, string, *helm.Credentials) helm.ChartRepository {
+					return &helm.FakeChartRepository{
+						DiscoverVersionsFn: func(context.Context, string) ([]string, error) {
+							return []string{"1.1.0", "1.0.0"}, nil
+						},
+						GetMetadataFn: func(context.Context, []string) ([]helm.ChartMetadata, error) {
+							return nil, fmt.Errorf("something went wrong")
+						},
+					}
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL: "https://example.com",
+					Name:    "fake-chart",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error discovering metadata of chart versions")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
 		{
 			name: "no chart versions discovered",
 			reconciler: &reconciler{
 				credentialsDB: &credentials.FakeDB{},
-				discoverChartVersionsFn: func(
-					context.Context,
-					string,
-					string,
-					string,
-					*helm.Credentials,
-				) ([]string, error) {
-					return nil, nil
+				newChartRepositoryFn: func(string, string, *helm.Credentials) helm.ChartRepository {
+					return &helm.FakeChartRepository{
+						DiscoverVersionsFn: func(context.Context, string) ([]string, error) {
+							return nil, nil
+						},
+						GetMetadataFn: func(context.Context, []string) ([]helm.ChartMetadata, error) {
+							return nil, nil
+						},
+					}
 				},
 			},
 			subs: []kargoapi.RepoSubscription{
@@ -117,14 +161,12 @@ func TestDiscoverCharts(t *testing.T) {
 			name: "error discovering chart versions",
 			reconciler: &reconciler{
 				credentialsDB: &credentials.FakeDB{},
-				discoverChartVersionsFn: func(
-					context.Context,
-					string,
-					string,
-					string,
-					*helm.Credentials,
-				) ([]string, error) {
-					return nil, fmt.Errorf("something went wrong")
+				newChartRepositoryFn: func(string, string, *helm.Credentials) helm.ChartRepository {
+					return &helm.FakeChartRepository{
+						DiscoverVersionsFn: func(context.Context, string) ([]string, error) {
+							return nil, fmt.Errorf("something went wrong")
+						},
+					}
 				},
 			},
 			subs: []kargoapi.RepoSubscription{
@@ -140,14 +182,12 @@ func TestDiscoverCharts(t *testing.T) {
 			name: "error discovering chart versions with chart name",
 			reconciler: &reconciler{
 				credentialsDB: &credentials.FakeDB{},
-				discoverChartVersionsFn: func(
-					context.Context,
-					string,
-					string,
-					string,
-					*helm.Credentials,
-				) ([]string, error) {
-					return nil, fmt.Errorf("something went wrong")
+				newChartRepositoryFn: func(string, string, *helm.Credentials) helm.ChartRepository {
+					return &helm.FakeChartRepository{
+						DiscoverVersionsFn: func(context.Context, string) ([]string, error) {
+							return nil, fmt.Errorf("something went wrong")
+						},
+					}
 				},
 			},
 			subs: []kargoapi.RepoSubscription{
@@ -174,3 +214,100 @@ func TestDiscoverCharts(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscoverChartMetadata(t *testing.T) {
+	testVersions := []string{"1.3.0", "1.2.0", "1.1.0", "1.0.0"}
+	testAppVersions := map[string]string{
+		"1.3.0": "2.0.0",
+		"1.2.0": "1.5.0",
+		"1.1.0": "not-a-semver",
+		"1.0.0": "1.4.0",
+	}
+	var requestedVersions []string
+	getChartMetadata := func(
+		_ context.Context,
+		versions []string,
+	) ([]helm.ChartMetadata, error) {
+		requestedVersions = append(requestedVersions, versions...)
+		metadata := make([]helm.ChartMetadata, 0, len(versions))
+		for _, version := range versions {
+			metadata = append(metadata, helm.ChartMetadata{
+				Version:    version,
+				AppVersion: testAppVersions[version],
+			})
+		}
+		return metadata, nil
+	}
+
+	testCases := []struct {
+		name       string
+		sub        *kargoapi.ChartSubscription
+		assertions func(*testing.T, []string, []helm.ChartMetadata, error)
+	}{
+		{
+			name: "without app version constraint",
+			sub: &kargoapi.ChartSubscription{
+				DiscoveryLimit: 2,
+			},
+			assertions: func(t *testing.T, versions []string, metadata []helm.ChartMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.3.0", "1.2.0"}, versions)
+				// Only the metadata of the latest version is retrieved
+				require.Equal(t, []string{"1.3.0"}, requestedVersions)
+				require.Equal(t, []helm.ChartMetadata{
+					{Version: "1.3.0", AppVersion: "2.0.0"},
+				}, metadata)
+			},
+		},
+		{
+			name: "invalid app version constraint",
+			sub: &kargoapi.ChartSubscription{
+				AppVersionConstraint: "invalid",
+			},
+			assertions: func(t *testing.T, _ []string, _ []helm.ChartMetadata, err error) {
+				require.ErrorContains(t, err, "error parsing app version constraint")
+			},
+		},
+		{
+			name: "with app version constraint",
+			sub: &kargoapi.ChartSubscription{
+				AppVersionConstraint: "^1.0.0",
+			},
+			assertions: func(t *testing.T, versions []string, metadata []helm.ChartMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.2.0", "1.0.0"}, versions)
+				require.Equal(t, []helm.ChartMetadata{
+					{Version: "1.2.0", AppVersion: "1.5.0"},
+					{Version: "1.0.0", AppVersion: "1.4.0"},
+				}, metadata)
+			},
+		},
+		{
+			name: "with app version constraint and discovery limit",
+			sub: &kargoapi.ChartSubscription{
+				AppVersionConstraint: "^1.0.0",
+				DiscoveryLimit:       1,
+			},
+			assertions: func(t *testing.T, versions []string, metadata []helm.ChartMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.2.0"}, versions)
+				require.Equal(t, []helm.ChartMetadata{
+					{Version: "1.2.0", AppVersion: "1.5.0"},
+				}, metadata)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			requestedVersions = nil
+			versions, metadata, err := discoverChartMetadata(
+				context.TODO(),
+				&helm.FakeChartRepository{GetMetadataFn: getChartMetadata},
+				testCase.sub,
+				testVersions,
+			)
+			testCase.assertions(t, versions, metadata, err)
+		})
+	}
+}
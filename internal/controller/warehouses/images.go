@@ -76,9 +76,11 @@ func (r *reconciler) discoverImages(
 		discoveredImages := make([]kargoapi.DiscoveredImageReference, 0, len(images))
 		for _, img := range images {
 			discovery := kargoapi.DiscoveredImageReference{
-				Tag:         img.Tag,
-				Digest:      img.Digest,
-				Annotations: img.Annotations,
+				Tag:           img.Tag,
+				Digest:        img.Digest,
+				Annotations:   img.Annotations,
+				SourceRepoURL: img.SourceRepoURL,
+				SourceCommit:  img.SourceCommit,
 			}
 			if img.CreatedAt != nil {
 				discovery.CreatedAt = &metav1.Time{Time: *img.CreatedAt}
@@ -174,6 +176,7 @@ func imageSelectorForSubscription(
 			Creds:                 creds,
 			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
 			DiscoveryLimit:        int(sub.DiscoveryLimit),
+			ExpressionFilter:      sub.ExpressionFilter,
 		},
 	)
 }
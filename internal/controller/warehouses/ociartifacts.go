@@ -0,0 +1,145 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// discoverOCIArtifacts discovers the latest suitable artifacts for the given
+// OCI artifact subscriptions. It returns a list of artifact discovery results,
+// one for each subscription.
+func (r *reconciler) discoverOCIArtifacts(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+	results := make([]kargoapi.OCIArtifactDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.OCIArtifact == nil {
+			continue
+		}
+		sub := *s.OCIArtifact
+
+		logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+		// Obtain credentials for the artifact repository. Artifacts are stored in
+		// the same registries as container images, so image credentials apply.
+		creds, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for artifact repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		var regCreds *image.Credentials
+		if creds != nil {
+			regCreds = &image.Credentials{
+				Username: creds.Username,
+				Password: creds.Password,
+			}
+			logger.Debug("obtained credentials for artifact repo")
+		} else {
+			logger.Debug("found no credentials for artifact repo")
+		}
+
+		// Enrich the logger with additional fields for this subscription.
+		logger = logger.WithValues(
+			"selectionStrategy", sub.SelectionStrategy,
+			"artifactTypeConstrained", sub.ArtifactType != "",
+		)
+
+		// Discover the latest suitable artifacts.
+		artifacts, err := r.discoverOCIArtifactRefsFn(ctx, sub, regCreds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering latest artifacts %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		if len(artifacts) == 0 {
+			results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+				RepoURL:      sub.RepoURL,
+				ArtifactType: sub.ArtifactType,
+			})
+			logger.Debug("discovered no artifacts")
+			continue
+		}
+
+		discoveredArtifacts := make([]kargoapi.DiscoveredOCIArtifactReference, 0, len(artifacts))
+		for _, artifact := range artifacts {
+			discovery := kargoapi.DiscoveredOCIArtifactReference{
+				Tag:          artifact.Tag,
+				Digest:       artifact.Digest,
+				ArtifactType: artifact.ArtifactType,
+				Annotations:  artifact.Annotations,
+			}
+			if artifact.CreatedAt != nil {
+				discovery.CreatedAt = &metav1.Time{Time: *artifact.CreatedAt}
+			}
+			discoveredArtifacts = append(discoveredArtifacts, discovery)
+		}
+
+		results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+			RepoURL:      sub.RepoURL,
+			ArtifactType: sub.ArtifactType,
+			References:   discoveredArtifacts,
+		})
+		logger.Debug(
+			"discovered artifacts",
+			"count", len(artifacts),
+		)
+	}
+
+	return results, nil
+}
+
+func (r *reconciler) discoverOCIArtifactRefs(
+	ctx context.Context,
+	sub kargoapi.OCIArtifactSubscription,
+	creds *image.Credentials,
+) ([]image.Image, error) {
+	// OCI artifacts are discovered using the same machinery as container
+	// images. Artifacts other than container images are recognized as such and
+	// their metadata is obtained from their manifests.
+	selector, err := image.NewSelector(
+		sub.RepoURL,
+		image.SelectionStrategy(sub.SelectionStrategy),
+		&image.SelectorOptions{
+			StrictSemvers:         sub.StrictSemvers,
+			Constraint:            sub.SemverConstraint,
+			AllowRegex:            sub.AllowTags,
+			Ignore:                sub.IgnoreTags,
+			ArtifactType:          sub.ArtifactType,
+			Creds:                 creds,
+			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+			DiscoveryLimit:        int(sub.DiscoveryLimit),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error creating selector for artifact %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+
+	artifacts, err := selector.Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error discovering newest applicable artifacts %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+	return artifacts, nil
+}
@@ -0,0 +1,170 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+)
+
+func TestDiscoverOCIArtifacts(t *testing.T) {
+	testCreatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		subs       []kargoapi.RepoSubscription
+		assertions func(*testing.T, []kargoapi.OCIArtifactDiscoveryResult, error)
+	}{
+		{
+			name:       "no OCI artifact subscription",
+			reconciler: &reconciler{},
+			subs: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (*credentials.Credentials, error) {
+						return nil, fmt.Errorf("something went wrong")
+					},
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for artifact repo")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "discovers artifact references",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Image, error) {
+					return []image.Image{
+						{
+							Tag:          "v1.1.0",
+							Digest:       "sha256:abc",
+							ArtifactType: "application/vnd.example.bundle.v1",
+							CreatedAt:    &testCreatedAt,
+						},
+						{
+							Tag:          "v1.0.0",
+							Digest:       "sha256:def",
+							ArtifactType: "application/vnd.example.bundle.v1",
+						},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					RepoURL:      "fake-repo",
+					ArtifactType: "application/vnd.example.bundle.v1",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.OCIArtifactDiscoveryResult{
+					{
+						RepoURL:      "fake-repo",
+						ArtifactType: "application/vnd.example.bundle.v1",
+						References: []kargoapi.DiscoveredOCIArtifactReference{
+							{
+								Tag:          "v1.1.0",
+								Digest:       "sha256:abc",
+								ArtifactType: "application/vnd.example.bundle.v1",
+								CreatedAt:    &metav1.Time{Time: testCreatedAt},
+							},
+							{
+								Tag:          "v1.0.0",
+								Digest:       "sha256:def",
+								ArtifactType: "application/vnd.example.bundle.v1",
+							},
+						},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering artifact references",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Image, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "no suitable artifacts discovered",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Image, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					RepoURL: "fake-repo",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.OCIArtifactDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+					},
+				}, results)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := testCase.reconciler.discoverOCIArtifacts(
+				context.TODO(),
+				"fake-namespace",
+				testCase.subs,
+			)
+			testCase.assertions(t, results, err)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package warehouses
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
+	"github.com/akuity/kargo/internal/logging"
+
+	_ "github.com/akuity/kargo/internal/gitprovider/azure"     // Azure provider registration
+	_ "github.com/akuity/kargo/internal/gitprovider/bitbucket" // Bitbucket provider registration
+	_ "github.com/akuity/kargo/internal/gitprovider/gitea"     // Gitea provider registration
+	_ "github.com/akuity/kargo/internal/gitprovider/github"    // GitHub provider registration
+	_ "github.com/akuity/kargo/internal/gitprovider/gitlab"    // GitLab provider registration
+)
+
+// pullRequestHeadCommitCache caches the head commits of pull requests by
+// repository URL and SHA. Because commits are immutable, entries only expire to
+// bound the size of the cache.
+var pullRequestHeadCommitCache = cache.New(24*time.Hour, time.Hour)
+
+// pullRequestHead is a discovered pull request along with the author and
+// committer of its head commit.
+type pullRequestHead struct {
+	gitprovider.PullRequest
+	// Author is the author of the head commit in "Name <email>" format. It is
+	// empty if the Git hosting provider does not expose it.
+	Author string
+	// Committer is the committer of the head commit in "Name <email>" format.
+	// It is empty if the Git hosting provider does not expose it.
+	Committer string
+}
+
+// discoverPullRequests returns the open pull requests of the repository
+// specified by the given subscription that match the subscription's pull
+// request selection criteria. Pull requests opened from forks are excluded,
+// since their head commits are not reachable from any branch of the repository.
+// The pull requests are sorted by the time they were last updated in descending
+// order and clipped to the subscription's discovery limit. Each is returned
+// along with the author and committer of its head commit.
+func (r *reconciler) discoverPullRequests(
+	ctx context.Context,
+	sub kargoapi.GitSubscription,
+	creds *credentials.Credentials,
+) ([]pullRequestHead, error) {
+	filter := sub.PullRequests
+	if filter == nil {
+		filter = &kargoapi.GitPullRequestFilter{}
+	}
+
+	gpOpts := &gitprovider.Options{
+		Name:                  filter.Provider,
+		InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+	}
+	if creds != nil {
+		gpOpts.Token = creds.Password
+	}
+	gitProvider, err := r.newGitProviderFn(sub.RepoURL, gpOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error creating git provider service: %w", err)
+	}
+
+	prs, err := gitProvider.ListPullRequests(
+		ctx,
+		&gitprovider.ListPullRequestOptions{
+			State:      gitprovider.PullRequestStateOpen,
+			BaseBranch: filter.BaseBranch,
+			Labels:     filter.Labels,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests: %w", err)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	prs = slices.DeleteFunc(prs, func(pr gitprovider.PullRequest) bool {
+		switch {
+		case pr.HeadSHA == "":
+			// Pull requests without a known head commit cannot be turned into
+			// Freight.
+			return true
+		case pr.FromFork:
+			logger.Debug(
+				"ignoring pull request opened from a fork",
+				"pullRequest", pr.Number,
+			)
+			return true
+		}
+		return false
+	})
+
+	slices.SortFunc(prs, func(lhs, rhs gitprovider.PullRequest) int {
+		lhsTime, rhsTime := pullRequestLastUpdated(lhs), pullRequestLastUpdated(rhs)
+		switch {
+		case lhsTime == nil && rhsTime != nil:
+			return 1
+		case lhsTime != nil && rhsTime == nil:
+			return -1
+		case lhsTime != nil && rhsTime != nil:
+			if c := rhsTime.Compare(*lhsTime); c != 0 {
+				return c
+			}
+		}
+		// Break ties using the pull request number, since it increases
+		// monotonically.
+		return cmp.Compare(rhs.Number, lhs.Number)
+	})
+
+	prs = trimSlice(prs, int(sub.DiscoveryLimit))
+
+	heads := make([]pullRequestHead, len(prs))
+	for i, pr := range prs {
+		commit, err := getPullRequestHeadCommit(ctx, gitProvider, sub.RepoURL, pr.HeadSHA)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error getting head commit %q of pull request %d: %w",
+				pr.HeadSHA, pr.Number, err,
+			)
+		}
+		heads[i] = pullRequestHead{
+			PullRequest: pr,
+			Author:      commit.Author,
+			Committer:   commit.Committer,
+		}
+	}
+	return heads, nil
+}
+
+// getPullRequestHeadCommit gets the commit with the given SHA from the given
+// Git hosting provider, serving it from pullRequestHeadCommitCache if possible.
+func getPullRequestHeadCommit(
+	ctx context.Context,
+	gitProvider gitprovider.Interface,
+	repoURL string,
+	sha string,
+) (*gitprovider.Commit, error) {
+	cacheKey := repoURL + "#" + sha
+	if entry, ok := pullRequestHeadCommitCache.Get(cacheKey); ok {
+		return entry.(*gitprovider.Commit), nil // nolint: forcetypeassert
+	}
+	commit, err := gitProvider.GetCommit(ctx, sha)
+	if err != nil {
+		return nil, err
+	}
+	pullRequestHeadCommitCache.Set(cacheKey, commit, cache.DefaultExpiration)
+	return commit, nil
+}
+
+// pullRequestLastUpdated returns the time the given pull request was last
+// updated, falling back to the time it was created for Git hosting providers
+// that do not expose the former.
+func pullRequestLastUpdated(pr gitprovider.PullRequest) *time.Time {
+	if pr.UpdatedAt != nil {
+		return pr.UpdatedAt
+	}
+	return pr.CreatedAt
+}
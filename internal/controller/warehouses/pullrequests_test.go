@@ -0,0 +1,166 @@
+package warehouses
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func TestDiscoverPullRequests(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	newest := newer.Add(time.Hour)
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.GitSubscription
+		creds      *credentials.Credentials
+		provider   func(*testing.T, string, *gitprovider.Options) (gitprovider.Interface, error)
+		assertions func(*testing.T, []pullRequestHead, error)
+	}{
+		{
+			name: "error creating git provider",
+			provider: func(*testing.T, string, *gitprovider.Options) (gitprovider.Interface, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ []pullRequestHead, err error) {
+				require.ErrorContains(t, err, "error creating git provider service")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error listing pull requests",
+			provider: func(*testing.T, string, *gitprovider.Options) (gitprovider.Interface, error) {
+				return &gitprovider.Fake{
+					ListPullRequestsFn: func(
+						context.Context,
+						*gitprovider.ListPullRequestOptions,
+					) ([]gitprovider.PullRequest, error) {
+						return nil, errors.New("something went wrong")
+					},
+				}, nil
+			},
+			assertions: func(t *testing.T, _ []pullRequestHead, err error) {
+				require.ErrorContains(t, err, "error listing pull requests")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error getting head commit",
+			sub: kargoapi.GitSubscription{
+				RepoURL: "https://github.com/example/error-getting-commit",
+			},
+			provider: func(*testing.T, string, *gitprovider.Options) (gitprovider.Interface, error) {
+				return &gitprovider.Fake{
+					ListPullRequestsFn: func(
+						context.Context,
+						*gitprovider.ListPullRequestOptions,
+					) ([]gitprovider.PullRequest, error) {
+						return []gitprovider.PullRequest{{Number: 1, HeadSHA: "a"}}, nil
+					},
+					GetCommitFn: func(context.Context, string) (*gitprovider.Commit, error) {
+						return nil, errors.New("something went wrong")
+					},
+				}, nil
+			},
+			assertions: func(t *testing.T, _ []pullRequestHead, err error) {
+				require.ErrorContains(t, err, `error getting head commit "a" of pull request 1`)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			sub: kargoapi.GitSubscription{
+				RepoURL:               "https://github.com/example/repo",
+				InsecureSkipTLSVerify: true,
+				DiscoveryLimit:        2,
+				PullRequests: &kargoapi.GitPullRequestFilter{
+					Provider:   "github",
+					BaseBranch: "main",
+					Labels:     []string{"preview"},
+				},
+			},
+			creds: &credentials.Credentials{Password: "fake-token"},
+			provider: func(
+				t *testing.T,
+				repoURL string,
+				opts *gitprovider.Options,
+			) (gitprovider.Interface, error) {
+				require.Equal(t, "https://github.com/example/repo", repoURL)
+				require.Equal(t, &gitprovider.Options{
+					Name:                  "github",
+					Token:                 "fake-token",
+					InsecureSkipTLSVerify: true,
+				}, opts)
+				return &gitprovider.Fake{
+					ListPullRequestsFn: func(
+						_ context.Context,
+						opts *gitprovider.ListPullRequestOptions,
+					) ([]gitprovider.PullRequest, error) {
+						require.Equal(t, &gitprovider.ListPullRequestOptions{
+							State:      gitprovider.PullRequestStateOpen,
+							BaseBranch: "main",
+							Labels:     []string{"preview"},
+						}, opts)
+						return []gitprovider.PullRequest{
+							{Number: 1, HeadSHA: "a", CreatedAt: &older, UpdatedAt: &newest},
+							{Number: 2, HeadSHA: "b"},
+							{Number: 3, HeadSHA: "c", CreatedAt: &newer},
+							{Number: 4}, // No head commit; should be ignored
+							// Opened from a fork; should be ignored
+							{Number: 5, HeadSHA: "d", FromFork: true, CreatedAt: &newest},
+						}, nil
+					},
+					GetCommitFn: func(_ context.Context, sha string) (*gitprovider.Commit, error) {
+						require.NotEqual(t, "b", sha, "head commit of a trimmed pull request was fetched")
+						return &gitprovider.Commit{
+							SHA:       sha,
+							Author:    "Alice <alice@example.com>",
+							Committer: "Bob <bob@example.com>",
+						}, nil
+					},
+				}, nil
+			},
+			assertions: func(t *testing.T, prs []pullRequestHead, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []pullRequestHead{
+					{
+						PullRequest: gitprovider.PullRequest{
+							Number: 1, HeadSHA: "a", CreatedAt: &older, UpdatedAt: &newest,
+						},
+						Author:    "Alice <alice@example.com>",
+						Committer: "Bob <bob@example.com>",
+					},
+					{
+						PullRequest: gitprovider.PullRequest{Number: 3, HeadSHA: "c", CreatedAt: &newer},
+						Author:      "Alice <alice@example.com>",
+						Committer:   "Bob <bob@example.com>",
+					},
+				}, prs)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				newGitProviderFn: func(
+					repoURL string,
+					opts *gitprovider.Options,
+				) (gitprovider.Interface, error) {
+					return testCase.provider(t, repoURL, opts)
+				},
+			}
+			pullRequestHeadCommitCache.Flush()
+			prs, err := r.discoverPullRequests(context.Background(), testCase.sub, testCase.creds)
+			testCase.assertions(t, prs, err)
+		})
+	}
+}
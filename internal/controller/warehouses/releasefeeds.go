@@ -0,0 +1,337 @@
+package warehouses
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/expr-lang/expr"
+	"github.com/hashicorp/go-cleanhttp"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// releaseFeedMaxBytes is the maximum size of a release feed response body.
+	releaseFeedMaxBytes = 2 << 20
+	// releaseFeedTimeout is the maximum time to wait for a release feed to
+	// respond.
+	releaseFeedTimeout = 30 * time.Second
+)
+
+// discoverReleases discovers the latest suitable versions for the given
+// release feed subscriptions. It returns a list of release discovery results,
+// one for each subscription.
+func (r *reconciler) discoverReleases(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.ReleaseFeedDiscoveryResult, error) {
+	results := make([]kargoapi.ReleaseFeedDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.ReleaseFeed == nil {
+			continue
+		}
+		sub := *s.ReleaseFeed
+
+		logger := logging.LoggerFromContext(ctx).WithValues("url", sub.URL)
+
+		creds, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeHTTP, sub.URL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for release feed %q: %w",
+				sub.URL,
+				err,
+			)
+		}
+		if creds != nil {
+			logger.Debug("obtained credentials for release feed")
+		} else {
+			logger.Debug("found no credentials for release feed")
+		}
+
+		// Enrich the logger with additional fields for this subscription.
+		logger = logger.WithValues("selectionStrategy", sub.SelectionStrategy)
+		if sub.SemverConstraint != "" {
+			logger = logger.WithValues("semverConstraint", sub.SemverConstraint)
+		}
+
+		releases, err := r.discoverReleaseVersionsFn(ctx, sub, creds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering latest versions from release feed %q: %w",
+				sub.URL,
+				err,
+			)
+		}
+
+		if len(releases) == 0 {
+			results = append(results, kargoapi.ReleaseFeedDiscoveryResult{
+				URL: sub.URL,
+			})
+			logger.Debug("discovered no versions")
+			continue
+		}
+
+		results = append(results, kargoapi.ReleaseFeedDiscoveryResult{
+			URL:      sub.URL,
+			Releases: releases,
+		})
+		logger.Debug(
+			"discovered versions",
+			"count", len(releases),
+		)
+	}
+
+	return results, nil
+}
+
+// discoverReleaseVersions polls the release feed described by the given
+// subscription and returns the releases it lists, ordered from newest to
+// oldest, after filtering and applying the discovery limit.
+func (r *reconciler) discoverReleaseVersions(
+	ctx context.Context,
+	sub kargoapi.ReleaseFeedSubscription,
+	creds *credentials.Credentials,
+) ([]kargoapi.DiscoveredRelease, error) {
+	env, err := fetchReleaseFeed(ctx, sub, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := extractReleaseVersions(sub.VersionsFromExpression, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if versions, err = selectReleaseVersions(sub, versions); err != nil {
+		return nil, err
+	}
+
+	releases := make([]kargoapi.DiscoveredRelease, 0, len(versions))
+	for _, version := range versions {
+		metadata, err := extractReleaseMetadata(sub.Metadata, env, version)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting metadata for version %q: %w", version, err)
+		}
+		releases = append(releases, kargoapi.DiscoveredRelease{
+			Version:  version,
+			Metadata: metadata,
+		})
+	}
+	return releases, nil
+}
+
+// fetchReleaseFeed requests the release feed described by the given
+// subscription and returns an expression environment built from the response.
+// The environment has the same shape as the one used by the http promotion
+// step, except that a JSON body may be of any type and a body of any other
+// content type is available as a string.
+func fetchReleaseFeed(
+	ctx context.Context,
+	sub kargoapi.ReleaseFeedSubscription,
+	creds *credentials.Credentials,
+) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sub.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building request: %w", err)
+	}
+	for _, header := range sub.Headers {
+		req.Header.Add(header.Name, header.Value)
+	}
+	if creds != nil {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	httpTransport := cleanhttp.DefaultTransport()
+	if sub.InsecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	client := &http.Client{
+		Transport: httpTransport,
+		Timeout:   releaseFeedTimeout,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("received unexpected HTTP %d response", resp.StatusCode)
+	}
+
+	bodyBytes, err := io.ReadAll(io.LimitReader(resp.Body, releaseFeedMaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	if len(bodyBytes) > releaseFeedMaxBytes {
+		return nil, fmt.Errorf("response body exceeds maximum size of %d bytes", releaseFeedMaxBytes)
+	}
+
+	var body any = string(bodyBytes)
+	contentType := strings.TrimSpace(
+		strings.Split(resp.Header.Get("Content-Type"), ";")[0],
+	)
+	if len(bodyBytes) > 0 && contentType == "application/json" {
+		if err = json.Unmarshal(bodyBytes, &body); err != nil {
+			return nil, fmt.Errorf("error parsing response body: %w", err)
+		}
+	}
+
+	return map[string]any{
+		"response": map[string]any{
+			"status":  int64(resp.StatusCode),
+			"header":  resp.Header.Get,
+			"headers": resp.Header,
+			"body":    body,
+		},
+	}, nil
+}
+
+// extractReleaseVersions evaluates the given expression against the given
+// environment and returns the resulting list of versions.
+func extractReleaseVersions(expression string, env map[string]any) ([]string, error) {
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling versions expression: %w", err)
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating versions expression: %w", err)
+	}
+	items, ok := result.([]any)
+	if !ok {
+		if versions, ok := result.([]string); ok {
+			return versions, nil
+		}
+		return nil, fmt.Errorf("versions expression must evaluate to a list, got %T", result)
+	}
+	versions := make([]string, 0, len(items))
+	for _, item := range items {
+		version, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("versions expression must evaluate to a list of strings, got element of type %T", item)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// selectReleaseVersions filters the given versions according to the given
+// subscription, orders them from newest to oldest using the subscription's
+// selection strategy, and applies the subscription's discovery limit.
+func selectReleaseVersions(
+	sub kargoapi.ReleaseFeedSubscription,
+	versions []string,
+) ([]string, error) {
+	var allowRegex *regexp.Regexp
+	if sub.AllowVersions != "" {
+		var err error
+		if allowRegex, err = regexp.Compile(sub.AllowVersions); err != nil {
+			return nil, fmt.Errorf("error compiling allowVersions regular expression: %w", err)
+		}
+	}
+
+	filtered := make([]string, 0, len(versions))
+	for _, version := range versions {
+		if version == "" ||
+			slices.Contains(filtered, version) ||
+			slices.Contains(sub.IgnoreVersions, version) ||
+			(allowRegex != nil && !allowRegex.MatchString(version)) {
+			continue
+		}
+		filtered = append(filtered, version)
+	}
+
+	switch sub.SelectionStrategy {
+	case kargoapi.ReleaseFeedSelectionStrategyLexical:
+		slices.SortFunc(filtered, func(a, b string) int {
+			return strings.Compare(b, a)
+		})
+	case kargoapi.ReleaseFeedSelectionStrategySemVer, "":
+		var constraint *semver.Constraints
+		if sub.SemverConstraint != "" {
+			var err error
+			if constraint, err = semver.NewConstraint(sub.SemverConstraint); err != nil {
+				return nil, fmt.Errorf("error parsing semver constraint %q: %w", sub.SemverConstraint, err)
+			}
+		}
+		semvers := make([]*semver.Version, 0, len(filtered))
+		for _, version := range filtered {
+			sv, err := semver.NewVersion(version)
+			if err != nil {
+				continue
+			}
+			if constraint != nil && !constraint.Check(sv) {
+				continue
+			}
+			semvers = append(semvers, sv)
+		}
+		slices.SortStableFunc(semvers, func(a, b *semver.Version) int {
+			return b.Compare(a)
+		})
+		filtered = filtered[:0]
+		for _, sv := range semvers {
+			filtered = append(filtered, sv.Original())
+		}
+	default:
+		return nil, fmt.Errorf("unknown selection strategy %q", sub.SelectionStrategy)
+	}
+
+	return trimSlice(filtered, int(sub.DiscoveryLimit)), nil
+}
+
+// extractReleaseMetadata evaluates the given metadata rules against the given
+// environment for the given version.
+func extractReleaseMetadata(
+	rules []kargoapi.ReleaseFeedMetadata,
+	env map[string]any,
+	version string,
+) (map[string]string, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	versionEnv := map[string]any{
+		"response": env["response"],
+		"version":  version,
+	}
+	metadata := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		program, err := expr.Compile(rule.FromExpression)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling expression for %q: %w", rule.Name, err)
+		}
+		result, err := expr.Run(program, versionEnv)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating expression for %q: %w", rule.Name, err)
+		}
+		switch v := result.(type) {
+		case nil:
+			continue
+		case string:
+			metadata[rule.Name] = v
+		default:
+			valueBytes, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("error converting value for %q to JSON: %w", rule.Name, err)
+			}
+			metadata[rule.Name] = string(valueBytes)
+		}
+	}
+	return metadata, nil
+}
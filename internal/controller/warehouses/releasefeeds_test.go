@@ -0,0 +1,351 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestDiscoverReleases(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		subs       []kargoapi.RepoSubscription
+		assertions func(*testing.T, []kargoapi.ReleaseFeedDiscoveryResult, error)
+	}{
+		{
+			name:       "no release feed subscription",
+			reconciler: &reconciler{},
+			subs: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ReleaseFeedDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (*credentials.Credentials, error) {
+						return nil, fmt.Errorf("something went wrong")
+					},
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{ReleaseFeed: &kargoapi.ReleaseFeedSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ReleaseFeedDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for release feed")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error discovering versions",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverReleaseVersionsFn: func(
+					context.Context,
+					kargoapi.ReleaseFeedSubscription,
+					*credentials.Credentials,
+				) ([]kargoapi.DiscoveredRelease, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{ReleaseFeed: &kargoapi.ReleaseFeedSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ReleaseFeedDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error discovering latest versions from release feed")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "no versions discovered",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverReleaseVersionsFn: func(
+					context.Context,
+					kargoapi.ReleaseFeedSubscription,
+					*credentials.Credentials,
+				) ([]kargoapi.DiscoveredRelease, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{ReleaseFeed: &kargoapi.ReleaseFeedSubscription{URL: "https://fake-url"}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ReleaseFeedDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.ReleaseFeedDiscoveryResult{
+					{URL: "https://fake-url"},
+				}, results)
+			},
+		},
+		{
+			name: "discovers versions",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverReleaseVersionsFn: func(
+					context.Context,
+					kargoapi.ReleaseFeedSubscription,
+					*credentials.Credentials,
+				) ([]kargoapi.DiscoveredRelease, error) {
+					return []kargoapi.DiscoveredRelease{
+						{Version: "v1.1.0"},
+						{Version: "v1.0.0"},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{ReleaseFeed: &kargoapi.ReleaseFeedSubscription{URL: "https://fake-url"}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ReleaseFeedDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.ReleaseFeedDiscoveryResult{
+					{
+						URL: "https://fake-url",
+						Releases: []kargoapi.DiscoveredRelease{
+							{Version: "v1.1.0"},
+							{Version: "v1.0.0"},
+						},
+					},
+				}, results)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := testCase.reconciler.discoverReleases(
+				context.TODO(),
+				"fake-namespace",
+				testCase.subs,
+			)
+			testCase.assertions(t, results, err)
+		})
+	}
+}
+
+func TestDiscoverReleaseVersions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		handler    http.HandlerFunc
+		sub        kargoapi.ReleaseFeedSubscription
+		creds      *credentials.Credentials
+		assertions func(*testing.T, []kargoapi.DiscoveredRelease, error)
+	}{
+		{
+			name: "unexpected status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			sub: kargoapi.ReleaseFeedSubscription{
+				VersionsFromExpression: "response.body",
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP 404 response")
+				require.Nil(t, releases)
+			},
+		},
+		{
+			name: "versions expression does not evaluate to a list",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"latest": "v1.0.0"}`))
+			},
+			sub: kargoapi.ReleaseFeedSubscription{
+				VersionsFromExpression: "response.body.latest",
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.ErrorContains(t, err, "must evaluate to a list")
+				require.Nil(t, releases)
+			},
+		},
+		{
+			name: "versions expression evaluates to a list of non-strings",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`[1, 2, 3]`))
+			},
+			sub: kargoapi.ReleaseFeedSubscription{
+				VersionsFromExpression: "response.body",
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.ErrorContains(t, err, "must evaluate to a list of strings")
+				require.Nil(t, releases)
+			},
+		},
+		{
+			name: "JSON feed with metadata and credentials",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if username, password, ok := r.BasicAuth(); !ok ||
+					username != "fake-username" || password != "fake-password" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.Header.Get("Accept") != "application/json" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				_, _ = w.Write([]byte(`{"releases": [
+					{"version": "v1.0.0", "channel": "stable", "size": 1},
+					{"version": "v1.2.0-rc.1", "channel": "beta", "size": 2},
+					{"version": "v1.1.0", "channel": "stable", "size": 3},
+					{"version": "not-a-version", "channel": "stable", "size": 4}
+				]}`))
+			},
+			sub: kargoapi.ReleaseFeedSubscription{
+				Headers: []kargoapi.ReleaseFeedHeader{
+					{Name: "Accept", Value: "application/json"},
+				},
+				VersionsFromExpression: "map(response.body.releases, .version)",
+				Metadata: []kargoapi.ReleaseFeedMetadata{
+					{
+						Name:           "channel",
+						FromExpression: "first(filter(response.body.releases, .version == version)).channel",
+					},
+					{
+						Name:           "size",
+						FromExpression: "first(filter(response.body.releases, .version == version)).size",
+					},
+				},
+			},
+			creds: &credentials.Credentials{
+				Username: "fake-username",
+				Password: "fake-password",
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.DiscoveredRelease{
+					{Version: "v1.2.0-rc.1", Metadata: map[string]string{"channel": "beta", "size": "2"}},
+					{Version: "v1.1.0", Metadata: map[string]string{"channel": "stable", "size": "3"}},
+					{Version: "v1.0.0", Metadata: map[string]string{"channel": "stable", "size": "1"}},
+				}, releases)
+			},
+		},
+		{
+			name: "plain text feed",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte("2025.01.01\n2025.03.01\n2025.02.01\n"))
+			},
+			sub: kargoapi.ReleaseFeedSubscription{
+				VersionsFromExpression: `split(trim(response.body), "\n")`,
+				SelectionStrategy:      kargoapi.ReleaseFeedSelectionStrategyLexical,
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.DiscoveredRelease{
+					{Version: "2025.03.01"},
+					{Version: "2025.02.01"},
+					{Version: "2025.01.01"},
+				}, releases)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			t.Cleanup(server.Close)
+
+			sub := testCase.sub
+			sub.URL = server.URL
+
+			releases, err := (&reconciler{}).discoverReleaseVersions(
+				context.Background(),
+				sub,
+				testCase.creds,
+			)
+			testCase.assertions(t, releases, err)
+		})
+	}
+}
+
+func TestSelectReleaseVersions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.ReleaseFeedSubscription
+		versions   []string
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name: "invalid allowVersions regular expression",
+			sub: kargoapi.ReleaseFeedSubscription{
+				AllowVersions: "(",
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error compiling allowVersions regular expression")
+			},
+		},
+		{
+			name: "invalid semver constraint",
+			sub: kargoapi.ReleaseFeedSubscription{
+				SemverConstraint: "invalid",
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name: "unknown selection strategy",
+			sub: kargoapi.ReleaseFeedSubscription{
+				SelectionStrategy: "Unknown",
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "unknown selection strategy")
+			},
+		},
+		{
+			name: "semver with constraint, filters, and limit",
+			sub: kargoapi.ReleaseFeedSubscription{
+				SelectionStrategy: kargoapi.ReleaseFeedSelectionStrategySemVer,
+				SemverConstraint:  "^1.0.0",
+				AllowVersions:     "^v",
+				IgnoreVersions:    []string{"v1.3.0"},
+				DiscoveryLimit:    2,
+			},
+			versions: []string{
+				"v1.0.0", "v1.1.0", "v1.3.0", "1.2.0", "v1.2.5", "v2.0.0", "v1.1.0", "",
+			},
+			assertions: func(t *testing.T, versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v1.2.5", "v1.1.0"}, versions)
+			},
+		},
+		{
+			name: "lexical",
+			sub: kargoapi.ReleaseFeedSubscription{
+				SelectionStrategy: kargoapi.ReleaseFeedSelectionStrategyLexical,
+			},
+			versions: []string{"b", "c", "a"},
+			assertions: func(t *testing.T, versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"c", "b", "a"}, versions)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			versions, err := selectReleaseVersions(testCase.sub, testCase.versions)
+			testCase.assertions(t, versions, err)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/akuity/kargo/internal/controller"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
 	"github.com/akuity/kargo/internal/helm"
 	"github.com/akuity/kargo/internal/image"
 	"github.com/akuity/kargo/internal/kargo"
@@ -56,18 +57,44 @@ type reconciler struct {
 
 	discoverChartsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ChartDiscoveryResult, error)
 
-	discoverChartVersionsFn func(context.Context, string, string, string, *helm.Credentials) ([]string, error)
+	newChartRepositoryFn func(repoURL, chart string, creds *helm.Credentials) helm.ChartRepository
+
+	discoverOCIArtifactsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.OCIArtifactDiscoveryResult, error)
+
+	discoverOCIArtifactRefsFn func(context.Context, kargoapi.OCIArtifactSubscription, *image.Credentials) ([]image.Image, error)
+
+	discoverReleasesFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ReleaseFeedDiscoveryResult, error)
+
+	discoverReleaseVersionsFn func(
+		context.Context,
+		kargoapi.ReleaseFeedSubscription,
+		*credentials.Credentials,
+	) ([]kargoapi.DiscoveredRelease, error)
 
 	buildFreightFromLatestArtifactsFn func(string, *kargoapi.DiscoveredArtifacts) (*kargoapi.Freight, error)
 
 	gitCloneFn func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error)
 
+	newGitProviderFn func(string, *gitprovider.Options) (gitprovider.Interface, error)
+
+	discoverPullRequestsFn func(
+		context.Context,
+		kargoapi.GitSubscription,
+		*credentials.Credentials,
+	) ([]pullRequestHead, error)
+
 	listCommitsFn func(repo git.Repo, limit, skip uint) ([]git.CommitMetadata, error)
 
 	listTagsFn func(repo git.Repo) ([]git.TagMetadata, error)
 
+	listBranchesFn func(repo git.Repo) ([]git.BranchMetadata, error)
+
+	listBranchCommitsFn func(repo git.Repo, branch string, limit, skip uint) ([]git.CommitMetadata, error)
+
 	discoverBranchHistoryFn func(repo git.Repo, sub kargoapi.GitSubscription) ([]git.CommitMetadata, error)
 
+	discoverBranchesFn func(repo git.Repo, sub kargoapi.GitSubscription) ([]git.BranchMetadata, error)
+
 	discoverTagsFn func(repo git.Repo, sub kargoapi.GitSubscription) ([]git.TagMetadata, error)
 
 	getDiffPathsForCommitIDFn func(repo git.Repo, commitID string) ([]string, error)
@@ -118,10 +145,11 @@ func newReconciler(
 	credentialsDB credentials.Database,
 ) *reconciler {
 	r := &reconciler{
-		client:                  kubeClient,
-		credentialsDB:           credentialsDB,
-		gitCloneFn:              git.Clone,
-		discoverChartVersionsFn: helm.DiscoverChartVersions,
+		client:               kubeClient,
+		credentialsDB:        credentialsDB,
+		gitCloneFn:           git.Clone,
+		newGitProviderFn:     gitprovider.New,
+		newChartRepositoryFn: helm.NewChartRepository,
 		imageSourceURLFnsByBaseURL: map[string]func(string, string) string{
 			githubURLPrefix: getGithubImageSourceURL,
 		},
@@ -133,11 +161,19 @@ func newReconciler(
 	r.discoverImagesFn = r.discoverImages
 	r.discoverImageRefsFn = r.discoverImageRefs
 	r.discoverChartsFn = r.discoverCharts
+	r.discoverOCIArtifactsFn = r.discoverOCIArtifacts
+	r.discoverOCIArtifactRefsFn = r.discoverOCIArtifactRefs
+	r.discoverReleasesFn = r.discoverReleases
+	r.discoverReleaseVersionsFn = r.discoverReleaseVersions
 	r.buildFreightFromLatestArtifactsFn = r.buildFreightFromLatestArtifacts
 	r.listCommitsFn = r.listCommits
 	r.listTagsFn = r.listTags
+	r.listBranchesFn = r.listBranches
+	r.listBranchCommitsFn = r.listBranchCommits
 	r.discoverBranchHistoryFn = r.discoverBranchHistory
+	r.discoverBranchesFn = r.discoverBranches
 	r.discoverTagsFn = r.discoverTags
+	r.discoverPullRequestsFn = r.discoverPullRequests
 	r.getDiffPathsForCommitIDFn = r.getDiffPathsForCommitID
 	r.patchStatusFn = r.patchStatus
 	return r
@@ -347,6 +383,87 @@ func (r *reconciler) syncWarehouse(
 			Name: warehouse.Name,
 		}
 
+		// Create Freight for the heads of pull requests other than the most
+		// recently updated one and, if requested, for the heads of branches
+		// other than the first selected one.
+		for _, headFreight := range buildFreightFromHeads(
+			freight,
+			status.DiscoveredArtifacts,
+			warehouse.Spec.Subscriptions,
+		) {
+			if err = r.createHeadFreight(ctx, warehouse, headFreight); err != nil {
+				// Make the error visible in the status and mark the Warehouse as
+				// not ready.
+				conditions.Set(
+					&status,
+					&metav1.Condition{
+						Type:   kargoapi.ConditionTypeReady,
+						Status: metav1.ConditionFalse,
+						Reason: "FreightCreationFailure",
+						Message: fmt.Sprintf(
+							"Error creating Freight %q in namespace %q: %s",
+							headFreight.Name,
+							headFreight.Namespace,
+							err.Error(),
+						),
+					},
+				)
+
+				return status, fmt.Errorf(
+					"error creating Freight %q in namespace %q: %w",
+					headFreight.Name,
+					headFreight.Namespace,
+					err,
+				)
+			}
+		}
+
+		// Skip the creation of the Freight if the latest artifacts do not
+		// satisfy the Warehouse's Freight creation criteria.
+		satisfied, err := r.freightCreationCriteriaSatisfied(ctx, warehouse, freight)
+		if err != nil {
+			// Make the error visible in the status and mark the Warehouse as
+			// not ready.
+			conditions.Set(
+				&status,
+				&metav1.Condition{
+					Type:   kargoapi.ConditionTypeReady,
+					Status: metav1.ConditionFalse,
+					Reason: "FreightCreationCriteriaEvaluationFailure",
+					Message: fmt.Sprintf(
+						"Error evaluating Freight creation criteria: %s",
+						err.Error(),
+					),
+				},
+			)
+
+			return status, fmt.Errorf("error evaluating Freight creation criteria: %w", err)
+		}
+		if !satisfied {
+			logger.Debug(
+				"latest artifacts do not satisfy Freight creation criteria",
+				"freight", freight.Name,
+			)
+
+			conditions.Delete(&status, kargoapi.ConditionTypeReconciling)
+			conditions.Set(
+				&status,
+				&metav1.Condition{
+					Type:   kargoapi.ConditionTypeReady,
+					Status: metav1.ConditionTrue,
+					Reason: "FreightCreationCriteriaNotSatisfied",
+					Message: fmt.Sprintf(
+						"Freight %q was not created because the latest artifacts do not "+
+							"satisfy the Freight creation criteria: expression %q evaluated to false",
+						freight.Name,
+						warehouse.Spec.FreightCreationCriteria.Expression,
+					),
+				},
+			)
+
+			return status, nil
+		}
+
 		// Attempt to create the Freight.
 		if err = r.createFreightFn(ctx, freight); client.IgnoreAlreadyExists(err) != nil {
 			// Make the error visible in the status and mark the Warehouse as
@@ -417,11 +534,23 @@ func (r *reconciler) discoverArtifacts(
 		return nil, fmt.Errorf("error discovering charts: %w", err)
 	}
 
+	ociArtifacts, err := r.discoverOCIArtifactsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering OCI artifacts: %w", err)
+	}
+
+	releases, err := r.discoverReleasesFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering releases: %w", err)
+	}
+
 	return &kargoapi.DiscoveredArtifacts{
 		DiscoveredAt: metav1.Now(),
 		Git:          commits,
 		Images:       images,
 		Charts:       charts,
+		OCIArtifacts: ociArtifacts,
+		Releases:     releases,
 	}, nil
 }
 
@@ -443,16 +572,7 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		if len(result.Commits) == 0 {
 			return nil, fmt.Errorf("no commits discovered for repository %q", result.RepoURL)
 		}
-		latestCommit := result.Commits[0]
-		freight.Commits = append(freight.Commits, kargoapi.GitCommit{
-			RepoURL:   result.RepoURL,
-			ID:        latestCommit.ID,
-			Branch:    latestCommit.Branch,
-			Tag:       latestCommit.Tag,
-			Message:   latestCommit.Subject,
-			Author:    latestCommit.Author,
-			Committer: latestCommit.Committer,
-		})
+		freight.Commits = append(freight.Commits, newGitCommit(result.RepoURL, result.Commits[0]))
 	}
 
 	for _, result := range artifacts.Images {
@@ -461,10 +581,12 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		}
 		latestImage := result.References[0]
 		freight.Images = append(freight.Images, kargoapi.Image{
-			RepoURL:     result.RepoURL,
-			Tag:         latestImage.Tag,
-			Digest:      latestImage.Digest,
-			Annotations: latestImage.Annotations,
+			RepoURL:       result.RepoURL,
+			Tag:           latestImage.Tag,
+			Digest:        latestImage.Digest,
+			Annotations:   latestImage.Annotations,
+			SourceRepoURL: latestImage.SourceRepoURL,
+			SourceCommit:  latestImage.SourceCommit,
 		})
 	}
 
@@ -476,11 +598,45 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 				result.Name,
 			)
 		}
-		latestChart := result.Versions[0]
-		freight.Charts = append(freight.Charts, kargoapi.Chart{
+		latestChart := kargoapi.Chart{
 			RepoURL: result.RepoURL,
 			Name:    result.Name,
-			Version: latestChart,
+			Version: result.Versions[0],
+		}
+		for _, md := range result.Metadata {
+			if md.Version == latestChart.Version {
+				latestChart.AppVersion = md.AppVersion
+				latestChart.Annotations = md.Annotations
+				latestChart.Dependencies = md.Dependencies
+				break
+			}
+		}
+		freight.Charts = append(freight.Charts, latestChart)
+	}
+
+	for _, result := range artifacts.OCIArtifacts {
+		if len(result.References) == 0 {
+			return nil, fmt.Errorf("no artifacts discovered for repository %q", result.RepoURL)
+		}
+		latestArtifact := result.References[0]
+		freight.OCIArtifacts = append(freight.OCIArtifacts, kargoapi.OCIArtifact{
+			RepoURL:      result.RepoURL,
+			Tag:          latestArtifact.Tag,
+			Digest:       latestArtifact.Digest,
+			ArtifactType: latestArtifact.ArtifactType,
+			Annotations:  latestArtifact.Annotations,
+		})
+	}
+
+	for _, result := range artifacts.Releases {
+		if len(result.Releases) == 0 {
+			return nil, fmt.Errorf("no versions discovered for release feed %q", result.URL)
+		}
+		latestRelease := result.Releases[0]
+		freight.Releases = append(freight.Releases, kargoapi.Release{
+			URL:      result.URL,
+			Version:  latestRelease.Version,
+			Metadata: latestRelease.Metadata,
 		})
 	}
 
@@ -490,6 +646,82 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 	return freight, nil
 }
 
+// createHeadFreight creates the given Freight built from the head of a pull
+// request or branch, provided it satisfies the Warehouse's Freight creation
+// criteria. It is not an error for the Freight to exist already.
+func (r *reconciler) createHeadFreight(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+	freight *kargoapi.Freight,
+) error {
+	logger := logging.LoggerFromContext(ctx).WithValues("freight", freight.Name)
+	satisfied, err := r.freightCreationCriteriaSatisfied(ctx, warehouse, freight)
+	if err != nil {
+		return fmt.Errorf("error evaluating Freight creation criteria: %w", err)
+	}
+	if !satisfied {
+		logger.Debug("head does not satisfy Freight creation criteria")
+		return nil
+	}
+	if err = r.createFreightFn(ctx, freight); client.IgnoreAlreadyExists(err) != nil {
+		return err
+	} else if err == nil {
+		logger.Debug("created Freight", "namespace", freight.Namespace)
+	}
+	return nil
+}
+
+// buildFreightFromHeads builds a Freight for the head commit of every
+// discovered pull request other than the most recently updated one, which is
+// already part of the given Freight built from the latest artifacts. The same
+// applies to the heads of the branches discovered for Git subscriptions that
+// request Freight per branch. Each Freight otherwise contains the same
+// artifacts as the given Freight. Pull requests and branches are updated
+// independently of one another, so building Freight from the latest artifacts
+// alone would miss the heads of those updated between two discoveries.
+func buildFreightFromHeads(
+	latest *kargoapi.Freight,
+	artifacts *kargoapi.DiscoveredArtifacts,
+	subs []kargoapi.RepoSubscription,
+) []*kargoapi.Freight {
+	// Git subscriptions are unique by repository URL.
+	perBranch := make(map[string]bool, len(subs))
+	for _, sub := range subs {
+		if sub.Git != nil {
+			perBranch[sub.Git.RepoURL] = sub.Git.FreightPerBranch && sub.Git.BranchPattern != ""
+		}
+	}
+	var freight []*kargoapi.Freight
+	for i, result := range artifacts.Git {
+		for _, commit := range result.Commits[1:] {
+			if commit.PullRequestNumber == 0 && !perBranch[result.RepoURL] {
+				continue
+			}
+			f := latest.DeepCopy()
+			f.Commits[i] = newGitCommit(result.RepoURL, commit)
+			f.Name = api.GenerateFreightID(f)
+			freight = append(freight, f)
+		}
+	}
+	return freight
+}
+
+// newGitCommit returns a GitCommit for the given commit discovered in the given
+// repository.
+func newGitCommit(repoURL string, commit kargoapi.DiscoveredCommit) kargoapi.GitCommit {
+	return kargoapi.GitCommit{
+		RepoURL:           repoURL,
+		ID:                commit.ID,
+		Branch:            commit.Branch,
+		Tag:               commit.Tag,
+		Message:           commit.Subject,
+		Author:            commit.Author,
+		Committer:         commit.Committer,
+		PullRequestNumber: commit.PullRequestNumber,
+		PullRequestURL:    commit.PullRequestURL,
+	}
+}
+
 func (r *reconciler) patchStatus(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
@@ -507,7 +739,9 @@ func validateDiscoveredArtifacts(
 ) bool {
 	artifacts := newStatus.DiscoveredArtifacts
 
-	if artifacts == nil || len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts) == 0 {
+	if artifacts == nil ||
+		len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts)+
+			len(artifacts.OCIArtifacts)+len(artifacts.Releases) == 0 {
 		message := "No artifacts discovered"
 		conditions.Set(
 			newStatus,
@@ -625,6 +859,66 @@ func validateDiscoveredArtifacts(
 		charts += count
 	}
 
+	var ociArtifacts int
+	for _, artifact := range artifacts.OCIArtifacts {
+		count := len(artifact.References)
+
+		if count == 0 {
+			message := fmt.Sprintf("No references discovered for OCI artifact repository %q", artifact.RepoURL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoOCIArtifactReferencesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingOCIArtifactReferences",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		ociArtifacts += count
+	}
+
+	var releases int
+	for _, release := range artifacts.Releases {
+		count := len(release.Releases)
+
+		if count == 0 {
+			message := fmt.Sprintf("No versions discovered for release feed %q", release.URL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoReleasesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingReleases",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		releases += count
+	}
+
 	var parts []string
 	if commits > 0 {
 		parts = append(parts, fmt.Sprintf("%d commits", commits))
@@ -635,6 +929,12 @@ func validateDiscoveredArtifacts(
 	if charts > 0 {
 		parts = append(parts, fmt.Sprintf("%d charts", charts))
 	}
+	if ociArtifacts > 0 {
+		parts = append(parts, fmt.Sprintf("%d OCI artifacts", ociArtifacts))
+	}
+	if releases > 0 {
+		parts = append(parts, fmt.Sprintf("%d releases", releases))
+	}
 
 	var message string
 	if len(parts) == 1 {
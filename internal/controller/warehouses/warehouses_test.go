@@ -33,11 +33,21 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.discoverCommitsFn)
 	require.NotNil(t, e.discoverImagesFn)
 	require.NotNil(t, e.discoverChartsFn)
+	require.NotNil(t, e.newChartRepositoryFn)
+	require.NotNil(t, e.discoverOCIArtifactsFn)
+	require.NotNil(t, e.discoverOCIArtifactRefsFn)
+	require.NotNil(t, e.discoverReleasesFn)
+	require.NotNil(t, e.discoverReleaseVersionsFn)
 	require.NotNil(t, e.buildFreightFromLatestArtifactsFn)
 	require.NotNil(t, e.listCommitsFn)
 	require.NotNil(t, e.listTagsFn)
+	require.NotNil(t, e.listBranchesFn)
+	require.NotNil(t, e.listBranchCommitsFn)
 	require.NotNil(t, e.discoverBranchHistoryFn)
+	require.NotNil(t, e.discoverBranchesFn)
 	require.NotNil(t, e.discoverTagsFn)
+	require.NotNil(t, e.discoverPullRequestsFn)
+	require.NotNil(t, e.newGitProviderFn)
 	require.NotNil(t, e.getDiffPathsForCommitIDFn)
 	require.NotNil(t, e.createFreightFn)
 	require.NotNil(t, e.patchStatusFn)
@@ -289,6 +299,121 @@ func TestSyncWarehouse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error evaluating Freight creation criteria",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationCriteria: &kargoapi.FreightCreationCriteria{
+						Expression: "bogus ==",
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.ErrorContains(t, err, "error evaluating Freight creation criteria")
+				require.NotNil(t, status.DiscoveredArtifacts)
+				require.Empty(t, status.LastFreightID)
+
+				require.Len(t, status.GetConditions(), 3)
+
+				// Ensure that the Ready condition is set to False.
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "FreightCreationCriteriaEvaluationFailure", readyCondition.Reason)
+
+				// Ensure that the Reconciling condition is still set to True.
+				reconcilingCondition := conditions.Get(&status, kargoapi.ConditionTypeReconciling)
+				require.NotNil(t, reconcilingCondition)
+				require.Equal(t, metav1.ConditionTrue, reconcilingCondition.Status)
+			},
+		},
+
+		{
+			name: "Freight creation criteria not satisfied",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("Freight should not have been created")
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationCriteria: &kargoapi.FreightCreationCriteria{
+						Expression: "1 > 2",
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, status.DiscoveredArtifacts)
+				require.Empty(t, status.LastFreightID)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				// Ensure that the Ready condition is set to True and explains
+				// why no Freight was created.
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionTrue, readyCondition.Status)
+				require.Equal(t, "FreightCreationCriteriaNotSatisfied", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "fake-freight")
+				require.Contains(t, readyCondition.Message, "1 > 2")
+
+				// Ensure that the Reconciling condition is removed.
+				require.Nil(t, conditions.Get(&status, kargoapi.ConditionTypeReconciling))
+			},
+		},
+
 		{
 			name: "automatic Freight creation",
 			reconciler: &reconciler{
@@ -346,6 +471,50 @@ func TestSyncWarehouse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error creating Freight for pull request head",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{{
+							RepoURL: "fake-repo",
+							Commits: []kargoapi.DiscoveredCommit{
+								{ID: "fake-commit", PullRequestNumber: 2},
+								{ID: "other-fake-commit", PullRequestNumber: 1},
+							},
+						}},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: (&reconciler{}).buildFreightFromLatestArtifacts,
+				createFreightFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					freight, ok := obj.(*kargoapi.Freight)
+					require.True(t, ok)
+					require.Equal(t, "other-fake-commit", freight.Commits[0].ID)
+					return errors.New("something went wrong")
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.ErrorContains(t, err, "error creating Freight")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, status.LastFreightID)
+
+				// Ensure that the Ready condition is set to False.
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "FreightCreationFailure", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "something went wrong")
+			},
+		},
+
 		{
 			name: "manual Freight creation",
 			reconciler: &reconciler{
@@ -571,6 +740,80 @@ func TestDiscoverArtifacts(t *testing.T) {
 				require.Nil(t, discoveredArtifacts)
 			},
 		},
+		{
+			name: "error discovering OCI artifacts",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering OCI artifacts")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
+		{
+			name: "error discovering releases",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{}, nil
+				},
+				discoverReleasesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ReleaseFeedDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering releases")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
 		{
 			name: "success",
 			reconciler: &reconciler{
@@ -604,12 +847,34 @@ func TestDiscoverArtifacts(t *testing.T) {
 						}},
 					}, nil
 				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "fake-tag"},
+						}},
+					}, nil
+				},
+				discoverReleasesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ReleaseFeedDiscoveryResult, error) {
+					return []kargoapi.ReleaseFeedDiscoveryResult{
+						{URL: "https://fake-url", Releases: []kargoapi.DiscoveredRelease{
+							{Version: "v1.0.0"},
+						}},
+					}, nil
+				},
 			},
 			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
 				require.NoError(t, err)
 				require.Len(t, discoveredArtifacts.Git, 1)
 				require.Len(t, discoveredArtifacts.Images, 1)
 				require.Len(t, discoveredArtifacts.Charts, 1)
+				require.Len(t, discoveredArtifacts.OCIArtifacts, 1)
+				require.Len(t, discoveredArtifacts.Releases, 1)
 			},
 		},
 	}
@@ -684,28 +949,104 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.Nil(t, freight)
 			},
 		},
+		{
+			name: "no OCI artifacts discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no artifacts discovered for repository")
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "no releases discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Releases: []kargoapi.ReleaseFeedDiscoveryResult{
+					{URL: "https://fake-url", Releases: []kargoapi.DiscoveredRelease{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no versions discovered for release feed")
+				require.Nil(t, freight)
+			},
+		},
 		{
 			name: "success",
 			artifacts: &kargoapi.DiscoveredArtifacts{
 				Git: []kargoapi.GitDiscoveryResult{
 					{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
-					{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+					{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{
+						ID:                "fake-commit",
+						PullRequestNumber: 42,
+						PullRequestURL:    "https://fake-repo/pull/42",
+					}}},
 				},
 				Images: []kargoapi.ImageDiscoveryResult{
 					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{{Tag: "fake-tag"}}},
-					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{{Tag: "fake-tag"}}},
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{{
+						Tag:           "fake-tag",
+						SourceRepoURL: "fake-source-repo",
+						SourceCommit:  "fake-source-commit",
+					}}},
 				},
 				Charts: []kargoapi.ChartDiscoveryResult{
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
-					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
+					{
+						RepoURL:  "fake-repo",
+						Versions: []string{"fake-version"},
+						Metadata: []kargoapi.ChartVersionMetadata{{
+							Version:      "fake-version",
+							AppVersion:   "fake-app-version",
+							Annotations:  map[string]string{"foo": "bar"},
+							Dependencies: []kargoapi.ChartDependency{{Name: "fake-dependency"}},
+						}},
+					},
+				},
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{{
+						Tag:          "fake-tag",
+						Digest:       "fake-digest",
+						ArtifactType: "application/vnd.example.bundle.v1",
+					}}},
+				},
+				Releases: []kargoapi.ReleaseFeedDiscoveryResult{
+					{URL: "https://fake-url", Releases: []kargoapi.DiscoveredRelease{
+						{Version: "v1.1.0", Metadata: map[string]string{"notes": "fake-notes"}},
+						{Version: "v1.0.0"},
+					}},
 				},
 			},
 			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
 				require.NoError(t, err)
 				require.NotNil(t, freight)
 				require.Len(t, freight.Commits, 2)
+				require.Equal(t, int64(42), freight.Commits[1].PullRequestNumber)
+				require.Equal(t, "https://fake-repo/pull/42", freight.Commits[1].PullRequestURL)
 				require.Len(t, freight.Images, 2)
+				require.Equal(t, "fake-source-repo", freight.Images[1].SourceRepoURL)
+				require.Equal(t, "fake-source-commit", freight.Images[1].SourceCommit)
 				require.Len(t, freight.Charts, 2)
+				require.Equal(t, kargoapi.Chart{
+					RepoURL:      "fake-repo",
+					Version:      "fake-version",
+					AppVersion:   "fake-app-version",
+					Annotations:  map[string]string{"foo": "bar"},
+					Dependencies: []kargoapi.ChartDependency{{Name: "fake-dependency"}},
+				}, freight.Charts[1])
+				require.Equal(t, []kargoapi.OCIArtifact{{
+					RepoURL:      "fake-repo",
+					Tag:          "fake-tag",
+					Digest:       "fake-digest",
+					ArtifactType: "application/vnd.example.bundle.v1",
+				}}, freight.OCIArtifacts)
+				require.Equal(t, []kargoapi.Release{{
+					URL:      "https://fake-url",
+					Version:  "v1.1.0",
+					Metadata: map[string]string{"notes": "fake-notes"},
+				}}, freight.Releases)
 			},
 		},
 	}
@@ -721,6 +1062,108 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 	}
 }
 
+func TestBuildFreightFromHeads(t *testing.T) {
+	subs := []kargoapi.RepoSubscription{
+		{Git: &kargoapi.GitSubscription{RepoURL: "fake-repo"}},
+		{Git: &kargoapi.GitSubscription{
+			RepoURL:       "fake-pr-repo",
+			PullRequests:  &kargoapi.GitPullRequestFilter{},
+			BranchPattern: "ignored/*",
+		}},
+		{Git: &kargoapi.GitSubscription{
+			RepoURL:       "fake-branch-repo",
+			BranchPattern: "release/*",
+		}},
+		{Git: &kargoapi.GitSubscription{
+			RepoURL:          "fake-per-branch-repo",
+			BranchPattern:    "release/*",
+			FreightPerBranch: true,
+		}},
+	}
+	artifacts := &kargoapi.DiscoveredArtifacts{
+		Git: []kargoapi.GitDiscoveryResult{
+			{
+				RepoURL: "fake-repo",
+				Commits: []kargoapi.DiscoveredCommit{
+					{ID: "fake-commit", Branch: "main"},
+					{ID: "older-fake-commit", Branch: "main"},
+				},
+			},
+			{
+				RepoURL: "fake-pr-repo",
+				Commits: []kargoapi.DiscoveredCommit{
+					{ID: "fake-pr-commit", PullRequestNumber: 3},
+					{
+						ID:                "other-fake-pr-commit",
+						Branch:            "feature",
+						Subject:           "Add feature",
+						PullRequestNumber: 2,
+						PullRequestURL:    "https://fake-pr-url",
+					},
+				},
+			},
+			{
+				RepoURL: "fake-branch-repo",
+				Commits: []kargoapi.DiscoveredCommit{
+					{ID: "fake-branch-commit", Branch: "release/2"},
+					{ID: "other-fake-branch-commit", Branch: "release/1"},
+				},
+			},
+			{
+				RepoURL: "fake-per-branch-repo",
+				Commits: []kargoapi.DiscoveredCommit{
+					{ID: "fake-per-branch-commit", Branch: "release/2"},
+					{
+						ID:      "other-fake-per-branch-commit",
+						Branch:  "release/1",
+						Subject: "Fix bug",
+						Author:  "Alice <alice@example.com>",
+					},
+				},
+			},
+		},
+		Images: []kargoapi.ImageDiscoveryResult{{
+			RepoURL:    "fake-image-repo",
+			References: []kargoapi.DiscoveredImageReference{{Tag: "v1.0.0"}},
+		}},
+	}
+	latest, err := (&reconciler{}).buildFreightFromLatestArtifacts("fake-namespace", artifacts)
+	require.NoError(t, err)
+
+	freight := buildFreightFromHeads(latest, artifacts, subs)
+	require.Len(t, freight, 2)
+	for _, f := range freight {
+		require.NotEqual(t, latest.Name, f.Name)
+		require.Equal(t, "fake-namespace", f.Namespace)
+		require.Equal(t, latest.Images, f.Images)
+	}
+	require.Equal(t, []kargoapi.GitCommit{
+		latest.Commits[0],
+		{
+			RepoURL:           "fake-pr-repo",
+			ID:                "other-fake-pr-commit",
+			Branch:            "feature",
+			Message:           "Add feature",
+			PullRequestNumber: 2,
+			PullRequestURL:    "https://fake-pr-url",
+		},
+		latest.Commits[2],
+		latest.Commits[3],
+	}, freight[0].Commits)
+	require.Equal(t, []kargoapi.GitCommit{
+		latest.Commits[0],
+		latest.Commits[1],
+		latest.Commits[2],
+		{
+			RepoURL: "fake-per-branch-repo",
+			ID:      "other-fake-per-branch-commit",
+			Branch:  "release/1",
+			Message: "Fix bug",
+			Author:  "Alice <alice@example.com>",
+		},
+	}, freight[1].Commits)
+}
+
 func TestValidateDiscoveredArtifacts(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -852,6 +1295,70 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
 		},
+		{
+			name: "OCI artifact repository with no references",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/bundle"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingOCIArtifactReferences", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No references discovered for OCI artifact repository")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoOCIArtifactReferencesDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No references discovered for OCI artifact repository")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
+		{
+			name: "release feed with no versions",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Releases: []kargoapi.ReleaseFeedDiscoveryResult{
+						{URL: "https://releases.example.com"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingReleases", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No versions discovered for release feed")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoReleasesDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No versions discovered for release feed")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
 		{
 			name: "successful discovery with all artifact types",
 			warehouse: &kargoapi.Warehouse{
@@ -875,6 +1382,17 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 					Charts: []kargoapi.ChartDiscoveryResult{
 						{RepoURL: "https://charts.example.com", Name: "mychart", Versions: []string{"1.0.0", "1.1.0"}},
 					},
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/bundle", References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "1.0.0"},
+						}},
+					},
+					Releases: []kargoapi.ReleaseFeedDiscoveryResult{
+						{URL: "https://releases.example.com", Releases: []kargoapi.DiscoveredRelease{
+							{Version: "1.0.0"},
+							{Version: "0.9.0"},
+						}},
+					},
 				},
 			},
 			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
@@ -889,7 +1407,7 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Contains(
 					t,
 					healthyCondition.Message,
-					"Successfully discovered 3 commits, 2 images, and 2 charts from 4 subscriptions",
+					"Successfully discovered 3 commits, 2 images, 2 charts, 1 OCI artifacts, and 2 releases from 6 subscriptions",
 				)
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
@@ -26,6 +26,9 @@ const (
 	TypeHelm Type = "helm"
 	// TypeImage represents credentials for an image repository.
 	TypeImage Type = "image"
+	// TypeHTTP represents credentials for an HTTP endpoint, such as a release
+	// feed.
+	TypeHTTP Type = "http"
 )
 
 // Credentials generically represents any type of repository credential.
@@ -61,10 +61,12 @@ func TestNewPromotionAnnotations(t *testing.T) {
 						Time: time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC),
 					},
 				},
-				Alias:   "test-alias",
-				Commits: []v1alpha1.GitCommit{{Tag: "test-tag"}},
-				Images:  []v1alpha1.Image{{Tag: "test-tag"}},
-				Charts:  []v1alpha1.Chart{{Name: "test-chart"}},
+				Alias:        "test-alias",
+				Commits:      []v1alpha1.GitCommit{{Tag: "test-tag"}},
+				Images:       []v1alpha1.Image{{Tag: "test-tag"}},
+				Charts:       []v1alpha1.Chart{{Name: "test-chart"}},
+				OCIArtifacts: []v1alpha1.OCIArtifact{{Tag: "test-tag"}},
+				Releases:     []v1alpha1.Release{{Version: "test-version"}},
 			},
 			expected: map[string]string{
 				v1alpha1.AnnotationKeyEventProject:             "test-namespace",
@@ -78,6 +80,8 @@ func TestNewPromotionAnnotations(t *testing.T) {
 				v1alpha1.AnnotationKeyEventFreightCommits:      `[{"tag":"test-tag"}]`,
 				v1alpha1.AnnotationKeyEventFreightImages:       `[{"tag":"test-tag"}]`,
 				v1alpha1.AnnotationKeyEventFreightCharts:       `[{"name":"test-chart"}]`,
+				v1alpha1.AnnotationKeyEventFreightOCIArtifacts: `[{"tag":"test-tag"}]`,
+				v1alpha1.AnnotationKeyEventFreightReleases:     `[{"version":"test-version"}]`,
 				v1alpha1.AnnotationKeyEventApplications: `[{"name":"test-app-1","namespace":"argocd"},` +
 					`{"name":"test-app-2","namespace":"test-namespace"}]`,
 			},
@@ -22,10 +22,10 @@ type exprFn func(params ...any) (any, error)
 // FreightOperations returns a slice of expr.Option containing functions for
 // Freight operations.
 //
-// It provides `warehouse()`, `commitFrom()`, `imageFrom()`, and `chartFrom()`
-// functions that can be used within expressions. The functions operate within
-// the context of a given project with the provided freight requests and
-// references.
+// It provides `warehouse()`, `commitFrom()`, `imageFrom()`, `chartFrom()`,
+// `ociArtifactFrom()`, and `releaseFrom()` functions that can be used within
+// expressions. The functions operate within the context of a given project
+// with the provided freight requests and references.
 func FreightOperations(
 	ctx context.Context,
 	c client.Client,
@@ -38,6 +38,8 @@ func FreightOperations(
 		CommitFrom(ctx, c, project, freightRequests, freightRefs),
 		ImageFrom(ctx, c, project, freightRequests, freightRefs),
 		ChartFrom(ctx, c, project, freightRequests, freightRefs),
+		OCIArtifactFrom(ctx, c, project, freightRequests, freightRefs),
+		ReleaseFrom(ctx, c, project, freightRequests, freightRefs),
 	}
 }
 
@@ -143,6 +145,48 @@ func ChartFrom(
 	)
 }
 
+// OCIArtifactFrom returns an expr.Option that provides an `ociArtifactFrom()`
+// function for use in expressions.
+//
+// The ociArtifactFrom function finds OCI artifacts based on repository URL and
+// optional origin, using the provided freight requests and references within
+// the project context.
+func OCIArtifactFrom(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) expr.Option {
+	return expr.Function(
+		"ociArtifactFrom",
+		getOCIArtifact(ctx, c, project, freightReqs, freightRefs),
+		new(func(repoURL string, origin kargoapi.FreightOrigin) kargoapi.OCIArtifact),
+		new(func(repoURL string) kargoapi.OCIArtifact),
+	)
+}
+
+// ReleaseFrom returns an expr.Option that provides a `releaseFrom()` function
+// for use in expressions.
+//
+// The releaseFrom function finds releases based on release feed URL and
+// optional origin, using the provided freight requests and references within
+// the project context.
+func ReleaseFrom(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) expr.Option {
+	return expr.Function(
+		"releaseFrom",
+		getRelease(ctx, c, project, freightReqs, freightRefs),
+		new(func(feedURL string, origin kargoapi.FreightOrigin) kargoapi.Release),
+		new(func(feedURL string) kargoapi.Release),
+	)
+}
+
 // ConfigMap returns an expr.Option that provides a `configMap()` function for
 // use in expressions.
 func ConfigMap(ctx context.Context, c client.Client, cache *gocache.Cache, project string) expr.Option {
@@ -382,6 +426,92 @@ func getChart(
 	}
 }
 
+// getOCIArtifact returns a function that finds OCI artifacts based on
+// repository URL and optional origin.
+//
+// The returned function uses freight requests and references to locate the
+// appropriate artifact within the project context.
+func getOCIArtifact(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) == 0 || len(a) > 2 {
+			return nil, fmt.Errorf("expected 1-2 arguments, got %d", len(a))
+		}
+
+		repoURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		var desiredOrigin *kargoapi.FreightOrigin
+		if len(a) == 2 {
+			origin, ok := a[1].(kargoapi.FreightOrigin)
+			if !ok {
+				return nil, fmt.Errorf("second argument must be FreightOrigin, got %T", a[1])
+			}
+			desiredOrigin = &origin
+		}
+
+		return freight.FindOCIArtifact(
+			ctx,
+			c,
+			project,
+			freightReqs,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+}
+
+// getRelease returns a function that finds releases based on release
+// feed URL and optional origin.
+//
+// The returned function uses freight requests and references to locate the
+// appropriate release within the project context.
+func getRelease(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) == 0 || len(a) > 2 {
+			return nil, fmt.Errorf("expected 1-2 arguments, got %d", len(a))
+		}
+
+		feedURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		var desiredOrigin *kargoapi.FreightOrigin
+		if len(a) == 2 {
+			origin, ok := a[1].(kargoapi.FreightOrigin)
+			if !ok {
+				return nil, fmt.Errorf("second argument must be FreightOrigin, got %T", a[1])
+			}
+			desiredOrigin = &origin
+		}
+
+		return freight.FindRelease(
+			ctx,
+			c,
+			project,
+			freightReqs,
+			desiredOrigin,
+			freightRefs,
+			feedURL,
+		)
+	}
+}
+
 // getConfigMap returns a function that retrieves a ConfigMap by its name
 // within the specified project namespace. If the ConfigMap is not found,
 // it returns an empty map.
@@ -640,6 +640,312 @@ func Test_getChart(t *testing.T) {
 	}
 }
 
+func Test_getOCIArtifact(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, kargoapi.AddToScheme(scheme))
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		freightReqs []kargoapi.FreightRequest
+		freightRefs []kargoapi.FreightReference
+		args        []any
+		assertions  func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "repo URL only",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: "registry.example.com/bundle",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					OCIArtifacts: []kargoapi.OCIArtifact{
+						{
+							RepoURL: "registry.example.com/bundle",
+						},
+					},
+				},
+			},
+			args: []any{"registry.example.com/bundle"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				artifact, ok := result.(*kargoapi.OCIArtifact)
+				assert.True(t, ok)
+				assert.Equal(t, "registry.example.com/bundle", artifact.RepoURL)
+			},
+		},
+		{
+			name: "repo URL and origin",
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					OCIArtifacts: []kargoapi.OCIArtifact{
+						{
+							RepoURL: "registry.example.com/bundle",
+						},
+					},
+				},
+			},
+			args: []any{"registry.example.com/bundle", kargoapi.FreightOrigin{
+				Kind: "Warehouse",
+				Name: "fake-warehouse",
+			}},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				artifact, ok := result.(*kargoapi.OCIArtifact)
+				assert.True(t, ok)
+				assert.Equal(t, "registry.example.com/bundle", artifact.RepoURL)
+			},
+		},
+		{
+			name: "no arguments",
+			args: []any{},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "too many arguments",
+			args: []any{"registry.example.com/bundle", kargoapi.FreightOrigin{}, "extra"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid first argument type",
+			args: []any{123},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "first argument must be string")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid second argument type",
+			args: []any{"registry.example.com/bundle", "invalid"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "second argument must be FreightOrigin")
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			fn := getOCIArtifact(
+				ctx,
+				c,
+				testProject,
+				tt.freightReqs,
+				tt.freightRefs,
+			)
+
+			result, err := fn(tt.args...)
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_getRelease(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, kargoapi.AddToScheme(scheme))
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		freightReqs []kargoapi.FreightRequest
+		freightRefs []kargoapi.FreightReference
+		args        []any
+		assertions  func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "feed URL only",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								ReleaseFeed: &kargoapi.ReleaseFeedSubscription{
+									URL: "https://example.com/releases.json",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					Releases: []kargoapi.Release{
+						{
+							URL: "https://example.com/releases.json",
+						},
+					},
+				},
+			},
+			args: []any{"https://example.com/releases.json"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				release, ok := result.(*kargoapi.Release)
+				assert.True(t, ok)
+				assert.Equal(t, "https://example.com/releases.json", release.URL)
+			},
+		},
+		{
+			name: "feed URL and origin",
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					Releases: []kargoapi.Release{
+						{
+							URL: "https://example.com/releases.json",
+						},
+					},
+				},
+			},
+			args: []any{"https://example.com/releases.json", kargoapi.FreightOrigin{
+				Kind: "Warehouse",
+				Name: "fake-warehouse",
+			}},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				release, ok := result.(*kargoapi.Release)
+				assert.True(t, ok)
+				assert.Equal(t, "https://example.com/releases.json", release.URL)
+			},
+		},
+		{
+			name: "no arguments",
+			args: []any{},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "too many arguments",
+			args: []any{"https://example.com/releases.json", kargoapi.FreightOrigin{}, "extra"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid first argument type",
+			args: []any{123},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "first argument must be string")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid second argument type",
+			args: []any{"https://example.com/releases.json", "invalid"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "second argument must be FreightOrigin")
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			fn := getRelease(
+				ctx,
+				c,
+				testProject,
+				tt.freightReqs,
+				tt.freightRefs,
+			)
+
+			result, err := fn(tt.args...)
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
 func Test_getConfigMap(t *testing.T) {
 	const testProject = "fake-project"
 	const testConfigMap = "fake-configmap"
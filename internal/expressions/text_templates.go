@@ -0,0 +1,87 @@
+package expressions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/valyala/fasttemplate"
+)
+
+// EvaluateTextTemplate evaluates all expr-lang expressions offset by ${{ and }}
+// in the provided template, using the provided environment as context, and
+// returns the result as a string. Unlike EvaluateTemplate, no attempt is made
+// to interpret the result as a number, bool, or any other type, which makes
+// this function suitable for rendering the contents of arbitrary files.
+//
+// The string results of expressions are written to the output as is. All other
+// results are marshaled to JSON. Expressions that evaluate to nil, as
+// references to missing keys do, produce no output unless strict is true, in
+// which case an error is returned instead.
+func EvaluateTextTemplate(
+	template string,
+	env map[string]any,
+	strict bool,
+	exprOpts ...expr.Option,
+) (string, error) {
+	exprOpts = append(
+		exprOpts,
+		expr.Function(
+			"quote",
+			quoteFunc,
+			new(func(any) string),
+		),
+		expr.Function(
+			"unsafeQuote",
+			unsafeQuoteFunc,
+			new(func(any) string),
+		),
+	)
+	t, err := fasttemplate.NewTemplate(template, "${{", "}}")
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %w", err)
+	}
+	out := &bytes.Buffer{}
+	if _, err = t.ExecuteFunc(out, getTextExpressionEvaluator(env, strict, exprOpts...)); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// getTextExpressionEvaluator returns a fasttemplate.TagFunc that evaluates
+// input as a single expr-lang expression with the provided map as the
+// environment. If strict is true, expressions evaluating to nil result in an
+// error.
+func getTextExpressionEvaluator(
+	env map[string]any,
+	strict bool,
+	exprOpts ...expr.Option,
+) fasttemplate.TagFunc {
+	return func(out io.Writer, expression string) (int, error) {
+		program, err := expr.Compile(expression, exprOpts...)
+		if err != nil {
+			return 0, err
+		}
+		result, err := expr.Run(program, env)
+		if err != nil {
+			return 0, err
+		}
+		switch res := result.(type) {
+		case nil:
+			if strict {
+				return 0, fmt.Errorf("expression %q evaluated to nil", strings.TrimSpace(expression))
+			}
+			return 0, nil
+		case string:
+			return out.Write([]byte(res))
+		}
+		resJSON, err := json.Marshal(result)
+		if err != nil {
+			return 0, err
+		}
+		return out.Write(resJSON)
+	}
+}
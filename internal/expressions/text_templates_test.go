@@ -0,0 +1,85 @@
+package expressions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateTextTemplate(t *testing.T) {
+	testEnv := map[string]any{
+		"vars": map[string]any{
+			"name":     "api",
+			"replicas": 3,
+			"enabled":  true,
+			"ports":    []int{8080, 8081},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		template   string
+		strict     bool
+		assertions func(t *testing.T, result string, err error)
+	}{
+		{
+			name:     "template without expressions",
+			template: "replicas: 42\n",
+			assertions: func(t *testing.T, result string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "replicas: 42\n", result)
+			},
+		},
+		{
+			name:     "invalid expression",
+			template: "name: ${{ vars.name + }}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "expressions of various types",
+			template: `name: ${{ vars.name }}
+replicas: ${{ vars.replicas }}
+enabled: ${{ vars.enabled }}
+ports: ${{ vars.ports }}
+quoted: ${{ quote(vars.name) }}
+`,
+			assertions: func(t *testing.T, result string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`name: api
+replicas: 3
+enabled: true
+ports: [8080,8081]
+quoted: "api"
+`,
+					result,
+				)
+			},
+		},
+		{
+			name:     "missing key",
+			template: "tag: ${{ vars.tag }}",
+			assertions: func(t *testing.T, result string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "tag: ", result)
+			},
+		},
+		{
+			name:     "missing key in strict mode",
+			template: "tag: ${{ vars.tag }}",
+			strict:   true,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `expression "vars.tag" evaluated to nil`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := EvaluateTextTemplate(testCase.template, testEnv, testCase.strict)
+			testCase.assertions(t, result, err)
+		})
+	}
+}
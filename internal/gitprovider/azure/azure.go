@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
@@ -172,6 +173,11 @@ func (p *provider) ListPullRequests(
 
 	pts := []gitprovider.PullRequest{}
 	for _, adoPR := range *adoPRs {
+		// NB: The Azure DevOps API doesn't support filtering pull requests by
+		// label, so we have to filter client-side.
+		if !hasLabels(&adoPR, opts.Labels) {
+			continue
+		}
 		pr, err := convertADOPullRequest(&adoPR)
 		if err != nil {
 			return nil, fmt.Errorf("error converting pull request %d: %w", adoPR.PullRequestId, err)
@@ -192,21 +198,80 @@ func mapADOPrState(state gitprovider.PullRequestState) adogit.PullRequestStatus
 	return adogit.PullRequestStatusValues.All
 }
 
+// GetCommit implements gitprovider.Interface.
+func (p *provider) GetCommit(
+	ctx context.Context,
+	sha string,
+) (*gitprovider.Commit, error) {
+	gitClient, err := adogit.NewClient(ctx, p.connection)
+	if err != nil {
+		return nil, err
+	}
+	adoCommit, err := gitClient.GetCommit(ctx, adogit.GetCommitArgs{
+		CommitId:     &sha,
+		RepositoryId: &p.repo,
+		Project:      &p.project,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gitprovider.Commit{
+		SHA:       ptr.Deref(adoCommit.CommitId, sha),
+		Author:    convertADOUserDate(adoCommit.Author),
+		Committer: convertADOUserDate(adoCommit.Committer),
+	}, nil
+}
+
+func convertADOUserDate(user *adogit.GitUserDate) string {
+	if user == nil {
+		return ""
+	}
+	return gitprovider.FormatSignature(ptr.Deref(user.Name, ""), ptr.Deref(user.Email, ""))
+}
+
+// hasLabels returns true if the given pull request has all of the given
+// labels.
+func hasLabels(pr *adogit.GitPullRequest, labels []string) bool {
+	if len(labels) == 0 {
+		return true
+	}
+	if pr.Labels == nil {
+		return false
+	}
+	for _, label := range labels {
+		if !slices.ContainsFunc(*pr.Labels, func(l adocore.WebApiTagDefinition) bool {
+			return ptr.Deref(l.Name, "") == label
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
 // convertADOPullRequest converts an adogit.GitPullRequest to a gitprovider.PullRequest.
 func convertADOPullRequest(pr *adogit.GitPullRequest) (*gitprovider.PullRequest, error) {
 	if pr.LastMergeSourceCommit == nil {
 		return nil, fmt.Errorf("no last merge source commit found for pull request %d", ptr.Deref(pr.PullRequestId, 0))
 	}
 	mergeCommit := ptr.Deref(pr.LastMergeCommit, adogit.GitCommitRef{})
-	return &gitprovider.PullRequest{
+	providerPR := &gitprovider.PullRequest{
 		Number:         int64(ptr.Deref(pr.PullRequestId, 0)),
 		URL:            ptr.Deref(pr.Url, ""),
+		Title:          ptr.Deref(pr.Title, ""),
 		Open:           ptr.Deref(pr.Status, "notSet") == "active",
 		Merged:         ptr.Deref(pr.Status, "notSet") == "completed",
 		MergeCommitSHA: ptr.Deref(mergeCommit.CommitId, ""),
 		Object:         pr,
+		HeadBranch:     strings.TrimPrefix(ptr.Deref(pr.SourceRefName, ""), "refs/heads/"),
 		HeadSHA:        ptr.Deref(pr.LastMergeSourceCommit.CommitId, ""),
-	}, nil
+		FromFork:       pr.ForkSource != nil,
+	}
+	// NB: The Azure DevOps API doesn't expose the time a pull request was last
+	// updated.
+	if pr.CreationDate != nil {
+		providerPR.CreatedAt = &pr.CreationDate.Time
+	}
+	return providerPR, nil
 }
 
 func parseRepoURL(repoURL string) (string, string, string, error) {
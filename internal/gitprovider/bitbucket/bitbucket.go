@@ -274,6 +274,12 @@ func (p *provider) ListPullRequests(
 			continue
 		}
 
+		// NB: Bitbucket pull requests have no labels, so none of them can have
+		// all of the labels being filtered by.
+		if len(opts.Labels) > 0 {
+			continue
+		}
+
 		if bbPR.MergeCommit.Hash != "" {
 			fullSHA, err := p.getFullCommitSHA(ctx, bbPR.MergeCommit.Hash)
 			if err != nil {
@@ -289,6 +295,55 @@ func (p *provider) ListPullRequests(
 	return prs, nil
 }
 
+// GetCommit implements gitprovider.Interface.
+//
+// NB: The Bitbucket API only exposes the author of a commit and not its
+// committer, so the committer of the returned commit is always empty.
+func (p *provider) GetCommit(
+	ctx context.Context,
+	sha string,
+) (*gitprovider.Commit, error) {
+	commitOpts := &bitbucket.CommitsOptions{
+		Owner:    p.owner,
+		RepoSlug: p.repoSlug,
+		Revision: sha,
+	}
+	commitOpts.WithContext(ctx)
+
+	resp, err := p.client.GetCommit(commitOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("marshal commit response: %w", err)
+	}
+	var bbCommit bitbucketCommit
+	if err = json.Unmarshal(b, &bbCommit); err != nil {
+		return nil, fmt.Errorf("unmarshal commit response: %w", err)
+	}
+	if bbCommit.Hash == "" {
+		return nil, fmt.Errorf("commit response missing 'hash' field")
+	}
+	return &gitprovider.Commit{
+		SHA:    bbCommit.Hash,
+		Author: bbCommit.Author.Raw,
+	}, nil
+}
+
+// bitbucketCommit represents the structure of a Bitbucket commit.
+// See: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-commit-commit-get
+// nolint:lll
+type bitbucketCommit struct {
+	Hash   string `json:"hash"`
+	Author struct {
+		// Raw is the name and email address of the author, formatted as
+		// "Name <email>".
+		Raw string `json:"raw"`
+	} `json:"author"`
+}
+
 func (p *provider) getFullCommitSHA(ctx context.Context, shortSHA string) (string, error) {
 	if shortSHA == "" {
 		return "", nil
@@ -323,32 +378,34 @@ func (p *provider) getFullCommitSHA(ctx context.Context, shortSHA string) (strin
 // nolint:lll
 type bitbucketPR struct {
 	ID    int64  `json:"id"`
+	Title string `json:"title"`
 	State string `json:"state"`
 	Links struct {
 		HTML struct {
 			Href string `json:"href"`
 		} `json:"html"`
 	} `json:"links"`
-	Source struct {
-		Branch struct {
-			Name string `json:"name"`
-		} `json:"branch"`
-		Commit struct {
-			Hash string `json:"hash"`
-		} `json:"commit"`
-	} `json:"source"`
-	Destination struct {
-		Branch struct {
-			Name string `json:"name"`
-		} `json:"branch"`
-		Commit struct {
-			Hash string `json:"hash"`
-		} `json:"commit"`
-	} `json:"destination"`
+	Source      bitbucketPREndpoint `json:"source"`
+	Destination bitbucketPREndpoint `json:"destination"`
 	MergeCommit struct {
 		Hash string `json:"hash"`
 	} `json:"merge_commit"`
 	CreatedOn string `json:"created_on"`
+	UpdatedOn string `json:"updated_on"`
+}
+
+// bitbucketPREndpoint represents the source or destination of a Bitbucket pull
+// request.
+type bitbucketPREndpoint struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	Commit struct {
+		Hash string `json:"hash"`
+	} `json:"commit"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
 }
 
 // toBitbucketPR converts a raw response to a bitbucketPR type.
@@ -370,26 +427,35 @@ func toProviderPR(pr *bitbucketPR, raw any) *gitprovider.PullRequest {
 		return nil
 	}
 
-	var createdAt *time.Time
-	if ts, err := time.Parse("2006-01-02T15:04:05Z", pr.CreatedOn); err == nil {
-		createdAt = &ts
-	}
-
 	return &gitprovider.PullRequest{
 		Number: pr.ID,
 		URL:    pr.Links.HTML.Href,
+		Title:  pr.Title,
 		Open:   pr.State == prStateOpen,
 		Merged: pr.State == prStateMerged,
 		// NB: As a sign of true craftsmanship, or lack thereof, the Bitbucket
 		// API returns a short commit SHA as merge commit hash. To get the full
 		// commit SHA, we need to fetch the commit details separately.
 		MergeCommitSHA: pr.MergeCommit.Hash,
+		HeadBranch:     pr.Source.Branch.Name,
 		HeadSHA:        pr.Source.Commit.Hash,
-		CreatedAt:      createdAt,
+		FromFork:       pr.Source.Repository.FullName != pr.Destination.Repository.FullName,
+		CreatedAt:      parseTimestamp(pr.CreatedOn),
+		UpdatedAt:      parseTimestamp(pr.UpdatedOn),
 		Object:         raw,
 	}
 }
 
+// parseTimestamp parses a timestamp returned by the Bitbucket API. It returns
+// nil if the timestamp cannot be parsed.
+func parseTimestamp(s string) *time.Time {
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	return &ts
+}
+
 // parseRepoURL extracts host, owner and repo slug from a repository URL
 func parseRepoURL(repoURL string) (host, owner, slug string, err error) {
 	u, err := url.Parse(git.NormalizeURL(repoURL))
@@ -709,6 +709,73 @@ func TestListPullRequests(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, prs)
 	})
+
+	t.Run("filter by labels", func(t *testing.T) {
+		mockClient := &mockPullRequestClient{
+			listPullRequestsFunc: func(*bitbucket.PullRequestsOptions) (any, error) {
+				return map[string]any{"values": []any{
+					map[string]any{"id": int64(1), "state": prStateOpen},
+				}}, nil
+			},
+		}
+		provider := &provider{
+			owner:    "owner",
+			repoSlug: "repo",
+			client:   mockClient,
+		}
+
+		ctx := context.Background()
+		prs, err := provider.ListPullRequests(ctx, &gitprovider.ListPullRequestOptions{
+			Labels: []string{"preview"},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, prs)
+	})
+}
+
+func TestGetCommit(t *testing.T) {
+	t.Run("successful retrieval", func(t *testing.T) {
+		mockClient := &mockPullRequestClient{
+			getCommitFunc: func(opt *bitbucket.CommitsOptions) (any, error) {
+				assert.Equal(t, "sha", opt.Revision)
+				return map[string]any{
+					"hash": "sha",
+					"author": map[string]any{
+						"raw": "Alice <alice@example.com>",
+					},
+				}, nil
+			},
+		}
+		provider := &provider{
+			owner:    "owner",
+			repoSlug: "repo",
+			client:   mockClient,
+		}
+
+		commit, err := provider.GetCommit(context.Background(), "sha")
+		require.NoError(t, err)
+		assert.Equal(t, &gitprovider.Commit{
+			SHA:    "sha",
+			Author: "Alice <alice@example.com>",
+		}, commit)
+	})
+
+	t.Run("missing hash", func(t *testing.T) {
+		mockClient := &mockPullRequestClient{
+			getCommitFunc: func(*bitbucket.CommitsOptions) (any, error) {
+				return map[string]any{}, nil
+			},
+		}
+		provider := &provider{
+			owner:    "owner",
+			repoSlug: "repo",
+			client:   mockClient,
+		}
+
+		commit, err := provider.GetCommit(context.Background(), "sha")
+		assert.ErrorContains(t, err, "missing 'hash' field")
+		assert.Nil(t, commit)
+	})
 }
 
 func TestGetFullCommitSHA(t *testing.T) {
@@ -961,14 +1028,7 @@ func Test_toProviderPR(t *testing.T) {
 					Href: "https://bitbucket.org/owner/repo/pull-requests/1",
 				},
 			},
-			Source: struct {
-				Branch struct {
-					Name string `json:"name"`
-				} `json:"branch"`
-				Commit struct {
-					Hash string `json:"hash"`
-				} `json:"commit"`
-			}{
+			Source: bitbucketPREndpoint{
 				Branch: struct {
 					Name string `json:"name"`
 				}{
@@ -979,8 +1039,21 @@ func Test_toProviderPR(t *testing.T) {
 				}{
 					Hash: "abcdef1234567890",
 				},
+				Repository: struct {
+					FullName string `json:"full_name"`
+				}{
+					FullName: "fork/repo",
+				},
+			},
+			Destination: bitbucketPREndpoint{
+				Repository: struct {
+					FullName string `json:"full_name"`
+				}{
+					FullName: "owner/repo",
+				},
 			},
 			CreatedOn: "2023-01-01T12:00:00Z",
+			UpdatedOn: "2023-01-02T12:00:00.123456+00:00",
 		}
 
 		raw := map[string]any{"id": 1}
@@ -991,7 +1064,9 @@ func Test_toProviderPR(t *testing.T) {
 		assert.Equal(t, "abcdef1234567890", pr.HeadSHA)
 		assert.True(t, pr.Open)
 		assert.False(t, pr.Merged)
+		assert.True(t, pr.FromFork)
 		assert.NotNil(t, pr.CreatedAt)
+		assert.NotNil(t, pr.UpdatedAt)
 		assert.Equal(t, raw, pr.Object)
 	})
 
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	"code.gitea.io/sdk/gitea"
@@ -67,6 +68,13 @@ type giteaClient interface {
 		number int,
 		labels []string,
 	) ([]*gitea.Label, *gitea.Response, error)
+
+	GetCommit(
+		ctx context.Context,
+		owner string,
+		repo string,
+		sha string,
+	) (*gitea.Commit, *gitea.Response, error)
 }
 
 // provider is a Gitea implementation of gitprovider.Interface.
@@ -159,6 +167,15 @@ func (g giteaClientWrapper) AddLabelsToIssue(
 	return g.client.AddIssueLabels(owner, repo, int64(number), gitea.IssueLabelsOption{})
 }
 
+func (g giteaClientWrapper) GetCommit(
+	_ context.Context,
+	owner string,
+	repo string,
+	sha string,
+) (*gitea.Commit, *gitea.Response, error) {
+	return g.client.GetSingleCommit(owner, repo, sha)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -244,9 +261,12 @@ func (p *provider) ListPullRequests(
 			return nil, err
 		}
 		for _, giteaPR := range giteaPRs {
-			if opts.HeadCommit == "" || giteaPR.Head.Sha == opts.HeadCommit {
-				prs = append(prs, convertGiteaPR(*giteaPR))
+			// NB: The Gitea API doesn't support filtering pull requests by branch,
+			// commit, or label names, so we have to filter client-side.
+			if !matchesListOptions(giteaPR, opts) {
+				continue
 			}
+			prs = append(prs, convertGiteaPR(*giteaPR))
 		}
 		if res == nil || res.NextPage == 0 {
 			break
@@ -257,21 +277,84 @@ func (p *provider) ListPullRequests(
 	return prs, nil
 }
 
+// matchesListOptions returns true if the given pull request satisfies the
+// branch, commit, and label criteria of the given options.
+func matchesListOptions(
+	giteaPR *gitea.PullRequest,
+	opts *gitprovider.ListPullRequestOptions,
+) bool {
+	if opts.HeadBranch != "" && (giteaPR.Head == nil || giteaPR.Head.Ref != opts.HeadBranch) {
+		return false
+	}
+	if opts.BaseBranch != "" && (giteaPR.Base == nil || giteaPR.Base.Ref != opts.BaseBranch) {
+		return false
+	}
+	if opts.HeadCommit != "" && (giteaPR.Head == nil || giteaPR.Head.Sha != opts.HeadCommit) {
+		return false
+	}
+	for _, label := range opts.Labels {
+		if !slices.ContainsFunc(giteaPR.Labels, func(l *gitea.Label) bool {
+			return l.Name == label
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetCommit implements gitprovider.Interface.
+func (p *provider) GetCommit(
+	ctx context.Context,
+	sha string,
+) (*gitprovider.Commit, error) {
+	giteaCommit, _, err := p.client.GetCommit(ctx, p.owner, p.repo, sha)
+	if err != nil {
+		return nil, err
+	}
+	if giteaCommit == nil {
+		return nil, fmt.Errorf("unexpected nil commit")
+	}
+	commit := &gitprovider.Commit{SHA: sha}
+	if giteaCommit.CommitMeta != nil && giteaCommit.SHA != "" {
+		commit.SHA = giteaCommit.SHA
+	}
+	if giteaCommit.RepoCommit != nil {
+		commit.Author = convertGiteaCommitUser(giteaCommit.RepoCommit.Author)
+		commit.Committer = convertGiteaCommitUser(giteaCommit.RepoCommit.Committer)
+	}
+	return commit, nil
+}
+
+func convertGiteaCommitUser(user *gitea.CommitUser) string {
+	if user == nil {
+		return ""
+	}
+	return gitprovider.FormatSignature(user.Name, user.Email)
+}
+
 func convertGiteaPR(giteaPR gitea.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
-		Number:  giteaPR.Index,
-		URL:     giteaPR.URL,
-		Open:    giteaPR.State == gitea.StateOpen,
-		Merged:  giteaPR.HasMerged,
-		Object:  giteaPR,
-		HeadSHA: giteaPR.Head.Sha,
+		Number:     giteaPR.Index,
+		URL:        giteaPR.URL,
+		Title:      giteaPR.Title,
+		Open:       giteaPR.State == gitea.StateOpen,
+		Merged:     giteaPR.HasMerged,
+		Object:     giteaPR,
+		HeadBranch: giteaPR.Head.Ref,
+		HeadSHA:    giteaPR.Head.Sha,
 	}
 	if giteaPR.MergedCommitID != nil {
 		pr.MergeCommitSHA = *giteaPR.MergedCommitID
 	}
+	if giteaPR.Base != nil {
+		pr.FromFork = giteaPR.Head.RepoID != giteaPR.Base.RepoID
+	}
 	if giteaPR.Created != nil {
 		pr.CreatedAt = giteaPR.Created
 	}
+	if giteaPR.Updated != nil {
+		pr.UpdatedAt = giteaPR.Updated
+	}
 	return pr
 }
 
@@ -171,6 +171,26 @@ func (m *mockGiteaClient) CreatePullRequest(
 	return pr, resp, args.Error(2)
 }
 
+func (m *mockGiteaClient) GetCommit(
+	ctx context.Context,
+	owner string,
+	repo string,
+	sha string,
+) (*gitea.Commit, *gitea.Response, error) {
+	args := m.Called(ctx, owner, repo, sha)
+	m.owner = owner
+	m.repo = repo
+	commit, ok := args.Get(0).(*gitea.Commit)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*gitea.Response)
+	if !ok {
+		return commit, nil, args.Error(2)
+	}
+	return commit, resp, args.Error(2)
+}
+
 func TestCreatePullRequestWithLabels(t *testing.T) {
 	opts := gitprovider.CreatePullRequestOpts{
 		Head:        "feature-branch",
@@ -321,6 +341,7 @@ func TestListPullRequests(t *testing.T) {
 		State:      gitprovider.PullRequestStateAny,
 		HeadBranch: "head",
 		BaseBranch: "base",
+		Labels:     []string{"preview"},
 	}
 
 	// set up mock
@@ -328,10 +349,13 @@ func TestListPullRequests(t *testing.T) {
 		pr: &gitea.PullRequest{
 			Index: int64(42),
 			State: gitea.StateOpen,
+			Title: "title",
 			Head: &gitea.PRBranchInfo{
+				Ref: "head",
 				Sha: "HeadSha",
 			},
 			Base: &gitea.PRBranchInfo{
+				Ref: "base",
 				Sha: "BaseSha",
 			},
 			URL:            "http://localhost:8080",
@@ -347,20 +371,53 @@ func TestListPullRequests(t *testing.T) {
 			},
 		}).
 		Return(
-			[]*gitea.PullRequest{{
-				Index: int64(42),
-				State: gitea.StateOpen,
-				Head: &gitea.PRBranchInfo{
-					Sha: "HeadSha",
+			[]*gitea.PullRequest{
+				{
+					Index: int64(42),
+					Title: "title",
+					State: gitea.StateOpen,
+					Head: &gitea.PRBranchInfo{
+						Ref: "head",
+						Sha: "HeadSha",
+					},
+					Base: &gitea.PRBranchInfo{
+						Ref: "base",
+						Sha: "BaseSha",
+					},
+					Labels:         []*gitea.Label{{Name: "preview"}},
+					URL:            "http://localhost:8080",
+					MergedCommitID: ptr.To("BaseSha"),
+					HasMerged:      false,
+					Created:        &time.Time{},
 				},
-				Base: &gitea.PRBranchInfo{
-					Sha: "BaseSha",
+				{
+					// This pull request targets a different base branch and should be
+					// filtered out
+					Index: int64(43),
+					State: gitea.StateOpen,
+					Head: &gitea.PRBranchInfo{
+						Ref: "head",
+						Sha: "HeadSha",
+					},
+					Base: &gitea.PRBranchInfo{
+						Ref: "other",
+					},
+					Labels: []*gitea.Label{{Name: "preview"}},
 				},
-				URL:            "http://localhost:8080",
-				MergedCommitID: ptr.To("BaseSha"),
-				HasMerged:      false,
-				Created:        &time.Time{},
-			}},
+				{
+					// This pull request lacks the required label and should be
+					// filtered out
+					Index: int64(44),
+					State: gitea.StateOpen,
+					Head: &gitea.PRBranchInfo{
+						Ref: "head",
+						Sha: "HeadSha",
+					},
+					Base: &gitea.PRBranchInfo{
+						Ref: "base",
+					},
+				},
+			},
 			&gitea.Response{},
 			nil,
 		)
@@ -378,8 +435,43 @@ func TestListPullRequests(t *testing.T) {
 	require.Equal(t, testRepoOwner, mockClient.owner)
 	require.Equal(t, testRepoName, mockClient.repo)
 
+	require.Len(t, prs, 1)
 	require.Equal(t, mockClient.pr.Index, prs[0].Number)
+	require.Equal(t, mockClient.pr.Title, prs[0].Title)
+	require.Equal(t, mockClient.pr.Head.Ref, prs[0].HeadBranch)
+	require.False(t, prs[0].FromFork)
 	require.Equal(t, mockClient.pr.Base.Sha, prs[0].MergeCommitSHA)
 	require.Equal(t, mockClient.pr.URL, prs[0].URL)
 	require.True(t, prs[0].Open)
 }
+
+func TestGetCommit(t *testing.T) {
+	mockClient := &mockGiteaClient{}
+	mockClient.
+		On("GetCommit", context.Background(), testRepoOwner, testRepoName, "sha").
+		Return(
+			&gitea.Commit{
+				CommitMeta: &gitea.CommitMeta{SHA: "sha"},
+				RepoCommit: &gitea.RepoCommit{
+					Author: &gitea.CommitUser{
+						Identity: gitea.Identity{Name: "Alice", Email: "alice@example.com"},
+					},
+				},
+			},
+			&gitea.Response{},
+			nil,
+		)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+
+	commit, err := g.GetCommit(context.Background(), "sha")
+	require.NoError(t, err)
+	require.Equal(t, &gitprovider.Commit{
+		SHA:    "sha",
+		Author: "Alice <alice@example.com>",
+	}, commit)
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/google/go-github/v71/github"
@@ -70,6 +71,13 @@ type githubClient interface {
 		number int,
 		labels []string,
 	) ([]*github.Label, *github.Response, error)
+
+	GetCommit(
+		ctx context.Context,
+		owner string,
+		repo string,
+		sha string,
+	) (*github.Commit, *github.Response, error)
 }
 
 // provider is a GitHub implementation of gitprovider.Interface.
@@ -164,6 +172,15 @@ func (g githubClientWrapper) AddLabelsToIssue(
 	return g.client.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
 }
 
+func (g githubClientWrapper) GetCommit(
+	ctx context.Context,
+	owner string,
+	repo string,
+	sha string,
+) (*github.Commit, *github.Response, error) {
+	return g.client.Git.GetCommit(ctx, owner, repo, sha)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -255,9 +272,15 @@ func (p *provider) ListPullRequests(
 			return nil, err
 		}
 		for _, ghPR := range ghPRs {
-			if opts.HeadCommit == "" || ptr.Deref(ghPR.Head.SHA, "") == opts.HeadCommit {
-				prs = append(prs, convertGithubPR(*ghPR))
+			// NB: The GitHub API doesn't support filtering pull requests by head
+			// commit or labels, so we have to filter client-side.
+			if opts.HeadCommit != "" && ptr.Deref(ghPR.Head.SHA, "") != opts.HeadCommit {
+				continue
+			}
+			if !hasLabels(ghPR, opts.Labels) {
+				continue
 			}
+			prs = append(prs, convertGithubPR(*ghPR))
 		}
 		if res == nil || res.NextPage == 0 {
 			break
@@ -268,22 +291,71 @@ func (p *provider) ListPullRequests(
 	return prs, nil
 }
 
+// GetCommit implements gitprovider.Interface.
+func (p *provider) GetCommit(
+	ctx context.Context,
+	sha string,
+) (*gitprovider.Commit, error) {
+	ghCommit, _, err := p.client.GetCommit(ctx, p.owner, p.repo, sha)
+	if err != nil {
+		return nil, err
+	}
+	if ghCommit == nil {
+		return nil, fmt.Errorf("unexpected nil commit")
+	}
+	return &gitprovider.Commit{
+		SHA:       ptr.Deref(ghCommit.SHA, sha),
+		Author:    convertGithubCommitAuthor(ghCommit.Author),
+		Committer: convertGithubCommitAuthor(ghCommit.Committer),
+	}, nil
+}
+
+func convertGithubCommitAuthor(author *github.CommitAuthor) string {
+	if author == nil {
+		return ""
+	}
+	return gitprovider.FormatSignature(author.GetName(), author.GetEmail())
+}
+
 func convertGithubPR(ghPR github.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
 		Number:         int64(ptr.Deref(ghPR.Number, 0)),
 		URL:            ptr.Deref(ghPR.HTMLURL, ""),
+		Title:          ptr.Deref(ghPR.Title, ""),
 		Open:           ptr.Deref(ghPR.State, "closed") == "open",
 		Merged:         ghPR.MergedAt != nil,
 		MergeCommitSHA: ptr.Deref(ghPR.MergeCommitSHA, ""),
 		Object:         ghPR,
-		HeadSHA:        ptr.Deref(ghPR.Head.SHA, ""),
+	}
+	if ghPR.Head != nil {
+		pr.HeadBranch = ptr.Deref(ghPR.Head.Ref, "")
+		pr.HeadSHA = ptr.Deref(ghPR.Head.SHA, "")
+		if ghPR.Base != nil && ghPR.Head.Repo != nil && ghPR.Base.Repo != nil {
+			pr.FromFork = ghPR.Head.Repo.GetID() != ghPR.Base.Repo.GetID()
+		}
 	}
 	if ghPR.CreatedAt != nil {
 		pr.CreatedAt = &ghPR.CreatedAt.Time
 	}
+	if ghPR.UpdatedAt != nil {
+		pr.UpdatedAt = &ghPR.UpdatedAt.Time
+	}
 	return pr
 }
 
+// hasLabels returns true if the given pull request has all of the given
+// labels.
+func hasLabels(ghPR *github.PullRequest, labels []string) bool {
+	for _, label := range labels {
+		if !slices.ContainsFunc(ghPR.Labels, func(l *github.Label) bool {
+			return l.GetName() == label
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
 func parseRepoURL(repoURL string) (string, string, string, string, error) {
 	u, err := url.Parse(git.NormalizeURL(repoURL))
 	if err != nil {
@@ -178,6 +178,26 @@ func (m *mockGithubClient) CreatePullRequest(
 	return pr, resp, args.Error(2)
 }
 
+func (m *mockGithubClient) GetCommit(
+	ctx context.Context,
+	owner string,
+	repo string,
+	sha string,
+) (*github.Commit, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, sha)
+	m.owner = owner
+	m.repo = repo
+	commit, ok := args.Get(0).(*github.Commit)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*github.Response)
+	if !ok {
+		return commit, nil, args.Error(2)
+	}
+	return commit, resp, args.Error(2)
+}
+
 func TestCreatePullRequestWithLabels(t *testing.T) {
 	opts := gitprovider.CreatePullRequestOpts{
 		Head:        "feature-branch",
@@ -308,12 +328,14 @@ func TestListPullRequests(t *testing.T) {
 		State:      gitprovider.PullRequestStateAny,
 		HeadBranch: "head",
 		BaseBranch: "base",
+		Labels:     []string{"preview"},
 	}
 
 	// set up mock
 	mockClient := &mockGithubClient{
 		pr: &github.PullRequest{
 			Number:         github.Ptr(42),
+			Title:          github.Ptr("title"),
 			MergeCommitSHA: github.Ptr("sha"),
 			State:          github.Ptr("open"),
 			URL:            github.Ptr("url"),
@@ -332,15 +354,37 @@ func TestListPullRequests(t *testing.T) {
 			},
 		}).
 		Return(
-			[]*github.PullRequest{{
-				Number: mockClient.pr.Number,
-				Head: &github.PullRequestBranch{
-					Ref: github.Ptr("head"),
+			[]*github.PullRequest{
+				{
+					Number: mockClient.pr.Number,
+					Title:  mockClient.pr.Title,
+					Head: &github.PullRequestBranch{
+						Ref:  github.Ptr("head"),
+						Repo: &github.Repository{ID: github.Ptr(int64(2))},
+					},
+					Base: &github.PullRequestBranch{
+						Ref:  github.Ptr("base"),
+						Repo: &github.Repository{ID: github.Ptr(int64(1))},
+					},
+					UpdatedAt: &github.Timestamp{},
+					Labels: []*github.Label{
+						{Name: github.Ptr("preview")},
+						{Name: github.Ptr("other")},
+					},
+					MergeCommitSHA: mockClient.pr.MergeCommitSHA,
+					State:          mockClient.pr.State,
+					HTMLURL:        mockClient.pr.URL,
 				},
-				MergeCommitSHA: mockClient.pr.MergeCommitSHA,
-				State:          mockClient.pr.State,
-				HTMLURL:        mockClient.pr.URL,
-			}},
+				{
+					// This pull request lacks the required label and should be
+					// filtered out
+					Number: github.Ptr(43),
+					Head: &github.PullRequestBranch{
+						Ref: github.Ptr("head"),
+					},
+					State: mockClient.pr.State,
+				},
+			},
 			&github.Response{},
 			nil,
 		)
@@ -360,8 +404,48 @@ func TestListPullRequests(t *testing.T) {
 	require.Equal(t, opts.HeadBranch, mockClient.listOpts.Head)
 	require.Equal(t, opts.BaseBranch, mockClient.listOpts.Base)
 
+	require.Len(t, prs, 1)
 	require.Equal(t, int64(*mockClient.pr.Number), prs[0].Number)
+	require.Equal(t, *mockClient.pr.Title, prs[0].Title)
+	require.Equal(t, "head", prs[0].HeadBranch)
+	require.True(t, prs[0].FromFork)
+	require.NotNil(t, prs[0].UpdatedAt)
 	require.Equal(t, *mockClient.pr.MergeCommitSHA, prs[0].MergeCommitSHA)
 	require.Equal(t, *mockClient.pr.URL, prs[0].URL)
 	require.True(t, prs[0].Open)
 }
+
+func TestGetCommit(t *testing.T) {
+	mockClient := &mockGithubClient{}
+	mockClient.
+		On("GetCommit", context.Background(), testRepoOwner, testRepoName, "sha").
+		Return(
+			&github.Commit{
+				SHA: github.Ptr("sha"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Alice"),
+					Email: github.Ptr("alice@example.com"),
+				},
+				Committer: &github.CommitAuthor{
+					Name:  github.Ptr("Bob"),
+					Email: github.Ptr("bob@example.com"),
+				},
+			},
+			&github.Response{},
+			nil,
+		)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+
+	commit, err := g.GetCommit(context.Background(), "sha")
+	require.NoError(t, err)
+	require.Equal(t, &gitprovider.Commit{
+		SHA:       "sha",
+		Author:    "Alice <alice@example.com>",
+		Committer: "Bob <bob@example.com>",
+	}, commit)
+}
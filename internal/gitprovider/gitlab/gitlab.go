@@ -61,10 +61,20 @@ type mergeRequestClient interface {
 	) (*gitlab.MergeRequest, *gitlab.Response, error)
 }
 
+type commitClient interface {
+	GetCommit(
+		pid any,
+		sha string,
+		opt *gitlab.GetCommitOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Commit, *gitlab.Response, error)
+}
+
 // provider is a GitLab-based implementation of gitprovider.Interface.
 type provider struct { // nolint: revive
-	projectName string
-	client      mergeRequestClient
+	projectName  string
+	client       mergeRequestClient
+	commitClient commitClient
 }
 
 // NewProvider returns a GitLab-based implementation of gitprovider.Interface.
@@ -106,8 +116,9 @@ func NewProvider(
 	}
 
 	return &provider{
-		projectName: projectName,
-		client:      client.MergeRequests,
+		projectName:  projectName,
+		client:       client.MergeRequests,
+		commitClient: client.Commits,
 	}, nil
 }
 
@@ -177,6 +188,10 @@ func (p *provider) ListPullRequests(
 			PerPage: 100,
 		},
 	}
+	if len(opts.Labels) > 0 {
+		labels := gitlab.LabelOptions(opts.Labels)
+		listOpts.Labels = &labels
+	}
 	var prs []gitprovider.PullRequest
 	for {
 		glMRs, res, err := p.client.ListProjectMergeRequests(p.projectName, listOpts)
@@ -198,16 +213,39 @@ func (p *provider) ListPullRequests(
 	return prs, nil
 }
 
+// GetCommit implements gitprovider.Interface.
+func (p *provider) GetCommit(
+	_ context.Context,
+	sha string,
+) (*gitprovider.Commit, error) {
+	glCommit, _, err := p.commitClient.GetCommit(p.projectName, sha, nil)
+	if err != nil {
+		return nil, err
+	}
+	if glCommit == nil {
+		return nil, fmt.Errorf("unexpected nil commit")
+	}
+	return &gitprovider.Commit{
+		SHA:       glCommit.ID,
+		Author:    gitprovider.FormatSignature(glCommit.AuthorName, glCommit.AuthorEmail),
+		Committer: gitprovider.FormatSignature(glCommit.CommitterName, glCommit.CommitterEmail),
+	}, nil
+}
+
 func convertGitlabMR(glMR gitlab.BasicMergeRequest) gitprovider.PullRequest {
 	return gitprovider.PullRequest{
 		Number:         int64(glMR.IID),
 		URL:            glMR.WebURL,
+		Title:          glMR.Title,
 		Open:           isMROpen(glMR),
 		Merged:         glMR.State == "merged",
 		MergeCommitSHA: glMR.MergeCommitSHA,
 		Object:         glMR,
+		HeadBranch:     glMR.SourceBranch,
 		HeadSHA:        glMR.SHA,
+		FromFork:       glMR.SourceProjectID != glMR.TargetProjectID,
 		CreatedAt:      glMR.CreatedAt,
+		UpdatedAt:      glMR.UpdatedAt,
 	}
 }
 
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -49,6 +50,23 @@ func (m *mockGitLabClient) GetMergeRequest(
 	return m.mr, nil, nil
 }
 
+type mockGitLabCommitClient struct {
+	commit *gitlab.Commit
+	pid    any
+	sha    string
+}
+
+func (m *mockGitLabCommitClient) GetCommit(
+	pid any,
+	sha string,
+	_ *gitlab.GetCommitOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.Commit, *gitlab.Response, error) {
+	m.pid = pid
+	m.sha = sha
+	return m.commit, nil, nil
+}
+
 func TestCreatePullRequest(t *testing.T) {
 	mockClient := &mockGitLabClient{
 		mr: &gitlab.MergeRequest{
@@ -116,10 +134,15 @@ func TestListPullRequests(t *testing.T) {
 	mockClient := &mockGitLabClient{
 		mr: &gitlab.MergeRequest{
 			BasicMergeRequest: gitlab.BasicMergeRequest{
-				IID:            1,
-				MergeCommitSHA: "sha",
-				State:          "merged",
-				WebURL:         "url",
+				IID:             1,
+				Title:           "title",
+				MergeCommitSHA:  "sha",
+				SourceBranch:    "head",
+				State:           "merged",
+				WebURL:          "url",
+				SourceProjectID: 2,
+				TargetProjectID: 1,
+				UpdatedAt:       &time.Time{},
 			},
 		},
 	}
@@ -132,6 +155,7 @@ func TestListPullRequests(t *testing.T) {
 		State:      gitprovider.PullRequestStateAny,
 		HeadBranch: "head",
 		BaseBranch: "base",
+		Labels:     []string{"preview"},
 	}
 	prs, err := g.ListPullRequests(context.Background(), &opts)
 	require.NoError(t, err)
@@ -139,13 +163,45 @@ func TestListPullRequests(t *testing.T) {
 	require.Equal(t, testProjectName, mockClient.pid)
 	require.Equal(t, opts.HeadBranch, *mockClient.listOpts.SourceBranch)
 	require.Equal(t, opts.BaseBranch, *mockClient.listOpts.TargetBranch)
+	require.Equal(t, gitlab.LabelOptions(opts.Labels), *mockClient.listOpts.Labels)
 
 	require.Equal(t, int64(mockClient.mr.IID), prs[0].Number)
+	require.Equal(t, mockClient.mr.Title, prs[0].Title)
+	require.Equal(t, mockClient.mr.SourceBranch, prs[0].HeadBranch)
+	require.True(t, prs[0].FromFork)
+	require.Equal(t, mockClient.mr.UpdatedAt, prs[0].UpdatedAt)
 	require.Equal(t, mockClient.mr.MergeCommitSHA, prs[0].MergeCommitSHA)
 	require.Equal(t, mockClient.mr.WebURL, prs[0].URL)
 	require.False(t, prs[0].Open)
 }
 
+func TestGetCommit(t *testing.T) {
+	mockClient := &mockGitLabCommitClient{
+		commit: &gitlab.Commit{
+			ID:             "sha",
+			AuthorName:     "Alice",
+			AuthorEmail:    "alice@example.com",
+			CommitterName:  "Bob",
+			CommitterEmail: "bob@example.com",
+		},
+	}
+	g := provider{
+		projectName:  testProjectName,
+		commitClient: mockClient,
+	}
+
+	commit, err := g.GetCommit(context.Background(), "sha")
+	require.NoError(t, err)
+
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, "sha", mockClient.sha)
+	require.Equal(t, &gitprovider.Commit{
+		SHA:       "sha",
+		Author:    "Alice <alice@example.com>",
+		Committer: "Bob <bob@example.com>",
+	}, commit)
+}
+
 func TestParseGitLabURL(t *testing.T) {
 	const expectedProjectName = "akuity/kargo"
 	testCases := []struct {
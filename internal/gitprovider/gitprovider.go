@@ -2,6 +2,7 @@ package gitprovider
 
 import (
 	"context"
+	"fmt"
 	"time"
 )
 
@@ -51,6 +52,9 @@ type Interface interface {
 	// to differences in the underlying provider APIs. It is the responsibility of
 	// the caller to sort the results as needed.
 	ListPullRequests(context.Context, *ListPullRequestOptions) ([]PullRequest, error)
+
+	// GetCommit gets an existing commit by SHA.
+	GetCommit(context.Context, string) (*Commit, error)
 }
 
 // CreatePullRequestOpts encapsulates the options used when creating a pull
@@ -83,6 +87,10 @@ type ListPullRequestOptions struct {
 	// BaseBranch is the name of the target branch. A non-empty value will limit
 	// results to pull requests with the given target branch.
 	BaseBranch string
+	// Labels is a list of labels. A non-empty value will limit results to pull
+	// requests having all of the given labels. Implementations that do not
+	// support labels return an error if this is non-empty.
+	Labels []string
 }
 
 // PullRequest is an abstracted representation of a Git hosting provider's pull
@@ -93,6 +101,8 @@ type PullRequest struct {
 	Number int64 `json:"id"`
 	// URL is the URL to the pull request.
 	URL string `json:"url"`
+	// Title is the title of the pull request.
+	Title string `json:"title"`
 	// Open is true if the pull request is logically open. Depending on the
 	// underlying Git hosting provider, this may encompass pull requests in other
 	// states such as "draft" or "ready for review".
@@ -103,14 +113,45 @@ type PullRequest struct {
 	MergeCommitSHA string `json:"mergeCommitSHA"`
 	// Object is the underlying object from the Git hosting provider.
 	Object any `json:"-"`
+	// HeadBranch is the name of the source branch.
+	HeadBranch string `json:"headBranch"`
 	// HeadSHA is the SHA of the commit at the head of the source branch.
 	HeadSHA string `json:"headSHA"`
+	// FromFork is true if the source branch of the pull request belongs to a
+	// fork of the repository rather than to the repository itself.
+	FromFork bool `json:"fromFork"`
 	// CreatedAt is the time the pull request was created.
 	CreatedAt *time.Time `json:"createdAt"`
+	// UpdatedAt is the time the pull request was last updated. Depending on the
+	// underlying Git hosting provider, this may be nil.
+	UpdatedAt *time.Time `json:"updatedAt"`
 }
 
 // Fake is a fake implementation of the provider Interface used to facilitate
 // testing.
+// Commit is an abstracted representation of a Git hosting provider's commit
+// object.
+type Commit struct {
+	// SHA is the SHA of the commit.
+	SHA string `json:"sha"`
+	// Author is the name and email address of the author of the commit. It is
+	// empty if the Git hosting provider does not expose the author.
+	Author string `json:"author"`
+	// Committer is the name and email address of the committer of the commit.
+	// It is empty if the Git hosting provider does not expose the committer.
+	Committer string `json:"committer"`
+}
+
+// FormatSignature formats the given name and email address of a commit author
+// or committer the same way Git does, i.e. "Name <email>". An empty string is
+// returned if both are empty.
+func FormatSignature(name, email string) string {
+	if name == "" && email == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s <%s>", name, email)
+}
+
 type Fake struct {
 	// CreatePullRequestFn defines the functionality of the CreatePullRequest
 	// method.
@@ -126,6 +167,8 @@ type Fake struct {
 		context.Context,
 		*ListPullRequestOptions,
 	) ([]PullRequest, error)
+	// GetCommitFn defines the functionality of the GetCommit method.
+	GetCommitFn func(context.Context, string) (*Commit, error)
 }
 
 // CreatePullRequest implements gitprovider.Interface.
@@ -151,3 +194,8 @@ func (f *Fake) ListPullRequests(
 ) ([]PullRequest, error) {
 	return f.ListPullRequestsFn(ctx, opts)
 }
+
+// GetCommit implements gitprovider.Interface.
+func (f *Fake) GetCommit(ctx context.Context, sha string) (*Commit, error) {
+	return f.GetCommitFn(ctx, sha)
+}
@@ -0,0 +1,197 @@
+package hcl
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Update represents a discrete update to be made to an HCL document.
+type Update struct {
+	// Key is the dot-separated path to the value to update.
+	Key string
+	// Value is the new value to set for the key.
+	Value any
+}
+
+// SetValuesInFile overwrites the specified file with the changes specified by
+// the list of Updates. Keys are of the form <key 0>.<key 1>...<key n>. Each key
+// addresses an attribute, a block type or a block label, or, within the value
+// of an attribute, an object attribute. Integers may be used as keys in cases
+// where a specific element needs to be selected from a tuple. An error is
+// returned for any attempted update to a key that does not exist or does not
+// address a literal value. Importantly, all comments and style choices in the
+// file are preserved.
+func SetValuesInFile(file string, updates []Update) error {
+	inBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	outBytes, err := SetValuesInBytes(inBytes, updates)
+	if err != nil {
+		return fmt.Errorf("error mutating bytes: %w", err)
+	}
+	// This file should always exist already, so the permissions we choose here
+	// don't really matter.
+	if err = os.WriteFile(file, outBytes, 0600); err != nil {
+		return fmt.Errorf("error writing mutated bytes to file %q: %w", file, err)
+	}
+	return nil
+}
+
+// SetValuesInBytes returns a copy of the provided bytes with the changes
+// specified by Updates applied. Keys are of the form <key 0>.<key 1>...<key n>.
+// Each key addresses an attribute, a block type or a block label, or, within
+// the value of an attribute, an object attribute. Integers may be used as keys
+// in cases where a specific element needs to be selected from a tuple. An
+// error is returned for any attempted update to a key that does not exist or
+// does not address a literal value. Importantly, all comments and style
+// choices in the input bytes are preserved in the output.
+func SetValuesInBytes(inBytes []byte, updates []Update) ([]byte, error) {
+	file, diags := hclsyntax.ParseConfig(inBytes, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("error parsing input: %w", diags)
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("unexpected body type %T", file.Body)
+	}
+
+	changes := make(map[int]change, len(updates))
+	for _, update := range updates {
+		rng, err := findLiteralInBody(body, strings.Split(update.Key, "."))
+		if err != nil {
+			return nil, fmt.Errorf("error finding key %s: %w", update.Key, err)
+		}
+		value, err := formatValue(update.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error formatting value for key %s: %w", update.Key, err)
+		}
+		changes[rng.Start.Byte] = change{rng: rng, value: value}
+	}
+
+	// Apply the changes from the end of the input to its beginning, so the
+	// offsets of changes that are yet to be applied remain valid.
+	offsets := make([]int, 0, len(changes))
+	for offset := range changes {
+		offsets = append(offsets, offset)
+	}
+	slices.Sort(offsets)
+	outBytes := slices.Clone(inBytes)
+	for _, offset := range slices.Backward(offsets) {
+		c := changes[offset]
+		outBytes = slices.Replace(outBytes, c.rng.Start.Byte, c.rng.End.Byte, c.value...)
+	}
+	return outBytes, nil
+}
+
+// change represents the replacement of the bytes in a range of the input.
+type change struct {
+	rng   hcl.Range
+	value []byte
+}
+
+// findLiteralInBody returns the range of the literal value addressed by the
+// provided key path within the provided body.
+func findLiteralInBody(body *hclsyntax.Body, keyPath []string) (hcl.Range, error) {
+	if len(keyPath) == 0 {
+		return hcl.Range{}, fmt.Errorf("key path does not address a literal value")
+	}
+	if attr, ok := body.Attributes[keyPath[0]]; ok {
+		return findLiteralInExpr(attr.Expr, keyPath[1:])
+	}
+	for _, block := range body.Blocks {
+		if block.Type != keyPath[0] || len(keyPath) < len(block.Labels)+1 ||
+			!slices.Equal(block.Labels, keyPath[1:len(block.Labels)+1]) {
+			continue
+		}
+		if rng, err := findLiteralInBody(block.Body, keyPath[len(block.Labels)+1:]); err == nil {
+			return rng, nil
+		}
+	}
+	return hcl.Range{}, fmt.Errorf("key path not found")
+}
+
+// findLiteralInExpr returns the range of the literal value addressed by the
+// provided key path within the provided expression.
+func findLiteralInExpr(expr hclsyntax.Expression, keyPath []string) (hcl.Range, error) {
+	if len(keyPath) == 0 {
+		if !isLiteral(expr) {
+			return hcl.Range{}, fmt.Errorf("key path does not address a literal value")
+		}
+		return expr.Range(), nil
+	}
+	switch e := expr.(type) {
+	case *hclsyntax.ObjectConsExpr:
+		for _, item := range e.Items {
+			if key, ok := objectKey(item.KeyExpr); ok && key == keyPath[0] {
+				return findLiteralInExpr(item.ValueExpr, keyPath[1:])
+			}
+		}
+	case *hclsyntax.TupleConsExpr:
+		index, err := strconv.Atoi(keyPath[0])
+		if err != nil {
+			return hcl.Range{}, err
+		}
+		if index < 0 || index >= len(e.Exprs) {
+			return hcl.Range{}, fmt.Errorf("index %d is out of range", index)
+		}
+		return findLiteralInExpr(e.Exprs[index], keyPath[1:])
+	}
+	return hcl.Range{}, fmt.Errorf("key path not found")
+}
+
+// objectKey returns the name of the provided object attribute key expression,
+// if it is a literal.
+func objectKey(expr hclsyntax.Expression) (string, bool) {
+	if keyword := hcl.ExprAsKeyword(expr); keyword != "" {
+		return keyword, true
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || !val.IsKnown() || val.IsNull() || val.Type() != cty.String {
+		return "", false
+	}
+	return val.AsString(), true
+}
+
+// isLiteral returns true if the provided expression is a literal, i.e. a
+// number, a boolean, null or a string without any interpolation.
+func isLiteral(expr hclsyntax.Expression) bool {
+	switch e := expr.(type) {
+	case *hclsyntax.LiteralValueExpr:
+		return true
+	case *hclsyntax.TemplateExpr:
+		return len(e.Parts) == 0 || e.IsStringLiteral()
+	case *hclsyntax.UnaryOpExpr:
+		// Negative numbers are represented as negated literals.
+		return e.Op == hclsyntax.OpNegate && isLiteral(e.Val)
+	}
+	return false
+}
+
+// formatValue returns the HCL representation of the provided value.
+func formatValue(value any) ([]byte, error) {
+	var val cty.Value
+	switch v := value.(type) {
+	case string:
+		val = cty.StringVal(v)
+	case bool:
+		val = cty.BoolVal(v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		var err error
+		if val, err = cty.ParseNumberVal(fmt.Sprint(v)); err != nil {
+			return nil, fmt.Errorf("value %v can not be represented in HCL: %w", v, err)
+		}
+	default:
+		return nil, fmt.Errorf("value of type %T is not a scalar", value)
+	}
+	return hclwrite.TokensForValue(val).Bytes(), nil
+}
@@ -0,0 +1,128 @@
+package hcl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetValuesInBytes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		updates    []Update
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:    "invalid HCL",
+			inBytes: []byte(`image_tag = `),
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error parsing input")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "key not found",
+			inBytes: []byte(`image_tag = "v1.0.0"`),
+			updates: []Update{{Key: "image_digest", Value: "sha256:abc"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error finding key image_digest")
+				require.ErrorContains(t, err, "key path not found")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "key addresses an interpolated string",
+			inBytes: []byte(`image = "ghcr.io/example/api:${var.tag}"`),
+			updates: []Update{{Key: "image", Value: "ghcr.io/example/api:v2.0.0"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "does not address a literal value")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "value is not a scalar",
+			inBytes: []byte(`image_tag = "v1.0.0"`),
+			updates: []Update{{Key: "image_tag", Value: []string{"v2.0.0"}}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "is not a scalar")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "success",
+			inBytes: []byte(`# Image settings
+image_tag     = "v1.0.0" # The tag to deploy
+replicas      = 1
+offset        = -1
+debug         = false
+images = {
+  api    = "v1.0.0"
+  "web"  = "v1.0.0"
+  worker = "v1.0.0"
+}
+zones = ["us-east-1a", "us-east-1b"]
+
+variable "image_tag" {
+  type    = string
+  default = "v1.0.0"
+}
+
+module "app" {
+  source = "./app"
+  config = {
+    tag = "v1.0.0"
+  }
+}
+`),
+			updates: []Update{
+				{Key: "image_tag", Value: "v2.0.0"},
+				{Key: "replicas", Value: float64(3)},
+				{Key: "offset", Value: 2},
+				{Key: "debug", Value: true},
+				{Key: "images.api", Value: "v2.0.0"},
+				{Key: "images.web", Value: "${not-interpolated}"},
+				{Key: "zones.1", Value: "us-east-1c"},
+				{Key: "variable.image_tag.default", Value: "v2.0.0"},
+				{Key: "module.app.config.tag", Value: "v2.0.0"},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`# Image settings
+image_tag     = "v2.0.0" # The tag to deploy
+replicas      = 3
+offset        = 2
+debug         = true
+images = {
+  api    = "v2.0.0"
+  "web"  = "$${not-interpolated}"
+  worker = "v1.0.0"
+}
+zones = ["us-east-1a", "us-east-1c"]
+
+variable "image_tag" {
+  type    = string
+  default = "v2.0.0"
+}
+
+module "app" {
+  source = "./app"
+  config = {
+    tag = "v2.0.0"
+  }
+}
+`,
+					string(bytes),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := SetValuesInBytes(testCase.inBytes, testCase.updates)
+			testCase.assertions(t, b, err)
+		})
+	}
+}
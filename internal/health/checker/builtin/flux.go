@@ -0,0 +1,376 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	helm "github.com/akuity/kargo/internal/controller/flux/api/helm/v2"
+	kustomize "github.com/akuity/kargo/internal/controller/flux/api/kustomize/v1"
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+	source "github.com/akuity/kargo/internal/controller/flux/api/source/v1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+const fluxResourceStatusesKey = "resourceStatuses"
+
+// FluxHealthInput is the input for a health check associated with the
+// flux-update step.
+type FluxHealthInput struct {
+	// Resources is a list of health checks to perform on specific Flux
+	// resources.
+	Resources []FluxResourceHealthCheck `json:"resources"`
+}
+
+// FluxResourceHealthCheck is the configuration for a health check on a single
+// Flux resource.
+type FluxResourceHealthCheck struct {
+	// Kind is the kind of the Flux resource to check. Supported kinds are
+	// GitRepository, HelmRelease, Kustomization and OCIRepository.
+	Kind string `json:"kind"`
+	// Name is the name of the Flux resource to check.
+	Name string `json:"name"`
+	// Namespace is the namespace of the Flux resource to check.
+	Namespace string `json:"namespace"`
+	// DesiredRevision is the revision the Flux resource is expected to have
+	// reconciled. For sources, this is compared to the revision of their
+	// artifact. For HelmReleases, it is compared to the last attempted chart
+	// version.
+	DesiredRevision string `json:"desiredRevision,omitempty"`
+	// ReconcileRequestedAt is the token of the reconciliation request that must
+	// have been handled by the Flux resource before its health can be assessed.
+	ReconcileRequestedAt string `json:"reconcileRequestedAt,omitempty"`
+}
+
+// FluxResourceStatus describes the current state of a single Flux resource.
+type FluxResourceStatus struct {
+	// Kind is the kind of the Flux resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the Flux resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource.
+	Name string `json:"name"`
+	// Revision is the revision the Flux resource last reconciled. For sources,
+	// this is the revision of their artifact. For Kustomizations, this is the
+	// last applied revision. For HelmReleases, this is the last attempted chart
+	// version.
+	Revision string `json:"revision,omitempty"`
+	// Conditions are the conditions of the Flux resource.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// fluxResource is the common view of the Flux resources the fluxChecker
+// supports.
+type fluxResource struct {
+	client.Object
+	suspend                bool
+	observedGeneration     int64
+	conditions             []metav1.Condition
+	lastHandledReconcileAt string
+	revision               string
+	// sourceRef is the reference to the source of a Kustomization.
+	sourceRef *kustomize.CrossNamespaceSourceReference
+}
+
+type fluxChecker struct {
+	fluxClient client.Client
+}
+
+// newFluxChecker returns an implementation of the health.Checker interface
+// that monitors the readiness and revisions of Flux resources.
+func newFluxChecker(fluxClient client.Client) *fluxChecker {
+	return &fluxChecker{
+		fluxClient: fluxClient,
+	}
+}
+
+// Name implements the health.Checker interface.
+func (f *fluxChecker) Name() string {
+	return "flux"
+}
+
+// Check implements the health.Checker interface.
+func (f *fluxChecker) Check(
+	ctx context.Context,
+	_ string,
+	_ string,
+	criteria health.Criteria,
+) health.Result {
+	cfg, err := health.InputToStruct[FluxHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					f.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return f.check(ctx, cfg)
+}
+
+func (f *fluxChecker) check(
+	ctx context.Context,
+	input FluxHealthInput,
+) health.Result {
+	if f.fluxClient == nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"Flux integration is disabled on this controller; cannot assess " +
+					"the health of Flux resources",
+			},
+		}
+	}
+	res := health.Result{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	statuses := make([]FluxResourceStatus, len(input.Resources))
+	for i, check := range input.Resources {
+		var state kargoapi.HealthState
+		var err error
+		state, statuses[i], err = f.getResourceHealth(ctx, check)
+		res.Status = res.Status.Merge(state)
+		if err != nil {
+			res.Issues = append(res.Issues, err.Error())
+		}
+	}
+	res.Output = map[string]any{
+		fluxResourceStatusesKey: statuses,
+	}
+	return res
+}
+
+// getResourceHealth assesses the health of a single Flux resource by looking
+// at whether it has handled the requested reconciliation, its conditions and
+// the revision it last reconciled. If it can not (fully) assess the health of
+// the resource, it returns an error with a message explaining why.
+func (f *fluxChecker) getResourceHealth(
+	ctx context.Context,
+	check FluxResourceHealthCheck,
+) (kargoapi.HealthState, FluxResourceStatus, error) {
+	status := FluxResourceStatus{
+		Kind:      check.Kind,
+		Namespace: check.Namespace,
+		Name:      check.Name,
+	}
+	res, err := f.getResource(ctx, check.Kind, client.ObjectKey{
+		Namespace: check.Namespace,
+		Name:      check.Name,
+	})
+	if err != nil {
+		return kargoapi.HealthStateUnknown, status, err
+	}
+	status.Revision = res.revision
+	status.Conditions = res.conditions
+
+	desc := fmt.Sprintf("Flux %s %q in namespace %q", check.Kind, check.Name, check.Namespace)
+
+	if res.suspend {
+		// To Kargo, a suspended resource is considered progressing until the
+		// suspension is lifted.
+		return kargoapi.HealthStateProgressing, status, fmt.Errorf("%s is suspended", desc)
+	}
+	if !fluxReconcileRequestHandled(res.lastHandledReconcileAt, check.ReconcileRequestedAt) {
+		return kargoapi.HealthStateProgressing, status, fmt.Errorf(
+			"%s has not yet handled the reconciliation request made at %s",
+			desc, check.ReconcileRequestedAt,
+		)
+	}
+	if res.observedGeneration < res.GetGeneration() {
+		return kargoapi.HealthStateProgressing, status, fmt.Errorf(
+			"%s has not yet observed its latest generation", desc,
+		)
+	}
+	if stalled := apimeta.FindStatusCondition(res.conditions, meta.StalledCondition); stalled != nil &&
+		stalled.Status == metav1.ConditionTrue {
+		return kargoapi.HealthStateUnhealthy, status, fmt.Errorf("%s is stalled: %s", desc, stalled.Message)
+	}
+	if reconciling := apimeta.FindStatusCondition(res.conditions, meta.ReconcilingCondition); reconciling != nil &&
+		reconciling.Status == metav1.ConditionTrue {
+		return kargoapi.HealthStateProgressing, status, fmt.Errorf(
+			"%s is being reconciled: %s", desc, reconciling.Message,
+		)
+	}
+	ready := apimeta.FindStatusCondition(res.conditions, meta.ReadyCondition)
+	switch {
+	case ready == nil:
+		return kargoapi.HealthStateProgressing, status, fmt.Errorf(
+			"%s has no %s condition yet", desc, meta.ReadyCondition,
+		)
+	case ready.Status == metav1.ConditionUnknown:
+		return kargoapi.HealthStateProgressing, status, fmt.Errorf(
+			"%s is not ready yet: %s", desc, ready.Message,
+		)
+	case ready.Status != metav1.ConditionTrue:
+		return kargoapi.HealthStateUnhealthy, status, fmt.Errorf("%s is not ready: %s", desc, ready.Message)
+	}
+
+	if check.DesiredRevision != "" && !fluxRevisionMatches(res.revision, check.DesiredRevision) {
+		return kargoapi.HealthStateUnhealthy, status, fmt.Errorf(
+			"%s has revision %q, which does not match the desired revision %q",
+			desc, res.revision, check.DesiredRevision,
+		)
+	}
+
+	if res.sourceRef != nil {
+		// A Kustomization is only considered healthy once it has applied the
+		// revision its source currently has.
+		return f.getKustomizationSourceHealth(ctx, res, status, desc)
+	}
+
+	return kargoapi.HealthStateHealthy, status, nil
+}
+
+// getKustomizationSourceHealth compares the last applied revision of a
+// Kustomization to the revision of the artifact of its source. Sources of
+// kinds other than GitRepository and OCIRepository are not compared.
+func (f *fluxChecker) getKustomizationSourceHealth(
+	ctx context.Context,
+	res *fluxResource,
+	status FluxResourceStatus,
+	desc string,
+) (kargoapi.HealthState, FluxResourceStatus, error) {
+	if res.sourceRef.Kind != source.GitRepositoryKind && res.sourceRef.Kind != source.OCIRepositoryKind {
+		return kargoapi.HealthStateHealthy, status, nil
+	}
+	sourceKey := client.ObjectKey{
+		Namespace: res.sourceRef.Namespace,
+		Name:      res.sourceRef.Name,
+	}
+	if sourceKey.Namespace == "" {
+		sourceKey.Namespace = res.GetNamespace()
+	}
+	src, err := f.getResource(ctx, res.sourceRef.Kind, sourceKey)
+	if err != nil {
+		return kargoapi.HealthStateUnknown, status, err
+	}
+	if src.revision != "" && src.revision != res.revision {
+		return kargoapi.HealthStateProgressing, status, fmt.Errorf(
+			"%s has not yet applied revision %q of its source %s %q",
+			desc, src.revision, res.sourceRef.Kind, res.sourceRef.Name,
+		)
+	}
+	return kargoapi.HealthStateHealthy, status, nil
+}
+
+// getResource retrieves the Flux resource of the given kind and returns a
+// common view of it.
+func (f *fluxChecker) getResource(
+	ctx context.Context,
+	kind string,
+	key client.ObjectKey,
+) (*fluxResource, error) {
+	var res *fluxResource
+	switch kind {
+	case kustomize.KustomizationKind:
+		res = &fluxResource{Object: &kustomize.Kustomization{}}
+	case helm.HelmReleaseKind:
+		res = &fluxResource{Object: &helm.HelmRelease{}}
+	case source.GitRepositoryKind:
+		res = &fluxResource{Object: &source.GitRepository{}}
+	case source.OCIRepositoryKind:
+		res = &fluxResource{Object: &source.OCIRepository{}}
+	default:
+		return nil, fmt.Errorf("unsupported Flux resource kind %q", kind)
+	}
+
+	if err := f.fluxClient.Get(ctx, key, res.Object); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"unable to find Flux %s %q in namespace %q",
+				kind, key.Name, key.Namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error finding Flux %s %q in namespace %q: %w",
+			kind, key.Name, key.Namespace, err,
+		)
+	}
+
+	switch obj := res.Object.(type) {
+	case *kustomize.Kustomization:
+		res.suspend = obj.Spec.Suspend
+		res.observedGeneration = obj.Status.ObservedGeneration
+		res.conditions = obj.Status.Conditions
+		res.lastHandledReconcileAt = obj.Status.LastHandledReconcileAt
+		res.revision = obj.Status.LastAppliedRevision
+		res.sourceRef = &obj.Spec.SourceRef
+	case *helm.HelmRelease:
+		res.suspend = obj.Spec.Suspend
+		res.observedGeneration = obj.Status.ObservedGeneration
+		res.conditions = obj.Status.Conditions
+		res.lastHandledReconcileAt = obj.Status.LastHandledReconcileAt
+		res.revision = obj.Status.LastAttemptedRevision
+	case *source.GitRepository:
+		res.suspend = obj.Spec.Suspend
+		res.observedGeneration = obj.Status.ObservedGeneration
+		res.conditions = obj.Status.Conditions
+		res.lastHandledReconcileAt = obj.Status.LastHandledReconcileAt
+		if obj.Status.Artifact != nil {
+			res.revision = obj.Status.Artifact.Revision
+		}
+	case *source.OCIRepository:
+		res.suspend = obj.Spec.Suspend
+		res.observedGeneration = obj.Status.ObservedGeneration
+		res.conditions = obj.Status.Conditions
+		res.lastHandledReconcileAt = obj.Status.LastHandledReconcileAt
+		if obj.Status.Artifact != nil {
+			res.revision = obj.Status.Artifact.Revision
+		}
+	}
+	return res, nil
+}
+
+// fluxReconcileRequestHandled returns true if the given last handled
+// reconciliation request token indicates the requested reconciliation has
+// been handled. Tokens are compared as RFC3339 timestamps where possible, so a
+// later request made by someone else does not prevent this from being true.
+func fluxReconcileRequestHandled(lastHandled, requested string) bool {
+	if requested == "" || lastHandled == requested {
+		return true
+	}
+	requestedAt, err := time.Parse(time.RFC3339Nano, requested)
+	if err != nil {
+		return false
+	}
+	lastHandledAt, err := time.Parse(time.RFC3339Nano, lastHandled)
+	if err != nil {
+		return false
+	}
+	return !lastHandledAt.Before(requestedAt)
+}
+
+// fluxRevisionMatches returns true if the given revision observed on a Flux
+// resource matches the given desired revision. Flux revisions take the form
+// <name>@<algorithm>:<checksum> (e.g. main@sha1:<commit> or
+// v1.0.0@sha256:<digest>), so the desired revision matches if it is equal to
+// the full revision, to its name, to its checksum with or without the
+// algorithm, or, for Helm chart versions, to the version without build
+// metadata.
+func fluxRevisionMatches(observed, desired string) bool {
+	if observed == desired {
+		return true
+	}
+	name, checksum := "", observed
+	if i := strings.LastIndex(observed, "@"); i >= 0 {
+		name, checksum = observed[:i], observed[i+1:]
+	}
+	if name == desired || checksum == desired {
+		return true
+	}
+	if _, sum, ok := strings.Cut(checksum, ":"); ok && sum == desired {
+		return true
+	}
+	return strings.HasPrefix(observed, desired+"+")
+}
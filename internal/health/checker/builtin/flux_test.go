@@ -0,0 +1,381 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	helm "github.com/akuity/kargo/internal/controller/flux/api/helm/v2"
+	kustomize "github.com/akuity/kargo/internal/controller/flux/api/kustomize/v1"
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+	source "github.com/akuity/kargo/internal/controller/flux/api/source/v1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_fluxChecker_check(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kustomize.AddToScheme(scheme))
+	require.NoError(t, helm.AddToScheme(scheme))
+	require.NoError(t, source.AddToScheme(scheme))
+
+	const testNamespace = "flux-system"
+	const testRequestedAt = "2025-01-01T00:00:00Z"
+
+	readyConditions := []metav1.Condition{{
+		Type:   meta.ReadyCondition,
+		Status: metav1.ConditionTrue,
+	}}
+	newGitRepository := func(revision string) *source.GitRepository {
+		return &source.GitRepository{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  testNamespace,
+				Name:       "fake-repo",
+				Generation: 2,
+			},
+			Status: source.GitRepositoryStatus{
+				ReconcileRequestStatus: meta.ReconcileRequestStatus{
+					LastHandledReconcileAt: testRequestedAt,
+				},
+				ObservedGeneration: 2,
+				Conditions:         readyConditions,
+				Artifact:           &source.Artifact{Revision: revision},
+			},
+		}
+	}
+	newKustomization := func(revision string) *kustomize.Kustomization {
+		return &kustomize.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "fake-kustomization",
+			},
+			Spec: kustomize.KustomizationSpec{
+				SourceRef: kustomize.CrossNamespaceSourceReference{
+					Kind: source.GitRepositoryKind,
+					Name: "fake-repo",
+				},
+			},
+			Status: kustomize.KustomizationStatus{
+				Conditions:          readyConditions,
+				LastAppliedRevision: revision,
+			},
+		}
+	}
+	gitRepoCheck := FluxResourceHealthCheck{
+		Kind:                 source.GitRepositoryKind,
+		Name:                 "fake-repo",
+		Namespace:            testNamespace,
+		DesiredRevision:      "fake-commit",
+		ReconcileRequestedAt: testRequestedAt,
+	}
+	kustomizationCheck := FluxResourceHealthCheck{
+		Kind:      kustomize.KustomizationKind,
+		Name:      "fake-kustomization",
+		Namespace: testNamespace,
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		input      FluxHealthInput
+		assertions func(*testing.T, health.Result)
+	}{
+		{
+			name:  "Flux integration disabled",
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "Flux integration is disabled")
+			},
+		},
+		{
+			name:   "resource not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			input:  FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Equal(t, []string{
+					`unable to find Flux GitRepository "fake-repo" in namespace "flux-system"`,
+				}, res.Issues)
+			},
+		},
+		{
+			name:   "unsupported kind",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{{
+				Kind:      "Bucket",
+				Name:      "fake-bucket",
+				Namespace: testNamespace,
+			}}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Equal(t, []string{`unsupported Flux resource kind "Bucket"`}, res.Issues)
+			},
+		},
+		{
+			name: "reconciliation request not yet handled",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				func() client.Object {
+					repo := newGitRepository("main@sha1:fake-commit")
+					repo.Status.LastHandledReconcileAt = "2024-12-31T23:59:59Z"
+					return repo
+				}(),
+			).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "has not yet handled the reconciliation request")
+			},
+		},
+		{
+			name: "latest generation not yet observed",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				func() client.Object {
+					repo := newGitRepository("main@sha1:fake-commit")
+					repo.Status.ObservedGeneration = 1
+					return repo
+				}(),
+			).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "has not yet observed its latest generation")
+			},
+		},
+		{
+			name: "suspended",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				func() client.Object {
+					repo := newGitRepository("main@sha1:fake-commit")
+					repo.Spec.Suspend = true
+					return repo
+				}(),
+			).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "is suspended")
+			},
+		},
+		{
+			name: "stalled",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				func() client.Object {
+					repo := newGitRepository("main@sha1:fake-commit")
+					repo.Status.Conditions = []metav1.Condition{{
+						Type:    meta.StalledCondition,
+						Status:  metav1.ConditionTrue,
+						Message: "invalid URL",
+					}}
+					return repo
+				}(),
+			).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "is stalled: invalid URL")
+			},
+		},
+		{
+			name: "reconciling",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				func() client.Object {
+					repo := newGitRepository("main@sha1:fake-commit")
+					repo.Status.Conditions = []metav1.Condition{{
+						Type:    meta.ReconcilingCondition,
+						Status:  metav1.ConditionTrue,
+						Message: "building artifact",
+					}}
+					return repo
+				}(),
+			).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "is being reconciled: building artifact")
+			},
+		},
+		{
+			name: "not ready",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				func() client.Object {
+					repo := newGitRepository("main@sha1:fake-commit")
+					repo.Status.Conditions = []metav1.Condition{{
+						Type:    meta.ReadyCondition,
+						Status:  metav1.ConditionFalse,
+						Message: "authentication required",
+					}}
+					return repo
+				}(),
+			).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "is not ready: authentication required")
+			},
+		},
+		{
+			name: "revision does not match",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newGitRepository("main@sha1:other-commit"),
+			).Build(),
+			input: FluxHealthInput{Resources: []FluxResourceHealthCheck{gitRepoCheck}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "does not match the desired revision")
+			},
+		},
+		{
+			name: "Kustomization has not yet applied revision of its source",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newGitRepository("main@sha1:fake-commit"),
+				newKustomization("main@sha1:other-commit"),
+			).Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{gitRepoCheck, kustomizationCheck},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], `has not yet applied revision "main@sha1:fake-commit"`)
+			},
+		},
+		{
+			name: "healthy",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newGitRepository("main@sha1:fake-commit"),
+				newKustomization("main@sha1:fake-commit"),
+				&helm.HelmRelease{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testNamespace,
+						Name:      "fake-release",
+					},
+					Status: helm.HelmReleaseStatus{
+						Conditions:            readyConditions,
+						LastAttemptedRevision: "1.2.3+fake-digest",
+					},
+				},
+			).Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{
+					gitRepoCheck,
+					kustomizationCheck,
+					{
+						Kind:            helm.HelmReleaseKind,
+						Name:            "fake-release",
+						Namespace:       testNamespace,
+						DesiredRevision: "1.2.3",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				statuses, ok := res.Output[fluxResourceStatusesKey].([]FluxResourceStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 3)
+				require.Equal(t, "main@sha1:fake-commit", statuses[0].Revision)
+				require.Equal(t, "main@sha1:fake-commit", statuses[1].Revision)
+				require.Equal(t, "1.2.3+fake-digest", statuses[2].Revision)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			checker := newFluxChecker(testCase.client)
+			testCase.assertions(t, checker.check(context.Background(), testCase.input))
+		})
+	}
+}
+
+func Test_fluxReconcileRequestHandled(t *testing.T) {
+	testCases := []struct {
+		name        string
+		lastHandled string
+		requested   string
+		expected    bool
+	}{
+		{
+			name:     "no request",
+			expected: true,
+		},
+		{
+			name:        "same token",
+			lastHandled: "fake-token",
+			requested:   "fake-token",
+			expected:    true,
+		},
+		{
+			name:        "different token",
+			lastHandled: "other-token",
+			requested:   "fake-token",
+			expected:    false,
+		},
+		{
+			name:        "later request handled",
+			lastHandled: "2025-01-01T00:00:01Z",
+			requested:   "2025-01-01T00:00:00.5Z",
+			expected:    true,
+		},
+		{
+			name:        "earlier request handled",
+			lastHandled: "2024-12-31T23:59:59Z",
+			requested:   "2025-01-01T00:00:00Z",
+			expected:    false,
+		},
+		{
+			name:      "no request handled",
+			requested: "2025-01-01T00:00:00Z",
+			expected:  false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				fluxReconcileRequestHandled(testCase.lastHandled, testCase.requested),
+			)
+		})
+	}
+}
+
+func Test_fluxRevisionMatches(t *testing.T) {
+	testCases := []struct {
+		observed string
+		desired  string
+		expected bool
+	}{
+		{"main@sha1:abc123", "main@sha1:abc123", true},
+		{"main@sha1:abc123", "abc123", true},
+		{"main@sha1:abc123", "sha1:abc123", true},
+		{"main@sha1:abc123", "def456", false},
+		{"v1.0.0@sha256:abc123", "v1.0.0", true},
+		{"v1.0.0@sha256:abc123", "sha256:abc123", true},
+		{"v1.0.0@sha256:abc123", "v1.0.1", false},
+		{"sha256:abc123", "sha256:abc123", true},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3+abc123", "1.2.3", true},
+		{"1.2.30", "1.2.3", false},
+		{"", "abc123", false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.observed+"/"+testCase.desired, func(t *testing.T) {
+			require.Equal(t, testCase.expected, fluxRevisionMatches(testCase.observed, testCase.desired))
+		})
+	}
+}
@@ -15,9 +15,62 @@ import (
 	"oras.land/oras-go/pkg/registry/remote/auth"
 )
 
-// DiscoverChartVersions connects to the specified Helm chart repository and
-// retrieves all available versions of the specified chart, optionally filtering
-// by a SemVer constraint. It then returns the versions in descending order.
+// ChartRepository retrieves the versions of a chart in a Helm chart repository
+// and their metadata. The index of a classic (HTTP/S) chart repository is
+// retrieved at most once by each ChartRepository, so one should only be used
+// for the duration of a single discovery. Implementations are not safe for
+// concurrent use.
+type ChartRepository interface {
+	// DiscoverVersions retrieves all available versions of the chart,
+	// optionally filtering by a SemVer constraint. It returns the versions in
+	// descending order.
+	//
+	// It returns an error if the repository cannot be reached or if the
+	// versions cannot be retrieved, but it does not return an error if no
+	// versions of the chart are found in the repository.
+	DiscoverVersions(ctx context.Context, semverConstraint string) ([]string, error)
+	// GetMetadata retrieves the metadata of the specified versions of the
+	// chart. The metadata is returned in the same order as the versions.
+	// Versions that cannot be found in the repository are omitted.
+	//
+	// For classic chart repositories, the metadata is read from the
+	// repository's index. For repositories within an OCI registry, the
+	// metadata is read from the config of each version's manifest and cached
+	// by the digest of the manifest.
+	GetMetadata(ctx context.Context, versions []string) ([]ChartMetadata, error)
+}
+
+// FakeChartRepository is a mock implementation of the ChartRepository
+// interface that is used to facilitate unit testing.
+type FakeChartRepository struct {
+	DiscoverVersionsFn func(ctx context.Context, semverConstraint string) ([]string, error)
+	GetMetadataFn      func(ctx context.Context, versions []string) ([]ChartMetadata, error)
+}
+
+// DiscoverVersions implements ChartRepository.
+func (f *FakeChartRepository) DiscoverVersions(
+	ctx context.Context,
+	semverConstraint string,
+) ([]string, error) {
+	if f.DiscoverVersionsFn == nil {
+		return nil, nil
+	}
+	return f.DiscoverVersionsFn(ctx, semverConstraint)
+}
+
+// GetMetadata implements ChartRepository.
+func (f *FakeChartRepository) GetMetadata(
+	ctx context.Context,
+	versions []string,
+) ([]ChartMetadata, error) {
+	if f.GetMetadataFn == nil {
+		return nil, nil
+	}
+	return f.GetMetadataFn(ctx, versions)
+}
+
+// NewChartRepository returns a ChartRepository for the specified chart in the
+// specified Helm chart repository.
 //
 // The repository can be either a classic chart repository (using HTTP/S) or a
 // repository within an OCI registry. Classic chart repositories can contain
@@ -28,34 +81,60 @@ import (
 //
 // The credentials argument may be nil for public repositories, but must be
 // non-nil for private repositories.
-//
-// It returns an error if the repository cannot be reached or if the versions
-// cannot be retrieved, but it does not return an error if no versions of the
-// chart are found in the repository.
+func NewChartRepository(repoURL, chart string, creds *Credentials) ChartRepository {
+	return &chartRepository{
+		repoURL: repoURL,
+		chart:   chart,
+		creds:   creds,
+	}
+}
+
+// chartRepository is the default implementation of ChartRepository.
+type chartRepository struct {
+	repoURL string
+	chart   string
+	creds   *Credentials
+	// index is the index of a classic (HTTP/S) chart repository, once it has
+	// been retrieved.
+	index *classicRepoIndex
+}
+
+// DiscoverChartVersions connects to the specified Helm chart repository and
+// retrieves all available versions of the specified chart, optionally filtering
+// by a SemVer constraint. It then returns the versions in descending order.
+// Refer to NewChartRepository for the arguments.
 func DiscoverChartVersions(
 	ctx context.Context,
 	repoURL string,
 	chart string,
 	semverConstraint string,
 	creds *Credentials,
+) ([]string, error) {
+	return NewChartRepository(repoURL, chart, creds).DiscoverVersions(ctx, semverConstraint)
+}
+
+// DiscoverVersions implements ChartRepository.
+func (c *chartRepository) DiscoverVersions(
+	ctx context.Context,
+	semverConstraint string,
 ) ([]string, error) {
 	var isOCI bool
 	var versions []string
 	var err error
 	switch {
-	case strings.HasPrefix(repoURL, "http://"), strings.HasPrefix(repoURL, "https://"):
-		versions, err = getChartVersionsFromClassicRepo(repoURL, chart, creds)
-	case strings.HasPrefix(repoURL, "oci://"):
-		versions, err = getChartVersionsFromOCIRepo(ctx, repoURL, creds)
+	case strings.HasPrefix(c.repoURL, "http://"), strings.HasPrefix(c.repoURL, "https://"):
+		versions, err = c.getChartVersionsFromClassicRepo()
+	case strings.HasPrefix(c.repoURL, "oci://"):
+		versions, err = getChartVersionsFromOCIRepo(ctx, c.repoURL, c.creds)
 		isOCI = true
 	default:
-		return nil, fmt.Errorf("repository URL %q is invalid", repoURL)
+		return nil, fmt.Errorf("repository URL %q is invalid", c.repoURL)
 	}
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error retrieving versions of chart %q from repository %q: %w",
-			chart,
-			repoURL,
+			c.chart,
+			c.repoURL,
 			err,
 		)
 	}
@@ -69,8 +148,8 @@ func DiscoverChartVersions(
 		if semvers, err = filterSemVers(semvers, semverConstraint); err != nil {
 			return nil, fmt.Errorf(
 				"error filtering versions of chart %q from repository %q: %w",
-				chart,
-				repoURL,
+				c.chart,
+				c.repoURL,
 				err,
 			)
 		}
@@ -90,16 +169,48 @@ func DiscoverChartVersions(
 	return semVerCollectionToVersions(semvers), nil
 }
 
-// getChartVersionsFromClassicRepo connects to the classic (HTTP/S) chart
-// repository specified by repoURL and retrieves all available versions of the
-// specified chart. The provided repoURL MUST begin with protocol http:// or
-// https://. Provided credentials may be nil for public repositories, but must
-// be non-nil for private repositories.
-func getChartVersionsFromClassicRepo(
-	repoURL string,
-	chart string,
-	creds *Credentials,
-) ([]string, error) {
+// getChartVersionsFromClassicRepo retrieves all available versions of the
+// chart from the index of the classic (HTTP/S) chart repository.
+func (c *chartRepository) getChartVersionsFromClassicRepo() ([]string, error) {
+	index, err := c.getClassicRepoIndex()
+	if err != nil {
+		return nil, err
+	}
+	entries, ok := index.Entries[c.chart]
+	if !ok {
+		return nil, nil
+	}
+	versions := make([]string, len(entries))
+	for i, entry := range entries {
+		versions[i] = entry.Version
+	}
+	return versions, nil
+}
+
+// getClassicRepoIndex returns the index of the classic (HTTP/S) chart
+// repository, retrieving it only if it has not been retrieved before.
+func (c *chartRepository) getClassicRepoIndex() (*classicRepoIndex, error) {
+	if c.index == nil {
+		index, err := getClassicRepoIndex(c.repoURL, c.creds)
+		if err != nil {
+			return nil, err
+		}
+		c.index = index
+	}
+	return c.index, nil
+}
+
+// classicRepoIndex represents the index of a classic (HTTP/S) chart
+// repository.
+type classicRepoIndex struct {
+	// Entries maps chart names to the metadata of all versions of the chart.
+	Entries map[string][]ChartMetadata `yaml:"entries,omitempty"`
+}
+
+// getClassicRepoIndex retrieves the index of the classic (HTTP/S) chart
+// repository specified by repoURL. Provided credentials may be nil for public
+// repositories, but must be non-nil for private repositories.
+func getClassicRepoIndex(repoURL string, creds *Credentials) (*classicRepoIndex, error) {
 	indexURL := fmt.Sprintf("%s/index.yaml", strings.TrimSuffix(repoURL, "/"))
 	req, err := http.NewRequest(http.MethodGet, indexURL, nil)
 	if err != nil {
@@ -124,23 +235,11 @@ func getChartVersionsFromClassicRepo(
 	if err != nil {
 		return nil, fmt.Errorf("error reading repository index from %q: %w", indexURL, err)
 	}
-	index := struct {
-		Entries map[string][]struct {
-			Version string `json:"version,omitempty"`
-		} `json:"entries,omitempty"`
-	}{}
-	if err = yaml.Unmarshal(resBodyBytes, &index); err != nil {
+	index := &classicRepoIndex{}
+	if err = yaml.Unmarshal(resBodyBytes, index); err != nil {
 		return nil, fmt.Errorf("error unmarshaling repository index from %q: %w", indexURL, err)
 	}
-	entries, ok := index.Entries[chart]
-	if !ok {
-		return nil, nil
-	}
-	versions := make([]string, len(entries))
-	for i, entry := range entries {
-		versions[i] = entry.Version
-	}
-	return versions, nil
+	return index, nil
 }
 
 // getChartVersionsFromOCIRepo connects to the OCI repository specified by
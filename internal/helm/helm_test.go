@@ -82,11 +82,11 @@ func TestGetChartVersionsFromClassicRepo(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			versions, err := getChartVersionsFromClassicRepo(
-				testCase.repoURL,
-				testCase.chart,
-				nil,
-			)
+			c := &chartRepository{
+				repoURL: testCase.repoURL,
+				chart:   testCase.chart,
+			}
+			versions, err := c.getChartVersionsFromClassicRepo()
 			testCase.assertions(t, versions, err)
 		})
 	}
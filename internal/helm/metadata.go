@@ -0,0 +1,160 @@
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/patrickmn/go-cache"
+)
+
+// ChartMetadata represents the metadata of a specific version of a chart, as
+// specified in the chart's Chart.yaml file.
+type ChartMetadata struct {
+	// Version is the version of the chart.
+	Version string `json:"version" yaml:"version"`
+	// AppVersion is the version of the application packaged by the chart.
+	AppVersion string `json:"appVersion,omitempty" yaml:"appVersion,omitempty"`
+	// Annotations are the annotations of the chart.
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	// Dependencies are the dependencies of the chart.
+	Dependencies []ChartDependency `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
+}
+
+// ChartDependency represents a dependency of a chart, as specified in the
+// chart's Chart.yaml file.
+type ChartDependency struct {
+	// Name is the name of the dependency.
+	Name string `json:"name" yaml:"name"`
+	// Version is the version or version range of the dependency.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	// Repository is the URL of the repository containing the dependency.
+	Repository string `json:"repository,omitempty" yaml:"repository,omitempty"`
+}
+
+// ociChartMetadataCache caches the metadata of charts within OCI registries by
+// the digest of their manifests. Because manifests are immutable, entries only
+// expire to bound the size of the cache.
+var ociChartMetadataCache = cache.New(24*time.Hour, time.Hour)
+
+// GetMetadata implements ChartRepository.
+func (c *chartRepository) GetMetadata(
+	ctx context.Context,
+	versions []string,
+) ([]ChartMetadata, error) {
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	var metadata []ChartMetadata
+	var err error
+	switch {
+	case strings.HasPrefix(c.repoURL, "http://"), strings.HasPrefix(c.repoURL, "https://"):
+		metadata, err = c.getChartMetadataFromClassicRepo(versions)
+	case strings.HasPrefix(c.repoURL, "oci://"):
+		metadata, err = getChartMetadataFromOCIRepo(ctx, c.repoURL, versions, getOCIRemoteOptions(ctx, c.creds))
+	default:
+		return nil, fmt.Errorf("repository URL %q is invalid", c.repoURL)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving metadata of chart %q from repository %q: %w",
+			c.chart,
+			c.repoURL,
+			err,
+		)
+	}
+	return metadata, nil
+}
+
+// getChartMetadataFromClassicRepo retrieves the metadata of the specified
+// versions of the chart from the index of the classic (HTTP/S) chart
+// repository.
+func (c *chartRepository) getChartMetadataFromClassicRepo(
+	versions []string,
+) ([]ChartMetadata, error) {
+	index, err := c.getClassicRepoIndex()
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]ChartMetadata, len(index.Entries[c.chart]))
+	for _, entry := range index.Entries[c.chart] {
+		entries[entry.Version] = entry
+	}
+	metadata := make([]ChartMetadata, 0, len(versions))
+	for _, version := range versions {
+		if entry, ok := entries[version]; ok {
+			metadata = append(metadata, entry)
+		}
+	}
+	return metadata, nil
+}
+
+// getChartMetadataFromOCIRepo retrieves the metadata of the specified versions
+// of the chart in the OCI repository specified by repoURL from the config of
+// each version's manifest. Only the digest of the manifest is retrieved for
+// versions whose metadata is already cached.
+func getChartMetadataFromOCIRepo(
+	ctx context.Context,
+	repoURL string,
+	versions []string,
+	opts []remote.Option,
+) ([]ChartMetadata, error) {
+	repo := strings.TrimPrefix(repoURL, "oci://")
+	metadata := make([]ChartMetadata, 0, len(versions))
+	for _, version := range versions {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// OCI artifact tags are not allowed to contain the "+" character, so
+		// Helm uses "_" in its place.
+		ref, err := name.ParseReference(repo + ":" + strings.ReplaceAll(version, "+", "_"))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing reference to version %q: %w", version, err)
+		}
+		desc, err := remote.Head(ref, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving manifest of version %q: %w", version, err)
+		}
+		digest := desc.Digest.String()
+		var md ChartMetadata
+		if entry, ok := ociChartMetadataCache.Get(digest); ok {
+			md = entry.(ChartMetadata) // nolint: forcetypeassert
+		} else {
+			img, err := remote.Image(ref.Context().Digest(digest), opts...)
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving manifest of version %q: %w", version, err)
+			}
+			cfg, err := img.RawConfigFile()
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving config of version %q: %w", version, err)
+			}
+			if err = json.Unmarshal(cfg, &md); err != nil {
+				return nil, fmt.Errorf("error unmarshaling config of version %q: %w", version, err)
+			}
+			ociChartMetadataCache.Set(digest, md, cache.DefaultExpiration)
+		}
+		// Report the version as it was requested rather than as it appears in
+		// Chart.yaml, so callers can correlate the two.
+		md.Version = version
+		metadata = append(metadata, md)
+	}
+	return metadata, nil
+}
+
+// getOCIRemoteOptions returns the remote.Options to use for retrieving charts
+// from an OCI registry using the provided credentials, which may be nil.
+func getOCIRemoteOptions(ctx context.Context, creds *Credentials) []remote.Option {
+	opts := []remote.Option{remote.WithContext(ctx)}
+	if creds != nil {
+		opts = append(opts, remote.WithAuth(&authn.Basic{
+			Username: creds.Username,
+			Password: creds.Password,
+		}))
+	}
+	return opts
+}
@@ -0,0 +1,277 @@
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChartRepository_GetMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		repoURL    string
+		versions   []string
+		assertions func(*testing.T, []ChartMetadata, error)
+	}{
+		{
+			name:     "no versions",
+			repoURL:  "bogus",
+			versions: nil,
+			assertions: func(t *testing.T, metadata []ChartMetadata, err error) {
+				require.NoError(t, err)
+				require.Nil(t, metadata)
+			},
+		},
+		{
+			name:     "invalid repository URL",
+			repoURL:  "bogus",
+			versions: []string{"1.0.0"},
+			assertions: func(t *testing.T, _ []ChartMetadata, err error) {
+				require.ErrorContains(t, err, "repository URL \"bogus\" is invalid")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			metadata, err := NewChartRepository(testCase.repoURL, "fake-chart", nil).GetMetadata(
+				context.Background(),
+				testCase.versions,
+			)
+			testCase.assertions(t, metadata, err)
+		})
+	}
+}
+
+func TestGetChartMetadataFromClassicRepo(t *testing.T) {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.URL.Path {
+				case "/fake-repo/index.yaml":
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+      appVersion: v2.3.4
+      annotations:
+        example.com/foo: bar
+      dependencies:
+        - name: redis
+          version: ~17.0.0
+          repository: https://charts.example.com
+    - version: 1.1.0
+      appVersion: v2.4.0
+`))
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			},
+		),
+	)
+	defer testServer.Close()
+
+	testCases := []struct {
+		name       string
+		repoURL    string
+		chart      string
+		versions   []string
+		assertions func(*testing.T, []ChartMetadata, error)
+	}{
+		{
+			name:     "request for repo index returns non-200 status",
+			repoURL:  fmt.Sprintf("%s/non-existent-repo", testServer.URL),
+			chart:    "fake-chart",
+			versions: []string{"1.0.0"},
+			assertions: func(t *testing.T, _ []ChartMetadata, err error) {
+				require.ErrorContains(t, err, "received unexpected HTTP 404")
+			},
+		},
+		{
+			name:     "chart not found",
+			repoURL:  fmt.Sprintf("%s/fake-repo", testServer.URL),
+			chart:    "non-existent-chart",
+			versions: []string{"1.0.0"},
+			assertions: func(t *testing.T, metadata []ChartMetadata, err error) {
+				require.NoError(t, err)
+				require.Empty(t, metadata)
+			},
+		},
+		{
+			name:     "success",
+			repoURL:  fmt.Sprintf("%s/fake-repo", testServer.URL),
+			chart:    "fake-chart",
+			versions: []string{"1.1.0", "1.0.5", "1.0.0"},
+			assertions: func(t *testing.T, metadata []ChartMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []ChartMetadata{
+					{
+						Version:    "1.1.0",
+						AppVersion: "v2.4.0",
+					},
+					{
+						Version:     "1.0.0",
+						AppVersion:  "v2.3.4",
+						Annotations: map[string]string{"example.com/foo": "bar"},
+						Dependencies: []ChartDependency{{
+							Name:       "redis",
+							Version:    "~17.0.0",
+							Repository: "https://charts.example.com",
+						}},
+					},
+				}, metadata)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &chartRepository{
+				repoURL: testCase.repoURL,
+				chart:   testCase.chart,
+			}
+			metadata, err := c.getChartMetadataFromClassicRepo(testCase.versions)
+			testCase.assertions(t, metadata, err)
+		})
+	}
+}
+
+// rawManifest is a remote.Taggable for pushing a manifest as-is.
+type rawManifest []byte
+
+func (r rawManifest) RawManifest() ([]byte, error) {
+	return r, nil
+}
+
+func TestGetChartMetadataFromOCIRepo(t *testing.T) {
+	// Count requests for config blobs to verify metadata is cached
+	var blobRequests atomic.Int32
+	reg := registry.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/blobs/") {
+			blobRequests.Add(1)
+		}
+		reg.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	repo := fmt.Sprintf("%s/charts/fake-chart", strings.TrimPrefix(server.URL, "http://"))
+
+	// Push a manifest whose config is the chart's metadata, as Helm does
+	cfg := static.NewLayer(
+		[]byte(`{"name":"fake-chart","version":"1.0.0+build.1","appVersion":"v2.3.4",`+
+			`"annotations":{"example.com/foo":"bar"},`+
+			`"dependencies":[{"name":"redis","version":"~17.0.0"}]}`),
+		"application/vnd.cncf.helm.config.v1+json",
+	)
+	repoRef, err := name.NewRepository(repo)
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteLayer(repoRef, cfg))
+	cfgDigest, err := cfg.Digest()
+	require.NoError(t, err)
+	cfgSize, err := cfg.Size()
+	require.NoError(t, err)
+	manifest, err := json.Marshal(v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		Config: v1.Descriptor{
+			MediaType: "application/vnd.cncf.helm.config.v1+json",
+			Digest:    cfgDigest,
+			Size:      cfgSize,
+		},
+		Layers: []v1.Descriptor{},
+	})
+	require.NoError(t, err)
+	ref, err := name.ParseReference(repo + ":1.0.0_build.1")
+	require.NoError(t, err)
+	require.NoError(t, remote.Put(ref, rawManifest(manifest)))
+
+	testCases := []struct {
+		name       string
+		versions   []string
+		assertions func(*testing.T, []ChartMetadata, error)
+	}{
+		{
+			name:     "version not found",
+			versions: []string{"2.0.0"},
+			assertions: func(t *testing.T, _ []ChartMetadata, err error) {
+				require.ErrorContains(t, err, "error retrieving manifest of version \"2.0.0\"")
+			},
+		},
+		{
+			name:     "success",
+			versions: []string{"1.0.0+build.1"},
+			assertions: func(t *testing.T, metadata []ChartMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []ChartMetadata{{
+					Version:      "1.0.0+build.1",
+					AppVersion:   "v2.3.4",
+					Annotations:  map[string]string{"example.com/foo": "bar"},
+					Dependencies: []ChartDependency{{Name: "redis", Version: "~17.0.0"}},
+				}}, metadata)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			metadata, err := getChartMetadataFromOCIRepo(
+				context.Background(),
+				"oci://"+repo,
+				testCase.versions,
+				getOCIRemoteOptions(context.Background(), nil),
+			)
+			testCase.assertions(t, metadata, err)
+		})
+	}
+
+	t.Run("metadata is cached by digest", func(t *testing.T) {
+		requests := blobRequests.Load()
+		metadata, err := getChartMetadataFromOCIRepo(
+			context.Background(),
+			"oci://"+repo,
+			[]string{"1.0.0+build.1"},
+			getOCIRemoteOptions(context.Background(), nil),
+		)
+		require.NoError(t, err)
+		require.Len(t, metadata, 1)
+		require.Equal(t, "v2.3.4", metadata[0].AppVersion)
+		require.Equal(t, requests, blobRequests.Load())
+	})
+}
+
+func TestChartRepository_reusesClassicRepoIndex(t *testing.T) {
+	var indexRequests atomic.Int32
+	testServer := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			indexRequests.Add(1)
+			_, err := w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+      appVersion: v2.3.4
+`))
+			require.NoError(t, err)
+		}),
+	)
+	defer testServer.Close()
+
+	repo := NewChartRepository(testServer.URL, "fake-chart", nil)
+	versions, err := repo.DiscoverVersions(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.0.0"}, versions)
+	metadata, err := repo.GetMetadata(context.Background(), versions)
+	require.NoError(t, err)
+	require.Equal(t, []ChartMetadata{{Version: "1.0.0", AppVersion: "v2.3.4"}}, metadata)
+	require.Equal(t, int32(1), indexRequests.Load())
+}
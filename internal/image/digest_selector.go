@@ -58,6 +58,15 @@ func (d *digestSelector) Select(ctx context.Context) ([]Image, error) {
 		return nil, nil
 	}
 
+	matched, err := d.opts.matchesFilters(*image)
+	if err != nil {
+		return nil, err
+	}
+	if !matched {
+		logger.Trace("image with tag did not match artifact type or expression filter")
+		return nil, nil
+	}
+
 	logger.Trace("found image with tag")
 	return []Image{*image}, nil
 }
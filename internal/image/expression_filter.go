@@ -0,0 +1,90 @@
+package image
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+// ValidateExpressionFilter returns an error if the provided expression is not a
+// valid expression filter.
+func ValidateExpressionFilter(expression string) error {
+	_, err := compileExpressionFilter(expression)
+	return err
+}
+
+// compileExpressionFilter compiles the provided expression into a program that
+// can be evaluated against the environment returned by
+// newExpressionFilterEnv. The expression must evaluate to a boolean.
+func compileExpressionFilter(expression string) (*vm.Program, error) {
+	program, err := expr.Compile(
+		expression,
+		expr.Env(newExpressionFilterEnv(Image{})),
+		expr.AsBool(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error compiling expression filter %q: %w", expression, err,
+		)
+	}
+	return program, nil
+}
+
+// newExpressionFilterEnv returns the environment in which an expression filter
+// is evaluated for the provided Image.
+func newExpressionFilterEnv(img Image) map[string]any {
+	return map[string]any{
+		"tag":    img.Tag,
+		"digest": img.Digest,
+		"annotation": func(key string) string {
+			return img.Annotations[key]
+		},
+		"label": func(key string) (string, error) {
+			if img.Labels == nil {
+				return "", errors.New(
+					"labels are not available for this image; labels of images " +
+						"with manifests for multiple platforms are only available " +
+						"when a platform constraint is specified",
+				)
+			}
+			return img.Labels[key], nil
+		},
+		"createdAt": func() (time.Time, error) {
+			if img.CreatedAt == nil {
+				return time.Time{}, errors.New(
+					"build date is not available for this image",
+				)
+			}
+			return *img.CreatedAt, nil
+		},
+		"age": func() (time.Duration, error) {
+			if img.CreatedAt == nil {
+				return 0, errors.New(
+					"build date is not available for this image",
+				)
+			}
+			return time.Since(*img.CreatedAt), nil
+		},
+	}
+}
+
+// evaluateExpressionFilter returns a boolean indicating whether the provided
+// Image satisfies the expression filter represented by the provided program. A
+// nil program is satisfied by every Image.
+func evaluateExpressionFilter(program *vm.Program, img Image) (bool, error) {
+	if program == nil {
+		return true, nil
+	}
+	result, err := expr.Run(program, newExpressionFilterEnv(img))
+	if err != nil {
+		return false, fmt.Errorf(
+			"error evaluating expression filter for image with tag %q and digest %q: %w",
+			img.Tag, img.Digest, err,
+		)
+	}
+	matched, _ := result.(bool) // Guaranteed by expr.AsBool()
+	return matched, nil
+}
@@ -0,0 +1,178 @@
+package image
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateExpressionFilter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		errMsg     string
+	}{
+		{
+			name:       "invalid syntax",
+			expression: `label("env") ==`,
+			errMsg:     "error compiling expression filter",
+		},
+		{
+			name:       "unknown variable",
+			expression: `foo == "bar"`,
+			errMsg:     "unknown name foo",
+		},
+		{
+			name:       "does not evaluate to a boolean",
+			expression: `label("env")`,
+			errMsg:     "expected bool",
+		},
+		{
+			name:       "valid",
+			expression: `label("env") == "release" && age() < duration("24h")`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateExpressionFilter(testCase.expression)
+			if testCase.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, testCase.errMsg)
+		})
+	}
+}
+
+func TestEvaluateExpressionFilter(t *testing.T) {
+	testImage := Image{
+		Tag:    "v1.0.0",
+		Digest: "fake-digest",
+		Annotations: map[string]string{
+			"org.opencontainers.image.vendor": "Example",
+		},
+		Labels: map[string]string{
+			"env": "release",
+		},
+		CreatedAt: ptr.To(time.Now().Add(-2 * time.Hour)),
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		img        Image
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "no expression filter",
+			img:  testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+		{
+			name:       "matches tag and digest",
+			expression: `tag == "v1.0.0" && digest == "fake-digest"`,
+			img:        testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+		{
+			name:       "matches label",
+			expression: `label("env") == "release"`,
+			img:        testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+		{
+			name:       "missing label",
+			expression: `label("team") == "platform"`,
+			img:        testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.False(t, matched)
+			},
+		},
+		{
+			name:       "labels not available",
+			expression: `label("env") == "release"`,
+			img: Image{
+				Tag:    "v1.0.0",
+				Digest: "fake-digest",
+			},
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error evaluating expression filter")
+				require.ErrorContains(t, err, `"v1.0.0"`)
+				require.ErrorContains(t, err, "labels are not available for this image")
+			},
+		},
+		{
+			name:       "matches annotation",
+			expression: `annotation("org.opencontainers.image.vendor") == "Example"`,
+			img:        testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+		{
+			name:       "matches minimum age",
+			expression: `age() >= duration("1h")`,
+			img:        testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+		{
+			name:       "does not match maximum age",
+			expression: `age() <= duration("1h")`,
+			img:        testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.False(t, matched)
+			},
+		},
+		{
+			name:       "matches build date",
+			expression: `createdAt() > date("2020-01-01")`,
+			img:        testImage,
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+		{
+			name:       "build date not available",
+			expression: `age() < duration("24h")`,
+			img: Image{
+				Tag:    "v1.0.0",
+				Labels: map[string]string{},
+			},
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "build date is not available for this image")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var err error
+			var matched bool
+			if testCase.expression == "" {
+				matched, err = evaluateExpressionFilter(nil, testCase.img)
+			} else {
+				program, cErr := compileExpressionFilter(testCase.expression)
+				require.NoError(t, cErr)
+				matched, err = evaluateExpressionFilter(program, testCase.img)
+			}
+			testCase.assertions(t, matched, err)
+		})
+	}
+}
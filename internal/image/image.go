@@ -11,7 +11,21 @@ type Image struct {
 	Tag         string
 	Digest      string
 	Annotations map[string]string
-	CreatedAt   *time.Time
+	// Labels holds the labels from the image's config. It is nil if the labels
+	// are not available, which is the case for images with manifests for
+	// multiple platforms when no platform constraint was applied.
+	Labels    map[string]string
+	CreatedAt *time.Time
+	// SourceRepoURL is the URL of the repository containing the source code the
+	// image was built from, if indicated by the image's annotations or labels.
+	SourceRepoURL string
+	// SourceCommit identifies the commit the image was built from, if indicated
+	// by the image's annotations or labels.
+	SourceCommit string
+	// ArtifactType is the type of the artifact referenced by the manifest. For
+	// container images, this is the media type of the image config. It is empty
+	// for manifest lists and image indices.
+	ArtifactType string
 
 	semVer *semver.Version
 }
@@ -65,6 +65,17 @@ func (l *lexicalSelector) Select(ctx context.Context) ([]Image, error) {
 			)
 			continue
 		}
+		matched, err := l.opts.matchesFilters(*image)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			logger.Trace(
+				"image was found, but did not match artifact type or expression filter",
+				"tag", tag,
+			)
+			continue
+		}
 
 		logger.Trace(
 			"discovered image",
@@ -0,0 +1,254 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/patrickmn/go-cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MetadataCacheBackendMemory is the name of the default metadata cache
+	// backend, which keeps image metadata in memory only.
+	MetadataCacheBackendMemory = "memory"
+	// MetadataCacheBackendBolt is the name of the metadata cache backend that
+	// persists image metadata to a bbolt database on disk.
+	MetadataCacheBackendBolt = "bolt"
+	// MetadataCacheBackendConfigMap is the name of the metadata cache backend
+	// that persists image metadata to a Kubernetes ConfigMap, which makes it
+	// possible to share cached image metadata between multiple controllers.
+	MetadataCacheBackendConfigMap = "configmap"
+)
+
+// MetadataCache is an interface for components that cache image metadata.
+// Because image metadata is retrieved by digest and digests are immutable,
+// entries never need to be invalidated and implementations are free to retain
+// them for as long as their capacity allows.
+type MetadataCache interface {
+	// Get returns the Image cached under the provided key, along with a boolean
+	// indicating whether it was found.
+	Get(ctx context.Context, key string) (*Image, bool)
+	// Set caches the provided Image under the provided key. Existing entries are
+	// never overwritten.
+	Set(ctx context.Context, key string, img Image) error
+}
+
+// MetadataCacheConfig represents configuration for a persistent MetadataCache.
+type MetadataCacheConfig struct {
+	// Backend is the name of the backend to use for persisting image metadata.
+	Backend string `envconfig:"IMAGE_METADATA_CACHE_BACKEND" default:"memory"`
+	// Path is the path of the database file used by the bolt backend.
+	Path string `envconfig:"IMAGE_METADATA_CACHE_PATH" default:"/tmp/image-metadata-cache.db"`
+	// MaxEntries is the maximum number of entries retained by the bolt backend.
+	// When exceeded, the oldest entries are evicted first.
+	MaxEntries int `envconfig:"IMAGE_METADATA_CACHE_MAX_ENTRIES" default:"10000"`
+	// ConfigMapNamespace is the namespace of the ConfigMap used by the
+	// configmap backend.
+	ConfigMapNamespace string `envconfig:"IMAGE_METADATA_CACHE_CONFIGMAP_NAMESPACE" default:"kargo"`
+	// ConfigMapName is the name of the ConfigMap used by the configmap backend.
+	ConfigMapName string `envconfig:"IMAGE_METADATA_CACHE_CONFIGMAP_NAME" default:"kargo-image-metadata-cache"`
+	// ConfigMapMaxBytes is the maximum combined size of all entries retained by
+	// the configmap backend. When exceeded, the oldest entries are evicted
+	// first. This must remain comfortably below the size limit Kubernetes
+	// imposes on ConfigMaps.
+	ConfigMapMaxBytes int `envconfig:"IMAGE_METADATA_CACHE_CONFIGMAP_MAX_BYTES" default:"786432"`
+	// ConfigMapFlushInterval is the interval at which the configmap backend
+	// writes new entries to the ConfigMap and picks up entries written by other
+	// controllers. If not positive, new entries are only written when the
+	// MetadataCache is closed.
+	ConfigMapFlushInterval time.Duration `envconfig:"IMAGE_METADATA_CACHE_CONFIGMAP_FLUSH_INTERVAL" default:"30s"`
+}
+
+// MetadataCacheConfigFromEnv returns a MetadataCacheConfig populated from
+// environment variables.
+func MetadataCacheConfigFromEnv() MetadataCacheConfig {
+	cfg := MetadataCacheConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// NewMetadataCache returns a persistent MetadataCache for the backend
+// specified by the provided MetadataCacheConfig. If the memory backend is
+// specified, nil is returned, since the in-memory cache every registry already
+// maintains is sufficient. The provided client is only used by the configmap
+// backend. The returned function must be called to release any resources held
+// by the MetadataCache.
+func NewMetadataCache(
+	cfg MetadataCacheConfig,
+	c client.Client,
+) (MetadataCache, func() error, error) {
+	switch cfg.Backend {
+	case "", MetadataCacheBackendMemory:
+		return nil, func() error { return nil }, nil
+	case MetadataCacheBackendBolt:
+		boltCache, err := newBoltMetadataCache(cfg.Path, cfg.MaxEntries)
+		if err != nil {
+			return nil, nil, err
+		}
+		return boltCache, boltCache.close, nil
+	case MetadataCacheBackendConfigMap:
+		configMapCache := newConfigMapMetadataCache(
+			c,
+			cfg.ConfigMapNamespace,
+			cfg.ConfigMapName,
+			cfg.ConfigMapMaxBytes,
+		)
+		if cfg.ConfigMapFlushInterval > 0 {
+			configMapCache.start(cfg.ConfigMapFlushInterval)
+		}
+		return configMapCache, configMapCache.close, nil
+	default:
+		return nil, nil, fmt.Errorf(
+			"unknown image metadata cache backend %q", cfg.Backend,
+		)
+	}
+}
+
+var (
+	// sharedCache is the persistent MetadataCache, if any, that backs the
+	// in-memory cache of every registry.
+	sharedCache MetadataCache
+	// sharedCacheMu is for preventing concurrent access to sharedCache.
+	sharedCacheMu sync.RWMutex
+)
+
+// SetMetadataCache sets a persistent MetadataCache to be consulted by all
+// registries whenever image metadata is not found in their own in-memory
+// cache. Passing nil restores the default behavior of caching image metadata
+// in memory only.
+func SetMetadataCache(c MetadataCache) {
+	sharedCacheMu.Lock()
+	defer sharedCacheMu.Unlock()
+	sharedCache = c
+}
+
+// getSharedMetadataCache returns the persistent MetadataCache, if any, that was
+// set using SetMetadataCache.
+func getSharedMetadataCache() MetadataCache {
+	sharedCacheMu.RLock()
+	defer sharedCacheMu.RUnlock()
+	return sharedCache
+}
+
+// registryMetadataCache is the MetadataCache implementation used by registries.
+// It caches image metadata in memory and, if a persistent MetadataCache has
+// been set using SetMetadataCache, falls back to that. Keys written to the
+// persistent MetadataCache are qualified by the registry's image prefix so
+// that a single persistent MetadataCache can be shared by all registries.
+type registryMetadataCache struct {
+	imagePrefix string
+	memory      MetadataCache
+}
+
+// newRegistryMetadataCache returns a MetadataCache for the registry with the
+// provided image prefix.
+func newRegistryMetadataCache(imagePrefix string) MetadataCache {
+	return &registryMetadataCache{
+		imagePrefix: imagePrefix,
+		memory:      newMemoryMetadataCache(30 * time.Minute),
+	}
+}
+
+// Get implements MetadataCache.
+func (r *registryMetadataCache) Get(ctx context.Context, key string) (*Image, bool) {
+	if img, ok := r.memory.Get(ctx, key); ok {
+		return img, true
+	}
+	shared := getSharedMetadataCache()
+	if shared == nil {
+		return nil, false
+	}
+	img, ok := shared.Get(ctx, r.sharedKey(key))
+	if !ok {
+		return nil, false
+	}
+	_ = r.memory.Set(ctx, key, *img)
+	return img, true
+}
+
+// Set implements MetadataCache.
+func (r *registryMetadataCache) Set(ctx context.Context, key string, img Image) error {
+	if err := r.memory.Set(ctx, key, img); err != nil {
+		return err
+	}
+	if shared := getSharedMetadataCache(); shared != nil {
+		return shared.Set(ctx, r.sharedKey(key), img)
+	}
+	return nil
+}
+
+// metadataCacheSchemaVersion is the version of the schema of entries written to
+// a persistent MetadataCache. It must be incremented whenever fields are added
+// to Image or the format of keys changes so that entries persisted by earlier
+// versions of Kargo are not used.
+const metadataCacheSchemaVersion = "v5"
+
+// sharedKey qualifies the provided key with the schema version and the
+// registry's image prefix.
+func (r *registryMetadataCache) sharedKey(key string) string {
+	return metadataCacheSchemaVersion + "/" + r.imagePrefix + "@" + key
+}
+
+// memoryMetadataCache is a MetadataCache implementation that keeps image
+// metadata in memory for a fixed period of time.
+type memoryMetadataCache struct {
+	cache *cache.Cache
+}
+
+// newMemoryMetadataCache returns a MetadataCache that keeps image metadata in
+// memory for the provided period of time.
+func newMemoryMetadataCache(ttl time.Duration) MetadataCache {
+	return &memoryMetadataCache{
+		cache: cache.New(
+			ttl,       // Default ttl for each entry
+			time.Hour, // Cleanup interval
+		),
+	}
+}
+
+// Get implements MetadataCache.
+func (m *memoryMetadataCache) Get(_ context.Context, key string) (*Image, bool) {
+	entry, exists := m.cache.Get(key)
+	if !exists {
+		metadataCacheRequestsTotal.WithLabelValues(MetadataCacheBackendMemory, "miss").Inc()
+		return nil, false
+	}
+	metadataCacheRequestsTotal.WithLabelValues(MetadataCacheBackendMemory, "hit").Inc()
+	img := entry.(Image) // nolint: forcetypeassert
+	return &img, true
+}
+
+// Set implements MetadataCache.
+func (m *memoryMetadataCache) Set(_ context.Context, key string, img Image) error {
+	m.cache.Set(key, img, cache.DefaultExpiration)
+	return nil
+}
+
+// marshalImage serializes the provided Image for storage by a persistent
+// MetadataCache.
+func marshalImage(img Image) ([]byte, error) {
+	data, err := json.Marshal(img)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling image %s: %w", img.Digest, err)
+	}
+	return data, nil
+}
+
+// unmarshalImage deserializes an Image that was serialized using marshalImage.
+func unmarshalImage(data []byte) (*Image, error) {
+	img := &Image{}
+	if err := json.Unmarshal(data, img); err != nil {
+		return nil, fmt.Errorf("error unmarshaling image: %w", err)
+	}
+	// It's ok if the tag doesn't parse as semver, but if it does, store it
+	if sv, err := semver.NewVersion(img.Tag); err == nil {
+		img.semVer = sv
+	}
+	return img, nil
+}
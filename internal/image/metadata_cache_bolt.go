@@ -0,0 +1,178 @@
+package image
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+var (
+	// boltImagesBucket is the name of the bucket that maps keys to serialized
+	// images. Each value is prefixed with the sequence number under which the
+	// key is recorded in the boltOrderBucket.
+	boltImagesBucket = []byte("images")
+	// boltOrderBucket is the name of the bucket that maps sequence numbers to
+	// keys. Since bbolt keeps keys sorted, this records the order in which
+	// entries were added and permits the oldest entries to be evicted first.
+	boltOrderBucket = []byte("order")
+)
+
+// boltMetadataCache is a MetadataCache implementation that persists image
+// metadata to a bbolt database on disk so that it survives restarts.
+type boltMetadataCache struct {
+	db         *bbolt.DB
+	maxEntries int
+	// entries tracks the number of entries in the database.
+	entries int
+	// entriesMu is for preventing concurrent access to entries. It is held for
+	// the duration of every read-write transaction.
+	entriesMu sync.Mutex
+}
+
+// newBoltMetadataCache opens or creates the bbolt database at the provided path
+// and returns a MetadataCache backed by it that retains at most the provided
+// number of entries.
+func newBoltMetadataCache(path string, maxEntries int) (*boltMetadataCache, error) {
+	if maxEntries <= 0 {
+		return nil, fmt.Errorf(
+			"max entries for image metadata cache must be greater than 0; got %d",
+			maxEntries,
+		)
+	}
+	db, err := bbolt.Open(
+		path,
+		0o600,
+		// bbolt obtains an exclusive lock on the database file. Do not wait
+		// indefinitely if another process is already holding it.
+		&bbolt.Options{Timeout: 10 * time.Second},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error opening image metadata cache database %s: %w", path, err,
+		)
+	}
+	b := &boltMetadataCache{
+		db:         db,
+		maxEntries: maxEntries,
+	}
+	var entries int
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		images, err := tx.CreateBucketIfNotExists(boltImagesBucket)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.CreateBucketIfNotExists(boltOrderBucket); err != nil {
+			return err
+		}
+		// The maximum number of entries may have been lowered since the
+		// database was last used.
+		entries, err = b.evict(tx, images.Stats().KeyN)
+		return err
+	}); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf(
+			"error initializing image metadata cache database %s: %w", path, err,
+		)
+	}
+	b.entries = entries
+	return b, nil
+}
+
+// Get implements MetadataCache.
+func (b *boltMetadataCache) Get(ctx context.Context, key string) (*Image, bool) {
+	var data []byte
+	if err := b.db.View(func(tx *bbolt.Tx) error {
+		if value := tx.Bucket(boltImagesBucket).Get([]byte(key)); len(value) > 8 {
+			// The value is only valid for the life of the transaction, so it
+			// must be copied.
+			data = append([]byte(nil), value[8:]...)
+		}
+		return nil
+	}); err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "error reading from image metadata cache",
+			"key", key,
+		)
+	}
+	if data == nil {
+		metadataCacheRequestsTotal.WithLabelValues(MetadataCacheBackendBolt, "miss").Inc()
+		return nil, false
+	}
+	img, err := unmarshalImage(data)
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "error reading from image metadata cache",
+			"key", key,
+		)
+		metadataCacheRequestsTotal.WithLabelValues(MetadataCacheBackendBolt, "miss").Inc()
+		return nil, false
+	}
+	metadataCacheRequestsTotal.WithLabelValues(MetadataCacheBackendBolt, "hit").Inc()
+	return img, true
+}
+
+// Set implements MetadataCache.
+func (b *boltMetadataCache) Set(_ context.Context, key string, img Image) error {
+	data, err := marshalImage(img)
+	if err != nil {
+		return err
+	}
+	b.entriesMu.Lock()
+	defer b.entriesMu.Unlock()
+	entries := b.entries
+	if err = b.db.Update(func(tx *bbolt.Tx) error {
+		images := tx.Bucket(boltImagesBucket)
+		if images.Get([]byte(key)) != nil {
+			return nil // Entries are immutable
+		}
+		order := tx.Bucket(boltOrderBucket)
+		seq, err := order.NextSequence()
+		if err != nil {
+			return err
+		}
+		seqKey := make([]byte, 8)
+		binary.BigEndian.PutUint64(seqKey, seq)
+		if err = order.Put(seqKey, []byte(key)); err != nil {
+			return err
+		}
+		if err = images.Put([]byte(key), append(seqKey, data...)); err != nil {
+			return err
+		}
+		entries, err = b.evict(tx, entries+1)
+		return err
+	}); err != nil {
+		return fmt.Errorf("error writing to image metadata cache: %w", err)
+	}
+	b.entries = entries
+	return nil
+}
+
+// evict removes the oldest entries from the database until the provided number
+// of entries no longer exceeds the maximum and returns the resulting number of
+// entries. It must be called from within a read-write transaction.
+func (b *boltMetadataCache) evict(tx *bbolt.Tx, entries int) (int, error) {
+	images := tx.Bucket(boltImagesBucket)
+	cursor := tx.Bucket(boltOrderBucket).Cursor()
+	for seqKey, key := cursor.First(); seqKey != nil && entries > b.maxEntries; seqKey, key = cursor.First() {
+		if err := images.Delete(key); err != nil {
+			return 0, err
+		}
+		if err := cursor.Delete(); err != nil {
+			return 0, err
+		}
+		entries--
+		metadataCacheEvictionsTotal.WithLabelValues(MetadataCacheBackendBolt).Inc()
+	}
+	return entries, nil
+}
+
+// close closes the underlying database.
+func (b *boltMetadataCache) close() error {
+	return b.db.Close()
+}
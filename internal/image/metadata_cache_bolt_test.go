@@ -0,0 +1,60 @@
+package image
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltMetadataCache(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "cache.db")
+
+	c, err := newBoltMetadataCache(dbPath, 2)
+	require.NoError(t, err)
+
+	_, ok := c.Get(ctx, "fake-key-1")
+	require.False(t, ok)
+
+	require.NoError(t, c.Set(ctx, "fake-key-1", Image{Tag: "v1.0.0", Digest: "fake-digest-1"}))
+	img, ok := c.Get(ctx, "fake-key-1")
+	require.True(t, ok)
+	require.Equal(t, "fake-digest-1", img.Digest)
+	require.NotNil(t, img.semVer)
+
+	// Entries are immutable
+	require.NoError(t, c.Set(ctx, "fake-key-1", Image{Digest: "other-digest"}))
+	img, ok = c.Get(ctx, "fake-key-1")
+	require.True(t, ok)
+	require.Equal(t, "fake-digest-1", img.Digest)
+
+	// Exceeding the maximum number of entries evicts the oldest entry
+	require.NoError(t, c.Set(ctx, "fake-key-2", Image{Digest: "fake-digest-2"}))
+	require.NoError(t, c.Set(ctx, "fake-key-3", Image{Digest: "fake-digest-3"}))
+	_, ok = c.Get(ctx, "fake-key-1")
+	require.False(t, ok)
+	_, ok = c.Get(ctx, "fake-key-2")
+	require.True(t, ok)
+	_, ok = c.Get(ctx, "fake-key-3")
+	require.True(t, ok)
+	require.NoError(t, c.close())
+
+	// Entries survive reopening the database, and lowering the maximum number
+	// of entries evicts the oldest entries right away
+	c, err = newBoltMetadataCache(dbPath, 1)
+	require.NoError(t, err)
+	defer c.close()
+	require.Equal(t, 1, c.entries)
+	_, ok = c.Get(ctx, "fake-key-2")
+	require.False(t, ok)
+	img, ok = c.Get(ctx, "fake-key-3")
+	require.True(t, ok)
+	require.Equal(t, "fake-digest-3", img.Digest)
+}
+
+func TestNewBoltMetadataCache(t *testing.T) {
+	_, err := newBoltMetadataCache(filepath.Join(t.TempDir(), "cache.db"), 0)
+	require.ErrorContains(t, err, "must be greater than 0")
+}
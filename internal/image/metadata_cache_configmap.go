@@ -0,0 +1,344 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// configMapMetadataCacheEntry is the representation of a single entry stored
+// in the ConfigMap backing a configMapMetadataCache.
+type configMapMetadataCacheEntry struct {
+	// StoredAt is the time at which the entry was added. It is used to evict the
+	// oldest entries first.
+	StoredAt time.Time `json:"storedAt"`
+	// Image is the serialized Image.
+	Image json.RawMessage `json:"image"`
+}
+
+// configMapMetadataCache is a MetadataCache implementation that persists image
+// metadata to a Kubernetes ConfigMap. Because the ConfigMap can be accessed
+// by any number of controllers, this permits cached image metadata to be
+// shared between controller restarts as well as between shards.
+//
+// ConfigMaps are not served from the controller's cache, so to avoid reading
+// and rewriting the entire ConfigMap for every lookup and every new entry,
+// reads are served from a local copy of the entries that is loaded once and
+// new entries are buffered until they are flushed to the ConfigMap. Flushing
+// also refreshes the local copy with entries written by other controllers.
+type configMapMetadataCache struct {
+	client    client.Client
+	namespace string
+	name      string
+	maxBytes  int
+
+	// mu guards entries, loaded, and pending.
+	mu sync.Mutex
+	// entries is the local copy of the entries stored in the ConfigMap, keyed
+	// by their keys in the ConfigMap's data.
+	entries map[string]string
+	// loaded indicates whether entries has been loaded from the ConfigMap.
+	loaded bool
+	// pending holds the entries that have been set, but not yet flushed to the
+	// ConfigMap, keyed by their keys in the ConfigMap's data.
+	pending map[string]string
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// newConfigMapMetadataCache returns a MetadataCache that persists image
+// metadata to the ConfigMap with the provided namespace and name, retaining
+// entries up to the provided combined size.
+func newConfigMapMetadataCache(
+	c client.Client,
+	namespace string,
+	name string,
+	maxBytes int,
+) *configMapMetadataCache {
+	return &configMapMetadataCache{
+		client:    c,
+		namespace: namespace,
+		name:      name,
+		maxBytes:  maxBytes,
+		entries:   map[string]string{},
+		pending:   map[string]string{},
+	}
+}
+
+// start flushes buffered entries to the ConfigMap at the provided interval
+// until close is called.
+func (c *configMapMetadataCache) start(interval time.Duration) {
+	c.stopCh = make(chan struct{})
+	c.doneCh = make(chan struct{})
+	go func() {
+		defer close(c.doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.stopCh:
+				return
+			case <-ticker.C:
+				ctx := context.Background()
+				if err := c.flush(ctx); err != nil {
+					logging.LoggerFromContext(ctx).Error(err, "error flushing image metadata cache")
+				}
+			}
+		}
+	}()
+}
+
+// close stops flushing buffered entries periodically and flushes any that
+// remain.
+func (c *configMapMetadataCache) close() error {
+	if c.stopCh != nil {
+		close(c.stopCh)
+		<-c.doneCh
+	}
+	c.mu.Lock()
+	pending := len(c.pending)
+	c.mu.Unlock()
+	if pending == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	return c.flush(ctx)
+}
+
+// Get implements MetadataCache.
+func (c *configMapMetadataCache) Get(ctx context.Context, key string) (*Image, bool) {
+	logger := logging.LoggerFromContext(ctx)
+	if err := c.load(ctx); err != nil {
+		logger.Error(err, "error reading from image metadata cache", "key", key)
+	}
+	dataKey := configMapMetadataCacheKey(key)
+	c.mu.Lock()
+	value, ok := c.pending[dataKey]
+	if !ok {
+		value, ok = c.entries[dataKey]
+	}
+	c.mu.Unlock()
+	var img *Image
+	if ok {
+		var err error
+		if img, err = unmarshalConfigMapMetadataCacheEntry(value); err != nil {
+			logger.Error(err, "error reading from image metadata cache", "key", key)
+		}
+	}
+	if img == nil {
+		metadataCacheRequestsTotal.WithLabelValues(MetadataCacheBackendConfigMap, "miss").Inc()
+		return nil, false
+	}
+	metadataCacheRequestsTotal.WithLabelValues(MetadataCacheBackendConfigMap, "hit").Inc()
+	return img, true
+}
+
+// Set implements MetadataCache.
+func (c *configMapMetadataCache) Set(_ context.Context, key string, img Image) error {
+	data, err := marshalImage(img)
+	if err != nil {
+		return err
+	}
+	entry, err := json.Marshal(configMapMetadataCacheEntry{
+		StoredAt: time.Now().UTC(),
+		Image:    data,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling image metadata cache entry: %w", err)
+	}
+	if len(entry) > c.maxBytes {
+		// This entry could never be retained.
+		return nil
+	}
+	dataKey := configMapMetadataCacheKey(key)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.entries[dataKey]; exists {
+		return nil // Entries are immutable
+	}
+	if _, exists := c.pending[dataKey]; exists {
+		return nil // Entries are immutable
+	}
+	c.pending[dataKey] = string(entry)
+	return nil
+}
+
+// load populates the local copy of the entries from the ConfigMap, unless that
+// has already happened.
+func (c *configMapMetadataCache) load(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded {
+		return nil
+	}
+	cm, err := c.getConfigMap(ctx)
+	if err != nil {
+		return err
+	}
+	if cm != nil {
+		maps.Copy(c.entries, cm.Data)
+	}
+	c.loaded = true
+	return nil
+}
+
+// flush writes buffered entries to the ConfigMap, evicting the oldest entries
+// as needed, and replaces the local copy of the entries with the contents of
+// the ConfigMap, which may include entries written by other controllers.
+func (c *configMapMetadataCache) flush(ctx context.Context) error {
+	c.mu.Lock()
+	pending := maps.Clone(c.pending)
+	c.mu.Unlock()
+	var data map[string]string
+	// Other controllers may be updating the ConfigMap concurrently, so retry on
+	// conflict.
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := c.getConfigMap(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			if cm != nil {
+				data = cm.Data
+			}
+			return nil
+		}
+		if cm == nil {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: c.namespace,
+					Name:      c.name,
+				},
+				Data: maps.Clone(pending),
+			}
+			c.evict(cm)
+			err = c.client.Create(ctx, cm)
+			if apierrors.IsAlreadyExists(err) {
+				// Another controller created the ConfigMap first. Treat this
+				// as a conflict so that we try again.
+				return apierrors.NewConflict(
+					corev1.Resource("configmaps"), c.name, err,
+				)
+			}
+			data = cm.Data
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		for key, value := range pending {
+			if _, exists := cm.Data[key]; !exists { // Entries are immutable
+				cm.Data[key] = value
+			}
+		}
+		c.evict(cm)
+		data = cm.Data
+		return c.client.Update(ctx, cm)
+	}); err != nil {
+		return fmt.Errorf(
+			"error writing to image metadata cache ConfigMap %q in namespace %q: %w",
+			c.name, c.namespace, err,
+		)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range pending {
+		delete(c.pending, key)
+	}
+	c.entries = maps.Clone(data)
+	if c.entries == nil {
+		c.entries = map[string]string{}
+	}
+	c.loaded = true
+	return nil
+}
+
+// getConfigMap retrieves the ConfigMap backing the cache. If it does not
+// exist, nil is returned.
+func (c *configMapMetadataCache) getConfigMap(
+	ctx context.Context,
+) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.client.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: c.namespace,
+			Name:      c.name,
+		},
+		cm,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting image metadata cache ConfigMap %q in namespace %q: %w",
+			c.name, c.namespace, err,
+		)
+	}
+	return cm, nil
+}
+
+// unmarshalConfigMapMetadataCacheEntry returns the Image stored in the provided
+// serialized configMapMetadataCacheEntry.
+func unmarshalConfigMapMetadataCacheEntry(value string) (*Image, error) {
+	entry := configMapMetadataCacheEntry{}
+	if err := json.Unmarshal([]byte(value), &entry); err != nil {
+		return nil, fmt.Errorf("error unmarshaling image metadata cache entry: %w", err)
+	}
+	return unmarshalImage(entry.Image)
+}
+
+// evict removes the oldest entries from the provided ConfigMap until the
+// combined size of all entries no longer exceeds the maximum. Entries that
+// cannot be parsed are considered to be the oldest.
+func (c *configMapMetadataCache) evict(cm *corev1.ConfigMap) {
+	type sizedEntry struct {
+		key      string
+		storedAt time.Time
+	}
+	var size int
+	entries := make([]sizedEntry, 0, len(cm.Data))
+	for key, value := range cm.Data {
+		size += len(key) + len(value)
+		entry := configMapMetadataCacheEntry{}
+		_ = json.Unmarshal([]byte(value), &entry)
+		entries = append(entries, sizedEntry{key: key, storedAt: entry.StoredAt})
+	}
+	if size <= c.maxBytes {
+		return
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].storedAt.Before(entries[j].storedAt)
+	})
+	for _, entry := range entries {
+		if size <= c.maxBytes {
+			break
+		}
+		size -= len(entry.key) + len(cm.Data[entry.key])
+		delete(cm.Data, entry.key)
+		metadataCacheEvictionsTotal.WithLabelValues(MetadataCacheBackendConfigMap).Inc()
+	}
+}
+
+// configMapMetadataCacheKey converts the provided key into one that is valid
+// as a key in a ConfigMap's data.
+func configMapMetadataCacheKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
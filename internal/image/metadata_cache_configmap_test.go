@@ -0,0 +1,155 @@
+package image
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestConfigMapMetadataCache(t *testing.T) {
+	ctx := context.Background()
+	var gets, writes int
+	kubeClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(
+			ctx context.Context,
+			c client.WithWatch,
+			key client.ObjectKey,
+			obj client.Object,
+			opts ...client.GetOption,
+		) error {
+			gets++
+			return c.Get(ctx, key, obj, opts...)
+		},
+		Create: func(
+			ctx context.Context,
+			c client.WithWatch,
+			obj client.Object,
+			opts ...client.CreateOption,
+		) error {
+			writes++
+			return c.Create(ctx, obj, opts...)
+		},
+		Update: func(
+			ctx context.Context,
+			c client.WithWatch,
+			obj client.Object,
+			opts ...client.UpdateOption,
+		) error {
+			writes++
+			return c.Update(ctx, obj, opts...)
+		},
+	}).Build()
+	getConfigMap := func() (*corev1.ConfigMap, error) {
+		cm := &corev1.ConfigMap{}
+		err := kubeClient.Get(
+			ctx,
+			client.ObjectKey{Namespace: "fake-namespace", Name: "fake-name"},
+			cm,
+		)
+		return cm, err
+	}
+
+	c := newConfigMapMetadataCache(kubeClient, "fake-namespace", "fake-name", 512)
+
+	// The ConfigMap does not exist yet
+	_, ok := c.Get(ctx, "fake-key-1")
+	require.False(t, ok)
+
+	// Setting an entry makes it available immediately, but does not write it to
+	// the ConfigMap until the cache is flushed
+	require.NoError(t, c.Set(ctx, "fake-key-1", Image{Tag: "v1.0.0", Digest: "fake-digest-1"}))
+	img, ok := c.Get(ctx, "fake-key-1")
+	require.True(t, ok)
+	require.Equal(t, "fake-digest-1", img.Digest)
+	require.NotNil(t, img.semVer)
+	// The ConfigMap was read only once
+	require.Equal(t, 1, gets)
+	_, err := getConfigMap()
+	require.True(t, apierrors.IsNotFound(err))
+	require.Equal(t, 0, writes)
+
+	// Flushing creates the ConfigMap
+	require.NoError(t, c.flush(ctx))
+	require.Equal(t, 1, writes)
+	_, err = getConfigMap()
+	require.NoError(t, err)
+
+	// Entries are immutable
+	require.NoError(t, c.Set(ctx, "fake-key-1", Image{Digest: "other-digest"}))
+	img, ok = c.Get(ctx, "fake-key-1")
+	require.True(t, ok)
+	require.Equal(t, "fake-digest-1", img.Digest)
+
+	// Another cache backed by the same ConfigMap, e.g. in another controller,
+	// sees the same entries
+	other := newConfigMapMetadataCache(kubeClient, "fake-namespace", "fake-name", 512)
+	_, ok = other.Get(ctx, "fake-key-1")
+	require.True(t, ok)
+
+	// Entries flushed by another cache are picked up when flushing
+	require.NoError(t, other.Set(ctx, "fake-key-2", Image{Digest: "fake-digest-2"}))
+	require.NoError(t, other.flush(ctx))
+	_, ok = c.Get(ctx, "fake-key-2")
+	require.False(t, ok)
+	require.NoError(t, c.flush(ctx))
+	_, ok = c.Get(ctx, "fake-key-2")
+	require.True(t, ok)
+
+	// Exceeding the maximum size evicts the oldest entries
+	for _, key := range []string{"fake-key-3", "fake-key-4", "fake-key-5", "fake-key-6"} {
+		require.NoError(t, c.Set(ctx, key, Image{Digest: key}))
+	}
+	writes = 0
+	require.NoError(t, c.flush(ctx))
+	require.Equal(t, 1, writes)
+	_, ok = c.Get(ctx, "fake-key-1")
+	require.False(t, ok)
+	_, ok = c.Get(ctx, "fake-key-6")
+	require.True(t, ok)
+	cm, err := getConfigMap()
+	require.NoError(t, err)
+	var size int
+	for key, value := range cm.Data {
+		size += len(key) + len(value)
+	}
+	require.LessOrEqual(t, size, 512)
+
+	// Closing flushes remaining entries
+	require.NoError(t, other.Set(ctx, "fake-key-7", Image{Digest: "fake-digest-7"}))
+	require.NoError(t, other.close())
+	cm, err = getConfigMap()
+	require.NoError(t, err)
+	require.Contains(t, cm.Data, configMapMetadataCacheKey("fake-key-7"))
+}
+
+func TestConfigMapMetadataCacheStart(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewClientBuilder().Build()
+
+	c := newConfigMapMetadataCache(kubeClient, "fake-namespace", "fake-name", 512)
+	c.start(10 * time.Millisecond)
+	t.Cleanup(func() {
+		require.NoError(t, c.close())
+	})
+
+	require.NoError(t, c.Set(ctx, "fake-key", Image{Digest: "fake-digest"}))
+	require.Eventually(t, func() bool {
+		cm := &corev1.ConfigMap{}
+		if err := kubeClient.Get(
+			ctx,
+			client.ObjectKey{Namespace: "fake-namespace", Name: "fake-name"},
+			cm,
+		); err != nil {
+			return false
+		}
+		_, ok := cm.Data[configMapMetadataCacheKey("fake-key")]
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
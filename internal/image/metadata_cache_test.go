@@ -0,0 +1,105 @@
+package image
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestNewMetadataCache(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        MetadataCacheConfig
+		assertions func(*testing.T, MetadataCache, func() error, error)
+	}{
+		{
+			name: "memory backend",
+			cfg:  MetadataCacheConfig{Backend: MetadataCacheBackendMemory},
+			assertions: func(t *testing.T, c MetadataCache, closeFn func() error, err error) {
+				require.NoError(t, err)
+				require.Nil(t, c)
+				require.NoError(t, closeFn())
+			},
+		},
+		{
+			name: "bolt backend",
+			cfg: MetadataCacheConfig{
+				Backend:    MetadataCacheBackendBolt,
+				Path:       t.TempDir() + "/cache.db",
+				MaxEntries: 10,
+			},
+			assertions: func(t *testing.T, c MetadataCache, closeFn func() error, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &boltMetadataCache{}, c)
+				require.NoError(t, closeFn())
+			},
+		},
+		{
+			name: "configmap backend",
+			cfg:  MetadataCacheConfig{Backend: MetadataCacheBackendConfigMap},
+			assertions: func(t *testing.T, c MetadataCache, closeFn func() error, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &configMapMetadataCache{}, c)
+				require.NoError(t, closeFn())
+			},
+		},
+		{
+			name: "unknown backend",
+			cfg:  MetadataCacheConfig{Backend: "bogus"},
+			assertions: func(t *testing.T, _ MetadataCache, _ func() error, err error) {
+				require.ErrorContains(t, err, "unknown image metadata cache backend")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, closeFn, err := NewMetadataCache(testCase.cfg, nil)
+			testCase.assertions(t, c, closeFn, err)
+		})
+	}
+}
+
+func TestRegistryMetadataCache(t *testing.T) {
+	ctx := context.Background()
+	testImage := newImage("v1.0.0", "fake-digest", ptr.To(time.Now().UTC()))
+
+	shared := newMemoryMetadataCache(time.Hour)
+	SetMetadataCache(shared)
+	t.Cleanup(func() { SetMetadataCache(nil) })
+
+	// Entries should be written through to the shared cache with a key
+	// qualified by the registry's image prefix.
+	c := newRegistryMetadataCache("fake-prefix")
+	require.NoError(t, c.Set(ctx, testImage.Digest, testImage))
+	img, ok := shared.Get(ctx, metadataCacheSchemaVersion+"/fake-prefix@fake-digest")
+	require.True(t, ok)
+	require.Equal(t, testImage, *img)
+
+	// A fresh registry cache, e.g. after a restart, should find the entry in
+	// the shared cache.
+	c = newRegistryMetadataCache("fake-prefix")
+	img, ok = c.Get(ctx, testImage.Digest)
+	require.True(t, ok)
+	require.Equal(t, testImage, *img)
+
+	// A registry with a different prefix should not.
+	c = newRegistryMetadataCache("other-prefix")
+	_, ok = c.Get(ctx, testImage.Digest)
+	require.False(t, ok)
+}
+
+func TestMarshalImage(t *testing.T) {
+	testImage := newImage("v1.0.0", "fake-digest", ptr.To(time.Now().UTC()))
+	testImage.Annotations = map[string]string{"foo": "bar"}
+	data, err := marshalImage(testImage)
+	require.NoError(t, err)
+	img, err := unmarshalImage(data)
+	require.NoError(t, err)
+	require.NotNil(t, img.semVer)
+	require.True(t, testImage.CreatedAt.Equal(*img.CreatedAt))
+	img.CreatedAt = testImage.CreatedAt
+	require.Equal(t, testImage, *img)
+}
@@ -0,0 +1,35 @@
+package image
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// metadataCacheRequestsTotal counts lookups of image metadata by cache
+	// backend and result ("hit" or "miss"). The hit ratio of a backend can be
+	// derived from it.
+	metadataCacheRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kargo_image_metadata_cache_requests_total",
+			Help: "Number of image metadata cache lookups by backend and result",
+		},
+		[]string{"backend", "result"},
+	)
+	// metadataCacheEvictionsTotal counts entries evicted from size-bounded
+	// cache backends.
+	metadataCacheEvictionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kargo_image_metadata_cache_evictions_total",
+			Help: "Number of entries evicted from the image metadata cache by backend",
+		},
+		[]string{"backend"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		metadataCacheRequestsTotal,
+		metadataCacheEvictionsTotal,
+	)
+}
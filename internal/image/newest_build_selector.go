@@ -51,18 +51,38 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 	}
 
 	if n.opts.platform == nil {
-		for _, image := range images[:limit] {
+		discoveredImages := make([]Image, 0, limit)
+		for _, image := range images {
+			if len(discoveredImages) >= limit {
+				break
+			}
+			matched, err := n.opts.matchesFilters(image)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				logger.Trace(
+					"image was found, but did not match artifact type or expression filter",
+					"tag", image.Tag,
+				)
+				continue
+			}
 			logger.Trace(
 				"discovered image",
 				"tag", image.Tag,
 				"digest", image.Digest,
 			)
+			discoveredImages = append(discoveredImages, image)
+		}
+		if len(discoveredImages) == 0 {
+			logger.Trace("no images matched artifact type or expression filter")
+			return nil, nil
 		}
 		logger.Trace(
 			"discovered images",
-			"count", limit,
+			"count", len(discoveredImages),
 		)
-		return images[:limit], nil
+		return discoveredImages, nil
 	}
 
 	// TODO(hidde): this could be more efficient, as we are fetching the image
@@ -89,6 +109,17 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 		}
 
 		discoveredImage.Tag = image.Tag
+		matched, err := n.opts.matchesFilters(*discoveredImage)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			logger.Trace(
+				"image was found, but did not match artifact type or expression filter",
+				"tag", discoveredImage.Tag,
+			)
+			continue
+		}
 		discoveredImages = append(discoveredImages, *discoveredImage)
 
 		logger.Trace(
@@ -100,7 +131,7 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 	}
 
 	if len(discoveredImages) == 0 {
-		logger.Trace("no images matched platform constraint")
+		logger.Trace("no images matched platform constraint, artifact type or expression filter")
 		return nil, nil
 	}
 
@@ -233,11 +264,19 @@ func (n *newestBuildSelector) getImagesByTags(
 }
 
 // sortImagesByDate sorts the provided images in place, in chronologically
-// descending order, breaking ties lexically by tag.
+// descending order, breaking ties lexically by tag. Images without a build date,
+// such as OCI artifacts lacking a creation annotation, are sorted last.
 func sortImagesByDate(images []Image) {
 	slices.SortFunc(images, func(lhs, rhs Image) int {
-		if comp := rhs.CreatedAt.Compare(*lhs.CreatedAt); comp != 0 {
-			return comp
+		switch {
+		case lhs.CreatedAt == nil && rhs.CreatedAt != nil:
+			return 1
+		case lhs.CreatedAt != nil && rhs.CreatedAt == nil:
+			return -1
+		case lhs.CreatedAt != nil && rhs.CreatedAt != nil:
+			if comp := rhs.CreatedAt.Compare(*lhs.CreatedAt); comp != 0 {
+				return comp
+			}
 		}
 		return strings.Compare(rhs.Tag, lhs.Tag)
 	})
@@ -36,6 +36,7 @@ func TestSortImagesByDate(t *testing.T) {
 		{CreatedAt: timePtr(now.Add(2 * time.Hour))},
 		{CreatedAt: timePtr(now.Add(7 * time.Hour))},
 		{CreatedAt: timePtr(now.Add(3 * time.Hour))},
+		{Tag: "undated"},
 	}
 
 	sortImagesByDate(images)
@@ -51,6 +52,7 @@ func TestSortImagesByDate(t *testing.T) {
 			{CreatedAt: timePtr(now.Add(2 * time.Hour))},
 			{CreatedAt: timePtr(now.Add(time.Hour))},
 			{CreatedAt: &now},
+			{Tag: "undated"},
 		},
 		images,
 	)
@@ -1,8 +1,10 @@
 package image
 
 import (
+	"cmp"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
@@ -15,7 +17,6 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/hashicorp/go-cleanhttp"
-	"github.com/patrickmn/go-cache"
 	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
 
@@ -31,6 +32,16 @@ const (
 	maxMetadataConcurrency = 1000
 
 	unknown = "unknown"
+
+	// ociSourceKey is the key of the standard OCI annotation (or label)
+	// indicating the URL of the source code an image was built from.
+	ociSourceKey = "org.opencontainers.image.source"
+	// ociRevisionKey is the key of the standard OCI annotation (or label)
+	// indicating the source control revision an image was built from.
+	ociRevisionKey = "org.opencontainers.image.revision"
+	// ociCreatedKey is the key of the standard OCI annotation indicating the
+	// date and time at which an artifact was created.
+	ociCreatedKey = "org.opencontainers.image.created"
 )
 
 var metaSem = semaphore.NewWeighted(maxMetadataConcurrency)
@@ -185,9 +196,9 @@ func (r *repositoryClient) getImageByDigest(
 		"digest", digest,
 	)
 
-	if entry, exists := r.registry.imageCache.Get(digest); exists {
-		image := entry.(Image) // nolint: forcetypeassert
-		return &image, nil
+	cacheKey := imageCacheKey(digest, platform)
+	if image, exists := r.registry.imageCache.Get(ctx, cacheKey); exists {
+		return image, nil
 	}
 
 	logger.Trace(
@@ -215,16 +226,34 @@ func (r *repositoryClient) getImageByDigest(
 
 	if img != nil {
 		// Cache the image
-		r.registry.imageCache.Set(digest, *img, cache.DefaultExpiration)
-		logger.Trace(
-			"cached image",
-			"digest", digest,
-		)
+		if err = r.registry.imageCache.Set(ctx, cacheKey, *img); err != nil {
+			// Failing to cache the image is not fatal
+			logger.Error(
+				err, "error caching image",
+				"digest", digest,
+			)
+		} else {
+			logger.Trace(
+				"cached image",
+				"digest", digest,
+			)
+		}
 	}
 
 	return img, nil
 }
 
+// imageCacheKey returns the key under which the Image with the provided digest
+// is cached. Because the metadata of an Image retrieved from a multi-platform
+// index depends on the platform constraint, the key includes the platform
+// constraint, if any.
+func imageCacheKey(digest string, platform *platformConstraint) string {
+	if platform == nil {
+		return digest
+	}
+	return digest + "/" + platform.String()
+}
+
 // getImageFromRemoteDesc gets an Image from a given remote.Descriptor.
 func (r *repositoryClient) getImageFromRemoteDesc(
 	ctx context.Context,
@@ -369,6 +398,9 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 		}
 		img.Digest = digest
 		img.Annotations = annotations
+		// Annotations on the index take precedence over those on the manifest
+		img.SourceRepoURL = cmp.Or(annotations[ociSourceKey], img.SourceRepoURL)
+		img.SourceCommit = cmp.Or(annotations[ociRevisionKey], img.SourceCommit)
 
 		return img, nil
 	}
@@ -379,6 +411,7 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 	// platform constraint, so we'll follow ALL the references to find the most
 	// recently pushed manifest's createdAt timestamp.
 	var createdAt *time.Time
+	var sourceRepoURL, sourceCommit string
 	for _, ref := range refs {
 		img, err := r.getImageByDigestFn(ctx, ref.Digest.String(), platform)
 		if err != nil {
@@ -393,6 +426,10 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 		if createdAt == nil || img.CreatedAt.After(*createdAt) {
 			createdAt = img.CreatedAt
 		}
+		// Images for all platforms are expected to have been built from the
+		// same source, so the first one indicating it wins.
+		sourceRepoURL = cmp.Or(sourceRepoURL, img.SourceRepoURL)
+		sourceCommit = cmp.Or(sourceCommit, img.SourceCommit)
 
 		// TODO(hidde): Without a platform constraint, we can not collect
 		// annotations in a meaningful way. We should consider how to handle
@@ -400,9 +437,11 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 	}
 
 	return &Image{
-		Digest:      digest,
-		CreatedAt:   createdAt,
-		Annotations: annotations,
+		Digest:        digest,
+		CreatedAt:     createdAt,
+		Annotations:   annotations,
+		SourceRepoURL: cmp.Or(annotations[ociSourceKey], sourceRepoURL),
+		SourceCommit:  cmp.Or(annotations[ociRevisionKey], sourceCommit),
 	}, nil
 }
 
@@ -414,6 +453,25 @@ func (r *repositoryClient) getImageFromV1Image(
 	img v1.Image,
 	platform *platformConstraint,
 ) (*Image, error) {
+	// Extract annotations from the manifest
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting manifest for image with digest %s: %w",
+			digest, err,
+		)
+	}
+
+	if manifest.Config.MediaType != "" && !manifest.Config.MediaType.IsConfig() {
+		// This isn't a container image, but some other kind of OCI artifact,
+		// whose config cannot be expected to be an image config.
+		if platform != nil {
+			// Artifacts are not specific to any platform.
+			return nil, nil
+		}
+		return getArtifactFromV1Image(digest, img, manifest)
+	}
+
 	cfg, err := img.ConfigFile()
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -426,19 +484,70 @@ func (r *repositoryClient) getImageFromV1Image(
 		return nil, nil
 	}
 
-	// Extract annotations from the manifest
-	manifest, err := img.Manifest()
+	// Distinguish an image without labels from one whose labels are unknown
+	labels := cfg.Config.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	// Annotations on the manifest take precedence over labels on the config
+	return &Image{
+		Digest:        digest,
+		CreatedAt:     &cfg.Created.Time,
+		Annotations:   manifest.Annotations,
+		Labels:        labels,
+		SourceRepoURL: cmp.Or(manifest.Annotations[ociSourceKey], labels[ociSourceKey]),
+		SourceCommit:  cmp.Or(manifest.Annotations[ociRevisionKey], labels[ociRevisionKey]),
+		ArtifactType:  string(manifest.Config.MediaType),
+	}, nil
+}
+
+// getArtifactFromV1Image gets an Image representing an OCI artifact other than
+// a container image from a given v1.Image and its manifest. Since such
+// artifacts have no image config, all metadata is obtained from the manifest.
+func getArtifactFromV1Image(
+	digest string,
+	img v1.Image,
+	manifest *v1.Manifest,
+) (*Image, error) {
+	rawManifest, err := img.RawManifest()
 	if err != nil {
 		return nil, fmt.Errorf(
-			"error getting manifest for image with digest %s: %w",
+			"error getting raw manifest for artifact with digest %s: %w",
 			digest, err,
 		)
 	}
-
+	// v1.Manifest does not include the artifactType field, so it must be
+	// extracted from the raw manifest.
+	artifactManifest := struct {
+		ArtifactType string `json:"artifactType,omitempty"`
+	}{}
+	if err = json.Unmarshal(rawManifest, &artifactManifest); err != nil {
+		return nil, fmt.Errorf(
+			"error parsing manifest for artifact with digest %s: %w",
+			digest, err,
+		)
+	}
+	var createdAt *time.Time
+	if created, err := time.Parse(
+		time.RFC3339,
+		manifest.Annotations[ociCreatedKey],
+	); err == nil {
+		createdAt = &created
+	}
 	return &Image{
 		Digest:      digest,
-		CreatedAt:   &cfg.Created.Time,
+		CreatedAt:   createdAt,
 		Annotations: manifest.Annotations,
+		// Artifacts have no labels
+		Labels:        map[string]string{},
+		SourceRepoURL: manifest.Annotations[ociSourceKey],
+		SourceCommit:  manifest.Annotations[ociRevisionKey],
+		// Per the OCI image spec, the config's media type indicates the type of
+		// an artifact that does not specify one explicitly.
+		ArtifactType: cmp.Or(
+			artifactManifest.ArtifactType,
+			string(manifest.Config.MediaType),
+		),
 	}, nil
 }
 
@@ -10,7 +10,6 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
-	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/require"
 	"k8s.io/utils/ptr"
 )
@@ -137,17 +136,31 @@ func TestGetImageByDigest(t *testing.T) {
 	}
 
 	testRegistry := &registry{
-		imageCache: cache.New(0, 0),
+		imageCache: newMemoryMetadataCache(0),
 	}
-	testRegistry.imageCache.Set(
-		testImage.Digest,
-		testImage,
-		cache.DefaultExpiration,
+	require.NoError(
+		t,
+		testRegistry.imageCache.Set(context.Background(), testImage.Digest, testImage),
+	)
+
+	testPlatform := &platformConstraint{os: "linux", arch: "amd64"}
+	testPlatformImage := Image{
+		Digest: testDigest,
+		Labels: map[string]string{"platform": testPlatform.String()},
+	}
+	require.NoError(
+		t,
+		testRegistry.imageCache.Set(
+			context.Background(),
+			imageCacheKey(testDigest, testPlatform),
+			testPlatformImage,
+		),
 	)
 
 	testCases := []struct {
 		name       string
 		client     *repositoryClient
+		platform   *platformConstraint
 		assertions func(*testing.T, *Image, error)
 	}{
 		{
@@ -160,12 +173,39 @@ func TestGetImageByDigest(t *testing.T) {
 				require.Equal(t, testImage, *img)
 			},
 		},
+		{
+			name: "cache hit with platform constraint",
+			client: &repositoryClient{
+				registry: testRegistry,
+			},
+			platform: testPlatform,
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testPlatformImage, *img)
+			},
+		},
+		{
+			name: "cache miss for different platform constraint",
+			client: &repositoryClient{
+				repoRef:  testRepoRef,
+				registry: testRegistry,
+				remoteGetFn: func(
+					name.Reference, ...remote.Option,
+				) (*remote.Descriptor, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			platform: &platformConstraint{os: "linux", arch: "arm64"},
+			assertions: func(t *testing.T, _ *Image, err error) {
+				require.ErrorContains(t, err, "error getting image descriptor for digest")
+			},
+		},
 		{
 			name: "error getting descriptor by digest",
 			client: &repositoryClient{
 				repoRef: testRepoRef,
 				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
+					imageCache: newMemoryMetadataCache(30 * time.Minute),
 				},
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
@@ -183,7 +223,7 @@ func TestGetImageByDigest(t *testing.T) {
 			client: &repositoryClient{
 				repoRef: testRepoRef,
 				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
+					imageCache: newMemoryMetadataCache(30 * time.Minute),
 				},
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
@@ -206,7 +246,7 @@ func TestGetImageByDigest(t *testing.T) {
 			client: &repositoryClient{
 				repoRef: testRepoRef,
 				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
+					imageCache: newMemoryMetadataCache(30 * time.Minute),
 				},
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
@@ -232,7 +272,7 @@ func TestGetImageByDigest(t *testing.T) {
 			img, err := testCase.client.getImageByDigest(
 				context.Background(),
 				testDigest,
-				nil,
+				testCase.platform,
 			)
 			testCase.assertions(t, img, err)
 		})
@@ -593,6 +633,84 @@ func TestImageFromV1ImageIndex(t *testing.T) {
 				require.Equal(t, "Test Vendor", img.Annotations["org.opencontainers.image.vendor"])
 			},
 		},
+		{
+			name: "with platform constraint, source from manifest and index",
+			idx: &mockImageIndex{
+				indexManifest: &v1.IndexManifest{
+					Manifests: []v1.Descriptor{{
+						Platform: &v1.Platform{
+							OS:           "linux",
+							Architecture: "amd64",
+						},
+					}},
+					Annotations: map[string]string{
+						"org.opencontainers.image.revision": "fake-index-commit",
+					},
+				},
+			},
+			platform: &platformConstraint{
+				os:   "linux",
+				arch: "amd64",
+			},
+			client: &repositoryClient{
+				getImageByDigestFn: func(
+					context.Context, string, *platformConstraint,
+				) (*Image, error) {
+					return &Image{
+						Digest:        testDigest,
+						CreatedAt:     testImage.CreatedAt,
+						SourceRepoURL: "https://github.com/example/repo",
+						SourceCommit:  "fake-manifest-commit",
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(t, "https://github.com/example/repo", img.SourceRepoURL)
+				// Index annotations take precedence
+				require.Equal(t, "fake-index-commit", img.SourceCommit)
+			},
+		},
+		{
+			name: "without platform constraint, source from manifests",
+			idx: &mockImageIndex{
+				indexManifest: &v1.IndexManifest{
+					Manifests: []v1.Descriptor{
+						{
+							Platform: &v1.Platform{
+								OS:           "linux",
+								Architecture: "amd64",
+							},
+						},
+						{
+							Platform: &v1.Platform{
+								OS:           "linux",
+								Architecture: "arm64",
+							},
+						},
+					},
+				},
+			},
+			client: &repositoryClient{
+				getImageByDigestFn: func(
+					_ context.Context, _ string, _ *platformConstraint,
+				) (*Image, error) {
+					return &Image{
+						Digest:        testDigest,
+						CreatedAt:     testImage.CreatedAt,
+						SourceRepoURL: "https://github.com/example/repo",
+						SourceCommit:  "fake-manifest-commit",
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(t, "https://github.com/example/repo", img.SourceRepoURL)
+				require.Equal(t, "fake-manifest-commit", img.SourceCommit)
+			},
+		},
 		{
 			name: "platform specific annotations are ignored",
 			idx: &mockImageIndex{
@@ -678,6 +796,9 @@ func TestGetImageFromV1Image(t *testing.T) {
 				require.NotEmpty(t, img.Digest)
 				require.NotNil(t, img.CreatedAt)
 				require.Nil(t, img.Annotations) // No annotations in manifest
+				// No labels in config, but labels are known to be empty
+				require.NotNil(t, img.Labels)
+				require.Empty(t, img.Labels)
 			},
 		},
 		{
@@ -702,6 +823,117 @@ func TestGetImageFromV1Image(t *testing.T) {
 				require.Equal(t, "2023-01-01T00:00:00Z", img.Annotations["org.opencontainers.image.created"])
 			},
 		},
+		{
+			name: "with source in labels",
+			img: &mockImage{
+				configFile: &v1.ConfigFile{
+					Config: v1.Config{
+						Labels: map[string]string{
+							"org.opencontainers.image.source":   "https://github.com/example/repo",
+							"org.opencontainers.image.revision": "fake-label-commit",
+						},
+					},
+				},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(t, "https://github.com/example/repo", img.SourceRepoURL)
+				require.Equal(t, "fake-label-commit", img.SourceCommit)
+				require.Len(t, img.Labels, 2)
+			},
+		},
+		{
+			name: "with source in annotations and labels",
+			img: &mockImage{
+				configFile: &v1.ConfigFile{
+					Config: v1.Config{
+						Labels: map[string]string{
+							"org.opencontainers.image.source":   "https://github.com/example/repo",
+							"org.opencontainers.image.revision": "fake-label-commit",
+						},
+					},
+				},
+				manifest: &v1.Manifest{
+					Annotations: map[string]string{
+						"org.opencontainers.image.revision": "fake-annotation-commit",
+					},
+				},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				// Annotations take precedence over labels
+				require.Equal(t, "https://github.com/example/repo", img.SourceRepoURL)
+				require.Equal(t, "fake-annotation-commit", img.SourceCommit)
+			},
+		},
+		{
+			name: "artifact",
+			img: &mockImage{
+				manifest: &v1.Manifest{
+					Config: v1.Descriptor{
+						MediaType: "application/vnd.oci.empty.v1+json",
+					},
+					Annotations: map[string]string{
+						"org.opencontainers.image.created":  "2023-01-01T00:00:00Z",
+						"org.opencontainers.image.revision": "fake-commit",
+					},
+				},
+				rawManifest: []byte(`{"artifactType":"application/vnd.example.bundle.v1"}`),
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(t, testDigest, img.Digest)
+				require.Equal(t, "application/vnd.example.bundle.v1", img.ArtifactType)
+				require.NotNil(t, img.CreatedAt)
+				require.Equal(t, 2023, img.CreatedAt.Year())
+				require.Equal(t, "fake-commit", img.SourceCommit)
+				require.NotNil(t, img.Labels)
+				require.Empty(t, img.Labels)
+			},
+		},
+		{
+			name: "artifact without artifact type",
+			img: &mockImage{
+				manifest: &v1.Manifest{
+					Config: v1.Descriptor{
+						MediaType: "application/vnd.cncf.helm.config.v1+json",
+					},
+				},
+				rawManifest: []byte(`{}`),
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(t, "application/vnd.cncf.helm.config.v1+json", img.ArtifactType)
+				require.Nil(t, img.CreatedAt)
+			},
+		},
+		{
+			name: "artifact with platform constraint",
+			img: &mockImage{
+				manifest: &v1.Manifest{
+					Config: v1.Descriptor{
+						MediaType: "application/vnd.oci.empty.v1+json",
+					},
+				},
+			},
+			platform: &platformConstraint{
+				os:   "linux",
+				arch: "amd64",
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, img)
+			},
+		},
 		{
 			name: "does not match platform constraint",
 			img: &mockImage{
@@ -793,8 +1025,9 @@ func (m *mockImageIndex) ImageIndex(v1.Hash) (v1.ImageIndex, error) {
 }
 
 type mockImage struct {
-	configFile *v1.ConfigFile
-	manifest   *v1.Manifest
+	configFile  *v1.ConfigFile
+	manifest    *v1.Manifest
+	rawManifest []byte
 }
 
 func (m *mockImage) Layers() ([]v1.Layer, error) {
@@ -833,7 +1066,10 @@ func (m *mockImage) Manifest() (*v1.Manifest, error) {
 }
 
 func (m *mockImage) RawManifest() ([]byte, error) {
-	return nil, errNotImplemented
+	if m.rawManifest == nil {
+		return nil, errNotImplemented
+	}
+	return m.rawManifest, nil
 }
 
 func (m *mockImage) LayerByDigest(v1.Hash) (v1.Layer, error) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+
+	"github.com/expr-lang/expr/vm"
 )
 
 // SelectionStrategy represents a strategy for selecting a single image from a
@@ -73,9 +75,18 @@ type SelectorOptions struct {
 	InsecureSkipTLSVerify bool
 	// DiscoveryLimit is an optional limit on the number of images that can be
 	// discovered by the Selector. The limit is applied after filtering images
-	// based on the AllowRegex and Ignore fields. If the limit is zero, all
-	// discovered images will be returned.
+	// based on the AllowRegex, Ignore, and ExpressionFilter fields. If the limit
+	// is zero, all discovered images will be returned.
 	DiscoveryLimit int
+	// ExpressionFilter is an optional expression that images must satisfy to be
+	// selected. It is evaluated against each image's metadata after filtering
+	// based on the AllowRegex and Ignore fields.
+	ExpressionFilter string
+	expressionFilter *vm.Program
+	// ArtifactType is an optional artifact type that images must have to be
+	// selected. This is useful for selecting OCI artifacts other than container
+	// images from repositories that may contain artifacts of several types.
+	ArtifactType string
 }
 
 // NewSelector returns some implementation of the Selector interface that
@@ -108,6 +119,13 @@ func NewSelector(
 		}
 	}
 
+	if opts.ExpressionFilter != "" {
+		var err error
+		if opts.expressionFilter, err = compileExpressionFilter(opts.ExpressionFilter); err != nil {
+			return nil, err
+		}
+	}
+
 	repoClient, err := newRepositoryClient(repoURL, opts.InsecureSkipTLSVerify, opts.Creds)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -150,3 +168,12 @@ func ignoresTag(tag string, ignore []string) bool {
 	}
 	return false
 }
+
+// matchesFilters returns a boolean indicating whether the provided Image
+// satisfies the ArtifactType and ExpressionFilter options.
+func (o *SelectorOptions) matchesFilters(img Image) (bool, error) {
+	if o.ArtifactType != "" && img.ArtifactType != o.ArtifactType {
+		return false, nil
+	}
+	return evaluateExpressionFilter(o.expressionFilter, img)
+}
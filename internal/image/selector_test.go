@@ -35,6 +35,16 @@ func TestNewSelector(t *testing.T) {
 				require.ErrorContains(t, err, "error parsing platform constraint")
 			},
 		},
+		{
+			name:    "invalid expression filter",
+			repoURL: "debian",
+			opts: &SelectorOptions{
+				ExpressionFilter: "label(", // Invalid due to unclosed parenthesis
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling expression filter")
+			},
+		},
 		{
 			name:     "invalid selection strategy",
 			strategy: SelectionStrategy("invalid"),
@@ -155,3 +165,45 @@ func TestIgnoresTag(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectorOptionsMatchesFilters(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       SelectorOptions
+		img        Image
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "no filters",
+			img:  Image{ArtifactType: "application/vnd.example.bundle.v1"},
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+		{
+			name: "artifact type does not match",
+			opts: SelectorOptions{ArtifactType: "application/vnd.example.bundle.v1"},
+			img:  Image{ArtifactType: "application/vnd.oci.image.config.v1+json"},
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.False(t, matched)
+			},
+		},
+		{
+			name: "artifact type matches",
+			opts: SelectorOptions{ArtifactType: "application/vnd.example.bundle.v1"},
+			img:  Image{ArtifactType: "application/vnd.example.bundle.v1"},
+			assertions: func(t *testing.T, matched bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matched)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			matched, err := testCase.opts.matchesFilters(testCase.img)
+			testCase.assertions(t, matched, err)
+		})
+	}
+}
@@ -80,6 +80,17 @@ func (s *semVerSelector) Select(ctx context.Context) ([]Image, error) {
 			)
 			continue
 		}
+		matched, err := s.opts.matchesFilters(*image)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			logger.Trace(
+				"image was found, but did not match artifact type or expression filter",
+				"tag", svImage.Tag,
+			)
+			continue
+		}
 
 		logger.Trace(
 			"discovered image",
@@ -0,0 +1,184 @@
+package ini
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Update represents a discrete update to be made to an INI document.
+type Update struct {
+	// Key is the path to the value to update, of the form <section>.<key>. Keys
+	// that precede the first section of a document are addressed by their name
+	// alone.
+	Key string
+	// Value is the new value to set for the key.
+	Value any
+}
+
+// SetValuesInFile overwrites the specified file with the changes specified by
+// the list of Updates. Keys are of the form <section>.<key>, or <key> for keys
+// that precede the first section of the file. An error is returned for any
+// attempted update to a key that does not exist or is defined more than once.
+// Importantly, all comments and style choices in the file are preserved.
+func SetValuesInFile(file string, updates []Update) error {
+	inBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	outBytes, err := SetValuesInBytes(inBytes, updates)
+	if err != nil {
+		return fmt.Errorf("error mutating bytes: %w", err)
+	}
+	// This file should always exist already, so the permissions we choose here
+	// don't really matter.
+	if err = os.WriteFile(file, outBytes, 0600); err != nil {
+		return fmt.Errorf("error writing mutated bytes to file %q: %w", file, err)
+	}
+	return nil
+}
+
+// SetValuesInBytes returns a copy of the provided bytes with the changes
+// specified by Updates applied. Keys are of the form <section>.<key>, or <key>
+// for keys that precede the first section of the document. An error is
+// returned for any attempted update to a key that does not exist or is defined
+// more than once. Importantly, all comments and style choices in the input
+// bytes are preserved in the output.
+func SetValuesInBytes(inBytes []byte, updates []Update) ([]byte, error) {
+	lines := bytes.SplitAfter(inBytes, []byte("\n"))
+	entries, err := parseEntries(lines)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing input: %w", err)
+	}
+
+	// Only the last update to any given key is applied.
+	changes := make(map[int]change, len(updates))
+	for _, update := range updates {
+		e, err := findEntry(entries, update.Key)
+		if err != nil {
+			return nil, fmt.Errorf("error finding key %s: %w", update.Key, err)
+		}
+		value, err := formatValue(update.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error formatting value for key %s: %w", update.Key, err)
+		}
+		changes[e.line] = change{entry: e, value: value}
+	}
+
+	for _, c := range changes {
+		line := lines[c.entry.line]
+		oldValue := line[c.entry.valueStart:c.entry.valueEnd]
+		value := c.value
+		// Retain quotes around the existing value.
+		if len(oldValue) >= 2 && (oldValue[0] == '"' || oldValue[0] == '\'') &&
+			oldValue[len(oldValue)-1] == oldValue[0] {
+			value = string(oldValue[0]) + value + string(oldValue[0])
+		}
+		newLine := make([]byte, 0, len(line)-len(oldValue)+len(value))
+		newLine = append(newLine, line[:c.entry.valueStart]...)
+		newLine = append(newLine, value...)
+		newLine = append(newLine, line[c.entry.valueEnd:]...)
+		lines[c.entry.line] = newLine
+	}
+
+	return bytes.Join(lines, nil), nil
+}
+
+// change represents the replacement of the value of an entry.
+type change struct {
+	entry entry
+	value string
+}
+
+// entry describes a key-value pair in an INI document.
+type entry struct {
+	section string
+	key     string
+	// line is the index of the line the entry is defined on.
+	line int
+	// valueStart and valueEnd delimit the value of the entry within its line.
+	valueStart int
+	valueEnd   int
+}
+
+// parseEntries returns all key-value pairs defined by the provided lines of an
+// INI document. Blank lines and lines starting with ';' or '#' are ignored.
+func parseEntries(lines [][]byte) ([]entry, error) {
+	var entries []entry
+	var section string
+	for i, line := range lines {
+		content := bytes.TrimRight(line, "\r\n")
+		trimmed := bytes.TrimSpace(content)
+		switch {
+		case len(trimmed) == 0, trimmed[0] == ';', trimmed[0] == '#':
+			continue
+		case trimmed[0] == '[':
+			end := bytes.IndexByte(trimmed, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated section header on line %d", i+1)
+			}
+			section = string(bytes.TrimSpace(trimmed[1:end]))
+			continue
+		}
+		sep := bytes.IndexAny(content, "=:")
+		if sep < 0 {
+			// Keys without values and continuation lines can not be updated, but
+			// need not prevent other keys from being updated.
+			continue
+		}
+		valueStart := sep + 1
+		for valueStart < len(content) && (content[valueStart] == ' ' || content[valueStart] == '\t') {
+			valueStart++
+		}
+		valueEnd := len(bytes.TrimRight(content, " \t"))
+		if valueEnd < valueStart {
+			valueEnd = valueStart
+		}
+		entries = append(entries, entry{
+			section:    section,
+			key:        string(bytes.TrimSpace(content[:sep])),
+			line:       i,
+			valueStart: valueStart,
+			valueEnd:   valueEnd,
+		})
+	}
+	return entries, nil
+}
+
+// findEntry returns the entry addressed by the provided key path. Because both
+// section names and keys may contain dots, the key path is compared to the full
+// path of every entry rather than split.
+func findEntry(entries []entry, keyPath string) (entry, error) {
+	var found []entry
+	for _, e := range entries {
+		if (e.section == "" && e.key == keyPath) ||
+			(e.section != "" && keyPath == e.section+"."+e.key) {
+			found = append(found, e)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return entry{}, fmt.Errorf("key path not found")
+	case 1:
+		return found[0], nil
+	default:
+		return entry{}, fmt.Errorf("key path is defined %d times", len(found))
+	}
+}
+
+// formatValue returns the INI representation of the provided value.
+func formatValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if strings.ContainsAny(v, "\r\n") {
+			return "", fmt.Errorf("value must not span multiple lines")
+		}
+		return v, nil
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return fmt.Sprint(v), nil
+	default:
+		return "", fmt.Errorf("value of type %T is not a scalar", value)
+	}
+}
@@ -0,0 +1,109 @@
+package ini
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetValuesInBytes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		updates    []Update
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:    "invalid INI",
+			inBytes: []byte("[image\ntag = v1.0.0\n"),
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "unterminated section header on line 1")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "key not found",
+			inBytes: []byte("[image]\ntag = v1.0.0\n"),
+			updates: []Update{{Key: "image.digest", Value: "sha256:abc"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error finding key image.digest")
+				require.ErrorContains(t, err, "key path not found")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "key defined more than once",
+			inBytes: []byte("[image]\ntag = v1.0.0\n\n[image]\ntag = v1.1.0\n"),
+			updates: []Update{{Key: "image.tag", Value: "v2.0.0"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "key path is defined 2 times")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "value spans multiple lines",
+			inBytes: []byte("[image]\ntag = v1.0.0\n"),
+			updates: []Update{{Key: "image.tag", Value: "v2.0.0\nfoo = bar"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "must not span multiple lines")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "success",
+			inBytes: []byte(`; Global settings
+name=api
+
+[image]
+# The image to deploy
+tag    =   v1.0.0
+digest: "sha256:abc"
+replicas = 1
+
+[service.web]
+enabled = false   
+`),
+			updates: []Update{
+				{Key: "name", Value: "web"},
+				{Key: "image.tag", Value: "v2.0.0"},
+				{Key: "image.digest", Value: "sha256:def"},
+				{Key: "image.replicas", Value: float64(3)},
+				{Key: "service.web.enabled", Value: true},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`; Global settings
+name=web
+
+[image]
+# The image to deploy
+tag    =   v2.0.0
+digest: "sha256:def"
+replicas = 3
+
+[service.web]
+enabled = true   
+`,
+					string(bytes),
+				)
+			},
+		},
+		{
+			name:    "CRLF line endings are preserved",
+			inBytes: []byte("[image]\r\ntag = v1.0.0\r\n"),
+			updates: []Update{{Key: "image.tag", Value: "v2.0.0"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "[image]\r\ntag = v2.0.0\r\n", string(bytes))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := SetValuesInBytes(testCase.inBytes, testCase.updates)
+			testCase.assertions(t, b, err)
+		})
+	}
+}
@@ -2,11 +2,13 @@ package kargo
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/oklog/ulid/v2"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -92,6 +94,166 @@ func (b *PromotionBuilder) Build(
 	return &promotion, nil
 }
 
+// BuildUndo creates a new Promotion for the Freight that undoes the changes
+// made to Git repositories by the given (previous) Promotion of the given
+// Stage. The Git steps of the previous Promotion are repeated, with a
+// git-revert step inserted ahead of each git-push step to revert the commits
+// that the previous Promotion produced.
+func (b *PromotionBuilder) BuildUndo(
+	ctx context.Context,
+	stage kargoapi.Stage,
+	promo kargoapi.Promotion,
+	freight string,
+) (*kargoapi.Promotion, error) {
+	if stage.Name == "" {
+		return nil, fmt.Errorf("stage is required")
+	}
+
+	if freight == "" {
+		return nil, fmt.Errorf("freight is required")
+	}
+
+	steps, err := buildUndoSteps(promo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build metadata
+	annotations := map[string]string{
+		kargoapi.AnnotationKeyUndo: promo.Name,
+	}
+	if u, ok := user.InfoFromContext(ctx); ok {
+		annotations[kargoapi.AnnotationKeyCreateActor] = api.FormatEventUserActor(u)
+	}
+
+	return &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        generatePromotionName(stage.Name, freight),
+			Namespace:   stage.Namespace,
+			Annotations: annotations,
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   stage.Name,
+			Freight: freight,
+			// The variables of the previous Promotion already include those of
+			// the Stage.
+			Vars:  promo.Spec.Vars,
+			Steps: steps,
+		},
+	}, nil
+}
+
+// BuildRetry creates a new Promotion that retries the given (previous)
+// Promotion of the given Stage using the same Freight, variables, and steps.
+// Unless fromStart is true, the new Promotion is annotated so that it resumes
+// from the step at which the previous Promotion stopped.
+func (b *PromotionBuilder) BuildRetry(
+	ctx context.Context,
+	stage kargoapi.Stage,
+	promo kargoapi.Promotion,
+	fromStart bool,
+) (*kargoapi.Promotion, error) {
+	if stage.Name == "" {
+		return nil, fmt.Errorf("stage is required")
+	}
+
+	if promo.Spec.Freight == "" {
+		return nil, fmt.Errorf("freight is required")
+	}
+
+	// Build metadata
+	annotations := make(map[string]string)
+	if !fromStart {
+		annotations[kargoapi.AnnotationKeyRetry] = promo.Name
+	}
+	if u, ok := user.InfoFromContext(ctx); ok {
+		annotations[kargoapi.AnnotationKeyCreateActor] = api.FormatEventUserActor(u)
+	}
+
+	return &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        generatePromotionName(stage.Name, promo.Spec.Freight),
+			Namespace:   stage.Namespace,
+			Annotations: annotations,
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   stage.Name,
+			Freight: promo.Spec.Freight,
+			Vars:    promo.Spec.Vars,
+			// The steps of the previous Promotion have already been inflated, so
+			// the step indices and aliases of both Promotions line up.
+			Steps: promo.Spec.Steps,
+		},
+	}, nil
+}
+
+// buildUndoSteps returns the steps required to revert the commits made by
+// the given Promotion. Only the Git-related steps of the Promotion are
+// retained, with their aliases unchanged so that any references to their
+// output remain valid. The aliases of the inserted git-revert steps are chosen
+// so that they do not collide with any of these.
+func buildUndoSteps(promo kargoapi.Promotion) ([]kargoapi.PromotionStep, error) {
+	aliases := make(map[string]struct{}, len(promo.Spec.Steps))
+	for i, step := range promo.Spec.Steps {
+		aliases[step.GetAlias(i)] = struct{}{}
+	}
+	var revertIndex int
+	nextRevertAlias := func() string {
+		for {
+			revertIndex++
+			alias := fmt.Sprintf("revert-%d", revertIndex)
+			if _, taken := aliases[alias]; !taken {
+				return alias
+			}
+		}
+	}
+
+	steps := make([]kargoapi.PromotionStep, 0, len(promo.Spec.Steps))
+	var reverts int
+	for i, step := range promo.Spec.Steps {
+		step.As = step.GetAlias(i)
+		switch step.Uses {
+		case "git-clone", "git-open-pr", "git-wait-for-pr":
+			steps = append(steps, step)
+		case "git-push":
+			var pushCfg struct {
+				Path string `json:"path"`
+			}
+			if step.Config != nil {
+				if err := json.Unmarshal(step.Config.Raw, &pushCfg); err != nil {
+					return nil, fmt.Errorf(
+						"error unmarshaling config of step %q: %w", step.As, err,
+					)
+				}
+			}
+			revertCfg, err := json.Marshal(map[string]any{
+				"path":      pushCfg.Path,
+				"promotion": promo.Name,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling git-revert config: %w", err)
+			}
+			reverts++
+			steps = append(
+				steps,
+				kargoapi.PromotionStep{
+					Uses:   "git-revert",
+					As:     nextRevertAlias(),
+					Vars:   step.Vars,
+					Config: &apiextensionsv1.JSON{Raw: revertCfg},
+				},
+				step,
+			)
+		}
+	}
+	if reverts == 0 {
+		return nil, fmt.Errorf(
+			"Promotion %q did not push any commits to a Git repository", promo.Name,
+		)
+	}
+	return steps, nil
+}
+
 // InflateSteps inflates the Promotion steps by resolving any references to
 // PromotionTasks and expanding them into their individual steps. The inflated
 // steps are then set on the Promotion, replacing the original steps.
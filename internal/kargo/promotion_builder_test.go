@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -186,6 +187,267 @@ func TestPromotionBuilder_Build(t *testing.T) {
 	}
 }
 
+func TestPromotionBuilder_BuildUndo(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(s))
+
+	testStage := kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-stage",
+			Namespace: "test-project",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		stage      kargoapi.Stage
+		promo      kargoapi.Promotion
+		freight    string
+		assertions func(*testing.T, *kargoapi.Promotion, error)
+	}{
+		{
+			name: "empty stage name returns error",
+			stage: kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-project",
+				},
+			},
+			freight: "abc123",
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				assert.ErrorContains(t, err, "stage is required")
+				assert.Nil(t, promotion)
+			},
+		},
+		{
+			name:    "empty freight returns error",
+			stage:   testStage,
+			freight: "",
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				assert.ErrorContains(t, err, "freight is required")
+				assert.Nil(t, promotion)
+			},
+		},
+		{
+			name:  "promotion without git-push steps returns error",
+			stage: testStage,
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{As: "update", Uses: "argocd-update"},
+					},
+				},
+			},
+			freight: "abc123",
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				assert.ErrorContains(t, err, "did not push any commits")
+				assert.Nil(t, promotion)
+			},
+		},
+		{
+			name:  "successful build",
+			stage: testStage,
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "key0", Value: "value0"},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{As: "clone", Uses: "git-clone"},
+						{Uses: "kustomize-set-image"},
+						{As: "commit", Uses: "git-commit"},
+						{
+							As:   "push",
+							Uses: "git-push",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"path":"./out"}`),
+							},
+						},
+						{As: "update", Uses: "argocd-update"},
+					},
+				},
+			},
+			freight: "abc123",
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, promotion)
+
+				assert.Equal(t, "test-project", promotion.Namespace)
+				assert.Equal(t, "test-stage", promotion.Spec.Stage)
+				assert.Equal(t, "abc123", promotion.Spec.Freight)
+				assert.Equal(
+					t,
+					"test-promotion",
+					promotion.Annotations[kargoapi.AnnotationKeyUndo],
+				)
+				assert.Equal(t, []kargoapi.ExpressionVariable{
+					{Name: "key0", Value: "value0"},
+				}, promotion.Spec.Vars)
+
+				require.Len(t, promotion.Spec.Steps, 3)
+				assert.Equal(t, "clone", promotion.Spec.Steps[0].As)
+				assert.Equal(t, "git-clone", promotion.Spec.Steps[0].Uses)
+				assert.Equal(t, "revert-1", promotion.Spec.Steps[1].As)
+				assert.Equal(t, "git-revert", promotion.Spec.Steps[1].Uses)
+				assert.JSONEq(
+					t,
+					`{"path":"./out","promotion":"test-promotion"}`,
+					string(promotion.Spec.Steps[1].Config.Raw),
+				)
+				assert.Equal(t, "push", promotion.Spec.Steps[2].As)
+				assert.Equal(t, "git-push", promotion.Spec.Steps[2].Uses)
+			},
+		},
+		{
+			name:  "revert aliases do not collide with existing aliases",
+			stage: testStage,
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{As: "revert-1", Uses: "git-clone"},
+						{As: "push-1", Uses: "git-push"},
+						{As: "revert-2", Uses: "git-clone"},
+						{As: "push-2", Uses: "git-push"},
+					},
+				},
+			},
+			freight: "abc123",
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, promotion)
+
+				var aliases []string
+				for _, step := range promotion.Spec.Steps {
+					aliases = append(aliases, step.As)
+				}
+				assert.Equal(
+					t,
+					[]string{"revert-1", "revert-3", "push-1", "revert-2", "revert-4", "push-2"},
+					aliases,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(s).
+				Build()
+
+			b := NewPromotionBuilder(c)
+			promotion, err := b.BuildUndo(context.Background(), tt.stage, tt.promo, tt.freight)
+			tt.assertions(t, promotion, err)
+		})
+	}
+}
+
+func TestPromotionBuilder_BuildRetry(t *testing.T) {
+	testPromo := kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-promotion",
+			Namespace: "test-project",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "test-stage",
+			Freight: "abc123",
+			Vars: []kargoapi.ExpressionVariable{
+				{Name: "key0", Value: "value0"},
+			},
+			Steps: []kargoapi.PromotionStep{
+				{As: "template", Uses: "helm-template"},
+				{As: "push", Uses: "git-push"},
+			},
+		},
+	}
+	testStage := kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-stage",
+			Namespace: "test-project",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		stage      kargoapi.Stage
+		promo      kargoapi.Promotion
+		fromStart  bool
+		assertions func(*testing.T, *kargoapi.Promotion, error)
+	}{
+		{
+			name: "empty stage name returns error",
+			stage: kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-project",
+				},
+			},
+			promo: testPromo,
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				assert.ErrorContains(t, err, "stage is required")
+				assert.Nil(t, promotion)
+			},
+		},
+		{
+			name:  "empty freight returns error",
+			stage: testStage,
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				assert.ErrorContains(t, err, "freight is required")
+				assert.Nil(t, promotion)
+			},
+		},
+		{
+			name:  "successful build",
+			stage: testStage,
+			promo: testPromo,
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, promotion)
+
+				assert.NotEqual(t, "test-promotion", promotion.Name)
+				assert.Equal(t, "test-project", promotion.Namespace)
+				assert.Equal(t, testPromo.Spec, promotion.Spec)
+				assert.Equal(
+					t,
+					"test-promotion",
+					promotion.Annotations[kargoapi.AnnotationKeyRetry],
+				)
+			},
+		},
+		{
+			name:      "successful build from start",
+			stage:     testStage,
+			promo:     testPromo,
+			fromStart: true,
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, promotion)
+
+				assert.Equal(t, testPromo.Spec, promotion.Spec)
+				assert.NotContains(t, promotion.Annotations, kargoapi.AnnotationKeyRetry)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewPromotionBuilder(nil)
+			promotion, err := b.BuildRetry(context.Background(), tt.stage, tt.promo, tt.fromStart)
+			tt.assertions(t, promotion, err)
+		})
+	}
+}
+
 func TestPromotionBuilder_InflateSteps(t *testing.T) {
 	s := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(s))
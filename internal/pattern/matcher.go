@@ -40,6 +40,20 @@ func ParseNamePattern(pattern string) (Matcher, error) {
 	}
 }
 
+// ParseGlobPattern parses a pattern string and returns a Matcher.
+// It recognizes regular expressions (with "regex:" or "regexp:" prefix) and
+// glob patterns (with or without "glob:" prefix).
+func ParseGlobPattern(pattern string) (Matcher, error) {
+	switch {
+	case strings.HasPrefix(pattern, regexPrefix):
+		return NewRegexpMatcher(strings.TrimPrefix(pattern, regexPrefix))
+	case strings.HasPrefix(pattern, regexpPrefix):
+		return NewRegexpMatcher(strings.TrimPrefix(pattern, regexpPrefix))
+	default:
+		return NewGlobPattern(strings.TrimPrefix(pattern, globPrefix))
+	}
+}
+
 // ParsePathPattern parses a pattern string and returns a Matcher.
 // It recognizes glob patterns (with "glob:" prefix), regular expressions (with
 // "regex:" or "regexp:" prefix), and base directory patterns (without any prefix).
@@ -85,6 +85,75 @@ func TestParseNamePattern(t *testing.T) {
 	}
 }
 
+func TestParseGlobPattern(t *testing.T) {
+	tests := []struct {
+		name       string
+		pattern    string
+		assertions func(*testing.T, Matcher, error)
+	}{
+		{
+			name:    "pattern without prefix",
+			pattern: "release/*",
+			assertions: func(t *testing.T, matcher Matcher, err error) {
+				assert.NoError(t, err)
+				assert.IsType(t, &GlobMatcher{}, matcher)
+				assert.Equal(t, "release/*", matcher.String())
+				assert.True(t, matcher.Matches("release/1.0"))
+				assert.False(t, matcher.Matches("main"))
+			},
+		},
+		{
+			name:    "glob pattern",
+			pattern: "glob:release/*",
+			assertions: func(t *testing.T, matcher Matcher, err error) {
+				assert.NoError(t, err)
+				assert.IsType(t, &GlobMatcher{}, matcher)
+				assert.Equal(t, "release/*", matcher.String())
+			},
+		},
+		{
+			name:    "glob pattern with invalid syntax",
+			pattern: "[",
+			assertions: func(t *testing.T, matcher Matcher, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, matcher)
+			},
+		},
+		{
+			name:    "regex pattern",
+			pattern: "regex:^release/[0-9.]+$",
+			assertions: func(t *testing.T, matcher Matcher, err error) {
+				assert.NoError(t, err)
+				assert.IsType(t, &RegexpMatcher{}, matcher)
+				assert.Equal(t, "^release/[0-9.]+$", matcher.String())
+			},
+		},
+		{
+			name:    "regexp pattern",
+			pattern: "regexp:^release/[0-9.]+$",
+			assertions: func(t *testing.T, matcher Matcher, err error) {
+				assert.NoError(t, err)
+				assert.IsType(t, &RegexpMatcher{}, matcher)
+				assert.Equal(t, "^release/[0-9.]+$", matcher.String())
+			},
+		},
+		{
+			name:    "regexp pattern with invalid syntax",
+			pattern: "regexp:[a-z",
+			assertions: func(t *testing.T, matcher Matcher, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, matcher)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matcher, err := ParseGlobPattern(test.pattern)
+			test.assertions(t, matcher, err)
+		})
+	}
+}
+
 func TestParsePathPattern(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1,6 +1,8 @@
 package promotion
 
 import (
+	"context"
+
 	"github.com/akuity/kargo/pkg/promotion"
 )
 
@@ -32,3 +34,53 @@ func RegisterStepRunner(runner promotion.StepRunner) {
 func GetStepRunner(name string) promotion.StepRunner {
 	return stepRunnerReg.getStepRunner(name)
 }
+
+// StepRunnerSource is a source of StepRunners for promotion steps that are
+// discovered at runtime instead of being registered ahead of time, e.g. steps
+// provided by plugins.
+type StepRunnerSource interface {
+	// GetStepRunner returns the StepRunner for the promotion step with the
+	// given name. If the source does not provide a StepRunner for the step, nil
+	// is returned instead.
+	GetStepRunner(ctx context.Context, name string) (promotion.StepRunner, error)
+}
+
+// stepRunnerSources are the StepRunnerSources consulted for promotion steps
+// for which no StepRunner is registered.
+var stepRunnerSources []StepRunnerSource
+
+// RegisterStepRunnerSource adds a StepRunnerSource to the package's internal
+// list of sources. Sources are consulted in the order they were registered and
+// only for promotion steps for which no StepRunner is registered.
+func RegisterStepRunnerSource(source StepRunnerSource) {
+	stepRunnerSources = append(stepRunnerSources, source)
+}
+
+// LookupStepRunner returns the StepRunner for the promotion step with the given
+// name from the package's internal registry or, if none is registered, from the
+// first registered StepRunnerSource that provides one. If no StepRunner is
+// found, nil is returned instead.
+func LookupStepRunner(ctx context.Context, name string) (promotion.StepRunner, error) {
+	return lookupStepRunner(ctx, stepRunnerReg, stepRunnerSources, name)
+}
+
+// lookupStepRunner returns the StepRunner for the promotion step with the given
+// name from the given registry or, if none is registered, from the first of the
+// given StepRunnerSources that provides one.
+func lookupStepRunner(
+	ctx context.Context,
+	registry stepRunnerRegistry,
+	sources []StepRunnerSource,
+	name string,
+) (promotion.StepRunner, error) {
+	if runner := registry.getStepRunner(name); runner != nil {
+		return runner, nil
+	}
+	for _, source := range sources {
+		runner, err := source.GetStepRunner(ctx, name)
+		if err != nil || runner != nil {
+			return runner, err
+		}
+	}
+	return nil, nil
+}
@@ -1,6 +1,8 @@
 package promotion
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -43,3 +45,61 @@ func TestStepRunnerRegistry_getStepRunner(t *testing.T) {
 		assert.Nil(t, stepRunnerRegistry{}.getStepRunner("nonexistent"))
 	})
 }
+
+type fakeStepRunnerSource struct {
+	runners map[string]promotion.StepRunner
+	err     error
+}
+
+func (f *fakeStepRunnerSource) GetStepRunner(
+	_ context.Context,
+	name string,
+) (promotion.StepRunner, error) {
+	return f.runners[name], f.err
+}
+
+func Test_lookupStepRunner(t *testing.T) {
+	builtin := &promotion.MockStepRunner{StepName: "fake-step"}
+	fromSource := &promotion.MockStepRunner{StepName: "fake-step"}
+	otherFromSource := &promotion.MockStepRunner{StepName: "other-step"}
+	registry := stepRunnerRegistry{}
+	registry.register(builtin)
+	sources := []StepRunnerSource{
+		&fakeStepRunnerSource{},
+		&fakeStepRunnerSource{
+			runners: map[string]promotion.StepRunner{
+				"fake-step":  fromSource,
+				"other-step": otherFromSource,
+			},
+		},
+	}
+
+	t.Run("registered runner takes precedence", func(t *testing.T) {
+		runner, err := lookupStepRunner(context.Background(), registry, sources, "fake-step")
+		assert.NoError(t, err)
+		assert.Same(t, builtin, runner)
+	})
+
+	t.Run("runner from source", func(t *testing.T) {
+		runner, err := lookupStepRunner(context.Background(), registry, sources, "other-step")
+		assert.NoError(t, err)
+		assert.Same(t, otherFromSource, runner)
+	})
+
+	t.Run("runner not found", func(t *testing.T) {
+		runner, err := lookupStepRunner(context.Background(), registry, sources, "nonexistent")
+		assert.NoError(t, err)
+		assert.Nil(t, runner)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		runner, err := lookupStepRunner(
+			context.Background(),
+			registry,
+			[]StepRunnerSource{&fakeStepRunnerSource{err: errors.New("something went wrong")}},
+			"other-step",
+		)
+		assert.ErrorContains(t, err, "something went wrong")
+		assert.Nil(t, runner)
+	})
+}
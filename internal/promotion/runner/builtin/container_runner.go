@@ -0,0 +1,541 @@
+package builtin
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/xeipuuv/gojsonschema"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	// containerRunnerLabelKey is the key of the label identifying Pods created
+	// by the container-run step.
+	containerRunnerLabelKey = "kargo.akuity.io/step-runner"
+	// containerRunnerPromotionAnnotationKey is the key of the annotation
+	// recording the name of the Promotion a Pod was created for.
+	containerRunnerPromotionAnnotationKey = "kargo.akuity.io/promotion"
+	// containerRunnerAliasAnnotationKey is the key of the annotation recording
+	// the alias of the step a Pod was created for.
+	containerRunnerAliasAnnotationKey = "kargo.akuity.io/alias"
+
+	// containerRunnerContainerName is the name of the container running the
+	// image of a container-run step.
+	containerRunnerContainerName = "step"
+	// containerRunnerWorkDirVolumeName is the name of the volume holding the
+	// working directory of the promotion.
+	containerRunnerWorkDirVolumeName = "work-dir"
+	// containerRunnerWorkDirMountPath is the path at which the working directory
+	// of the promotion is mounted in the container.
+	containerRunnerWorkDirMountPath = "/workspace"
+	// containerRunnerOutputDir is the directory, relative to the working
+	// directory of the promotion, that containers write their outputs to.
+	containerRunnerOutputDir = ".kargo"
+
+	// containerRunnerPodRetention is how long Pods that finished without their
+	// outcome being collected, e.g. because the step timed out, are retained
+	// before they are deleted.
+	containerRunnerPodRetention = 10 * time.Minute
+	// containerRunnerLogTailLines is the number of lines of the logs of a failed
+	// container that are included in the message of the step.
+	containerRunnerLogTailLines = 10
+	// containerRunnerDefaultTimeout is the timeout of the step when its retry
+	// settings do not specify one.
+	containerRunnerDefaultTimeout = time.Hour
+)
+
+// containerRunnerConfig represents configuration for the container-run step
+// that applies to all promotions.
+type containerRunnerConfig struct {
+	// Namespace is the namespace in which Pods running containers are created.
+	Namespace string `envconfig:"CONTAINER_RUN_NAMESPACE" default:"kargo"`
+	// WorkDirClaimName is the name of the PersistentVolumeClaim mounted at the
+	// controller's temporary directory, which holds the working directories of
+	// promotions. It must be located in Namespace. The container-run step is
+	// disabled when this is empty.
+	WorkDirClaimName string `envconfig:"CONTAINER_RUN_WORK_DIR_CLAIM_NAME"`
+	// ServiceAccountName is the name of the ServiceAccount used by Pods running
+	// containers. Its token is never mounted into these Pods.
+	ServiceAccountName string `envconfig:"CONTAINER_RUN_SERVICE_ACCOUNT_NAME"`
+}
+
+// containerRunnerConfigFromEnv returns a containerRunnerConfig populated from
+// environment variables.
+func containerRunnerConfigFromEnv() containerRunnerConfig {
+	cfg := containerRunnerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// containerRunner is an implementation of the promotion.StepRunner interface
+// that runs an arbitrary container image in a Pod that has the working
+// directory of the promotion mounted.
+type containerRunner struct {
+	schemaLoader gojsonschema.JSONLoader
+	kargoClient  client.Client
+	kubeClient   kubernetes.Interface
+	cfg          containerRunnerConfig
+	tmpDir       string
+	// streamingLogs holds the names of the Pods whose logs are being streamed.
+	streamingLogs sync.Map
+	// streamedLogsUntil holds, by Pod name, the time until which the logs of a
+	// Pod were streamed by a stream that has ended, so that a later stream can
+	// resume from there.
+	streamedLogsUntil sync.Map
+}
+
+// newContainerRunner returns an implementation of the promotion.StepRunner
+// interface that runs an arbitrary container image in a Pod that has the
+// working directory of the promotion mounted.
+func newContainerRunner(
+	kargoClient client.Client,
+	kubeClient kubernetes.Interface,
+) promotion.StepRunner {
+	r := &containerRunner{
+		kargoClient: kargoClient,
+		kubeClient:  kubeClient,
+		cfg:         containerRunnerConfigFromEnv(),
+		tmpDir:      os.TempDir(),
+	}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (c *containerRunner) Name() string {
+	return "container-run"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (c *containerRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := c.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.ContainerRunConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", c.Name(), err)
+	}
+	return c.run(ctx, stepCtx, cfg)
+}
+
+// validate validates containerRunner configuration against a JSON schema.
+func (c *containerRunner) validate(cfg promotion.Config) error {
+	return validate(c.schemaLoader, gojsonschema.NewGoLoader(cfg), c.Name())
+}
+
+func (c *containerRunner) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.ContainerRunConfig,
+) (promotion.StepResult, error) {
+	if c.kubeClient == nil || c.cfg.WorkDirClaimName == "" {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("the %s step is not enabled for this Kargo installation", c.Name()),
+			}
+	}
+	if cfg.Path != "" && !filepath.IsLocal(cfg.Path) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("path %q is not within the working directory", cfg.Path),
+			}
+	}
+
+	c.cleanupPods(ctx)
+
+	podName := containerRunnerPodName(stepCtx)
+	pod, err := c.kubeClient.CoreV1().Pods(c.cfg.Namespace).Get(ctx, podName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return c.startContainer(ctx, stepCtx, cfg, podName)
+	}
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting Pod %q: %w", podName, err)
+	}
+
+	if pod.DeletionTimestamp != nil {
+		// This is the Pod of a previous, failed, attempt at running the step.
+		return promotion.StepResult{
+			Status:  kargoapi.PromotionStepStatusRunning,
+			Message: fmt.Sprintf("waiting for Pod %q of a previous attempt to be deleted", pod.Name),
+		}, nil
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return c.collectOutput(ctx, stepCtx, pod)
+	case corev1.PodFailed:
+		return c.collectFailure(ctx, pod)
+	case corev1.PodRunning:
+		c.streamLogs(ctx, pod.Name)
+	}
+	return promotion.StepResult{
+		Status:  kargoapi.PromotionStepStatusRunning,
+		Message: fmt.Sprintf("waiting for container in Pod %q to complete", pod.Name),
+	}, nil
+}
+
+// startContainer creates the Pod running the container of the step.
+func (c *containerRunner) startContainer(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.ContainerRunConfig,
+	podName string,
+) (promotion.StepResult, error) {
+	workDirSubPath, err := filepath.Rel(c.tmpDir, stepCtx.WorkDir)
+	if err != nil || !filepath.IsLocal(workDirSubPath) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf(
+					"working directory %q is not located on the working directory volume", stepCtx.WorkDir,
+				),
+			}
+	}
+	// The container writes its output to this directory. It is created upfront
+	// because the container may not have permission to do so itself.
+	outputDir := filepath.Join(stepCtx.WorkDir, containerRunnerOutputDir)
+	if err = os.MkdirAll(outputDir, 0o700); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating output directory: %w", err)
+	}
+	// Remove any output left behind by a previous attempt.
+	if err = os.Remove(filepath.Join(outputDir, podName+".json")); err != nil &&
+		!errors.Is(err, os.ErrNotExist) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error removing output of previous attempt: %w", err)
+	}
+
+	var input []byte
+	if len(cfg.Input) > 0 {
+		if input, err = json.Marshal(cfg.Input); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				&promotion.TerminalError{Err: fmt.Errorf("error marshaling input: %w", err)}
+		}
+	}
+
+	pod := c.buildPod(stepCtx, cfg, podName, filepath.ToSlash(workDirSubPath), input)
+	if timeout := c.getTimeout(ctx, stepCtx); timeout > 0 {
+		pod.Spec.ActiveDeadlineSeconds = ptr.To(int64(timeout.Seconds()))
+	}
+	if _, err = c.kubeClient.CoreV1().Pods(c.cfg.Namespace).Create(
+		ctx, pod, metav1.CreateOptions{},
+	); err != nil && !apierrors.IsAlreadyExists(err) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating Pod %q: %w", podName, err)
+	}
+	return promotion.StepResult{
+		Status:  kargoapi.PromotionStepStatusRunning,
+		Message: fmt.Sprintf("started container in Pod %q", podName),
+	}, nil
+}
+
+// buildPod returns the Pod running the container of the step.
+func (c *containerRunner) buildPod(
+	stepCtx *promotion.StepContext,
+	cfg builtin.ContainerRunConfig,
+	podName string,
+	workDirSubPath string,
+	input []byte,
+) *corev1.Pod {
+	env := make([]corev1.EnvVar, 0, len(cfg.Env)+7)
+	for _, e := range cfg.Env {
+		env = append(env, corev1.EnvVar{Name: e.Name, Value: e.Value})
+	}
+	env = append(
+		env,
+		corev1.EnvVar{Name: "KARGO_PROJECT", Value: stepCtx.Project},
+		corev1.EnvVar{Name: "KARGO_STAGE", Value: stepCtx.Stage},
+		corev1.EnvVar{Name: "KARGO_PROMOTION", Value: stepCtx.Promotion},
+		corev1.EnvVar{Name: "KARGO_STEP_ALIAS", Value: stepCtx.Alias},
+		corev1.EnvVar{Name: "KARGO_STEP_INPUT", Value: string(input)},
+		corev1.EnvVar{Name: "KARGO_WORK_DIR", Value: containerRunnerWorkDirMountPath},
+		corev1.EnvVar{
+			Name: "KARGO_OUTPUT_FILE",
+			Value: path.Join(
+				containerRunnerWorkDirMountPath,
+				containerRunnerOutputDir,
+				podName+".json",
+			),
+		},
+	)
+
+	// The container runs as the same user as the controller so that it has
+	// access to the working directory of the promotion.
+	uid, gid := int64(os.Getuid()), int64(os.Getgid())
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: c.cfg.Namespace,
+			Name:      podName,
+			Labels: map[string]string{
+				containerRunnerLabelKey:  c.Name(),
+				kargoapi.ProjectLabelKey: stepCtx.Project,
+			},
+			Annotations: map[string]string{
+				containerRunnerPromotionAnnotationKey: stepCtx.Promotion,
+				containerRunnerAliasAnnotationKey:     stepCtx.Alias,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyNever,
+			ServiceAccountName:           c.cfg.ServiceAccountName,
+			AutomountServiceAccountToken: ptr.To(false),
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsUser:    &uid,
+				RunAsGroup:   &gid,
+				RunAsNonRoot: ptr.To(uid != 0),
+			},
+			Containers: []corev1.Container{{
+				Name:       containerRunnerContainerName,
+				Image:      cfg.Image,
+				Command:    cfg.Command,
+				Args:       cfg.Args,
+				WorkingDir: path.Join(containerRunnerWorkDirMountPath, filepath.ToSlash(cfg.Path)),
+				Env:        env,
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      containerRunnerWorkDirVolumeName,
+					MountPath: containerRunnerWorkDirMountPath,
+					SubPath:   workDirSubPath,
+				}},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To(false),
+					Capabilities: &corev1.Capabilities{
+						Drop: []corev1.Capability{"ALL"},
+					},
+				},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: containerRunnerWorkDirVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: c.cfg.WorkDirClaimName,
+					},
+				},
+			}},
+		},
+	}
+}
+
+// getTimeout returns the timeout of the step, as configured by the retry
+// settings of the corresponding step of the Promotion. A timeout of 0 means
+// the step may run indefinitely.
+func (c *containerRunner) getTimeout(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) time.Duration {
+	timeout := containerRunnerDefaultTimeout
+	promo := &kargoapi.Promotion{}
+	if err := c.kargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: stepCtx.Project, Name: stepCtx.Promotion},
+		promo,
+	); err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "error getting Promotion; using default timeout for container",
+			"promotion", stepCtx.Promotion,
+		)
+		return timeout
+	}
+	for i, step := range promo.Spec.Steps {
+		// Steps without an explicit alias are assigned one based on their index.
+		if step.GetAlias(i) == stepCtx.Alias {
+			if t := step.Retry.GetTimeout(&timeout); t != nil {
+				return *t
+			}
+		}
+	}
+	return timeout
+}
+
+// collectOutput reads the output written by the container of a succeeded Pod
+// and deletes the Pod.
+func (c *containerRunner) collectOutput(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	pod *corev1.Pod,
+) (promotion.StepResult, error) {
+	outputPath := filepath.Join(stepCtx.WorkDir, containerRunnerOutputDir, pod.Name+".json")
+	var output map[string]any
+	outputBytes, err := os.ReadFile(outputPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error reading output of container: %w", err)
+	default:
+		if err = json.Unmarshal(outputBytes, &output); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				&promotion.TerminalError{
+					Err: fmt.Errorf("output of container is not a JSON object: %w", err),
+				}
+		}
+		if err = os.Remove(outputPath); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error removing output of container: %w", err)
+		}
+	}
+	if err = c.deletePod(ctx, pod.Name); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: output,
+	}, nil
+}
+
+// collectFailure returns the outcome of the step for a failed Pod and deletes
+// the Pod. The error that is returned is not terminal, so that the step is
+// retried in a new Pod if its retry settings allow for it.
+func (c *containerRunner) collectFailure(
+	ctx context.Context,
+	pod *corev1.Pod,
+) (promotion.StepResult, error) {
+	reason := pod.Status.Reason
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerRunnerContainerName && status.State.Terminated != nil {
+			reason = fmt.Sprintf("exit code %d", status.State.Terminated.ExitCode)
+		}
+	}
+	if reason == "" {
+		reason = "unknown reason"
+	}
+	msg := fmt.Sprintf("container in Pod %q failed (%s)", pod.Name, reason)
+	if logs := c.tailLogs(ctx, pod.Name); logs != "" {
+		msg = fmt.Sprintf("%s: %s", msg, logs)
+	}
+	if err := c.deletePod(ctx, pod.Name); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	return promotion.StepResult{
+		Status:  kargoapi.PromotionStepStatusFailed,
+		Message: msg,
+	}, errors.New(msg)
+}
+
+// deletePod deletes the Pod with the given name.
+func (c *containerRunner) deletePod(ctx context.Context, name string) error {
+	c.streamedLogsUntil.Delete(name)
+	err := c.kubeClient.CoreV1().Pods(c.cfg.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting Pod %q: %w", name, err)
+	}
+	c.streamingLogs.Delete(name)
+	return nil
+}
+
+// cleanupPods deletes Pods that finished some time ago without their outcome
+// being collected, e.g. because the step timed out. Errors are logged rather
+// than returned, as they do not affect the outcome of the step.
+func (c *containerRunner) cleanupPods(ctx context.Context) {
+	logger := logging.LoggerFromContext(ctx)
+	pods, err := c.kubeClient.CoreV1().Pods(c.cfg.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", containerRunnerLabelKey, c.Name()),
+	})
+	if err != nil {
+		logger.Error(err, "error listing Pods for cleanup")
+		return
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		finishedAt := pod.CreationTimestamp.Time
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Terminated != nil {
+				finishedAt = status.State.Terminated.FinishedAt.Time
+			}
+		}
+		if time.Since(finishedAt) < containerRunnerPodRetention {
+			continue
+		}
+		if err = c.deletePod(ctx, pod.Name); err != nil {
+			logger.Error(err, "error cleaning up Pod")
+		}
+	}
+}
+
+// streamLogs streams the logs of the container in the Pod with the given name
+// to the controller's log until the container terminates or the given context
+// is canceled. It does nothing if the logs of the Pod are already being
+// streamed. A stream that ended before the container terminated is resumed by
+// the next call, from the time the previous stream ended.
+func (c *containerRunner) streamLogs(ctx context.Context, podName string) {
+	if _, streaming := c.streamingLogs.LoadOrStore(podName, struct{}{}); streaming {
+		return
+	}
+	logger := logging.LoggerFromContext(ctx).WithValues("pod", podName)
+	opts := &corev1.PodLogOptions{Container: containerRunnerContainerName, Follow: true}
+	if since, ok := c.streamedLogsUntil.Load(podName); ok {
+		opts.SinceTime = ptr.To(since.(metav1.Time)) // nolint: forcetypeassert
+	}
+	go func() {
+		defer func() {
+			c.streamedLogsUntil.Store(podName, metav1.Now())
+			c.streamingLogs.Delete(podName)
+		}()
+		stream, err := c.kubeClient.CoreV1().Pods(c.cfg.Namespace).GetLogs(podName, opts).Stream(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error(err, "error streaming container logs")
+			}
+			return
+		}
+		defer stream.Close()
+		scanner := bufio.NewScanner(stream)
+		for scanner.Scan() {
+			logger.Info(scanner.Text())
+		}
+	}()
+}
+
+// tailLogs returns the last lines of the logs of the container in the Pod with
+// the given name. An empty string is returned if the logs can not be obtained.
+func (c *containerRunner) tailLogs(ctx context.Context, podName string) string {
+	logs, err := c.kubeClient.CoreV1().Pods(c.cfg.Namespace).GetLogs(
+		podName,
+		&corev1.PodLogOptions{
+			Container: containerRunnerContainerName,
+			TailLines: ptr.To(int64(containerRunnerLogTailLines)),
+		},
+	).DoRaw(ctx)
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error getting container logs", "pod", podName)
+		return ""
+	}
+	return strings.TrimSpace(string(logs))
+}
+
+// containerRunnerPodName returns the name of the Pod running the container of
+// the given step. It is derived from the step's Project, Promotion and alias so
+// that subsequent runs of the same step find the Pod started by the first.
+func containerRunnerPodName(stepCtx *promotion.StepContext) string {
+	sum := sha256.Sum256(
+		[]byte(strings.Join([]string{stepCtx.Project, stepCtx.Promotion, stepCtx.Alias}, "/")),
+	)
+	return "kargo-step-" + hex.EncodeToString(sum[:10])
+}
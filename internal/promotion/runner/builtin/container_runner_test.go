@@ -0,0 +1,540 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_containerRunner_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "image not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): image is required",
+			},
+		},
+		{
+			name: "image is empty string",
+			config: promotion.Config{
+				"image": "",
+			},
+			expectedProblems: []string{
+				"image: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "env var name not specified",
+			config: promotion.Config{
+				"image": "alpine",
+				"env": []promotion.Config{{
+					"value": "bar",
+				}},
+			},
+			expectedProblems: []string{
+				"env.0: name is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"image":   "alpine",
+				"command": []string{"sh", "-c"},
+				"args":    []string{"echo hello"},
+				"env": []promotion.Config{{
+					"name":  "FOO",
+					"value": "bar",
+				}},
+				"path": "src",
+				"input": promotion.Config{
+					"foo": "bar",
+				},
+			},
+		},
+	}
+
+	r := newContainerRunner(nil, nil)
+	runner, ok := r.(*containerRunner)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_containerRunner_run(t *testing.T) {
+	const testNamespace = "kargo"
+
+	testStepCtx := func(workDir string) *promotion.StepContext {
+		return &promotion.StepContext{
+			WorkDir:   workDir,
+			Project:   "fake-project",
+			Stage:     "fake-stage",
+			Promotion: "fake-promotion",
+			Alias:     "fake-alias",
+		}
+	}
+
+	testPod := func(stepCtx *promotion.StepContext, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      containerRunnerPodName(stepCtx),
+				Labels: map[string]string{
+					containerRunnerLabelKey: "container-run",
+				},
+				CreationTimestamp: metav1.Now(),
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		cfg        containerRunnerConfig
+		stepCfg    builtin.ContainerRunConfig
+		objects    func(*promotion.StepContext) []runtime.Object
+		setup      func(*testing.T, *promotion.StepContext)
+		assertions func(
+			*testing.T,
+			kubernetes.Interface,
+			*promotion.StepContext,
+			promotion.StepResult,
+			error,
+		)
+	}{
+		{
+			name: "not enabled",
+			cfg: containerRunnerConfig{
+				Namespace: testNamespace,
+			},
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			assertions: func(
+				t *testing.T,
+				_ kubernetes.Interface,
+				_ *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "not enabled")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "path is not within the working directory",
+			stepCfg: builtin.ContainerRunConfig{
+				Image: "alpine",
+				Path:  "../foo",
+			},
+			assertions: func(
+				t *testing.T,
+				_ kubernetes.Interface,
+				_ *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "is not within the working directory")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "Pod is created",
+			stepCfg: builtin.ContainerRunConfig{
+				Image:   "alpine",
+				Command: []string{"sh", "-c"},
+				Args:    []string{"echo hello"},
+				Env: []builtin.ContainerRunEnvVar{{
+					Name:  "FOO",
+					Value: "bar",
+				}},
+				Path: "src",
+				Input: map[string]any{
+					"foo": "bar",
+				},
+			},
+			objects: func(stepCtx *promotion.StepContext) []runtime.Object {
+				return []runtime.Object{
+					&kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: stepCtx.Project,
+							Name:      stepCtx.Promotion,
+						},
+						Spec: kargoapi.PromotionSpec{
+							Steps: []kargoapi.PromotionStep{{
+								Uses: "container-run",
+								As:   stepCtx.Alias,
+								Retry: &kargoapi.PromotionStepRetry{
+									Timeout: &metav1.Duration{Duration: 5 * time.Minute},
+								},
+							}},
+						},
+					},
+				}
+			},
+			assertions: func(
+				t *testing.T,
+				kubeClient kubernetes.Interface,
+				stepCtx *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+
+				pod, err := kubeClient.CoreV1().Pods(testNamespace).Get(
+					context.Background(),
+					containerRunnerPodName(stepCtx),
+					metav1.GetOptions{},
+				)
+				require.NoError(t, err)
+				require.Equal(t, "container-run", pod.Labels[containerRunnerLabelKey])
+				require.Equal(t, stepCtx.Project, pod.Labels[kargoapi.ProjectLabelKey])
+				require.Equal(t, int64(300), ptr.Deref(pod.Spec.ActiveDeadlineSeconds, 0))
+				require.Equal(t, "fake-claim", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+				container := pod.Spec.Containers[0]
+				require.Equal(t, "alpine", container.Image)
+				require.Equal(t, []string{"sh", "-c"}, container.Command)
+				require.Equal(t, []string{"echo hello"}, container.Args)
+				require.Equal(t, "/workspace/src", container.WorkingDir)
+				require.Equal(t, filepath.Base(stepCtx.WorkDir), container.VolumeMounts[0].SubPath)
+				require.Contains(t, container.Env, corev1.EnvVar{Name: "FOO", Value: "bar"})
+				require.Contains(
+					t,
+					container.Env,
+					corev1.EnvVar{Name: "KARGO_STEP_INPUT", Value: `{"foo":"bar"}`},
+				)
+				require.Contains(
+					t,
+					container.Env,
+					corev1.EnvVar{
+						Name:  "KARGO_OUTPUT_FILE",
+						Value: "/workspace/.kargo/" + pod.Name + ".json",
+					},
+				)
+
+				require.DirExists(t, filepath.Join(stepCtx.WorkDir, containerRunnerOutputDir))
+			},
+		},
+		{
+			name:    "Pod is running",
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: func(stepCtx *promotion.StepContext) []runtime.Object {
+				return []runtime.Object{testPod(stepCtx, corev1.PodPending)}
+			},
+			assertions: func(
+				t *testing.T,
+				_ kubernetes.Interface,
+				_ *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				require.Contains(t, res.Message, "waiting for container")
+			},
+		},
+		{
+			name:    "Pod succeeded",
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: func(stepCtx *promotion.StepContext) []runtime.Object {
+				return []runtime.Object{testPod(stepCtx, corev1.PodSucceeded)}
+			},
+			setup: func(t *testing.T, stepCtx *promotion.StepContext) {
+				outputDir := filepath.Join(stepCtx.WorkDir, containerRunnerOutputDir)
+				require.NoError(t, os.MkdirAll(outputDir, 0o700))
+				require.NoError(t, os.WriteFile(
+					filepath.Join(outputDir, containerRunnerPodName(stepCtx)+".json"),
+					[]byte(`{"foo":"bar"}`),
+					0o600,
+				))
+			},
+			assertions: func(
+				t *testing.T,
+				kubeClient kubernetes.Interface,
+				stepCtx *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(t, map[string]any{"foo": "bar"}, res.Output)
+
+				require.NoFileExists(
+					t,
+					filepath.Join(
+						stepCtx.WorkDir,
+						containerRunnerOutputDir,
+						containerRunnerPodName(stepCtx)+".json",
+					),
+				)
+				pods, err := kubeClient.CoreV1().Pods(testNamespace).List(
+					context.Background(),
+					metav1.ListOptions{},
+				)
+				require.NoError(t, err)
+				require.Empty(t, pods.Items)
+			},
+		},
+		{
+			name:    "Pod succeeded with invalid output",
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: func(stepCtx *promotion.StepContext) []runtime.Object {
+				return []runtime.Object{testPod(stepCtx, corev1.PodSucceeded)}
+			},
+			setup: func(t *testing.T, stepCtx *promotion.StepContext) {
+				outputDir := filepath.Join(stepCtx.WorkDir, containerRunnerOutputDir)
+				require.NoError(t, os.MkdirAll(outputDir, 0o700))
+				require.NoError(t, os.WriteFile(
+					filepath.Join(outputDir, containerRunnerPodName(stepCtx)+".json"),
+					[]byte(`["foo"]`),
+					0o600,
+				))
+			},
+			assertions: func(
+				t *testing.T,
+				_ kubernetes.Interface,
+				_ *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "output of container is not a JSON object")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:    "Pod failed",
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: func(stepCtx *promotion.StepContext) []runtime.Object {
+				pod := testPod(stepCtx, corev1.PodFailed)
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+					Name: containerRunnerContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode:   2,
+							FinishedAt: metav1.Now(),
+						},
+					},
+				}}
+				return []runtime.Object{pod}
+			},
+			assertions: func(
+				t *testing.T,
+				kubeClient kubernetes.Interface,
+				_ *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.ErrorContains(t, err, "exit code 2")
+				require.False(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+
+				pods, err := kubeClient.CoreV1().Pods(testNamespace).List(
+					context.Background(),
+					metav1.ListOptions{},
+				)
+				require.NoError(t, err)
+				require.Empty(t, pods.Items)
+			},
+		},
+		{
+			name:    "old finished Pods are cleaned up",
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: func(stepCtx *promotion.StepContext) []runtime.Object {
+				pod := testPod(stepCtx, corev1.PodFailed)
+				pod.Name = "kargo-step-old"
+				pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+				return []runtime.Object{pod}
+			},
+			assertions: func(
+				t *testing.T,
+				kubeClient kubernetes.Interface,
+				stepCtx *promotion.StepContext,
+				res promotion.StepResult,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+
+				pods, err := kubeClient.CoreV1().Pods(testNamespace).List(
+					context.Background(),
+					metav1.ListOptions{},
+				)
+				require.NoError(t, err)
+				require.Len(t, pods.Items, 1)
+				require.Equal(t, containerRunnerPodName(stepCtx), pods.Items[0].Name)
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			workDir := filepath.Join(tmpDir, "promotion-fake")
+			require.NoError(t, os.Mkdir(workDir, 0o700))
+			stepCtx := testStepCtx(workDir)
+
+			var objects []runtime.Object
+			if testCase.objects != nil {
+				objects = testCase.objects(stepCtx)
+			}
+			var pods, kargoObjects []runtime.Object
+			for _, obj := range objects {
+				if _, ok := obj.(*corev1.Pod); ok {
+					pods = append(pods, obj)
+				} else {
+					kargoObjects = append(kargoObjects, obj)
+				}
+			}
+			kubeClient := kubefake.NewClientset(pods...)
+
+			cfg := testCase.cfg
+			if cfg == (containerRunnerConfig{}) {
+				cfg = containerRunnerConfig{
+					Namespace:        testNamespace,
+					WorkDirClaimName: "fake-claim",
+				}
+			}
+
+			if testCase.setup != nil {
+				testCase.setup(t, stepCtx)
+			}
+
+			runner := &containerRunner{
+				kargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithRuntimeObjects(kargoObjects...).
+					Build(),
+				kubeClient: kubeClient,
+				cfg:        cfg,
+				tmpDir:     tmpDir,
+			}
+			res, err := runner.run(context.Background(), stepCtx, testCase.stepCfg)
+			testCase.assertions(t, kubeClient, stepCtx, res, err)
+		})
+	}
+}
+
+func Test_containerRunner_getTimeout(t *testing.T) {
+	testPromotion := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Steps: []kargoapi.PromotionStep{
+				{
+					Uses: "git-clone",
+				},
+				{
+					Uses: "container-run",
+					Retry: &kargoapi.PromotionStepRetry{
+						Timeout: &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+				{
+					Uses: "container-run",
+					As:   "aliased",
+					Retry: &kargoapi.PromotionStepRetry{
+						Timeout: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		promotion string
+		alias     string
+		expected  time.Duration
+	}{
+		{
+			name:      "Promotion not found",
+			promotion: "non-existent",
+			alias:     "aliased",
+			expected:  containerRunnerDefaultTimeout,
+		},
+		{
+			name:      "step without a timeout",
+			promotion: "fake-promotion",
+			alias:     "step-1",
+			expected:  containerRunnerDefaultTimeout,
+		},
+		{
+			name:      "step without an explicit alias",
+			promotion: "fake-promotion",
+			alias:     "step-2",
+			expected:  5 * time.Minute,
+		},
+		{
+			name:      "step with an explicit alias",
+			promotion: "fake-promotion",
+			alias:     "aliased",
+			expected:  10 * time.Minute,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			runner := &containerRunner{
+				kargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testPromotion).
+					Build(),
+			}
+			require.Equal(
+				t,
+				testCase.expected,
+				runner.getTimeout(
+					context.Background(),
+					&promotion.StepContext{
+						Project:   "fake-project",
+						Promotion: testCase.promotion,
+						Alias:     testCase.alias,
+					},
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package builtin
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"cuelang.org/go/cue/errors"
+	"cuelang.org/go/cue/load"
+	"cuelang.org/go/mod/modcache"
+	"cuelang.org/go/mod/modconfig"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// cueExporter is an implementation of the promotion.StepRunner interface that
+// exports a CUE package to YAML or JSON.
+type cueExporter struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newCUEExporter returns an implementation of the promotion.StepRunner
+// interface that exports a CUE package to YAML or JSON.
+func newCUEExporter(credsDB credentials.Database) promotion.StepRunner {
+	r := &cueExporter{credsDB: credsDB}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (c *cueExporter) Name() string {
+	return "cue-export"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (c *cueExporter) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := c.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.CUEExportConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", c.Name(), err)
+	}
+	return c.run(ctx, stepCtx, cfg)
+}
+
+// validate validates cueExporter configuration against a JSON schema.
+func (c *cueExporter) validate(cfg promotion.Config) error {
+	return validate(c.schemaLoader, gojsonschema.NewGoLoader(cfg), c.Name())
+}
+
+func (c *cueExporter) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.CUEExportConfig,
+) (promotion.StepResult, error) {
+	dir, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not secure join path %q: %w", cfg.Path, err)
+	}
+
+	value, err := c.buildValue(ctx, stepCtx, dir, cfg)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error exporting %q: %s", cfg.Path, formatCUEError(err, stepCtx.WorkDir))
+	}
+	out, err := value.MarshalJSON()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error exporting %q: %s", cfg.Path, formatCUEError(err, stepCtx.WorkDir))
+	}
+
+	outPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not secure join outPath %q: %w", cfg.OutPath, err)
+	}
+	if err = writeRenderedOutput(outPath, out); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"error writing exported output to %q: %w",
+				cfg.OutPath, sanitizePathError(err, stepCtx.WorkDir),
+			)
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// buildValue loads the CUE package in the given directory, injects the tags
+// and values from the configuration and returns the concrete value to export.
+func (c *cueExporter) buildValue(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	dir string,
+	cfg builtin.CUEExportConfig,
+) (cue.Value, error) {
+	regDir, err := os.MkdirTemp("", "cue-registry-")
+	if err != nil {
+		return cue.Value{}, fmt.Errorf("error creating directory for CUE registry: %w", err)
+	}
+	// The module cache makes its directories read-only, so it must be removed
+	// using the function provided for that purpose.
+	defer modcache.RemoveAll(regDir) // nolint: errcheck
+	registry, err := c.newRegistry(ctx, stepCtx.Project, regDir)
+	if err != nil {
+		return cue.Value{}, err
+	}
+
+	tags := make([]string, 0, len(cfg.Tags))
+	for k, v := range cfg.Tags {
+		tags = append(tags, k+"="+v)
+	}
+	slices.Sort(tags)
+
+	pkg := "."
+	if cfg.Package != "" {
+		pkg += ":" + cfg.Package
+	}
+	insts := load.Instances([]string{pkg}, &load.Config{
+		Dir:        dir,
+		ModuleRoot: findCUEModuleRoot(stepCtx.WorkDir, dir),
+		Tags:       tags,
+		Registry:   registry,
+	})
+	if len(insts) != 1 {
+		return cue.Value{}, fmt.Errorf("expected a single CUE instance, got %d", len(insts))
+	}
+	if err := insts[0].Err; err != nil {
+		return cue.Value{}, err
+	}
+
+	cueCtx := cuecontext.New()
+	value := cueCtx.BuildInstance(insts[0])
+	if err := value.Err(); err != nil {
+		return cue.Value{}, err
+	}
+	for _, v := range cfg.Values {
+		path := cue.ParsePath(v.Path)
+		if err := path.Err(); err != nil {
+			return cue.Value{}, fmt.Errorf("invalid path %q: %w", v.Path, err)
+		}
+		value = value.FillPath(path, v.Value)
+	}
+	if cfg.Expression != "" {
+		value = cueCtx.CompileString(cfg.Expression, cue.Scope(value), cue.InferBuiltins(true))
+	}
+	if err := value.Validate(cue.Concrete(true)); err != nil {
+		return cue.Value{}, err
+	}
+	return value, nil
+}
+
+// newRegistry returns a modconfig.Registry for fetching the dependencies of
+// CUE modules on behalf of the given Project. Modules are fetched into a cache
+// within the given directory, so they are never shared between promotions.
+// Registries are authenticated using the Project's image credentials, and
+// never using the logins of the controller itself. Registries are configured
+// using the controller's CUE_REGISTRY environment variable, if it is set.
+func (c *cueExporter) newRegistry(
+	ctx context.Context,
+	project string,
+	dir string,
+) (modconfig.Registry, error) {
+	dockerConfigDir := filepath.Join(dir, "docker")
+	env := []string{
+		"CUE_REGISTRY=" + os.Getenv("CUE_REGISTRY"),
+		"CUE_CACHE_DIR=" + filepath.Join(dir, "cache"),
+		"CUE_CONFIG_DIR=" + filepath.Join(dir, "config"),
+		"DOCKER_CONFIG=" + dockerConfigDir,
+	}
+	resolver, err := modconfig.NewResolver(&modconfig.Config{Env: env})
+	if err != nil {
+		return nil, fmt.Errorf("error configuring CUE registries: %w", err)
+	}
+
+	type dockerAuth struct {
+		Auth string `json:"auth"`
+	}
+	auths := map[string]dockerAuth{}
+	for _, host := range resolver.AllHosts() {
+		creds, err := c.credsDB.Get(ctx, project, credentials.TypeImage, host.Name)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for CUE registry %q: %w",
+				host.Name, err,
+			)
+		}
+		if creds == nil {
+			continue
+		}
+		auths[host.Name] = dockerAuth{
+			Auth: base64.StdEncoding.EncodeToString(
+				[]byte(creds.Username + ":" + creds.Password),
+			),
+		}
+	}
+	dockerConfig, err := json.Marshal(map[string]any{"auths": auths})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling CUE registry credentials: %w", err)
+	}
+	if err = os.Mkdir(dockerConfigDir, 0o700); err != nil {
+		return nil, fmt.Errorf("error writing CUE registry credentials: %w", err)
+	}
+	if err = os.WriteFile(
+		filepath.Join(dockerConfigDir, "config.json"),
+		dockerConfig,
+		0o600,
+	); err != nil {
+		return nil, fmt.Errorf("error writing CUE registry credentials: %w", err)
+	}
+
+	registry, err := modconfig.NewRegistry(&modconfig.Config{
+		Env:        env,
+		ClientType: "kargo",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error configuring CUE registries: %w", err)
+	}
+	return registry, nil
+}
+
+// findCUEModuleRoot returns the closest directory containing a cue.mod
+// directory, starting from the given directory and not looking beyond the
+// working directory. If no such directory is found, the given directory is
+// returned, so that loading never reaches outside the working directory.
+func findCUEModuleRoot(workDir, dir string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		if fi, err := os.Stat(filepath.Join(d, "cue.mod")); err == nil && fi.IsDir() {
+			return d
+		}
+		if d == workDir || d == filepath.Dir(d) {
+			return dir
+		}
+	}
+}
+
+// formatCUEError returns a description of the given error. For CUE errors,
+// this includes the positions of all errors it wraps, with absolute paths
+// within the working directory made relative to it.
+func formatCUEError(err error, workDir string) string {
+	if _, ok := err.(errors.Error); !ok { // nolint: errorlint
+		return err.Error()
+	}
+	return strings.TrimSpace(errors.Details(err, &errors.Config{
+		Cwd:     workDir,
+		ToSlash: true,
+	}))
+}
@@ -0,0 +1,371 @@
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_cueExporter_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "path and outPath not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+				"(root): outPath is required",
+			},
+		},
+		{
+			name: "path and outPath are empty strings",
+			config: promotion.Config{
+				"path":    "",
+				"outPath": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+				"outPath: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "tag value is not a string",
+			config: promotion.Config{
+				"path":    "env",
+				"outPath": "out.yaml",
+				"tags": map[string]any{
+					"replicas": 3,
+				},
+			},
+			expectedProblems: []string{
+				"tags.replicas: Invalid type. Expected: string, given: integer",
+			},
+		},
+		{
+			name: "value without path",
+			config: promotion.Config{
+				"path":    "env",
+				"outPath": "out.yaml",
+				"values": []map[string]any{{
+					"value": "v1.0.0",
+				}},
+			},
+			expectedProblems: []string{
+				"values.0: path is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":       "env",
+				"outPath":    "out.yaml",
+				"package":    "prod",
+				"expression": "objects",
+				"tags": map[string]any{
+					"env": "prod",
+				},
+				"values": []map[string]any{{
+					"path":  "image.tag",
+					"value": "v1.0.0",
+				}},
+			},
+		},
+	}
+
+	r := newCUEExporter(&credentials.FakeDB{})
+	runner, ok := r.(*cueExporter)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_cueExporter_run(t *testing.T) {
+	const testPackage = `package app
+
+env: string @tag(env)
+
+image: {
+	repo: "ghcr.io/example/app"
+	tag:  string
+}
+
+let img = image
+
+replicas: *1 | int
+if env == "prod" {
+	replicas: 3
+}
+
+objects: [{
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	metadata: name: "app-\(env)"
+	spec: {
+		"replicas": replicas
+		template: spec: containers: [{
+			name:  "app"
+			image: "\(img.repo):\(img.tag)"
+		}]
+	}
+}, {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: name: "app-\(env)"
+	data: "env": env
+}]
+`
+
+	testCases := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.CUEExportConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "exports expression as YAML stream",
+			files: map[string]string{
+				"cue.mod/module.cue": "module: \"example.com/app\"\nlanguage: version: \"v0.9.0\"\n",
+				"env/app.cue":        testPackage,
+			},
+			cfg: builtin.CUEExportConfig{
+				Path:       "env",
+				OutPath:    "out/manifests.yaml",
+				Expression: "objects",
+				Tags:       map[string]string{"env": "prod"},
+				Values: []builtin.CUEValue{{
+					Path:  "image.tag",
+					Value: "v1.2.3",
+				}},
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "out/manifests.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app-prod
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+        - name: app
+          image: ghcr.io/example/app:v1.2.3
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-prod
+data:
+  env: prod
+`, string(b))
+			},
+		},
+		{
+			name: "exports package as JSON",
+			files: map[string]string{
+				"values.cue": "package values\n\nname: string\nport: int\n",
+			},
+			cfg: builtin.CUEExportConfig{
+				Path:    ".",
+				OutPath: "out.json",
+				Values: []builtin.CUEValue{
+					{Path: "name", Value: "app"},
+					{Path: "port", Value: 8080},
+				},
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "out.json"))
+				require.NoError(t, err)
+				require.Equal(t, "{\n  \"name\": \"app\",\n  \"port\": 8080\n}\n", string(b))
+			},
+		},
+		{
+			name: "selects package",
+			files: map[string]string{
+				"a.cue": "package a\n\nname: \"a\"\n",
+				"b.cue": "package b\n\nname: \"b\"\n",
+			},
+			cfg: builtin.CUEExportConfig{
+				Path:    ".",
+				OutPath: "out.yaml",
+				Package: "b",
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "out.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, "name: b\n", string(b))
+			},
+		},
+		{
+			name: "incomplete value",
+			files: map[string]string{
+				"env/app.cue": testPackage,
+			},
+			cfg: builtin.CUEExportConfig{
+				Path:       "env",
+				OutPath:    "out.yaml",
+				Expression: "objects",
+				Tags:       map[string]string{"env": "prod"},
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error exporting "env"`)
+				require.ErrorContains(t, err, "non-concrete value string")
+				require.NotContains(t, err.Error(), workDir)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "conflicting value",
+			files: map[string]string{
+				"values.cue": "package values\n\nport: int & <1024\n",
+			},
+			cfg: builtin.CUEExportConfig{
+				Path:    ".",
+				OutPath: "out.yaml",
+				Values: []builtin.CUEValue{
+					{Path: "port", Value: 8080},
+				},
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "invalid value 8080")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "invalid value path",
+			files: map[string]string{
+				"values.cue": "package values\n\nport: int\n",
+			},
+			cfg: builtin.CUEExportConfig{
+				Path:    ".",
+				OutPath: "out.yaml",
+				Values: []builtin.CUEValue{
+					{Path: "port[", Value: 8080},
+				},
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `invalid path "port["`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "no CUE files",
+			cfg: builtin.CUEExportConfig{
+				Path:    ".",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "matched no packages")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range testCase.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			r := newCUEExporter(&credentials.FakeDB{})
+			runner, ok := r.(*cueExporter)
+			require.True(t, ok)
+
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{WorkDir: workDir},
+				testCase.cfg,
+			)
+			testCase.assertions(t, workDir, res, err)
+		})
+	}
+}
+
+func Test_cueExporter_newRegistry(t *testing.T) {
+	t.Setenv("CUE_REGISTRY", "registry.example.com")
+	runner := &cueExporter{
+		credsDB: &credentials.FakeDB{
+			GetFn: func(
+				_ context.Context,
+				project string,
+				credType credentials.Type,
+				repo string,
+			) (*credentials.Credentials, error) {
+				require.Equal(t, "fake-project", project)
+				require.Equal(t, credentials.TypeImage, credType)
+				require.Equal(t, "registry.example.com", repo)
+				return &credentials.Credentials{
+					Username: "fake-username",
+					Password: "fake-password",
+				}, nil
+			},
+		},
+	}
+	dir := t.TempDir()
+	registry, err := runner.newRegistry(context.Background(), "fake-project", dir)
+	require.NoError(t, err)
+	require.NotNil(t, registry)
+
+	// Only the Project's credentials are made available to the registry
+	b, err := os.ReadFile(filepath.Join(dir, "docker", "config.json"))
+	require.NoError(t, err)
+	dockerConfig := map[string]map[string]map[string]string{}
+	require.NoError(t, json.Unmarshal(b, &dockerConfig))
+	require.Equal(
+		t,
+		map[string]map[string]map[string]string{
+			"auths": {
+				// base64 of "fake-username:fake-password"
+				"registry.example.com": {"auth": "ZmFrZS11c2VybmFtZTpmYWtlLXBhc3N3b3Jk"},
+			},
+		},
+		dockerConfig,
+	)
+}
+
+func Test_findCUEModuleRoot(t *testing.T) {
+	workDir := t.TempDir()
+	dir := filepath.Join(workDir, "a", "b")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+
+	// Without a cue.mod directory, the directory itself is the root
+	require.Equal(t, dir, findCUEModuleRoot(workDir, dir))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(workDir, "cue.mod"), 0o700))
+	require.Equal(t, workDir, findCUEModuleRoot(workDir, dir))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(workDir, "a", "cue.mod"), 0o700))
+	require.Equal(t, filepath.Join(workDir, "a"), findCUEModuleRoot(workDir, dir))
+}
@@ -0,0 +1,322 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	helm "github.com/akuity/kargo/internal/controller/flux/api/helm/v2"
+	kustomize "github.com/akuity/kargo/internal/controller/flux/api/kustomize/v1"
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+	source "github.com/akuity/kargo/internal/controller/flux/api/source/v1"
+	checkers "github.com/akuity/kargo/internal/health/checker/builtin"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// fluxDefaultNamespace is the namespace Flux resources are assumed to be in
+// when no namespace is specified.
+const fluxDefaultNamespace = "flux-system"
+
+// fluxUpdater is an implementation of the promotion.StepRunner interface that
+// updates Flux resources and requests their reconciliation.
+type fluxUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+	fluxClient   client.Client
+}
+
+// newFluxUpdater returns an implementation of the promotion.StepRunner
+// interface that updates Flux resources and requests their reconciliation.
+func newFluxUpdater(fluxClient client.Client) promotion.StepRunner {
+	r := &fluxUpdater{
+		fluxClient: fluxClient,
+	}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (f *fluxUpdater) Name() string {
+	return "flux-update"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (f *fluxUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := f.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.FluxUpdateConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", f.Name(), err)
+	}
+	return f.run(ctx, stepCtx, cfg)
+}
+
+// validate validates fluxUpdater configuration against a JSON schema.
+func (f *fluxUpdater) validate(cfg promotion.Config) error {
+	return validate(f.schemaLoader, gojsonschema.NewGoLoader(cfg), f.Name())
+}
+
+func (f *fluxUpdater) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.FluxUpdateConfig,
+) (promotion.StepResult, error) {
+	if f.fluxClient == nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, errors.New(
+			"Flux integration is disabled on this controller; cannot update Flux resources",
+		)
+	}
+
+	for i, res := range cfg.Resources {
+		if err := validateFluxUpdateResource(res); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("invalid update for resource %d: %w", i, err)
+		}
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+
+	// All resources are asked to reconcile using the same token, which the
+	// health check uses to determine whether Flux has handled the request.
+	requestedAt := time.Now().UTC().Format(time.RFC3339Nano)
+
+	resourceHealthChecks := make([]checkers.FluxResourceHealthCheck, len(cfg.Resources))
+	for i, res := range cfg.Resources {
+		key := client.ObjectKey{
+			Namespace: res.Namespace,
+			Name:      res.Name,
+		}
+		if key.Namespace == "" {
+			key.Namespace = fluxDefaultNamespace
+		}
+		if err := f.updateResource(ctx, stepCtx, res, key, requestedAt); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+				"error updating Flux %s %q in namespace %q: %w",
+				res.Kind, key.Name, key.Namespace, err,
+			)
+		}
+		logger.Debug(
+			"updated Flux resource and requested its reconciliation",
+			"kind", res.Kind,
+			"namespace", key.Namespace,
+			"name", key.Name,
+		)
+		resourceHealthChecks[i] = checkers.FluxResourceHealthCheck{
+			Kind:                 string(res.Kind),
+			Name:                 key.Name,
+			Namespace:            key.Namespace,
+			DesiredRevision:      getFluxDesiredRevision(res),
+			ReconcileRequestedAt: requestedAt,
+		}
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		HealthCheck: &health.Criteria{
+			Kind: "flux",
+			Input: health.Input{
+				"resources": resourceHealthChecks,
+			},
+		},
+	}, nil
+}
+
+// updateResource applies the given update to the Flux resource with the given
+// key and requests its reconciliation. The resource is handled in its
+// unstructured form, so that any fields that are unknown to Kargo are retained.
+func (f *fluxUpdater) updateResource(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	res builtin.FluxUpdateResource,
+	key client.ObjectKey,
+	requestedAt string,
+) error {
+	gvk, err := getFluxGroupVersionKind(res.Kind)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		if err := f.fluxClient.Get(ctx, key, obj); err != nil {
+			if kubeerr.IsNotFound(err) {
+				return errors.New("resource not found")
+			}
+			return err
+		}
+		if err := authorizeFluxResourceUpdate(stepCtx, obj); err != nil {
+			return err
+		}
+
+		// Use an optimistic lock, so that the patch is only applied if the
+		// resource has not been modified since we retrieved it.
+		patch := client.MergeFromWithOptions(obj.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		if err := applyFluxUpdate(obj, res); err != nil {
+			return err
+		}
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[meta.ReconcileRequestAnnotation] = requestedAt
+		obj.SetAnnotations(annotations)
+		return f.fluxClient.Patch(ctx, obj, patch)
+	})
+}
+
+// validateFluxUpdateResource returns an error if the given update specifies
+// fields that are not applicable to the kind of the resource it targets.
+func validateFluxUpdateResource(res builtin.FluxUpdateResource) error {
+	var allowed []string
+	switch res.Kind {
+	case builtin.GitRepository:
+		allowed = []string{"branch", "commit", "semver", "tag"}
+	case builtin.OCIRepository:
+		allowed = []string{"digest", "semver", "tag"}
+	case builtin.HelmRelease:
+		allowed = []string{"chartVersion"}
+	case builtin.Kustomization:
+	default:
+		return fmt.Errorf("unsupported Flux resource kind %q", res.Kind)
+	}
+	for field, value := range map[string]string{
+		"branch":       res.Branch,
+		"chartVersion": res.ChartVersion,
+		"commit":       res.Commit,
+		"digest":       res.Digest,
+		"semver":       res.Semver,
+		"tag":          res.Tag,
+	} {
+		if value == "" {
+			continue
+		}
+		if !slices.Contains(allowed, field) {
+			return fmt.Errorf("field %q is not applicable to Flux %s resources", field, res.Kind)
+		}
+	}
+	return nil
+}
+
+// applyFluxUpdate applies the given update to the given Flux resource. If the
+// update specifies any field of a source reference, the reference of the
+// source is replaced as a whole, as Flux assigns precedence to the fields of a
+// reference and stale fields could otherwise take precedence over the new
+// ones.
+func applyFluxUpdate(obj *unstructured.Unstructured, res builtin.FluxUpdateResource) error {
+	ref := map[string]any{}
+	switch res.Kind {
+	case builtin.GitRepository:
+		setFluxRefField(ref, "branch", res.Branch)
+		setFluxRefField(ref, "commit", res.Commit)
+		setFluxRefField(ref, "semver", res.Semver)
+		setFluxRefField(ref, "tag", res.Tag)
+	case builtin.OCIRepository:
+		setFluxRefField(ref, "digest", res.Digest)
+		setFluxRefField(ref, "semver", res.Semver)
+		setFluxRefField(ref, "tag", res.Tag)
+	case builtin.HelmRelease:
+		if res.ChartVersion == "" {
+			return nil
+		}
+		if _, found, _ := unstructured.NestedMap(obj.Object, "spec", "chart"); !found {
+			return errors.New(
+				"HelmRelease does not define a chart template; cannot update chart version",
+			)
+		}
+		return unstructured.SetNestedField(obj.Object, res.ChartVersion, "spec", "chart", "spec", "version")
+	}
+	if len(ref) == 0 {
+		return nil
+	}
+	return unstructured.SetNestedMap(obj.Object, ref, "spec", "ref")
+}
+
+func setFluxRefField(ref map[string]any, field, value string) {
+	if value != "" {
+		ref[field] = value
+	}
+}
+
+// getFluxDesiredRevision returns the revision the Flux resource targeted by
+// the given update is expected to reconcile, if it can be determined from the
+// update.
+func getFluxDesiredRevision(res builtin.FluxUpdateResource) string {
+	switch res.Kind {
+	case builtin.GitRepository:
+		if res.Commit != "" {
+			return res.Commit
+		}
+		return res.Tag
+	case builtin.OCIRepository:
+		if res.Digest != "" {
+			return res.Digest
+		}
+		return res.Tag
+	case builtin.HelmRelease:
+		return res.ChartVersion
+	}
+	return ""
+}
+
+// getFluxGroupVersionKind returns the GroupVersionKind of the given kind of
+// Flux resource.
+func getFluxGroupVersionKind(kind builtin.Kind) (schema.GroupVersionKind, error) {
+	switch kind {
+	case builtin.GitRepository:
+		return source.GroupVersion.WithKind(source.GitRepositoryKind), nil
+	case builtin.HelmRelease:
+		return helm.GroupVersion.WithKind(helm.HelmReleaseKind), nil
+	case builtin.Kustomization:
+		return kustomize.GroupVersion.WithKind(kustomize.KustomizationKind), nil
+	case builtin.OCIRepository:
+		return source.GroupVersion.WithKind(source.OCIRepositoryKind), nil
+	}
+	return schema.GroupVersionKind{}, fmt.Errorf("unsupported Flux resource kind %q", kind)
+}
+
+// authorizeFluxResourceUpdate returns an error if the given Flux resource does
+// not explicitly permit mutation by the Kargo Stage the promotion is for.
+func authorizeFluxResourceUpdate(stepCtx *promotion.StepContext, obj client.Object) error {
+	allowedStage, ok := obj.GetAnnotations()[kargoapi.AnnotationKeyAuthorizedStage]
+	if !ok {
+		return fmt.Errorf(
+			"resource does not permit mutation by Kargo Stage %s in namespace %s",
+			stepCtx.Stage,
+			stepCtx.Project,
+		)
+	}
+	tokens := strings.SplitN(allowedStage, ":", 2)
+	if len(tokens) != 2 {
+		return fmt.Errorf(
+			"unable to parse value of annotation %q (%q)",
+			kargoapi.AnnotationKeyAuthorizedStage,
+			allowedStage,
+		)
+	}
+	if tokens[0] != stepCtx.Project || tokens[1] != stepCtx.Stage {
+		return fmt.Errorf(
+			"resource does not permit mutation by Kargo Stage %s in namespace %s",
+			stepCtx.Stage,
+			stepCtx.Project,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,445 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	helm "github.com/akuity/kargo/internal/controller/flux/api/helm/v2"
+	kustomize "github.com/akuity/kargo/internal/controller/flux/api/kustomize/v1"
+	"github.com/akuity/kargo/internal/controller/flux/api/meta"
+	source "github.com/akuity/kargo/internal/controller/flux/api/source/v1"
+	checkers "github.com/akuity/kargo/internal/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_fluxUpdater_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "resources not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): resources is required",
+			},
+		},
+		{
+			name: "resources is empty",
+			config: promotion.Config{
+				"resources": []any{},
+			},
+			expectedProblems: []string{
+				"resources: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "kind and name not specified",
+			config: promotion.Config{
+				"resources": []any{map[string]any{}},
+			},
+			expectedProblems: []string{
+				"resources.0: kind is required",
+				"resources.0: name is required",
+			},
+		},
+		{
+			name: "kind is not supported",
+			config: promotion.Config{
+				"resources": []any{map[string]any{
+					"kind": "Bucket",
+					"name": "fake-name",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.kind: resources.0.kind must be one of the following",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"resources": []any{
+					map[string]any{
+						"kind":      "GitRepository",
+						"name":      "fake-repo",
+						"namespace": "fake-namespace",
+						"branch":    "main",
+						"commit":    "fake-commit",
+					},
+					map[string]any{
+						"kind":   "OCIRepository",
+						"name":   "fake-repo",
+						"digest": "sha256:fake-digest",
+					},
+					map[string]any{
+						"kind":         "HelmRelease",
+						"name":         "fake-release",
+						"chartVersion": "1.2.3",
+					},
+					map[string]any{
+						"kind": "Kustomization",
+						"name": "fake-kustomization",
+					},
+				},
+			},
+		},
+	}
+
+	r := newFluxUpdater(nil)
+	runner, ok := r.(*fluxUpdater)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_fluxUpdater_run(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kustomize.AddToScheme(scheme))
+	require.NoError(t, helm.AddToScheme(scheme))
+	require.NoError(t, source.AddToScheme(scheme))
+
+	authorizedAnnotations := map[string]string{
+		kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		cfg        builtin.FluxUpdateConfig
+		assertions func(*testing.T, client.Client, promotion.StepResult, error)
+	}{
+		{
+			name: "Flux integration disabled",
+			cfg: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxUpdateResource{{
+					Kind: builtin.Kustomization,
+					Name: "fake-kustomization",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "Flux integration is disabled")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:   "field not applicable to kind",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			cfg: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxUpdateResource{{
+					Kind:         builtin.GitRepository,
+					Name:         "fake-repo",
+					ChartVersion: "1.2.3",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `field "chartVersion" is not applicable to Flux GitRepository resources`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:   "resource not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			cfg: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxUpdateResource{{
+					Kind: builtin.Kustomization,
+					Name: "fake-kustomization",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(
+					t, err,
+					`error updating Flux Kustomization "fake-kustomization" in namespace "flux-system"`,
+				)
+				require.ErrorContains(t, err, "resource not found")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "resource does not permit mutation",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&kustomize.Kustomization{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "flux-system",
+						Name:      "fake-kustomization",
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyAuthorizedStage: "fake-project:other-stage",
+						},
+					},
+				},
+			).Build(),
+			cfg: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxUpdateResource{{
+					Kind: builtin.Kustomization,
+					Name: "fake-kustomization",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "does not permit mutation by Kargo Stage fake-stage")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "HelmRelease without chart template",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&helm.HelmRelease{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "flux-system",
+						Name:        "fake-release",
+						Annotations: authorizedAnnotations,
+					},
+					Spec: helm.HelmReleaseSpec{
+						ChartRef: &helm.CrossNamespaceSourceReference{
+							Kind: source.OCIRepositoryKind,
+							Name: "fake-repo",
+						},
+					},
+				},
+			).Build(),
+			cfg: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxUpdateResource{{
+					Kind:         builtin.HelmRelease,
+					Name:         "fake-release",
+					ChartVersion: "1.2.3",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "does not define a chart template")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "updates resources",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&source.GitRepository{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "fake-namespace",
+						Name:        "fake-repo",
+						Annotations: authorizedAnnotations,
+					},
+					Spec: source.GitRepositorySpec{
+						URL: "https://github.com/example/repo.git",
+						Reference: &source.GitRepositoryRef{
+							SemVer: ">=1.0.0",
+						},
+					},
+				},
+				&source.OCIRepository{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "flux-system",
+						Name:        "fake-repo",
+						Annotations: authorizedAnnotations,
+					},
+					Spec: source.OCIRepositorySpec{
+						URL: "oci://ghcr.io/example/manifests",
+						Reference: &source.OCIRepositoryRef{
+							Tag: "latest",
+						},
+					},
+				},
+				&helm.HelmRelease{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "flux-system",
+						Name:        "fake-release",
+						Annotations: authorizedAnnotations,
+					},
+					Spec: helm.HelmReleaseSpec{
+						Chart: &helm.HelmChartTemplate{
+							Spec: helm.HelmChartTemplateSpec{
+								Chart:   "fake-chart",
+								Version: "1.0.0",
+							},
+						},
+					},
+				},
+				&kustomize.Kustomization{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "flux-system",
+						Name:        "fake-kustomization",
+						Annotations: authorizedAnnotations,
+					},
+					Spec: kustomize.KustomizationSpec{
+						Path: "./fake-path",
+					},
+				},
+			).Build(),
+			cfg: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxUpdateResource{
+					{
+						Kind:      builtin.GitRepository,
+						Name:      "fake-repo",
+						Namespace: "fake-namespace",
+						Branch:    "main",
+						Commit:    "fake-commit",
+					},
+					{
+						Kind:   builtin.OCIRepository,
+						Name:   "fake-repo",
+						Digest: "sha256:fake-digest",
+					},
+					{
+						Kind:         builtin.HelmRelease,
+						Name:         "fake-release",
+						ChartVersion: "1.2.3",
+					},
+					{
+						Kind: builtin.Kustomization,
+						Name: "fake-kustomization",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				require.NotNil(t, res.HealthCheck)
+				require.Equal(t, "flux", res.HealthCheck.Kind)
+				checks, ok := res.HealthCheck.Input["resources"].([]checkers.FluxResourceHealthCheck)
+				require.True(t, ok)
+				require.Len(t, checks, 4)
+				requestedAt := checks[0].ReconcileRequestedAt
+				require.NotEmpty(t, requestedAt)
+				require.Equal(t, []checkers.FluxResourceHealthCheck{
+					{
+						Kind:                 "GitRepository",
+						Name:                 "fake-repo",
+						Namespace:            "fake-namespace",
+						DesiredRevision:      "fake-commit",
+						ReconcileRequestedAt: requestedAt,
+					},
+					{
+						Kind:                 "OCIRepository",
+						Name:                 "fake-repo",
+						Namespace:            "flux-system",
+						DesiredRevision:      "sha256:fake-digest",
+						ReconcileRequestedAt: requestedAt,
+					},
+					{
+						Kind:                 "HelmRelease",
+						Name:                 "fake-release",
+						Namespace:            "flux-system",
+						DesiredRevision:      "1.2.3",
+						ReconcileRequestedAt: requestedAt,
+					},
+					{
+						Kind:                 "Kustomization",
+						Name:                 "fake-kustomization",
+						Namespace:            "flux-system",
+						ReconcileRequestedAt: requestedAt,
+					},
+				}, checks)
+
+				gitRepo := &source.GitRepository{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: "fake-namespace", Name: "fake-repo"},
+					gitRepo,
+				))
+				require.Equal(t, requestedAt, gitRepo.Annotations[meta.ReconcileRequestAnnotation])
+				require.Equal(t, "https://github.com/example/repo.git", gitRepo.Spec.URL)
+				// The reference is replaced as a whole
+				require.Equal(t, &source.GitRepositoryRef{
+					Branch: "main",
+					Commit: "fake-commit",
+				}, gitRepo.Spec.Reference)
+
+				ociRepo := &source.OCIRepository{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: "flux-system", Name: "fake-repo"},
+					ociRepo,
+				))
+				require.Equal(t, requestedAt, ociRepo.Annotations[meta.ReconcileRequestAnnotation])
+				require.Equal(t, &source.OCIRepositoryRef{
+					Digest: "sha256:fake-digest",
+				}, ociRepo.Spec.Reference)
+
+				release := &helm.HelmRelease{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: "flux-system", Name: "fake-release"},
+					release,
+				))
+				require.Equal(t, requestedAt, release.Annotations[meta.ReconcileRequestAnnotation])
+				require.Equal(t, "fake-chart", release.Spec.Chart.Spec.Chart)
+				require.Equal(t, "1.2.3", release.Spec.Chart.Spec.Version)
+
+				kustomization := &kustomize.Kustomization{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: "flux-system", Name: "fake-kustomization"},
+					kustomization,
+				))
+				require.Equal(t, requestedAt, kustomization.Annotations[meta.ReconcileRequestAnnotation])
+				require.Equal(t, "./fake-path", kustomization.Spec.Path)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFluxUpdater(testCase.client)
+			runner, ok := r.(*fluxUpdater)
+			require.True(t, ok)
+
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "fake-project",
+					Stage:   "fake-stage",
+				},
+				testCase.cfg,
+			)
+			testCase.assertions(t, testCase.client, res, err)
+		})
+	}
+}
+
+func Test_applyFluxUpdate(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"url": "oci://ghcr.io/example/manifests",
+			"ref": map[string]any{
+				"semver": ">=1.0.0",
+			},
+		},
+	}}
+	require.NoError(t, applyFluxUpdate(obj, builtin.FluxUpdateResource{
+		Kind: builtin.OCIRepository,
+		Tag:  "v1.2.3",
+	}))
+	require.Equal(t, map[string]any{
+		"spec": map[string]any{
+			"url": "oci://ghcr.io/example/manifests",
+			"ref": map[string]any{
+				"tag": "v1.2.3",
+			},
+		},
+	}, obj.Object)
+
+	// An update without reference fields leaves the reference untouched
+	require.NoError(t, applyFluxUpdate(obj, builtin.FluxUpdateResource{
+		Kind: builtin.OCIRepository,
+	}))
+	require.Equal(t, map[string]any{"tag": "v1.2.3"}, obj.Object["spec"].(map[string]any)["ref"]) // nolint: forcetypeassert
+}
@@ -0,0 +1,226 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// gitReverter is an implementation of the promotion.StepRunner interface that
+// reverts commits made by a previous Promotion in a local Git repository.
+type gitReverter struct {
+	schemaLoader gojsonschema.JSONLoader
+	kargoClient  client.Client
+}
+
+// newGitReverter returns an implementation of the promotion.StepRunner
+// interface that reverts commits made by a previous Promotion in a local Git
+// repository.
+func newGitReverter(kargoClient client.Client) promotion.StepRunner {
+	r := &gitReverter{
+		kargoClient: kargoClient,
+	}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (g *gitReverter) Name() string {
+	return "git-revert"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitReverter) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := g.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.GitRevertConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", g.Name(), err)
+	}
+	return g.run(ctx, stepCtx, cfg)
+}
+
+// validate validates gitReverter configuration against a JSON schema.
+func (g *gitReverter) validate(cfg promotion.Config) error {
+	return validate(g.schemaLoader, gojsonschema.NewGoLoader(cfg), g.Name())
+}
+
+func (g *gitReverter) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.GitRevertConfig,
+) (promotion.StepResult, error) {
+	path, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+			"error joining path %s with work dir %s: %w",
+			cfg.Path, stepCtx.WorkDir, err,
+		)
+	}
+	workTree, err := git.LoadWorkTree(path, nil)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+	}
+
+	var commits []string
+	if cfg.Promotion != "" {
+		if commits, err = g.getPromotionCommits(ctx, stepCtx.Project, cfg.Promotion); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+	}
+	for _, commit := range cfg.Commits {
+		if !slices.Contains(commits, commit) {
+			commits = append(commits, commit)
+		}
+	}
+
+	headCommitID, err := workTree.LastCommitID()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting last commit ID: %w", err)
+	}
+
+	// Only commits reachable from HEAD can be reverted. Anything else was made
+	// to a different repository or branch than the one checked out in this
+	// working tree.
+	toRevert := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		var exists bool
+		if exists, err = workTree.CommitExists(commit); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		if !exists {
+			// A commit that does not exist in a full clone was made to a
+			// different repository, and is skipped. In a shallow clone, it may
+			// also be missing from the truncated history, which can not be told
+			// apart.
+			var shallow bool
+			if shallow, err = workTree.IsShallow(); err != nil {
+				return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+			}
+			if shallow {
+				return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+					fmt.Errorf(
+						"commit %q not found in shallow clone; clone the repository with its full history",
+						commit,
+					)
+			}
+			continue
+		}
+		var isAncestor bool
+		if isAncestor, err = workTree.IsAncestor(commit, headCommitID); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error determining whether commit %q is reachable from HEAD: %w", commit, err)
+		}
+		if isAncestor {
+			toRevert = append(toRevert, commit)
+		}
+	}
+
+	if len(toRevert) == 0 {
+		return promotion.StepResult{
+			Status:  kargoapi.PromotionStepStatusSkipped,
+			Message: "no commits to revert",
+			Output:  map[string]any{stateKeyCommit: headCommitID},
+		}, nil
+	}
+
+	revertOpts := &git.RevertOptions{}
+	if cfg.Author != nil {
+		revertOpts.Author = &git.User{
+			Name:       cfg.Author.Name,
+			Email:      cfg.Author.Email,
+			SigningKey: cfg.Author.SigningKey,
+		}
+	}
+	// Revert the most recent commits first to minimize the chance of conflicts.
+	for i := len(toRevert) - 1; i >= 0; i-- {
+		if err = workTree.Revert(toRevert[i], revertOpts); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error reverting commit %q: %w", toRevert[i], err)
+		}
+	}
+
+	commitID, err := workTree.LastCommitID()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting last commit ID: %w", err)
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{stateKeyCommit: commitID},
+	}, nil
+}
+
+// getPromotionCommits returns the IDs of the commits produced by the specified
+// Promotion, in the order they were made. If the Promotion waited for any pull
+// requests to be merged, the merge commits are returned. Otherwise, the
+// commits pushed by the Promotion are returned.
+func (g *gitReverter) getPromotionCommits(
+	ctx context.Context,
+	project string,
+	promoName string,
+) ([]string, error) {
+	if g.kargoClient == nil {
+		return nil, fmt.Errorf("no Kargo client available to look up Promotion %q", promoName)
+	}
+	promo := &kargoapi.Promotion{}
+	if err := g.kargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: promoName},
+		promo,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Promotion %q in namespace %q: %w", promoName, project, err,
+		)
+	}
+
+	succeeded := make(map[string]struct{}, len(promo.Status.StepExecutionMetadata))
+	for _, md := range promo.Status.StepExecutionMetadata {
+		if md.Status == kargoapi.PromotionStepStatusSucceeded {
+			succeeded[md.Alias] = struct{}{}
+		}
+	}
+
+	state := promo.Status.GetState()
+	var pushed, merged []string
+	for i, step := range promo.Spec.Steps {
+		alias := step.GetAlias(i)
+		if _, ok := succeeded[alias]; !ok {
+			continue
+		}
+		output, ok := state[alias].(map[string]any)
+		if !ok {
+			continue
+		}
+		commit, ok := output[stateKeyCommit].(string)
+		if !ok || commit == "" {
+			continue
+		}
+		switch step.Uses {
+		case "git-push":
+			pushed = append(pushed, commit)
+		case "git-wait-for-pr":
+			merged = append(merged, commit)
+		}
+	}
+	if len(merged) > 0 {
+		return merged, nil
+	}
+	return pushed, nil
+}
@@ -0,0 +1,248 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_gitReverter_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name: "path not specified",
+			config: promotion.Config{
+				"promotion": "fake-promotion",
+			},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path":      "",
+				"promotion": "fake-promotion",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "neither promotion nor commits specified",
+			config: promotion.Config{
+				"path": "/tmp/foo",
+			},
+			expectedProblems: []string{
+				"(root): Must validate at least one schema (anyOf)",
+			},
+		},
+		{
+			name: "promotion is empty string",
+			config: promotion.Config{
+				"path":      "/tmp/foo",
+				"promotion": "",
+			},
+			expectedProblems: []string{
+				"promotion: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "commits is empty",
+			config: promotion.Config{
+				"path":    "/tmp/foo",
+				"commits": []string{},
+			},
+			expectedProblems: []string{
+				"commits: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "author name is not specified",
+			config: promotion.Config{
+				"author": promotion.Config{
+					"email": "example@example.com",
+				},
+				"path":      "/tmp/foo",
+				"promotion": "fake-promotion",
+			},
+			expectedProblems: []string{
+				"invalid git-revert config: author: name is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"author": promotion.Config{
+					"email":      "tony@starkindustries.com",
+					"name":       "Tony Stark",
+					"signingKey": "valid-signing-key",
+				},
+				"commits":   []string{"abc123"},
+				"path":      "/tmp/foo",
+				"promotion": "fake-promotion",
+			},
+		},
+	}
+
+	r := newGitReverter(nil)
+	runner, ok := r.(*gitReverter)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_gitReverter_run(t *testing.T) {
+	// Set up a test Git server in-process
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	// This is the URL of the "remote" repository
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	workDir := t.TempDir()
+
+	repo, err := git.CloneBare(
+		testRepoURL,
+		nil,
+		&git.BareCloneOptions{
+			BaseDir: workDir,
+		},
+	)
+	require.NoError(t, err)
+	defer repo.Close()
+	workTreePath := filepath.Join(workDir, "master")
+	workTree, err := repo.AddWorkTree(
+		workTreePath,
+		&git.AddWorkTreeOptions{Orphan: true},
+	)
+	require.NoError(t, err)
+	err = workTree.CreateOrphanedBranch("master")
+	require.NoError(t, err)
+
+	// Make an initial commit and then a commit that stands in for one made by a
+	// previous Promotion.
+	err = os.WriteFile(filepath.Join(workTree.Dir(), "test.txt"), []byte("foo"), 0600)
+	require.NoError(t, err)
+	err = workTree.AddAllAndCommit("Initial commit", nil)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(workTree.Dir(), "test.txt"), []byte("bar"), 0600)
+	require.NoError(t, err)
+	err = workTree.AddAllAndCommit("Promoted commit", nil)
+	require.NoError(t, err)
+	promotedCommit, err := workTree.LastCommitID()
+	require.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	kargoClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-promotion",
+			},
+			Spec: kargoapi.PromotionSpec{
+				Steps: []kargoapi.PromotionStep{
+					{Uses: "git-clone"},
+					{Uses: "git-push", As: "push"},
+					// This step did not succeed, so its output should be ignored
+					{Uses: "git-push", As: "failed-push"},
+				},
+			},
+			Status: kargoapi.PromotionStatus{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+					{Alias: "step-0", Status: kargoapi.PromotionStepStatusSucceeded},
+					{Alias: "push", Status: kargoapi.PromotionStepStatusSucceeded},
+					{Alias: "failed-push", Status: kargoapi.PromotionStepStatusErrored},
+				},
+				State: &apiextensionsv1.JSON{
+					Raw: []byte(fmt.Sprintf(
+						`{"push":{"commit":%q},"failed-push":{"commit":"deadbeef"}}`,
+						promotedCommit,
+					)),
+				},
+			},
+		},
+	).Build()
+
+	r := newGitReverter(kargoClient)
+	runner, ok := r.(*gitReverter)
+	require.True(t, ok)
+
+	stepCtx := &promotion.StepContext{
+		Project: "fake-project",
+		WorkDir: workDir,
+	}
+
+	res, err := runner.run(
+		context.Background(),
+		stepCtx,
+		builtin.GitRevertConfig{
+			Path:      "master",
+			Promotion: "fake-promotion",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	expectedCommit, err := workTree.LastCommitID()
+	require.NoError(t, err)
+	require.NotEqual(t, promotedCommit, expectedCommit)
+	actualCommit, ok := res.Output[stateKeyCommit]
+	require.True(t, ok)
+	require.Equal(t, expectedCommit, actualCommit)
+	content, err := os.ReadFile(filepath.Join(workTree.Dir(), "test.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(content))
+
+	// Commits that are not reachable from HEAD should be ignored, resulting in a
+	// Skipped status.
+	res, err = runner.run(
+		context.Background(),
+		stepCtx,
+		builtin.GitRevertConfig{
+			Path:    "master",
+			Commits: []string{"deadbeef"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSkipped, res.Status)
+	actualCommit, ok = res.Output[stateKeyCommit]
+	require.True(t, ok)
+	require.Equal(t, expectedCommit, actualCommit)
+}
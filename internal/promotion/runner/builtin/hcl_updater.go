@@ -0,0 +1,12 @@
+package builtin
+
+import (
+	inthcl "github.com/akuity/kargo/internal/hcl"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// newHCLUpdater returns an implementation of the promotion.StepRunner interface
+// that updates the values of specified keys in an HCL file.
+func newHCLUpdater() promotion.StepRunner {
+	return newKeyPathUpdater("hcl-update", "HCL", inthcl.SetValuesInFile)
+}
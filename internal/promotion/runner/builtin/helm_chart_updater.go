@@ -172,13 +172,13 @@ func (h *helmChartUpdater) updateDependencies(
 	for _, dep := range chartDependencies {
 		if strings.HasPrefix(dep.Repository, "file://") {
 			depPath := filepath.FromSlash(strings.TrimPrefix(dep.Repository, "file://"))
-			if err = h.validateFileDependency(stepCtx.WorkDir, chartPath, depPath); err != nil {
+			if err = validateChartFileDependency(stepCtx.WorkDir, chartPath, depPath); err != nil {
 				return nil, fmt.Errorf("invalid dependency %q: %w", dep.Repository, err)
 			}
 		}
 	}
 
-	if err = h.setupDependencyRepositories(
+	if err = setupChartDependencyRepositories(
 		ctx,
 		h.credsDB,
 		registryClient,
@@ -225,7 +225,7 @@ func (h *helmChartUpdater) updateDependencies(
 	// cache is properly populated, as otherwise the download manager will
 	// attempt to download the repository indexes to the default cache path
 	// instead of using the cache path set in the environment settings.
-	if err = h.downloadRepositoryIndexes(repositoryFile.Repositories, env); err != nil {
+	if err = downloadChartRepositoryIndexes(repositoryFile.Repositories, env); err != nil {
 		return nil, err
 	}
 
@@ -279,7 +279,9 @@ func (h *helmChartUpdater) updateDependencies(
 	return changes, nil
 }
 
-func (h *helmChartUpdater) validateFileDependency(workDir, chartPath, dependencyPath string) error {
+// validateChartFileDependency validates that the file:// dependency at the
+// given path, relative to the chart, does not reach outside the work directory.
+func validateChartFileDependency(workDir, chartPath, dependencyPath string) error {
 	if filepath.IsAbs(dependencyPath) {
 		return errors.New("dependency path must be relative")
 	}
@@ -302,7 +304,11 @@ func (h *helmChartUpdater) validateFileDependency(workDir, chartPath, dependency
 	return checkSymlinks(workDir, dependencyPath, visited, 0, 100)
 }
 
-func (h *helmChartUpdater) setupDependencyRepositories(
+// setupChartDependencyRepositories adds the classic chart repositories of the
+// given dependencies to the repository file, and logs the registry client in to
+// the OCI repositories of the dependencies, using credentials from the
+// credentials database where available.
+func setupChartDependencyRepositories(
 	ctx context.Context,
 	credentialsDB credentials.Database,
 	registryClient *registry.Client,
@@ -355,7 +361,10 @@ func (h *helmChartUpdater) setupDependencyRepositories(
 	return nil
 }
 
-func (h *helmChartUpdater) downloadRepositoryIndexes(
+// downloadChartRepositoryIndexes downloads the indexes of the given classic
+// chart repositories to the repository cache of the given environment
+// settings.
+func downloadChartRepositoryIndexes(
 	repositories []*repo.Entry,
 	env *cli.EnvSettings,
 ) error {
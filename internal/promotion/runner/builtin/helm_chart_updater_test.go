@@ -585,7 +585,7 @@ func Test_helmChartUpdater_updateDependencies(t *testing.T) {
 	}
 }
 
-func Test_helmChartUpdater_validateFileDependency(t *testing.T) {
+func Test_validateChartFileDependency(t *testing.T) {
 	tests := []struct {
 		name       string
 		setup      func(t *testing.T) (workDir, chartPath, dependencyPath string)
@@ -691,18 +691,16 @@ func Test_helmChartUpdater_validateFileDependency(t *testing.T) {
 		},
 	}
 
-	runner := &helmChartUpdater{}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			workDir, chartPath, dependencyPath := tt.setup(t)
-			err := runner.validateFileDependency(workDir, chartPath, dependencyPath)
+			err := validateChartFileDependency(workDir, chartPath, dependencyPath)
 			tt.assertions(t, err)
 		})
 	}
 }
 
-func Test_helmChartUpdater_setupDependencyRepositories(t *testing.T) {
+func Test_setupChartDependencyRepositories(t *testing.T) {
 	tests := []struct {
 		name              string
 		credentialsDB     credentials.Database
@@ -967,8 +965,6 @@ func Test_helmChartUpdater_setupDependencyRepositories(t *testing.T) {
 		},
 	}
 
-	runner := &helmChartUpdater{}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			helmHome, registryClient := tt.newRegistryClient(t)
@@ -980,7 +976,7 @@ func Test_helmChartUpdater_setupDependencyRepositories(t *testing.T) {
 
 			dependencies := tt.buildDependencies(registryURL)
 
-			err := runner.setupDependencyRepositories(
+			err := setupChartDependencyRepositories(
 				context.Background(),
 				tt.credentialsDB,
 				registryClient,
@@ -0,0 +1,525 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/provenance"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// helmDependencyBuilder is an implementation of the promotion.StepRunner
+// interface that builds the dependencies of a Helm chart from its lock file.
+type helmDependencyBuilder struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newHelmDependencyBuilder returns an implementation of the
+// promotion.StepRunner interface that builds the dependencies of a Helm chart
+// from its lock file.
+func newHelmDependencyBuilder(credsDB credentials.Database) promotion.StepRunner {
+	r := &helmDependencyBuilder{
+		credsDB: credsDB,
+	}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (h *helmDependencyBuilder) Name() string {
+	return "helm-dependency-build"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (h *helmDependencyBuilder) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	failure := promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+
+	if err := h.validate(stepCtx.Config); err != nil {
+		return failure, err
+	}
+
+	// Convert the configuration into a typed struct
+	cfg, err := promotion.ConfigToStruct[builtin.HelmDependencyBuildConfig](stepCtx.Config)
+	if err != nil {
+		return failure, fmt.Errorf("could not convert config into %s config: %w", h.Name(), err)
+	}
+
+	return h.run(ctx, stepCtx, cfg)
+}
+
+// validate validates helmDependencyBuilder configuration against a JSON schema.
+func (h *helmDependencyBuilder) validate(cfg promotion.Config) error {
+	return validate(h.schemaLoader, gojsonschema.NewGoLoader(cfg), h.Name())
+}
+
+func (h *helmDependencyBuilder) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.HelmDependencyBuildConfig,
+) (promotion.StepResult, error) {
+	absChartPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("failed to join path %q: %w", cfg.Path, err)
+	}
+
+	metadata, err := chartutil.LoadChartfile(filepath.Join(absChartPath, chartutil.ChartfileName))
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"failed to load chart metadata from %q: %w",
+				cfg.Path, sanitizePathError(err, stepCtx.WorkDir),
+			)
+	}
+	if len(metadata.Dependencies) == 0 {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+	}
+
+	lock, err := readChartLockFile(filepath.Join(absChartPath, "Chart.lock"))
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"failed to load lock file of chart %q: %w",
+				cfg.Path, sanitizePathError(err, stepCtx.WorkDir),
+			)
+	}
+	if err = verifyChartLock(metadata.Dependencies, lock); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("failed to verify lock file of chart %q: %w", cfg.Path, err)
+	}
+
+	var absCachePath string
+	if cfg.CachePath != "" {
+		if absCachePath, err = securejoin.SecureJoin(stepCtx.WorkDir, cfg.CachePath); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("failed to join path %q: %w", cfg.CachePath, err)
+		}
+		if err = os.MkdirAll(absCachePath, 0o700); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(
+					"failed to create cache directory %q: %w",
+					cfg.CachePath, sanitizePathError(err, stepCtx.WorkDir),
+				)
+		}
+	}
+
+	chartsPath := filepath.Join(absChartPath, "charts")
+	if err = os.MkdirAll(chartsPath, 0o700); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"failed to create charts directory: %w", sanitizePathError(err, stepCtx.WorkDir),
+			)
+	}
+
+	missing, err := h.collectDependencies(stepCtx.WorkDir, absChartPath, absCachePath, lock.Dependencies)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	if len(missing) > 0 {
+		if cfg.Offline {
+			names := make([]string, len(missing))
+			for i, dep := range missing {
+				names[i] = fmt.Sprintf("%s-%s", dep.Name, dep.Version)
+			}
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(
+					"dependencies of chart %q are not available offline: %s",
+					cfg.Path, strings.Join(names, ", "),
+				)
+		}
+		if err = h.downloadDependencies(ctx, stepCtx, chartsPath, absCachePath, missing); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+	}
+
+	if err = removeOutdatedChartDependencies(chartsPath, lock.Dependencies); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"failed to remove outdated dependencies: %w", sanitizePathError(err, stepCtx.WorkDir),
+			)
+	}
+
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// collectDependencies places the locked dependencies that are available
+// locally in the charts directory of the chart, and returns the dependencies
+// that need to be downloaded. Dependencies from file:// repositories are
+// packaged from their source directory, while dependencies from remote
+// repositories are taken from the charts directory itself or, if a cache path
+// is given, from the cache.
+func (h *helmDependencyBuilder) collectDependencies(
+	workDir, chartPath, cachePath string,
+	deps []*chart.Dependency,
+) ([]*chart.Dependency, error) {
+	chartsPath := filepath.Join(chartPath, "charts")
+
+	var missing []*chart.Dependency
+	for _, dep := range deps {
+		switch {
+		case dep.Repository == "":
+			// Dependencies without a repository are expected to be present in
+			// the charts directory already.
+			continue
+		case strings.HasPrefix(dep.Repository, "file://"):
+			depPath := filepath.FromSlash(strings.TrimPrefix(dep.Repository, "file://"))
+			if err := validateChartFileDependency(workDir, chartPath, depPath); err != nil {
+				return nil, fmt.Errorf("invalid dependency %q: %w", dep.Repository, err)
+			}
+			if err := packageChartFileDependency(filepath.Join(chartPath, depPath), dep, chartsPath); err != nil {
+				return nil, fmt.Errorf(
+					"failed to package dependency %q: %w", dep.Name, sanitizePathError(err, workDir),
+				)
+			}
+			continue
+		}
+
+		archiveName := chartArchiveName(dep)
+		ok, err := isChartArchiveOf(filepath.Join(chartsPath, archiveName), dep)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to check dependency %q: %w", dep.Name, sanitizePathError(err, workDir),
+			)
+		}
+		if ok {
+			continue
+		}
+
+		if cachePath != "" {
+			cachedArchive := filepath.Join(cachePath, archiveName)
+			if ok, err = isChartArchiveOf(cachedArchive, dep); err != nil {
+				return nil, fmt.Errorf(
+					"failed to check cached dependency %q: %w", dep.Name, sanitizePathError(err, workDir),
+				)
+			}
+			if ok {
+				if err = copyChartArchive(cachedArchive, filepath.Join(chartsPath, archiveName)); err != nil {
+					return nil, fmt.Errorf(
+						"failed to copy cached dependency %q: %w", dep.Name, sanitizePathError(err, workDir),
+					)
+				}
+				continue
+			}
+		}
+
+		missing = append(missing, dep)
+	}
+	return missing, nil
+}
+
+// downloadDependencies downloads the given dependencies from their
+// repositories to the charts directory, and adds them to the cache if a cache
+// path is given.
+func (h *helmDependencyBuilder) downloadDependencies(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	chartsPath, cachePath string,
+	deps []*chart.Dependency,
+) error {
+	downloadPath, err := os.MkdirTemp("", "helm-dependency-build-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary download directory: %w", err)
+	}
+	defer os.RemoveAll(downloadPath)
+
+	charts := make([]chartDependency, len(deps))
+	for i, dep := range deps {
+		charts[i] = chartDependency{
+			Repository: dep.Repository,
+			Name:       dep.Name,
+			Version:    dep.Version,
+		}
+	}
+	downloaded, err := downloadCharts(ctx, h.credsDB, stepCtx.Project, charts, downloadPath)
+	if err != nil {
+		return err
+	}
+
+	for i, dep := range deps {
+		// Ensure the downloaded chart is the one that was locked
+		ok, err := isChartArchiveOf(downloaded[i], dep)
+		if err != nil {
+			return fmt.Errorf("failed to check downloaded dependency %q: %w", dep.Name, err)
+		}
+		if !ok {
+			return fmt.Errorf(
+				"chart downloaded from %q does not match dependency %q version %q",
+				dep.Repository, dep.Name, dep.Version,
+			)
+		}
+
+		archiveName := chartArchiveName(dep)
+		if err = copyChartArchive(downloaded[i], filepath.Join(chartsPath, archiveName)); err != nil {
+			return fmt.Errorf(
+				"failed to save dependency %q: %w", dep.Name, sanitizePathError(err, stepCtx.WorkDir),
+			)
+		}
+		if cachePath != "" {
+			if err = copyChartArchive(downloaded[i], filepath.Join(cachePath, archiveName)); err != nil {
+				return fmt.Errorf(
+					"failed to cache dependency %q: %w", dep.Name, sanitizePathError(err, stepCtx.WorkDir),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// downloadCharts downloads the given charts from their classic chart
+// repositories or OCI registries to the given directory, using credentials
+// from the given credentials database where available. It returns the paths of
+// the downloaded chart archives, in the order of the given charts. Versions may
+// be SemVer constraints, and an empty version downloads the latest version of
+// a chart.
+func downloadCharts(
+	ctx context.Context,
+	credsDB credentials.Database,
+	project string,
+	charts []chartDependency,
+	dest string,
+) ([]string, error) {
+	helmHome, err := os.MkdirTemp("", "helm-chart-download-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary Helm home directory: %w", err)
+	}
+	defer os.RemoveAll(helmHome)
+
+	registryClient, err := helm.NewRegistryClient(helmHome)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Helm registry client: %w", err)
+	}
+
+	repositoryFile := repo.NewFile()
+	if err = setupChartDependencyRepositories(
+		ctx,
+		credsDB,
+		registryClient,
+		repositoryFile,
+		project,
+		charts,
+	); err != nil {
+		return nil, err
+	}
+
+	repositoryConfig := filepath.Join(helmHome, "repositories.yaml")
+	if err = repositoryFile.WriteFile(repositoryConfig, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write Helm repositories file: %w", err)
+	}
+
+	// Prepare the environment settings for Helm
+	env := &cli.EnvSettings{
+		RepositoryConfig: repositoryConfig,
+		RepositoryCache:  filepath.Join(helmHome, "cache"),
+	}
+
+	// Download the repository indexes, which the downloader uses to resolve
+	// the URLs of charts in classic chart repositories.
+	if err = downloadChartRepositoryIndexes(repositoryFile.Repositories, env); err != nil {
+		return nil, err
+	}
+
+	downloaded := make([]string, len(charts))
+	for i, c := range charts {
+		dl := downloader.ChartDownloader{
+			Out:              io.Discard,
+			Verify:           downloader.VerifyNever,
+			Getters:          getter.All(env),
+			Options:          []getter.Option{getter.WithRegistryClient(registryClient)},
+			RegistryClient:   registryClient,
+			RepositoryConfig: env.RepositoryConfig,
+			RepositoryCache:  env.RepositoryCache,
+		}
+
+		// Charts in classic chart repositories are referenced by the name of
+		// the repository in the repositories file, which allows the downloader
+		// to find the chart in the repository index and to use the credentials
+		// of the repository.
+		ref := nameForRepositoryURL(c.Repository) + "/" + c.Name
+		if registry.IsOCI(c.Repository) {
+			ref = strings.TrimSuffix(c.Repository, "/") + "/" + c.Name
+		}
+
+		// Download each chart to its own directory, as charts from different
+		// repositories may have the same name and version.
+		chartDest := filepath.Join(dest, strconv.Itoa(i))
+		if err = os.MkdirAll(chartDest, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create download directory: %w", err)
+		}
+		if downloaded[i], _, err = dl.DownloadTo(ref, c.Version, chartDest); err != nil {
+			return nil, fmt.Errorf(
+				"failed to download chart %q version %q from %q: %w",
+				c.Name, c.Version, c.Repository, err,
+			)
+		}
+	}
+	return downloaded, nil
+}
+
+// readChartLockFile reads the lock file at the given path. It returns an error
+// if the lock file does not exist, as dependencies can only be built
+// reproducibly from a lock file.
+func readChartLockFile(path string) (*chart.Lock, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New(
+				"Chart.lock not found: dependencies must be locked before they can be built",
+			)
+		}
+		return nil, err
+	}
+	lock := &chart.Lock{}
+	if err = yaml.Unmarshal(b, lock); err != nil {
+		return nil, fmt.Errorf("failed to parse Chart.lock: %w", err)
+	}
+	return lock, nil
+}
+
+// verifyChartLock verifies that the given lock is in sync with the given
+// dependencies of a chart, using the same digest as Helm.
+func verifyChartLock(deps []*chart.Dependency, lock *chart.Lock) error {
+	data, err := json.Marshal([2][]*chart.Dependency{deps, lock.Dependencies})
+	if err != nil {
+		return err
+	}
+	digest, err := provenance.Digest(bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	if "sha256:"+digest != lock.Digest {
+		return errors.New(
+			"the lock file (Chart.lock) is out of sync with the dependencies file (Chart.yaml)",
+		)
+	}
+	return nil
+}
+
+// chartArchiveName returns the name of the archive of the given dependency, as
+// used by Helm in the charts directory.
+func chartArchiveName(dep *chart.Dependency) string {
+	return fmt.Sprintf("%s-%s.tgz", dep.Name, dep.Version)
+}
+
+// isChartArchiveOf returns true if the file at the given path is a regular
+// file containing a chart archive with the name and version of the given
+// dependency. It returns false if the file does not exist or is not a valid
+// chart archive.
+func isChartArchiveOf(path string, dep *chart.Dependency) (bool, error) {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !fi.Mode().IsRegular() {
+		return false, nil
+	}
+	c, err := loader.LoadFile(path)
+	if err != nil {
+		return false, nil // nolint: nilerr
+	}
+	return c.Name() == dep.Name && c.Metadata.Version == dep.Version, nil
+}
+
+// packageChartFileDependency packages the chart in the given directory into the
+// charts directory, after checking that it satisfies the version of the given
+// dependency.
+func packageChartFileDependency(path string, dep *chart.Dependency, chartsPath string) error {
+	c, err := loader.LoadDir(path)
+	if err != nil {
+		return err
+	}
+	constraint, err := semver.NewConstraint(dep.Version)
+	if err != nil {
+		return fmt.Errorf("invalid version %q: %w", dep.Version, err)
+	}
+	v, err := semver.NewVersion(c.Metadata.Version)
+	if err != nil {
+		return fmt.Errorf("invalid chart version %q: %w", c.Metadata.Version, err)
+	}
+	if !constraint.Check(v) {
+		return fmt.Errorf(
+			"chart version %q does not satisfy locked version %q", c.Metadata.Version, dep.Version,
+		)
+	}
+	_, err = chartutil.Save(c, chartsPath)
+	return err
+}
+
+// copyChartArchive copies the chart archive at the given source path to the
+// given destination path.
+func copyChartArchive(src, dst string) error {
+	b, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, b, 0o600)
+}
+
+// removeOutdatedChartDependencies removes chart archives from the charts
+// directory that contain a chart with the name of one of the given
+// dependencies, but not the locked version of it.
+func removeOutdatedChartDependencies(chartsPath string, deps []*chart.Dependency) error {
+	expected := make(map[string]struct{}, len(deps))
+	names := make(map[string]struct{}, len(deps))
+	for _, dep := range deps {
+		expected[chartArchiveName(dep)] = struct{}{}
+		names[dep.Name] = struct{}{}
+	}
+
+	entries, err := os.ReadDir(chartsPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || filepath.Ext(entry.Name()) != ".tgz" {
+			continue
+		}
+		if _, ok := expected[entry.Name()]; ok {
+			continue
+		}
+		archivePath := filepath.Join(chartsPath, entry.Name())
+		c, err := loader.LoadFile(archivePath)
+		if err != nil {
+			// Not a chart archive; leave it alone
+			continue
+		}
+		if _, ok := names[c.Name()]; ok {
+			if err = os.Remove(archivePath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
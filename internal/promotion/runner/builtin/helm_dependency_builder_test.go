@@ -0,0 +1,389 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/helmpath"
+	"helm.sh/helm/v3/pkg/provenance"
+	helmregistry "helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_helmDependencyBuilder_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path and cachePath are empty strings",
+			config: promotion.Config{
+				"path":      "",
+				"cachePath": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+				"cachePath: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":      "charts/my-chart",
+				"cachePath": "charts/.cache",
+				"offline":   true,
+			},
+		},
+	}
+
+	r := newHelmDependencyBuilder(nil)
+	runner, ok := r.(*helmDependencyBuilder)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_helmDependencyBuilder_run(t *testing.T) {
+	// Set up the HTTP repository
+	httpRepositoryRoot := t.TempDir()
+	require.NoError(t, copyFile(
+		"testdata/helm/charts/examplechart-0.1.0.tgz",
+		filepath.Join(httpRepositoryRoot, "examplechart-0.1.0.tgz"),
+	))
+	httpRepository := httptest.NewServer(http.FileServer(http.Dir(httpRepositoryRoot)))
+	t.Cleanup(httpRepository.Close)
+	repoIndex, err := repo.IndexDirectory(httpRepositoryRoot, httpRepository.URL)
+	require.NoError(t, err)
+	require.NoError(t, repoIndex.WriteFile(filepath.Join(httpRepositoryRoot, "index.yaml"), 0o600))
+
+	// Set up the OCI registry, which requires authentication
+	ociRegistry := newAuthRegistryServer("username", "password")
+	ociRegistry.Start()
+	t.Cleanup(ociRegistry.Close)
+	ociClient, err := helm.NewRegistryClient(t.TempDir())
+	require.NoError(t, err)
+	ociRepositoryRef := strings.TrimPrefix(ociRegistry.URL, "http://")
+	require.NoError(t, ociClient.Login(
+		ociRepositoryRef,
+		helmregistry.LoginOptBasicAuth("username", "password"),
+	))
+	b, err := os.ReadFile("testdata/helm/charts/demo-0.1.0.tgz")
+	require.NoError(t, err)
+	_, err = ociClient.Push(b, ociRepositoryRef+"/demo:0.1.0")
+	require.NoError(t, err)
+
+	credsDB := &credentials.FakeDB{
+		GetFn: func(
+			_ context.Context,
+			_ string,
+			credType credentials.Type,
+			repoURL string,
+		) (*credentials.Credentials, error) {
+			if credType == credentials.TypeHelm && repoURL == "oci://"+ociRepositoryRef+"/demo" {
+				return &credentials.Credentials{Username: "username", Password: "password"}, nil
+			}
+			return nil, nil
+		},
+	}
+
+	remoteDependencies := []*chart.Dependency{
+		{
+			Name:       "examplechart",
+			Version:    "^0.1.0",
+			Repository: httpRepository.URL,
+		},
+		{
+			Name:       "demo",
+			Version:    "0.1.0",
+			Repository: "oci://" + ociRepositoryRef,
+		},
+	}
+	remoteLockedDependencies := []*chart.Dependency{
+		{
+			Name:       "examplechart",
+			Version:    "0.1.0",
+			Repository: httpRepository.URL,
+		},
+		{
+			Name:       "demo",
+			Version:    "0.1.0",
+			Repository: "oci://" + ociRepositoryRef,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		cfg        builtin.HelmDependencyBuildConfig
+		setup      func(t *testing.T, workDir string)
+		assertions func(t *testing.T, workDir string, result promotion.StepResult, err error)
+	}{
+		{
+			name: "chart without dependencies",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path: "chart",
+			},
+			setup: func(t *testing.T, workDir string) {
+				writeTestChart(t, filepath.Join(workDir, "chart"), nil, nil)
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				assert.NoDirExists(t, filepath.Join(workDir, "chart", "charts"))
+			},
+		},
+		{
+			name: "chart without lock file",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path: "chart",
+			},
+			setup: func(t *testing.T, workDir string) {
+				writeTestChart(t, filepath.Join(workDir, "chart"), remoteDependencies, nil)
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "Chart.lock not found")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "lock file out of sync",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path: "chart",
+			},
+			setup: func(t *testing.T, workDir string) {
+				chartPath := filepath.Join(workDir, "chart")
+				writeTestChart(t, chartPath, remoteDependencies, remoteLockedDependencies)
+
+				// Change the dependencies after locking them
+				writeTestChart(t, chartPath, remoteDependencies[:1], nil)
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "out of sync")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "downloads dependencies and populates cache",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path:      "chart",
+				CachePath: ".cache/charts",
+			},
+			setup: func(t *testing.T, workDir string) {
+				chartPath := filepath.Join(workDir, "chart")
+				writeTestChart(t, chartPath, remoteDependencies, remoteLockedDependencies)
+
+				// Add an outdated version of a dependency
+				require.NoError(t, os.MkdirAll(filepath.Join(chartPath, "charts"), 0o700))
+				_, err := chartutil.Save(&chart.Chart{
+					Metadata: &chart.Metadata{
+						APIVersion: chart.APIVersionV2,
+						Name:       "examplechart",
+						Version:    "0.0.1",
+					},
+				}, filepath.Join(chartPath, "charts"))
+				require.NoError(t, err)
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+
+				chartsPath := filepath.Join(workDir, "chart", "charts")
+				assert.FileExists(t, filepath.Join(chartsPath, "examplechart-0.1.0.tgz"))
+				assert.FileExists(t, filepath.Join(chartsPath, "demo-0.1.0.tgz"))
+				assert.NoFileExists(t, filepath.Join(chartsPath, "examplechart-0.0.1.tgz"))
+
+				cachePath := filepath.Join(workDir, ".cache", "charts")
+				assert.FileExists(t, filepath.Join(cachePath, "examplechart-0.1.0.tgz"))
+				assert.FileExists(t, filepath.Join(cachePath, "demo-0.1.0.tgz"))
+			},
+		},
+		{
+			name: "offline with cached dependencies",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path:      "chart",
+				CachePath: ".cache/charts",
+				Offline:   true,
+			},
+			setup: func(t *testing.T, workDir string) {
+				// Use dependencies from repositories that cannot be reached
+				deps := []*chart.Dependency{
+					{Name: "examplechart", Version: "0.1.0", Repository: "https://charts.invalid"},
+					{Name: "demo", Version: "0.1.0", Repository: "oci://registry.invalid/charts"},
+				}
+				writeTestChart(t, filepath.Join(workDir, "chart"), deps, deps)
+
+				cachePath := filepath.Join(workDir, ".cache", "charts")
+				require.NoError(t, os.MkdirAll(cachePath, 0o700))
+				require.NoError(t, copyFile(
+					"testdata/helm/charts/examplechart-0.1.0.tgz",
+					filepath.Join(cachePath, "examplechart-0.1.0.tgz"),
+				))
+
+				// Vendored dependencies are used as well
+				chartsPath := filepath.Join(workDir, "chart", "charts")
+				require.NoError(t, os.MkdirAll(chartsPath, 0o700))
+				require.NoError(t, copyFile(
+					"testdata/helm/charts/demo-0.1.0.tgz",
+					filepath.Join(chartsPath, "demo-0.1.0.tgz"),
+				))
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+
+				chartsPath := filepath.Join(workDir, "chart", "charts")
+				assert.FileExists(t, filepath.Join(chartsPath, "examplechart-0.1.0.tgz"))
+				assert.FileExists(t, filepath.Join(chartsPath, "demo-0.1.0.tgz"))
+			},
+		},
+		{
+			name: "offline with missing dependencies",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path:      "chart",
+				CachePath: ".cache/charts",
+				Offline:   true,
+			},
+			setup: func(t *testing.T, workDir string) {
+				writeTestChart(t, filepath.Join(workDir, "chart"), remoteDependencies, remoteLockedDependencies)
+
+				// A cached archive that is not a valid chart is not used
+				cachePath := filepath.Join(workDir, ".cache", "charts")
+				require.NoError(t, os.MkdirAll(cachePath, 0o700))
+				require.NoError(t, os.WriteFile(
+					filepath.Join(cachePath, "demo-0.1.0.tgz"), []byte("invalid"), 0o600,
+				))
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "not available offline: examplechart-0.1.0, demo-0.1.0")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "packages file dependency",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path:    "chart",
+				Offline: true,
+			},
+			setup: func(t *testing.T, workDir string) {
+				writeTestChart(t, filepath.Join(workDir, "library"), nil, nil)
+				deps := []*chart.Dependency{
+					{Name: "test-chart", Version: "0.1.0", Repository: "file://../library"},
+				}
+				writeTestChart(t, filepath.Join(workDir, "chart"), deps, deps)
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				assert.FileExists(t, filepath.Join(workDir, "chart", "charts", "test-chart-0.1.0.tgz"))
+			},
+		},
+		{
+			name: "file dependency outside of work directory",
+			cfg: builtin.HelmDependencyBuildConfig{
+				Path: "chart",
+			},
+			setup: func(t *testing.T, workDir string) {
+				deps := []*chart.Dependency{
+					{Name: "test-chart", Version: "0.1.0", Repository: "file://../../library"},
+				}
+				writeTestChart(t, filepath.Join(workDir, "chart"), deps, deps)
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `invalid dependency "file://../../library"`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+	}
+
+	runner := &helmDependencyBuilder{credsDB: credsDB}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Set up a fake Helm cache directory to ensure it is not used
+			t.Setenv(helmpath.CacheHomeEnvVar, t.TempDir())
+
+			workDir := absoluteTempDir(t)
+			tt.setup(t, workDir)
+
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "fake-project",
+					WorkDir: workDir,
+				},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, result, err)
+
+			// Assert that the Helm cache directory was not used
+			assert.NoDirExistsf(t, helmpath.CachePath("repository"), "Helm home directory was used")
+		})
+	}
+}
+
+// writeTestChart writes a chart with the given dependencies to the given path.
+// If locked dependencies are given, a Chart.lock file locking them is written
+// as well.
+func writeTestChart(t *testing.T, chartPath string, deps, locked []*chart.Dependency) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(chartPath, 0o700))
+	b, err := yaml.Marshal(&chart.Metadata{
+		APIVersion:   chart.APIVersionV2,
+		Name:         "test-chart",
+		Version:      "0.1.0",
+		Dependencies: deps,
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(chartPath, "Chart.yaml"), b, 0o600))
+
+	if locked == nil {
+		return
+	}
+	data, err := json.Marshal([2][]*chart.Dependency{deps, locked})
+	require.NoError(t, err)
+	digest, err := provenance.Digest(bytes.NewBuffer(data))
+	require.NoError(t, err)
+	b, err = yaml.Marshal(&chart.Lock{
+		Digest:       "sha256:" + digest,
+		Dependencies: locked,
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(chartPath, "Chart.lock"), b, 0o600))
+}
@@ -0,0 +1,12 @@
+package builtin
+
+import (
+	intini "github.com/akuity/kargo/internal/ini"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// newINIUpdater returns an implementation of the promotion.StepRunner interface
+// that updates the values of specified keys in an INI file.
+func newINIUpdater() promotion.StepRunner {
+	return newKeyPathUpdater("ini-update", "INI", intini.SetValuesInFile)
+}
@@ -4,6 +4,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -16,7 +17,11 @@ var initialized atomic.Uint32
 
 // Initialize registers all built-in promotion.StepRunners with the promotion
 // package's internal StepRunner registry.
-func Initialize(kargoClient, argocdClient client.Client, credsDB credentials.Database) {
+func Initialize(
+	kargoClient, argocdClient, fluxClient client.Client,
+	kubeClient kubernetes.Interface,
+	credsDB credentials.Database,
+) {
 	if !initialized.CompareAndSwap(0, 1) {
 		panic("built-in promotion step runners already initialized")
 	}
@@ -27,21 +32,39 @@ func Initialize(kargoClient, argocdClient client.Client, credsDB credentials.Dat
 			0,
 		),
 		newHelmChartUpdater(credsDB),
+		newHelmDependencyBuilder(credsDB),
+		pkgPromotion.NewRetryableStepRunner(
+			newContainerRunner(kargoClient, kubeClient),
+			ptr.To(containerRunnerDefaultTimeout),
+			0,
+		),
 		newFileCopier(),
+		newCUEExporter(credsDB),
 		newFileDeleter(),
+		newFluxUpdater(fluxClient),
 		newGitCloner(credsDB),
 		newGitCommitter(),
 		newGitPROpener(credsDB),
 		newGitPRWaiter(credsDB),
 		newGitPusher(credsDB),
+		newGitReverter(kargoClient),
 		newGitTreeClearer(),
+		newHCLUpdater(),
 		newHelmTemplateRunner(),
 		newHTTPRequester(),
+		newINIUpdater(),
 		newJSONParser(),
 		newJSONUpdater(),
-		newKustomizeBuilder(),
+		newJsonnetRenderer(),
+		newKustomizeBuilder(credsDB),
 		newKustomizeImageSetter(kargoClient),
+		newOCIPuller(credsDB),
+		newOCIPusher(credsDB),
 		newOutputComposer(),
+		newSOPSDecrypter(kargoClient),
+		newSOPSEncrypter(kargoClient),
+		newTemplateRenderer(kargoClient),
+		newTOMLUpdater(),
 		newYAMLParser(),
 		newYAMLUpdater(),
 	}
@@ -7,11 +7,11 @@ import (
 )
 
 func TestInitialize(t *testing.T) {
-	require.NotPanics(t, func() { Initialize(nil, nil, nil) })
+	require.NotPanics(t, func() { Initialize(nil, nil, nil, nil, nil) })
 	// Should panic if called more than once
 	require.PanicsWithValue(
 		t,
 		"built-in promotion step runners already initialized",
-		func() { Initialize(nil, nil, nil) },
+		func() { Initialize(nil, nil, nil, nil, nil) },
 	)
 }
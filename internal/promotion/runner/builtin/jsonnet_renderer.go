@@ -0,0 +1,276 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/google/go-jsonnet"
+	"github.com/xeipuuv/gojsonschema"
+	yaml "sigs.k8s.io/yaml/goyaml.v3"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// jsonnetRenderer is an implementation of the promotion.StepRunner interface
+// that renders Jsonnet code to YAML or JSON.
+type jsonnetRenderer struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newJsonnetRenderer returns an implementation of the promotion.StepRunner
+// interface that renders Jsonnet code to YAML or JSON.
+func newJsonnetRenderer() promotion.StepRunner {
+	r := &jsonnetRenderer{}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (j *jsonnetRenderer) Name() string {
+	return "jsonnet-render"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (j *jsonnetRenderer) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := j.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.JsonnetRenderConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", j.Name(), err)
+	}
+	return j.run(stepCtx, cfg)
+}
+
+// validate validates jsonnetRenderer configuration against a JSON schema.
+func (j *jsonnetRenderer) validate(cfg promotion.Config) error {
+	return validate(j.schemaLoader, gojsonschema.NewGoLoader(cfg), j.Name())
+}
+
+func (j *jsonnetRenderer) run(
+	stepCtx *promotion.StepContext,
+	cfg builtin.JsonnetRenderConfig,
+) (promotion.StepResult, error) {
+	vm := jsonnet.MakeVM()
+	vm.Importer(newJsonnetImporter(stepCtx.WorkDir, cfg.JPath))
+	for name, value := range cfg.ExtVars {
+		code, isCode, err := getJsonnetVarValue(value)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error encoding value of external variable %q: %w", name, err)
+		}
+		if isCode {
+			vm.ExtCode(name, code)
+		} else {
+			vm.ExtVar(name, code)
+		}
+	}
+	for name, value := range cfg.TLAVars {
+		code, isCode, err := getJsonnetVarValue(value)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error encoding value of top-level argument %q: %w", name, err)
+		}
+		if isCode {
+			vm.TLACode(name, code)
+		} else {
+			vm.TLAVar(name, code)
+		}
+	}
+
+	// The importer resolves paths relative to the working directory, so the
+	// path is passed as-is.
+	out, err := vm.EvaluateFile(cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error rendering %q: %w", cfg.Path, err)
+	}
+
+	outPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not secure join outPath %q: %w", cfg.OutPath, err)
+	}
+	if err = writeRenderedOutput(outPath, []byte(out)); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"error writing rendered output to %q: %w",
+				cfg.OutPath, sanitizePathError(err, stepCtx.WorkDir),
+			)
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// getJsonnetVarValue returns the value to pass to Jsonnet for the given
+// variable value and whether it must be passed as code. Strings are passed
+// as-is, while any other values are passed as their JSON representation, which
+// is valid Jsonnet code.
+func getJsonnetVarValue(value any) (string, bool, error) {
+	if s, ok := value.(string); ok {
+		return s, false, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", false, err
+	}
+	return string(b), true, nil
+}
+
+// jsonnetImporter is an implementation of the jsonnet.Importer interface that
+// only imports files from within a working directory. Paths are resolved
+// relative to the directory of the importing file first, and then relative to
+// each of the library search paths. Absolute paths are resolved relative to
+// the working directory.
+type jsonnetImporter struct {
+	workDir string
+	jpath   []string
+	cache   map[string]*jsonnetImportResult
+}
+
+type jsonnetImportResult struct {
+	contents jsonnet.Contents
+	exists   bool
+}
+
+func newJsonnetImporter(workDir string, jpath []string) *jsonnetImporter {
+	return &jsonnetImporter{
+		workDir: workDir,
+		jpath:   jpath,
+		cache:   map[string]*jsonnetImportResult{},
+	}
+}
+
+// Import implements the jsonnet.Importer interface. The returned foundAt is
+// relative to the working directory, so that it can safely be included in
+// error messages.
+func (j *jsonnetImporter) Import(
+	importedFrom, importedPath string,
+) (jsonnet.Contents, string, error) {
+	candidates := []string{importedPath}
+	if !filepath.IsAbs(importedPath) {
+		candidates = []string{filepath.Join(filepath.Dir(importedFrom), importedPath)}
+		for _, dir := range j.jpath {
+			candidates = append(candidates, filepath.Join(dir, importedPath))
+		}
+	}
+	for _, candidate := range candidates {
+		res, err := j.tryPath(candidate)
+		if err != nil {
+			return jsonnet.Contents{}, "", err
+		}
+		if res.exists {
+			return res.contents, filepath.Clean(candidate), nil
+		}
+	}
+	return jsonnet.Contents{}, "", fmt.Errorf(
+		"couldn't open import %q: no match locally or in the Jsonnet library paths",
+		importedPath,
+	)
+}
+
+func (j *jsonnetImporter) tryPath(path string) (*jsonnetImportResult, error) {
+	path = filepath.Clean(path)
+	if res, ok := j.cache[path]; ok {
+		return res, nil
+	}
+	absPath, err := securejoin.SecureJoin(j.workDir, path)
+	if err != nil {
+		return nil, fmt.Errorf("could not secure join import path %q: %w", path, err)
+	}
+	res := &jsonnetImportResult{}
+	data, err := os.ReadFile(absPath)
+	switch {
+	case err == nil:
+		res.contents = jsonnet.MakeContents(string(data))
+		res.exists = true
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("error reading import %q: %w", path, sanitizePathError(err, j.workDir))
+	}
+	j.cache[path] = res
+	return res, nil
+}
+
+// writeRenderedOutput writes the given JSON document to the given path. If the
+// path has a .json extension, the document is written as indented JSON.
+// Otherwise, it is written as YAML, with each element of a top-level array
+// written as a separate document. The order of the keys of objects is
+// preserved.
+func writeRenderedOutput(path string, data []byte) error {
+	var out []byte
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, bytes.TrimSpace(data), "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		out = buf.Bytes()
+	} else {
+		var err error
+		if out, err = jsonToYAMLStream(data); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0o600)
+}
+
+// jsonToYAMLStream converts the given JSON document to YAML. If the document
+// is an array, each of its elements is written as a separate YAML document.
+func jsonToYAMLStream(data []byte) ([]byte, error) {
+	// JSON is a subset of YAML, so the document can be decoded as YAML, which
+	// preserves the order of the keys of objects.
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	clearYAMLStyle(root)
+
+	docs := []*yaml.Node{root}
+	if root.Kind == yaml.SequenceNode {
+		docs = root.Content
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	for _, d := range docs {
+		if err := enc.Encode(d); err != nil {
+			return nil, err
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// clearYAMLStyle recursively clears the style of the given node and its
+// children, so that nodes decoded from JSON are encoded in block style, with
+// strings only quoted where necessary.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
@@ -0,0 +1,293 @@
+package builtin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_jsonnetRenderer_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "path and outPath not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+				"(root): outPath is required",
+			},
+		},
+		{
+			name: "path and outPath are empty strings",
+			config: promotion.Config{
+				"path":    "",
+				"outPath": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+				"outPath: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "jpath entry is empty string",
+			config: promotion.Config{
+				"path":    "main.jsonnet",
+				"outPath": "out.yaml",
+				"jpath":   []string{""},
+			},
+			expectedProblems: []string{
+				"jpath.0: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":    "main.jsonnet",
+				"outPath": "out.yaml",
+				"jpath":   []string{"lib", "vendor"},
+				"extVars": map[string]any{
+					"tag":      "v1.0.0",
+					"replicas": 3,
+				},
+				"tlaVars": map[string]any{
+					"env": "prod",
+				},
+			},
+		},
+	}
+
+	r := newJsonnetRenderer()
+	runner, ok := r.(*jsonnetRenderer)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_jsonnetRenderer_run(t *testing.T) {
+	testCases := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.JsonnetRenderConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "renders array as YAML stream",
+			files: map[string]string{
+				"env/main.jsonnet": `
+local app = import 'app.libsonnet';
+local util = import 'util.libsonnet';
+[
+  app.deployment(std.extVar('tag'), std.extVar('replicas')),
+  util.configMap,
+]
+`,
+				"env/app.libsonnet": `
+{
+  deployment(tag, replicas):: {
+    apiVersion: 'apps/v1',
+    kind: 'Deployment',
+    metadata: { name: 'app' },
+    spec: {
+      replicas: replicas,
+      template: { spec: { containers: [{ name: 'app', image: 'app:' + tag }] } },
+    },
+  },
+}
+`,
+				"lib/util.libsonnet": `
+{
+  configMap: {
+    apiVersion: 'v1',
+    kind: 'ConfigMap',
+    metadata: { name: 'config' },
+    data: { enabled: 'true' },
+  },
+}
+`,
+			},
+			cfg: builtin.JsonnetRenderConfig{
+				Path:    "env/main.jsonnet",
+				OutPath: "out/manifests.yaml",
+				JPath:   []string{"lib"},
+				ExtVars: map[string]any{
+					"tag":      "v1.2.3",
+					"replicas": 3,
+				},
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "out/manifests.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+        - image: app:v1.2.3
+          name: app
+---
+apiVersion: v1
+data:
+  enabled: "true"
+kind: ConfigMap
+metadata:
+  name: config
+`, string(b))
+			},
+		},
+		{
+			name: "renders object with top-level arguments as JSON",
+			files: map[string]string{
+				"main.jsonnet": `
+function(env, settings) {
+  env: env,
+  settings: settings,
+}
+`,
+			},
+			cfg: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.json",
+				TLAVars: map[string]any{
+					"env":      "prod",
+					"settings": map[string]any{"debug": false},
+				},
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "out.json"))
+				require.NoError(t, err)
+				require.Equal(t, `{
+  "env": "prod",
+  "settings": {
+    "debug": false
+  }
+}
+`, string(b))
+			},
+		},
+		{
+			name: "import outside of working directory",
+			files: map[string]string{
+				"main.jsonnet": `import '../../../../etc/hostname'`,
+			},
+			cfg: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `couldn't open import "../../../../etc/hostname"`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "evaluation error",
+			files: map[string]string{
+				"main.jsonnet": `{ tag: std.extVar('tag') }`,
+			},
+			cfg: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error rendering "main.jsonnet"`)
+				require.ErrorContains(t, err, "Undefined external variable: tag")
+				require.NotContains(t, err.Error(), workDir)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "file does not exist",
+			cfg: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `couldn't open import "main.jsonnet"`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range testCase.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			r := newJsonnetRenderer()
+			runner, ok := r.(*jsonnetRenderer)
+			require.True(t, ok)
+
+			res, err := runner.run(
+				&promotion.StepContext{WorkDir: workDir},
+				testCase.cfg,
+			)
+			testCase.assertions(t, workDir, res, err)
+		})
+	}
+}
+
+func Test_jsonToYAMLStream(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "object key order is preserved",
+			input:    `{"kind": "ConfigMap", "apiVersion": "v1"}`,
+			expected: "kind: ConfigMap\napiVersion: v1\n",
+		},
+		{
+			name:     "ambiguous strings are quoted",
+			input:    `{"a": "true", "b": "1.0", "c": "null", "d": "plain"}`,
+			expected: "a: \"true\"\nb: \"1.0\"\nc: \"null\"\nd: plain\n",
+		},
+		{
+			name:     "array elements become documents",
+			input:    `[{"a": 1}, {"b": [1, 2]}]`,
+			expected: "a: 1\n---\nb:\n  - 1\n  - 2\n",
+		},
+		{
+			name:     "empty array",
+			input:    `[]`,
+			expected: "",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out, err := jsonToYAMLStream([]byte(testCase.input))
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, string(out))
+		})
+	}
+}
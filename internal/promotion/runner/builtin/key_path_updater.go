@@ -0,0 +1,144 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// keyPathUpdate is an update of the value at a key path in a file.
+type keyPathUpdate struct {
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+// keyPathUpdateConfig is the configuration shared by all steps that update the
+// values at key paths in a file of a specific format.
+type keyPathUpdateConfig struct {
+	Path    string          `json:"path"`
+	Updates []keyPathUpdate `json:"updates"`
+}
+
+// keyPathUpdater is an implementation of the promotion.StepRunner interface
+// that updates the values of specified keys in a file of a specific format. The
+// format-specific work is delegated to a function that applies the updates to
+// a file.
+type keyPathUpdater struct {
+	name            string
+	format          string
+	setValuesInFile func(file string, updates []keyPathUpdate) error
+	schemaLoader    gojsonschema.JSONLoader
+}
+
+// newKeyPathUpdater returns an implementation of the promotion.StepRunner
+// interface with the given name that updates the values of specified keys in
+// files of the given format using the given setValuesInFile function. U is the
+// format-specific representation of an update.
+func newKeyPathUpdater[U ~struct {
+	Key   string
+	Value any
+}](
+	name string,
+	format string,
+	setValuesInFile func(file string, updates []U) error,
+) promotion.StepRunner {
+	r := &keyPathUpdater{
+		name:   name,
+		format: format,
+		setValuesInFile: func(file string, updates []keyPathUpdate) error {
+			u := make([]U, len(updates))
+			for i, update := range updates {
+				u[i] = U(update)
+			}
+			return setValuesInFile(file, u)
+		},
+	}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (k *keyPathUpdater) Name() string {
+	return k.name
+}
+
+// Run implements the promotion.StepRunner interface.
+func (k *keyPathUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	failure := promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+
+	if err := k.validate(stepCtx.Config); err != nil {
+		return failure, err
+	}
+
+	cfg, err := promotion.ConfigToStruct[keyPathUpdateConfig](stepCtx.Config)
+	if err != nil {
+		return failure, fmt.Errorf("could not convert config into %s config: %w", k.Name(), err)
+	}
+
+	return k.run(ctx, stepCtx, cfg)
+}
+
+// validate validates keyPathUpdater configuration against a JSON schema.
+func (k *keyPathUpdater) validate(cfg promotion.Config) error {
+	return validate(k.schemaLoader, gojsonschema.NewGoLoader(cfg), k.Name())
+}
+
+func (k *keyPathUpdater) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg keyPathUpdateConfig,
+) (promotion.StepResult, error) {
+	result := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+	if len(cfg.Updates) > 0 {
+		if err := k.updateFile(stepCtx.WorkDir, cfg.Path, cfg.Updates); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("%s file update failed: %w", k.format, err)
+		}
+
+		if commitMsg := k.generateCommitMessage(cfg.Path, cfg.Updates); commitMsg != "" {
+			result.Output = map[string]any{
+				"commitMessage": commitMsg,
+			}
+		}
+	}
+	return result, nil
+}
+
+func (k *keyPathUpdater) updateFile(workDir string, path string, updates []keyPathUpdate) error {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	if err := k.setValuesInFile(absFilePath, updates); err != nil {
+		return fmt.Errorf("error updating %s file %q: %w", k.format, path, err)
+	}
+	return nil
+}
+
+func (k *keyPathUpdater) generateCommitMessage(path string, updates []keyPathUpdate) string {
+	if len(updates) == 0 {
+		return ""
+	}
+
+	var commitMsg strings.Builder
+	_, _ = commitMsg.WriteString(fmt.Sprintf("Updated %s\n", path))
+	for _, update := range updates {
+		switch v := update.Value.(type) {
+		case string:
+			_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s: %q", update.Key, v))
+		default:
+			_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s: %v", update.Key, v))
+		}
+	}
+
+	return commitMsg.String()
+}
@@ -0,0 +1,266 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+func Test_keyPathUpdater_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "path is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name:   "updates is null",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): updates is required",
+			},
+		},
+		{
+			name: "updates is empty",
+			config: promotion.Config{
+				"updates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"updates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "key not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: key is required",
+			},
+		},
+		{
+			name: "key is empty",
+			config: promotion.Config{
+				"updates": []promotion.Config{{
+					"key": "",
+				}},
+			},
+			expectedProblems: []string{
+				"updates.0.key: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "value not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: value is required",
+			},
+		},
+		{
+			name: "valid config",
+			config: promotion.Config{
+				"path": "fake-path",
+				"updates": []promotion.Config{
+					{
+						"key":   "fake-key",
+						"value": "fake-value",
+					},
+					{
+						"key":   "another-fake-key",
+						"value": 5,
+					},
+				},
+			},
+		},
+	}
+
+	// All key path updaters share the same configuration schema
+	for _, r := range []promotion.StepRunner{
+		newTOMLUpdater(),
+		newHCLUpdater(),
+		newINIUpdater(),
+	} {
+		runner, ok := r.(*keyPathUpdater)
+		require.True(t, ok)
+		for _, testCase := range testCases {
+			t.Run(runner.Name()+"/"+testCase.name, func(t *testing.T) {
+				err := runner.validate(testCase.config)
+				if len(testCase.expectedProblems) == 0 {
+					require.NoError(t, err)
+				} else {
+					for _, problem := range testCase.expectedProblems {
+						require.ErrorContains(t, err, problem)
+					}
+				}
+			})
+		}
+	}
+}
+
+func Test_keyPathUpdater_run(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             keyPathUpdateConfig
+		setValuesInFile func(string, []keyPathUpdate) error
+		assertions      func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run with updates",
+			cfg: keyPathUpdateConfig{
+				Path: "config.fake",
+				Updates: []keyPathUpdate{
+					{Key: "image.tag", Value: "fake-tag"},
+					{Key: "image.replicas", Value: 3},
+				},
+			},
+			setValuesInFile: func(file string, updates []keyPathUpdate) error {
+				b := []byte{}
+				for _, update := range updates {
+					b = append(b, update.Key...)
+				}
+				return os.WriteFile(file, b, 0o600)
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": `Updated config.fake
+
+- image.tag: "fake-tag"
+- image.replicas: 3`,
+					},
+				}, result)
+				content, err := os.ReadFile(path.Join(workDir, "config.fake"))
+				require.NoError(t, err)
+				assert.Equal(t, "image.tagimage.replicas", string(content))
+			},
+		},
+		{
+			name: "path outside of work dir is confined to it",
+			cfg: keyPathUpdateConfig{
+				Path:    "../config.fake",
+				Updates: []keyPathUpdate{{Key: "image.tag", Value: "fake-tag"}},
+			},
+			setValuesInFile: func(file string, _ []keyPathUpdate) error {
+				return os.WriteFile(file, nil, 0o600)
+			},
+			assertions: func(t *testing.T, workDir string, _ promotion.StepResult, err error) {
+				assert.NoError(t, err)
+				assert.FileExists(t, path.Join(workDir, "config.fake"))
+			},
+		},
+		{
+			name: "failed to update file",
+			cfg: keyPathUpdateConfig{
+				Path:    "config.fake",
+				Updates: []keyPathUpdate{{Key: "image.tag", Value: "fake-tag"}},
+			},
+			setValuesInFile: func(string, []keyPathUpdate) error {
+				return errors.New("error finding key image.tag")
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				assert.ErrorContains(t, err, "FAKE file update failed")
+				assert.ErrorContains(t, err, `error updating FAKE file "config.fake"`)
+				assert.ErrorContains(t, err, "error finding key image.tag")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &keyPathUpdater{
+				name:            "fake-update",
+				format:          "FAKE",
+				setValuesInFile: tt.setValuesInFile,
+			}
+			stepCtx := &promotion.StepContext{WorkDir: t.TempDir()}
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, stepCtx.WorkDir, result, err)
+		})
+	}
+}
+
+func Test_keyPathUpdater_formats(t *testing.T) {
+	tests := []struct {
+		runner   promotion.StepRunner
+		file     string
+		content  string
+		update   keyPathUpdate
+		expected string
+	}{
+		{
+			runner:   newTOMLUpdater(),
+			file:     "config.toml",
+			content:  "[image]\ntag = \"oldtag\" # The tag to deploy\n",
+			update:   keyPathUpdate{Key: "image.tag", Value: "fake-tag"},
+			expected: "[image]\ntag = \"fake-tag\" # The tag to deploy\n",
+		},
+		{
+			runner:   newHCLUpdater(),
+			file:     "terraform.tfvars",
+			content:  "image_tag = \"oldtag\" # The tag to deploy\n",
+			update:   keyPathUpdate{Key: "image_tag", Value: "fake-tag"},
+			expected: "image_tag = \"fake-tag\" # The tag to deploy\n",
+		},
+		{
+			runner:   newINIUpdater(),
+			file:     "config.ini",
+			content:  "[image]\ntag = oldtag\n",
+			update:   keyPathUpdate{Key: "image.tag", Value: "fake-tag"},
+			expected: "[image]\ntag = fake-tag\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.runner.Name(), func(t *testing.T) {
+			workDir := t.TempDir()
+			require.NoError(
+				t,
+				os.WriteFile(path.Join(workDir, tt.file), []byte(tt.content), 0o600),
+			)
+			result, err := tt.runner.Run(
+				context.Background(),
+				&promotion.StepContext{
+					WorkDir: workDir,
+					Config: promotion.Config{
+						"path": tt.file,
+						"updates": []any{
+							map[string]any{"key": tt.update.Key, "value": tt.update.Value},
+						},
+					},
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+			content, err := os.ReadFile(path.Join(workDir, tt.file))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, string(content))
+		})
+	}
+}
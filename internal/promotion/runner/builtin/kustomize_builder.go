@@ -17,13 +17,15 @@ import (
 	"sigs.k8s.io/kustomize/kyaml/filesys"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/pkg/promotion"
 	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
 )
 
 // kustomizeRenderMutex is a mutex that ensures only one kustomize build is
-// running at a time. Required because of an ancient bug in Kustomize that
-// causes it to concurrently read and write to the same map, causing a panic.
+// running at a time. Required because Kustomize keeps the OpenAPI schema in
+// process-wide globals, which every build resets and rebuilds while others may
+// concurrently read them, causing a fatal error.
 // xref: https://github.com/kubernetes-sigs/kustomize/issues/3659
 var kustomizeRenderMutex sync.Mutex
 
@@ -31,14 +33,16 @@ var kustomizeRenderMutex sync.Mutex
 // that builds a set of Kubernetes manifests using Kustomize.
 type kustomizeBuilder struct {
 	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
 }
 
 // newKustomizeBuilder returns an implementation of the
 // promotion.StepRunner interface that builds a set of Kubernetes manifests using
 // Kustomize.
-func newKustomizeBuilder() promotion.StepRunner {
+func newKustomizeBuilder(credsDB credentials.Database) promotion.StepRunner {
 	return &kustomizeBuilder{
 		schemaLoader: getConfigSchemaLoader("kustomize-build"),
+		credsDB:      credsDB,
 	}
 }
 
@@ -49,7 +53,7 @@ func (k *kustomizeBuilder) Name() string {
 
 // Run implements the promotion.StepRunner interface.
 func (k *kustomizeBuilder) Run(
-	_ context.Context,
+	ctx context.Context,
 	stepCtx *promotion.StepContext,
 ) (promotion.StepResult, error) {
 	failure := promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
@@ -65,10 +69,11 @@ func (k *kustomizeBuilder) Run(
 		return failure, fmt.Errorf("could not convert config into %s config: %w", k.Name(), err)
 	}
 
-	return k.run(stepCtx, cfg)
+	return k.run(ctx, stepCtx, cfg)
 }
 
 func (k *kustomizeBuilder) run(
+	ctx context.Context,
 	stepCtx *promotion.StepContext,
 	cfg builtin.KustomizeBuildConfig,
 ) (promotion.StepResult, error) {
@@ -78,8 +83,13 @@ func (k *kustomizeBuilder) run(
 		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
 	}
 
+	loadRestrictor := kustypes.LoadRestrictionsNone
+	if cfg.LoadRestrictor != nil && *cfg.LoadRestrictor == builtin.LoadRestrictionsRootOnly {
+		loadRestrictor = kustypes.LoadRestrictionsRootOnly
+	}
+
 	// Build the manifests.
-	rm, err := kustomizeBuild(fs, filepath.Join(stepCtx.WorkDir, cfg.Path), cfg.Plugin)
+	rm, err := k.build(ctx, stepCtx, fs, filepath.Join(stepCtx.WorkDir, cfg.Path), loadRestrictor, cfg.Plugin)
 	if err != nil {
 		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
 	}
@@ -140,11 +150,49 @@ func (k *kustomizeBuilder) writeResult(rm resmap.ResMap, outPath string) error {
 	return nil
 }
 
-// kustomizeBuild builds the manifests in the given directory using Kustomize.
-func kustomizeBuild(fs filesys.FileSystem, path string, pluginCfg *builtin.Plugin) (_ resmap.ResMap, err error) {
-	kustomizeRenderMutex.Lock()
-	defer kustomizeRenderMutex.Unlock()
+// build builds the manifests in the given directory using Kustomize. If
+// enabled, the Helm charts referenced by the Kustomizations are inflated
+// beforehand using an overlay of the given filesystem, which leaves the
+// filesystem itself untouched.
+func (k *kustomizeBuilder) build(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	fs filesys.FileSystem,
+	path string,
+	loadRestrictor kustypes.LoadRestrictions,
+	pluginCfg *builtin.Plugin,
+) (resmap.ResMap, error) {
+	if pluginCfg != nil && pluginCfg.Helm != nil && pluginCfg.Helm.Enabled {
+		root, _, err := fs.CleanedAbs(stepCtx.WorkDir)
+		if err != nil {
+			return nil, err
+		}
+		overlay := newOverlayFS(fs)
+		inflator := newHelmChartInflator(
+			k.credsDB,
+			stepCtx.Project,
+			root,
+			overlay,
+			loadRestrictor,
+			pluginCfg.Helm,
+		)
+		defer inflator.close()
+		if err = inflator.inflate(ctx, path); err != nil {
+			return nil, fmt.Errorf("failed to inflate Helm charts: %w", err)
+		}
+		fs = overlay
+	}
+	return kustomizeBuild(fs, path, loadRestrictor)
+}
 
+// kustomizeBuild builds the manifests in the given directory using Kustomize.
+// Builds are serialized, so Helm charts should be inflated beforehand to allow
+// them to be rendered in parallel.
+func kustomizeBuild(
+	fs filesys.FileSystem,
+	path string,
+	loadRestrictor kustypes.LoadRestrictions,
+) (_ resmap.ResMap, err error) {
 	// Kustomize can panic in unpredicted ways due to (accidental)
 	// invalid object data; recover when this happens to ensure
 	// continuity of operations.
@@ -154,27 +202,21 @@ func kustomizeBuild(fs filesys.FileSystem, path string, pluginCfg *builtin.Plugi
 		}
 	}()
 
-	// Disable plugins (i.e. "function based" plugins), but enable builtins
-	// (e.g. transformers, generators).
-	buildPluginCfg := kustypes.DisabledPluginConfig()
-	// Helm plugin builtin requires explicit enabling. Kustomize itself ensures
-	// the further Helm files (e.g. cache, data) are stored in a temporary
-	// directory, AS LONG AS the global configuration is not set.
-	buildPluginCfg.HelmConfig.Enabled = true
-	buildPluginCfg.HelmConfig.Command = "helm"
-
-	if pluginCfg != nil && pluginCfg.Helm != nil {
-		buildPluginCfg.HelmConfig.ApiVersions = pluginCfg.Helm.APIVersions
-		buildPluginCfg.HelmConfig.KubeVersion = pluginCfg.Helm.KubeVersion
-	}
-
 	buildOptions := &krusty.Options{
-		// As we make use of a "chrooted" filesystem, we can safely allow
-		// loading of files from anywhere.
-		LoadRestrictions: kustypes.LoadRestrictionsNone,
-		PluginConfig:     buildPluginCfg,
+		// As we make use of a "chrooted" filesystem, loading files from
+		// anywhere within it is safe. Restricting loading to the directory of
+		// the Kustomization is left as an option to the user.
+		LoadRestrictions: loadRestrictor,
+		// Disable plugins (i.e. "function based" plugins), but enable builtins
+		// (e.g. transformers, generators). NB: This leaves Kustomize's own Helm
+		// plugin disabled, as Helm charts are inflated using Kargo's built-in
+		// Helm support instead.
+		PluginConfig: kustypes.DisabledPluginConfig(),
 	}
 
+	kustomizeRenderMutex.Lock()
+	defer kustomizeRenderMutex.Unlock()
+
 	k := krusty.MakeKustomizer(buildOptions)
 	return k.Run(fs, path)
 }
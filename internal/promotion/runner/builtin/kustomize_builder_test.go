@@ -1,23 +1,61 @@
 package builtin
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chartutil"
+	helmregistry "helm.sh/helm/v3/pkg/registry"
 	"helm.sh/helm/v3/pkg/repo"
+	"k8s.io/utils/ptr"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
 	"github.com/akuity/kargo/pkg/promotion"
 	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
 )
 
 func Test_kustomizeBuilder_run(t *testing.T) {
+	// Set up the OCI registry, which requires authentication
+	ociRegistry := newAuthRegistryServer("username", "password")
+	ociRegistry.Start()
+	t.Cleanup(ociRegistry.Close)
+	ociClient, err := helm.NewRegistryClient(t.TempDir())
+	require.NoError(t, err)
+	ociRepositoryRef := strings.TrimPrefix(ociRegistry.URL, "http://")
+	require.NoError(t, ociClient.Login(
+		ociRepositoryRef,
+		helmregistry.LoginOptBasicAuth("username", "password"),
+	))
+	b, err := os.ReadFile("testdata/helm/charts/demo-0.1.0.tgz")
+	require.NoError(t, err)
+	_, err = ociClient.Push(b, ociRepositoryRef+"/demo:0.1.0")
+	require.NoError(t, err)
+
+	credsDB := &credentials.FakeDB{
+		GetFn: func(
+			_ context.Context,
+			_ string,
+			credType credentials.Type,
+			repoURL string,
+		) (*credentials.Credentials, error) {
+			if credType == credentials.TypeHelm && repoURL == "oci://"+ociRepositoryRef+"/demo" {
+				return &credentials.Credentials{Username: "username", Password: "password"}, nil
+			}
+			return nil, nil
+		},
+	}
+
 	tests := []struct {
 		name       string
 		setupFiles func(*testing.T, string)
@@ -94,6 +132,11 @@ replicaCount: 3`), 0o600))
 			config: builtin.KustomizeBuildConfig{
 				Path:    ".",
 				OutPath: "output.yaml",
+				Plugin: &builtin.Plugin{
+					Helm: &builtin.Helm{
+						Enabled: true,
+					},
+				},
 			},
 			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
 				require.NoError(t, err)
@@ -109,6 +152,235 @@ replicaCount: 3`), 0o600))
 
 				// The value from the values file should be in the output.
 				assert.Contains(t, string(b), "replicas: 3")
+
+				// The working directory should be left untouched.
+				kustomization, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+				require.NoError(t, err)
+				assert.Contains(t, string(kustomization), "- chart.yaml")
+				assert.NoDirExists(t, filepath.Join(dir, "charts"))
+			},
+		},
+		{
+			name: "successful build with helmCharts from OCI registry",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(fmt.Sprintf(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: demo
+resources:
+- base
+helmCharts:
+- name: demo
+  repo: oci://%s
+  version: 0.1.0
+  releaseName: demo
+  valuesInline:
+    replicaCount: 2
+  additionalValuesFiles:
+  - values.yaml
+`, ociRepositoryRef)), 0o600))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "values.yaml"), []byte(`---
+image:
+  tag: 1.2.3`), 0o600))
+				require.NoError(t, os.MkdirAll(filepath.Join(dir, "base"), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "base", "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- deployment.yaml
+`), 0o600))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "base", "deployment.yaml"), []byte(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+`), 0o600))
+			},
+			config: builtin.KustomizeBuildConfig{
+				Path:    ".",
+				OutPath: "output.yaml",
+				Plugin: &builtin.Plugin{
+					Helm: &builtin.Helm{
+						Enabled: true,
+					},
+				},
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+
+				b, err := os.ReadFile(filepath.Join(dir, "output.yaml"))
+				require.NoError(t, err)
+
+				// Both the resources and the inflated chart should be in the
+				// output.
+				assert.Contains(t, string(b), "name: test-deployment")
+				assert.Contains(t, string(b), "helm.sh/chart: demo-0.1.0")
+				assert.Contains(t, string(b), "namespace: demo")
+
+				// The inline values and the additional values files should be
+				// applied.
+				assert.Contains(t, string(b), "replicas: 2")
+				assert.Contains(t, string(b), "image: nginx:1.2.3")
+
+				// The chart should not be pulled to the working directory.
+				assert.NoDirExists(t, filepath.Join(dir, "charts"))
+			},
+		},
+		{
+			name: "successful build with chart in chart home",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, chartutil.ExpandFile(
+					filepath.Join(dir, "vendor"),
+					"testdata/helm/charts/demo-0.1.0.tgz",
+				))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+helmGlobals:
+  chartHome: vendor
+helmCharts:
+- name: demo
+  valuesInline:
+    replicaCount: 4
+  valuesMerge: merge
+  skipTests: true
+`), 0o600))
+			},
+			config: builtin.KustomizeBuildConfig{
+				Path:    ".",
+				OutPath: "output.yaml",
+				Plugin: &builtin.Plugin{
+					Helm: &builtin.Helm{
+						Enabled: true,
+					},
+				},
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+
+				b, err := os.ReadFile(filepath.Join(dir, "output.yaml"))
+				require.NoError(t, err)
+				assert.Contains(t, string(b), "helm.sh/chart: demo-0.1.0")
+
+				// With the merge strategy, the values of the chart take
+				// precedence over the inline values.
+				assert.Contains(t, string(b), "replicas: 1")
+
+				// The release name should default to "release-name", and test
+				// hooks should be skipped.
+				assert.Contains(t, string(b), "name: release-name-demo")
+				assert.NotContains(t, string(b), "test-connection")
+			},
+		},
+		{
+			name: "chart not found",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+helmCharts:
+- name: demo
+`), 0o600))
+			},
+			config: builtin.KustomizeBuildConfig{
+				Path:    ".",
+				OutPath: "output.yaml",
+				Plugin: &builtin.Plugin{
+					Helm: &builtin.Helm{
+						Enabled: true,
+					},
+				},
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "no repository specified for chart \"demo\"")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+
+				assert.NoFileExists(t, filepath.Join(dir, "output.yaml"))
+			},
+		},
+		{
+			name: "Helm inflation not enabled",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(fmt.Sprintf(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+helmCharts:
+- name: demo
+  repo: oci://%s
+  version: 0.1.0
+`, ociRepositoryRef)), 0o600))
+			},
+			config: builtin.KustomizeBuildConfig{
+				Path:    ".",
+				OutPath: "output.yaml",
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "must specify --enable-helm")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+
+				assert.NoFileExists(t, filepath.Join(dir, "output.yaml"))
+			},
+		},
+		{
+			name: "load restricted to root",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.MkdirAll(filepath.Join(dir, "overlay"), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "overlay", "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- ../deployment.yaml
+`), 0o600))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "deployment.yaml"), []byte(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+`), 0o600))
+			},
+			config: builtin.KustomizeBuildConfig{
+				Path:           "overlay",
+				OutPath:        "output.yaml",
+				LoadRestrictor: ptr.To(builtin.LoadRestrictionsRootOnly),
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "is not in or below")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+
+				assert.NoFileExists(t, filepath.Join(dir, "output.yaml"))
+			},
+		},
+		{
+			name: "load not restricted to root",
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.MkdirAll(filepath.Join(dir, "overlay"), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "overlay", "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- ../deployment.yaml
+`), 0o600))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "deployment.yaml"), []byte(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+`), 0o600))
+			},
+			config: builtin.KustomizeBuildConfig{
+				Path:           "overlay",
+				OutPath:        "output.yaml",
+				LoadRestrictor: ptr.To(builtin.LoadRestrictionsNone),
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+
+				b, err := os.ReadFile(filepath.Join(dir, "output.yaml"))
+				require.NoError(t, err)
+				assert.Contains(t, string(b), "test-deployment")
 			},
 		},
 		{
@@ -173,7 +445,7 @@ metadata:
 		},
 	}
 
-	runner := &kustomizeBuilder{}
+	runner := &kustomizeBuilder{credsDB: credsDB}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -182,11 +454,92 @@ metadata:
 			tt.setupFiles(t, tempDir)
 
 			stepCtx := &promotion.StepContext{
+				Project: "fake-project",
 				WorkDir: tempDir,
 			}
 
-			result, err := runner.run(stepCtx, tt.config)
+			result, err := runner.run(context.Background(), stepCtx, tt.config)
 			tt.assertions(t, tempDir, result, err)
 		})
 	}
 }
+
+func Test_kustomizeBuilder_run_parallel(t *testing.T) {
+	// Kustomize keeps the OpenAPI schema in process-wide globals. Builds running
+	// in parallel, some of which specify their own schema, must not race on it.
+	// Run with -race to detect regressions.
+	const (
+		builds = 8
+		rounds = 4
+	)
+
+	runner := &kustomizeBuilder{credsDB: &credentials.FakeDB{}}
+
+	var wg sync.WaitGroup
+	errs := make([]error, builds)
+	dirs := make([]string, builds)
+	for i := range builds {
+		dir := t.TempDir()
+		dirs[i] = dir
+		kustomization := `
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: demo
+resources:
+- deployment.yaml
+- widget.yaml
+patches:
+- patch: |-
+    apiVersion: apps/v1
+    kind: Deployment
+    metadata:
+      name: test-deployment
+    spec:
+      replicas: 2
+`
+		if i%2 == 0 {
+			kustomization += `openapi:
+  version: v1.21.2
+`
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(kustomization), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "deployment.yaml"), []byte(fmt.Sprintf(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  labels:
+    build: "%d"
+`, i)), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "widget.yaml"), []byte(`---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test-widget
+`), 0o600))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range rounds {
+				if _, errs[i] = runner.run(
+					context.Background(),
+					&promotion.StepContext{Project: "fake-project", WorkDir: dir},
+					builtin.KustomizeBuildConfig{Path: ".", OutPath: "output.yaml"},
+				); errs[i] != nil {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range builds {
+		require.NoError(t, errs[i])
+		b, err := os.ReadFile(filepath.Join(dirs[i], "output.yaml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(b), fmt.Sprintf(`build: "%d"`, i))
+		assert.Contains(t, string(b), "replicas: 2")
+		assert.Contains(t, string(b), "namespace: demo")
+	}
+}
@@ -0,0 +1,680 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"sigs.k8s.io/kustomize/api/konfig"
+	kustypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/kustomize/kyaml/kio"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+	"sigs.k8s.io/kustomize/kyaml/yaml/merge2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	// helmChartInflationGeneratorKind is the kind of the Kustomize builtin
+	// generator which inflates a Helm chart.
+	helmChartInflationGeneratorKind = "HelmChartInflationGenerator"
+
+	// inflatedHelmChartFilePattern is the pattern of the names of the files
+	// holding the inflated Helm charts. These files only exist in the overlay
+	// filesystem of a build.
+	inflatedHelmChartFilePattern = ".kargo-helm-chart-%d.yaml"
+)
+
+// overlayFS is a filesys.FileSystem which serves files from memory on top of
+// another filesys.FileSystem, without ever writing them to the latter. This
+// allows presenting Kustomize with rewritten Kustomization files and inflated
+// Helm charts without modifying the working directory, and without sharing
+// any state between concurrent builds.
+type overlayFS struct {
+	filesys.FileSystem
+	files map[string][]byte
+}
+
+// newOverlayFS returns an overlayFS on top of the given filesys.FileSystem.
+func newOverlayFS(fs filesys.FileSystem) *overlayFS {
+	return &overlayFS{
+		FileSystem: fs,
+		files:      map[string][]byte{},
+	}
+}
+
+// write adds a file with the given content to the overlay, replacing any file
+// with the same path in the overlay or the underlying filesystem.
+func (o *overlayFS) write(path string, data []byte) {
+	o.files[filepath.Clean(path)] = data
+}
+
+// ReadFile implements filesys.FileSystem.
+func (o *overlayFS) ReadFile(path string) ([]byte, error) {
+	if data, ok := o.files[filepath.Clean(path)]; ok {
+		return data, nil
+	}
+	return o.FileSystem.ReadFile(path)
+}
+
+// Exists implements filesys.FileSystem.
+func (o *overlayFS) Exists(path string) bool {
+	if _, ok := o.files[filepath.Clean(path)]; ok {
+		return true
+	}
+	return o.FileSystem.Exists(path)
+}
+
+// IsDir implements filesys.FileSystem.
+func (o *overlayFS) IsDir(path string) bool {
+	if _, ok := o.files[filepath.Clean(path)]; ok {
+		return false
+	}
+	return o.FileSystem.IsDir(path)
+}
+
+// CleanedAbs implements filesys.FileSystem.
+func (o *overlayFS) CleanedAbs(path string) (filesys.ConfirmedDir, string, error) {
+	if _, ok := o.files[filepath.Clean(path)]; ok {
+		// The file only exists in memory, so its directory is resolved using
+		// the underlying filesystem instead.
+		dir, _, err := o.FileSystem.CleanedAbs(filepath.Dir(path))
+		return dir, filepath.Base(path), err
+	}
+	return o.FileSystem.CleanedAbs(path)
+}
+
+// helmChartInflator inflates the Helm charts referenced by Kustomization files
+// using Kargo's built-in Helm support, as a replacement for Kustomize's own
+// HelmChartInflationGenerator, which depends on an external helm binary.
+//
+// Charts are rendered to files in an overlayFS, and the Kustomization files
+// referencing them are rewritten in the overlayFS to include the rendered
+// files as resources instead. Charts which are not present in the working
+// directory are pulled from their repositories using the credentials known to
+// Kargo, to a temporary directory outside the working directory.
+type helmChartInflator struct {
+	credsDB        credentials.Database
+	project        string
+	root           filesys.ConfirmedDir
+	fs             *overlayFS
+	loadRestrictor kustypes.LoadRestrictions
+	apiVersions    []string
+	kubeVersion    string
+	downloadDir    string
+	downloaded     map[chartDependency]string
+	inflated       map[string]inflatedGenerator
+	visited        map[string]struct{}
+	renderedCharts int
+}
+
+// inflatedGenerator holds the result of the inflation of a generators file.
+type inflatedGenerator struct {
+	// remaining is the content of the generators file without the
+	// HelmChartInflationGenerator documents, or nil if no other documents
+	// remain.
+	remaining []byte
+	// rendered holds the absolute paths of the files holding the inflated
+	// Helm charts.
+	rendered []string
+}
+
+// newHelmChartInflator returns a helmChartInflator for a build within the
+// given root directory of the given overlayFS.
+func newHelmChartInflator(
+	credsDB credentials.Database,
+	project string,
+	root filesys.ConfirmedDir,
+	fs *overlayFS,
+	loadRestrictor kustypes.LoadRestrictions,
+	helmCfg *builtin.Helm,
+) *helmChartInflator {
+	h := &helmChartInflator{
+		credsDB:        credsDB,
+		project:        project,
+		root:           root,
+		fs:             fs,
+		loadRestrictor: loadRestrictor,
+		downloaded:     map[chartDependency]string{},
+		inflated:       map[string]inflatedGenerator{},
+		visited:        map[string]struct{}{},
+	}
+	if helmCfg != nil {
+		h.apiVersions = helmCfg.APIVersions
+		h.kubeVersion = helmCfg.KubeVersion
+	}
+	return h
+}
+
+// close removes any charts pulled by the helmChartInflator.
+func (h *helmChartInflator) close() {
+	if h.downloadDir != "" {
+		_ = os.RemoveAll(h.downloadDir)
+	}
+}
+
+// inflate inflates the Helm charts referenced by the Kustomization in the
+// given directory, and by the Kustomizations it references in turn. Paths
+// which do not resolve to a directory with a valid Kustomization file are
+// ignored, leaving it to Kustomize to report any problem with them.
+func (h *helmChartInflator) inflate(ctx context.Context, path string) error {
+	if !h.fs.IsDir(path) {
+		return nil
+	}
+	dir, _, err := h.fs.CleanedAbs(path)
+	if err != nil {
+		return nil // nolint: nilerr
+	}
+	if _, ok := h.visited[dir.String()]; ok {
+		return nil
+	}
+	h.visited[dir.String()] = struct{}{}
+
+	kustPath, data := h.readKustomization(dir)
+	if kustPath == "" {
+		return nil
+	}
+	kust := &kustypes.Kustomization{}
+	if err = yaml.Unmarshal(data, kust); err != nil {
+		return nil // nolint: nilerr
+	}
+
+	// Inflate the charts of the Kustomizations this Kustomization builds on.
+	// NB: Bases are deprecated in favor of resources, but still supported by
+	// Kustomize.
+	for _, refs := range [][]string{kust.Resources, kust.Components, kust.Bases} {
+		for _, ref := range refs {
+			if filepath.IsAbs(ref) {
+				continue
+			}
+			if err = h.inflate(ctx, dir.Join(ref)); err != nil {
+				return err
+			}
+		}
+	}
+
+	charts, globals := kust.HelmCharts, kust.HelmGlobals
+	if len(kust.HelmChartInflationGenerator) > 0 {
+		legacyCharts, legacyGlobals := kustypes.SplitHelmParameters(kust.HelmChartInflationGenerator)
+		charts = append(charts, legacyCharts...)
+		if globals == nil {
+			globals = &legacyGlobals
+		}
+	}
+	if globals == nil {
+		globals = &kustypes.HelmGlobals{}
+	}
+
+	var rendered []string
+	for _, c := range charts {
+		renderedPath, err := h.inflateChart(ctx, dir, *globals, c)
+		if err != nil {
+			return err
+		}
+		rendered = append(rendered, renderedPath)
+	}
+
+	var generators []string
+	for _, ref := range kust.Generators {
+		genPath := ref
+		if !filepath.IsAbs(genPath) {
+			genPath = dir.Join(ref)
+		}
+		if !h.fs.Exists(genPath) || h.fs.IsDir(genPath) {
+			generators = append(generators, ref)
+			continue
+		}
+		gen, err := h.inflateGenerators(ctx, dir, genPath)
+		if err != nil {
+			return err
+		}
+		if gen.remaining != nil {
+			generators = append(generators, ref)
+		}
+		rendered = append(rendered, gen.rendered...)
+	}
+
+	if len(rendered) == 0 {
+		return nil
+	}
+
+	// Rewrite the Kustomization to include the inflated charts as resources,
+	// instead of inflating them itself.
+	node, err := kyaml.Parse(string(data))
+	if err != nil {
+		return fmt.Errorf(
+			"failed to parse Kustomization %q: %w", relativePath(h.root.String(), kustPath), err,
+		)
+	}
+	for _, field := range []string{"helmCharts", "helmGlobals", "helmChartInflationGenerator", "generators"} {
+		if _, err = node.Pipe(kyaml.Clear(field)); err != nil {
+			return fmt.Errorf("failed to update Kustomization: %w", err)
+		}
+	}
+	if len(generators) > 0 {
+		if err = node.PipeE(kyaml.SetField("generators", kyaml.NewListRNode(generators...))); err != nil {
+			return fmt.Errorf("failed to update Kustomization: %w", err)
+		}
+	}
+	resources, err := node.Pipe(kyaml.LookupCreate(kyaml.SequenceNode, "resources"))
+	if err != nil {
+		return fmt.Errorf("failed to update Kustomization: %w", err)
+	}
+	for _, p := range rendered {
+		rel, err := filepath.Rel(dir.String(), p)
+		if err != nil {
+			return fmt.Errorf("failed to update Kustomization: %w", err)
+		}
+		if err = resources.PipeE(kyaml.Append(kyaml.NewScalarRNode(filepath.ToSlash(rel)).YNode())); err != nil {
+			return fmt.Errorf("failed to update Kustomization: %w", err)
+		}
+	}
+	out, err := node.String()
+	if err != nil {
+		return fmt.Errorf("failed to update Kustomization: %w", err)
+	}
+	h.fs.write(kustPath, []byte(out))
+	return nil
+}
+
+// readKustomization returns the path and content of the Kustomization file in
+// the given directory, or an empty path if the directory does not contain
+// exactly one Kustomization file.
+func (h *helmChartInflator) readKustomization(dir filesys.ConfirmedDir) (string, []byte) {
+	var (
+		kustPath string
+		data     []byte
+	)
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		b, err := h.fs.ReadFile(dir.Join(name))
+		if err != nil {
+			continue
+		}
+		if kustPath != "" {
+			return "", nil
+		}
+		kustPath, data = dir.Join(name), b
+	}
+	return kustPath, data
+}
+
+// inflateGenerators inflates the HelmChartInflationGenerator documents in the
+// given generators file. The remaining documents of the file replace its
+// content in the overlay filesystem.
+func (h *helmChartInflator) inflateGenerators(
+	ctx context.Context,
+	dir filesys.ConfirmedDir,
+	path string,
+) (inflatedGenerator, error) {
+	if gen, ok := h.inflated[path]; ok {
+		return gen, nil
+	}
+
+	data, err := h.loadFile(dir, path)
+	if err != nil {
+		return inflatedGenerator{}, err
+	}
+	nodes, err := (&kio.ByteReader{
+		Reader:                bytes.NewReader(data),
+		OmitReaderAnnotations: true,
+	}).Read()
+	if err != nil {
+		return inflatedGenerator{}, fmt.Errorf(
+			"failed to parse generators file %q: %w", relativePath(h.root.String(), path), err,
+		)
+	}
+
+	var (
+		gen       inflatedGenerator
+		remaining []*kyaml.RNode
+	)
+	for _, node := range nodes {
+		if node.GetKind() != helmChartInflationGeneratorKind {
+			remaining = append(remaining, node)
+			continue
+		}
+		s, err := node.String()
+		if err != nil {
+			return inflatedGenerator{}, err
+		}
+		var generator struct {
+			kustypes.HelmGlobals `json:",inline"`
+			kustypes.HelmChart   `json:",inline"`
+		}
+		if err = yaml.Unmarshal([]byte(s), &generator); err != nil {
+			return inflatedGenerator{}, fmt.Errorf(
+				"failed to parse %s %q: %w", helmChartInflationGeneratorKind, node.GetName(), err,
+			)
+		}
+		// NB: Like any other file loaded by a Kustomization, paths in a
+		// generator are relative to the Kustomization rather than to the
+		// generators file.
+		renderedPath, err := h.inflateChart(ctx, dir, generator.HelmGlobals, generator.HelmChart)
+		if err != nil {
+			return inflatedGenerator{}, err
+		}
+		gen.rendered = append(gen.rendered, renderedPath)
+	}
+
+	if len(remaining) > 0 {
+		var buf bytes.Buffer
+		if err = (&kio.ByteWriter{Writer: &buf}).Write(remaining); err != nil {
+			return inflatedGenerator{}, err
+		}
+		gen.remaining = buf.Bytes()
+		h.fs.write(path, gen.remaining)
+	}
+	h.inflated[path] = gen
+	return gen, nil
+}
+
+// inflateChart renders the given Helm chart, and writes the result to a file
+// in the given directory of the overlay filesystem. It returns the absolute
+// path of the file.
+func (h *helmChartInflator) inflateChart(
+	ctx context.Context,
+	dir filesys.ConfirmedDir,
+	globals kustypes.HelmGlobals,
+	c kustypes.HelmChart,
+) (string, error) {
+	if c.Name == "" {
+		return "", fmt.Errorf("chart name cannot be empty")
+	}
+
+	chrt, err := h.loadChart(ctx, dir, globals, c)
+	if err != nil {
+		return "", err
+	}
+
+	vals, err := h.composeValues(dir, c, chrt)
+	if err != nil {
+		return "", fmt.Errorf("failed to compose values of chart %q: %w", c.Name, err)
+	}
+
+	manifests, err := h.renderChart(ctx, c, chrt, vals)
+	if err != nil {
+		return "", fmt.Errorf("failed to render chart %q: %w", c.Name, err)
+	}
+
+	h.renderedCharts++
+	path := dir.Join(fmt.Sprintf(inflatedHelmChartFilePattern, h.renderedCharts))
+	h.fs.write(path, manifests)
+	return path, nil
+}
+
+// loadChart loads the given Helm chart from the chart home if it is present
+// there, or pulls it from its repository otherwise. Like Kustomize, it expects
+// a chart with both a version and a repository to be found in a
+// <name>-<version> directory of the chart home.
+func (h *helmChartInflator) loadChart(
+	ctx context.Context,
+	dir filesys.ConfirmedDir,
+	globals kustypes.HelmGlobals,
+	c kustypes.HelmChart,
+) (*chart.Chart, error) {
+	chartHome := defaultValue(globals.ChartHome, kustypes.HelmDefaultHome)
+	if !filepath.IsAbs(chartHome) {
+		chartHome = dir.Join(chartHome)
+	}
+	if c.Version != "" && c.Repo != "" {
+		chartHome = filepath.Join(chartHome, fmt.Sprintf("%s-%s", c.Name, c.Version))
+	}
+
+	// Confine the chart home to the working directory.
+	relChartPath, err := filepath.Rel(h.root.String(), filepath.Join(chartHome, c.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine path of chart %q: %w", c.Name, err)
+	}
+	chartPath, err := securejoin.SecureJoin(h.root.String(), relChartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join path of chart %q: %w", c.Name, err)
+	}
+
+	var chrt *chart.Chart
+	if fi, err := os.Stat(chartPath); err == nil && fi.IsDir() {
+		if chrt, err = loader.Load(chartPath); err != nil {
+			return nil, fmt.Errorf(
+				"failed to load chart %q: %w", c.Name, sanitizePathError(err, h.root.String()),
+			)
+		}
+	} else {
+		if c.Repo == "" {
+			return nil, fmt.Errorf(
+				"no repository specified for chart %q, and no chart found at %q",
+				c.Name, relativePath(h.root.String(), chartPath),
+			)
+		}
+		archive, err := h.pullChart(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		if chrt, err = loader.Load(archive); err != nil {
+			return nil, fmt.Errorf("failed to load chart %q: %w", c.Name, err)
+		}
+	}
+
+	if req := chrt.Metadata.Dependencies; req != nil {
+		if err = action.CheckDependencies(chrt, req); err != nil {
+			return nil, fmt.Errorf("missing dependencies of chart %q: %w", c.Name, err)
+		}
+	}
+	return chrt, nil
+}
+
+// pullChart downloads the given Helm chart from its repository, and returns
+// the path of the downloaded chart archive. Charts are downloaded at most once
+// per build.
+func (h *helmChartInflator) pullChart(ctx context.Context, c kustypes.HelmChart) (string, error) {
+	dep := chartDependency{
+		Repository: c.Repo,
+		Name:       c.Name,
+		Version:    c.Version,
+	}
+	if archive, ok := h.downloaded[dep]; ok {
+		return archive, nil
+	}
+
+	if h.downloadDir == "" {
+		var err error
+		if h.downloadDir, err = os.MkdirTemp("", "kustomize-helm-"); err != nil {
+			return "", fmt.Errorf("failed to create temporary download directory: %w", err)
+		}
+	}
+
+	downloaded, err := downloadCharts(
+		ctx,
+		h.credsDB,
+		h.project,
+		[]chartDependency{dep},
+		filepath.Join(h.downloadDir, strconv.Itoa(len(h.downloaded))),
+	)
+	if err != nil {
+		return "", err
+	}
+	h.downloaded[dep] = downloaded[0]
+	return downloaded[0], nil
+}
+
+// composeValues composes the values for the given Helm chart like Kustomize
+// does. The values file, or the values of the chart if no values file is
+// given, is combined with the inline values according to the merge strategy
+// of the chart. Additional values files are then merged in the order they
+// are given.
+func (h *helmChartInflator) composeValues(
+	dir filesys.ConfirmedDir,
+	c kustypes.HelmChart,
+	chrt *chart.Chart,
+) (map[string]any, error) {
+	vals := chrt.Values
+	if c.ValuesFile != "" {
+		data, err := h.loadFile(dir, c.ValuesFile)
+		if err != nil {
+			return nil, err
+		}
+		if vals, err = chartutil.ReadValues(data); err != nil {
+			return nil, fmt.Errorf("failed to parse values file %q: %w", c.ValuesFile, err)
+		}
+	}
+
+	if len(c.ValuesInline) > 0 {
+		switch c.ValuesMerge {
+		case "", "override", "merge":
+			fileValues, err := kyaml.FromMap(vals)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse values: %w", err)
+			}
+			inlineValues, err := kyaml.FromMap(c.ValuesInline)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse inline values: %w", err)
+			}
+			// NB: merge2.Merge merges its first argument into its second
+			// argument, with the values of the first taking precedence.
+			var merged *kyaml.RNode
+			if c.ValuesMerge == "merge" {
+				merged, err = merge2.Merge(fileValues, inlineValues.Copy(), kyaml.MergeOptions{})
+			} else {
+				merged, err = merge2.Merge(inlineValues, fileValues.Copy(), kyaml.MergeOptions{})
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to merge inline values: %w", err)
+			}
+			if vals, err = merged.Map(); err != nil {
+				return nil, fmt.Errorf("failed to parse merged values: %w", err)
+			}
+		case "replace":
+			vals = c.ValuesInline
+		default:
+			return nil, fmt.Errorf(
+				"valuesMerge must be one of 'override', 'replace', or 'merge', got %q", c.ValuesMerge,
+			)
+		}
+	}
+
+	for _, p := range c.AdditionalValuesFiles {
+		data, err := h.loadFile(dir, p)
+		if err != nil {
+			return nil, err
+		}
+		additional, err := chartutil.ReadValues(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse values file %q: %w", p, err)
+		}
+		vals = mergeValues(vals, additional)
+	}
+	return vals, nil
+}
+
+// renderChart renders the given Helm chart with the given values, and returns
+// the rendered manifests, including those of the hooks of the chart, like the
+// helm template command does.
+func (h *helmChartInflator) renderChart(
+	ctx context.Context,
+	c kustypes.HelmChart,
+	chrt *chart.Chart,
+	vals map[string]any,
+) ([]byte, error) {
+	install := action.NewInstall(&action.Configuration{})
+	install.DryRun = true
+	install.DryRunOption = "client"
+	install.Replace = true
+	install.ClientOnly = true
+	install.Namespace = defaultValue(c.Namespace, "default")
+	install.IncludeCRDs = c.IncludeCRDs
+	install.DisableHooks = c.SkipHooks
+	install.APIVersions = defaultValue(h.apiVersions, c.ApiVersions)
+
+	releaseName := c.ReleaseName
+	if releaseName == "" && c.NameTemplate != "" {
+		var err error
+		if releaseName, err = action.TemplateName(c.NameTemplate); err != nil {
+			return nil, fmt.Errorf("failed to render name template: %w", err)
+		}
+	}
+	install.ReleaseName = defaultValue(releaseName, "release-name")
+
+	if kubeVersion := defaultValue(h.kubeVersion, c.KubeVersion); kubeVersion != "" {
+		v, err := chartutil.ParseKubeVersion(kubeVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Kubernetes version %q: %w", kubeVersion, err)
+		}
+		install.KubeVersion = v
+	}
+
+	rls, err := install.RunWithContext(ctx, chrt, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests bytes.Buffer
+	_, _ = fmt.Fprintln(&manifests, strings.TrimSpace(rls.Manifest))
+	if !c.SkipHooks {
+		for _, hook := range rls.Hooks {
+			if c.SkipTests && isTestHook(hook) {
+				continue
+			}
+			_, _ = fmt.Fprintf(&manifests, "---\n# Source: %s\n%s\n", hook.Path, hook.Manifest)
+		}
+	}
+	return manifests.Bytes(), nil
+}
+
+// loadFile reads the file at the given path, relative to the given directory
+// of a Kustomization, honoring the load restrictions of the build.
+func (h *helmChartInflator) loadFile(dir filesys.ConfirmedDir, path string) ([]byte, error) {
+	if !filepath.IsAbs(path) {
+		path = dir.Join(path)
+	}
+	if h.loadRestrictor == kustypes.LoadRestrictionsRootOnly {
+		d, f, err := h.fs.CleanedAbs(path)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to load %q: %w", relativePath(h.root.String(), path), sanitizePathError(err, h.root.String()),
+			)
+		}
+		if f == "" || !d.HasPrefix(dir) {
+			return nil, fmt.Errorf(
+				"security; file %q is not in or below %q",
+				relativePath(h.root.String(), path), relativePath(h.root.String(), dir.String()),
+			)
+		}
+	}
+	data, err := h.fs.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to load %q: %w", relativePath(h.root.String(), path), sanitizePathError(err, h.root.String()),
+		)
+	}
+	return data, nil
+}
+
+// mergeValues deep merges the given values into the given base values, with
+// the given values taking precedence, like Helm does for multiple values
+// files.
+func mergeValues(base, values map[string]any) map[string]any {
+	out := make(map[string]any, len(base))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range values {
+		if v, ok := v.(map[string]any); ok {
+			if bv, ok := out[k].(map[string]any); ok {
+				out[k] = mergeValues(bv, v)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+package builtin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	securefs "github.com/fluxcd/pkg/kustomize/filesys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_overlayFS(t *testing.T) {
+	workDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "on-disk.yaml"), []byte("on disk"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "replaced.yaml"), []byte("on disk"), 0o600))
+
+	fs, err := securefs.MakeFsOnDiskSecureBuild(workDir)
+	require.NoError(t, err)
+	root, _, err := fs.CleanedAbs(workDir)
+	require.NoError(t, err)
+
+	overlay := newOverlayFS(fs)
+	overlay.write(root.Join("in-memory.yaml"), []byte("in memory"))
+	overlay.write(root.Join("replaced.yaml"), []byte("in memory"))
+
+	t.Run("reads files from memory and disk", func(t *testing.T) {
+		for name, expected := range map[string]string{
+			"on-disk.yaml":   "on disk",
+			"in-memory.yaml": "in memory",
+			"replaced.yaml":  "in memory",
+		} {
+			b, err := overlay.ReadFile(root.Join(name))
+			require.NoError(t, err)
+			assert.Equal(t, expected, string(b))
+		}
+	})
+
+	t.Run("in-memory files exist as files", func(t *testing.T) {
+		assert.True(t, overlay.Exists(root.Join("in-memory.yaml")))
+		assert.False(t, overlay.IsDir(root.Join("in-memory.yaml")))
+		assert.False(t, overlay.Exists(root.Join("missing.yaml")))
+	})
+
+	t.Run("resolves in-memory files", func(t *testing.T) {
+		dir, file, err := overlay.CleanedAbs(root.Join("in-memory.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, root, dir)
+		assert.Equal(t, "in-memory.yaml", file)
+	})
+
+	t.Run("leaves disk untouched", func(t *testing.T) {
+		assert.NoFileExists(t, filepath.Join(workDir, "in-memory.yaml"))
+		b, err := os.ReadFile(filepath.Join(workDir, "replaced.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "on disk", string(b))
+	})
+}
+
+func Test_mergeValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     map[string]any
+		values   map[string]any
+		expected map[string]any
+	}{
+		{
+			name:     "nil base",
+			values:   map[string]any{"a": 1},
+			expected: map[string]any{"a": 1},
+		},
+		{
+			name:     "values take precedence",
+			base:     map[string]any{"a": 1, "b": 2},
+			values:   map[string]any{"a": 3},
+			expected: map[string]any{"a": 3, "b": 2},
+		},
+		{
+			name: "nested maps are merged",
+			base: map[string]any{
+				"image": map[string]any{"repository": "nginx", "tag": "1.0.0"},
+			},
+			values: map[string]any{
+				"image": map[string]any{"tag": "2.0.0"},
+			},
+			expected: map[string]any{
+				"image": map[string]any{"repository": "nginx", "tag": "2.0.0"},
+			},
+		},
+		{
+			name:     "lists are replaced",
+			base:     map[string]any{"a": []any{1, 2}},
+			values:   map[string]any{"a": []any{3}},
+			expected: map[string]any{"a": []any{3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mergeValues(tt.base, tt.values))
+		})
+	}
+}
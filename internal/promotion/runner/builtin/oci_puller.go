@@ -0,0 +1,283 @@
+package builtin
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	// ociTitleAnnotation is the annotation commonly used (e.g. by ORAS) to
+	// record the file name of an artifact layer.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+
+	stateKeyDigest = "digest"
+)
+
+// ociPullerConfig represents configuration for the oci-pull step that applies
+// to all promotions.
+type ociPullerConfig struct {
+	// MaxArtifactBytes is the maximum combined size of the layers pulled from
+	// a single artifact. It protects the disk of the controller, which is
+	// shared by all promotions, from large or hostile artifacts.
+	MaxArtifactBytes int64 `envconfig:"OCI_PULL_MAX_ARTIFACT_BYTES" default:"536870912"`
+}
+
+// ociPullerConfigFromEnv returns an ociPullerConfig populated from environment
+// variables.
+func ociPullerConfigFromEnv() ociPullerConfig {
+	cfg := ociPullerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// ociPuller is an implementation of the promotion.StepRunner interface that
+// pulls the layers of an OCI artifact into a directory.
+type ociPuller struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+	cfg          ociPullerConfig
+}
+
+// newOCIPuller returns an implementation of the promotion.StepRunner interface
+// that pulls the layers of an OCI artifact into a directory.
+func newOCIPuller(credsDB credentials.Database) promotion.StepRunner {
+	r := &ociPuller{
+		credsDB: credsDB,
+		cfg:     ociPullerConfigFromEnv(),
+	}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (o *ociPuller) Name() string {
+	return "oci-pull"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (o *ociPuller) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := o.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.OCIPullConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", o.Name(), err)
+	}
+	return o.run(ctx, stepCtx, cfg)
+}
+
+// validate validates ociPuller configuration against a JSON schema.
+func (o *ociPuller) validate(cfg promotion.Config) error {
+	return validate(o.schemaLoader, gojsonschema.NewGoLoader(cfg), o.Name())
+}
+
+func (o *ociPuller) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPullConfig,
+) (promotion.StepResult, error) {
+	ref, err := name.ParseReference(cfg.ImageRef)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error parsing artifact reference %q: %w", cfg.ImageRef, err)
+	}
+
+	outPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not secure join outPath %q: %w", cfg.OutPath, err)
+	}
+
+	opts, err := getOCIRemoteOptions(
+		ctx,
+		o.credsDB,
+		stepCtx.Project,
+		cfg.ImageRef,
+		ref,
+		cfg.InsecureSkipTLSVerify,
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	img, err := remote.Image(ref, opts...)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error retrieving artifact %q: %w", cfg.ImageRef, err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error computing digest of artifact %q: %w", cfg.ImageRef, err)
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error retrieving manifest of artifact %q: %w", cfg.ImageRef, err)
+	}
+
+	layers := make([]v1.Descriptor, 0, len(manifest.Layers))
+	var size int64
+	for _, desc := range manifest.Layers {
+		if cfg.MediaType != "" && string(desc.MediaType) != cfg.MediaType {
+			continue
+		}
+		if desc.Size < 0 {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("layer %s of artifact %q has invalid size %d", desc.Digest, cfg.ImageRef, desc.Size)
+		}
+		layers = append(layers, desc)
+		size += desc.Size
+	}
+	if len(layers) == 0 {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("artifact %q has no layers to pull", cfg.ImageRef)
+	}
+	// Check the size of the layers before pulling any of them. The size of
+	// each layer is enforced while it is pulled.
+	if size > o.cfg.MaxArtifactBytes {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"layers of artifact %q have a combined size of %d bytes, exceeding the maximum of %d bytes",
+				cfg.ImageRef, size, o.cfg.MaxArtifactBytes,
+			)
+	}
+
+	if err = os.MkdirAll(outPath, 0o700); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating directory %q: %w", cfg.OutPath, err)
+	}
+
+	for _, desc := range layers {
+		if err = o.pullLayer(img, desc, outPath); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error pulling layer %s of artifact %q: %w", desc.Digest, cfg.ImageRef, err)
+		}
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{stateKeyDigest: digest.String()},
+	}, nil
+}
+
+// getOCIRemoteOptions returns the remote.Options to use for accessing the
+// repository of the given artifact reference, including credentials for the
+// repository, if any.
+func getOCIRemoteOptions(
+	ctx context.Context,
+	credsDB credentials.Database,
+	project string,
+	imageRef string,
+	ref name.Reference,
+	insecureSkipTLSVerify bool,
+) ([]remote.Option, error) {
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	opts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithTransport(httpTransport),
+	}
+
+	repoURL := getOCIRepoURL(imageRef, ref)
+	creds, err := credsDB.Get(ctx, project, credentials.TypeImage, repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error obtaining credentials for artifact repo %q: %w", repoURL, err)
+	}
+	if creds != nil {
+		logging.LoggerFromContext(ctx).Debug("obtained credentials for artifact repo", "repo", repoURL)
+		opts = append(opts, remote.WithAuth(&authn.Basic{
+			Username: creds.Username,
+			Password: creds.Password,
+		}))
+	}
+	return opts, nil
+}
+
+// pullLayer writes the content of the layer described by the given descriptor
+// to a file in the given directory. The file is named after the layer's title
+// annotation or, if it has none, after the layer's digest. No more than the
+// size recorded in the descriptor is written.
+func (o *ociPuller) pullLayer(img v1.Image, desc v1.Descriptor, dir string) error {
+	fileName := desc.Annotations[ociTitleAnnotation]
+	if fileName == "" {
+		fileName = desc.Digest.Hex
+	}
+	path, err := securejoin.SecureJoin(dir, fileName)
+	if err != nil {
+		return fmt.Errorf("could not secure join file name %q: %w", fileName, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating directory for %q: %w", fileName, err)
+	}
+
+	layer, err := img.LayerByDigest(desc.Digest)
+	if err != nil {
+		return err
+	}
+	// Artifact layers are stored as-is, so the "compressed" content of the
+	// layer is the content of the file.
+	rc, err := layer.Compressed()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("error creating file %q: %w", fileName, err)
+	}
+	defer f.Close()
+	// Read one byte more than the recorded size to detect a layer that is
+	// larger than its descriptor claims.
+	n, err := io.Copy(f, io.LimitReader(rc, desc.Size+1))
+	if err == nil && n > desc.Size {
+		err = fmt.Errorf("layer is larger than its recorded size of %d bytes", desc.Size)
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return fmt.Errorf("error writing file %q: %w", fileName, err)
+	}
+	return f.Close()
+}
+
+// getOCIRepoURL returns the repository portion of the given artifact
+// reference, as it was specified, for use in looking up credentials.
+func getOCIRepoURL(imageRef string, ref name.Reference) string {
+	switch r := ref.(type) {
+	case name.Digest:
+		return strings.TrimSuffix(imageRef, "@"+r.DigestStr())
+	case name.Tag:
+		return strings.TrimSuffix(imageRef, ":"+r.TagStr())
+	}
+	return ref.Context().Name()
+}
@@ -0,0 +1,275 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_ociPuller_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name: "imageRef not specified",
+			config: promotion.Config{
+				"outPath": "out",
+			},
+			expectedProblems: []string{
+				"(root): imageRef is required",
+			},
+		},
+		{
+			name: "imageRef is empty string",
+			config: promotion.Config{
+				"imageRef": "",
+				"outPath":  "out",
+			},
+			expectedProblems: []string{
+				"imageRef: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "outPath not specified",
+			config: promotion.Config{
+				"imageRef": "ghcr.io/example/bundle:v1.0.0",
+			},
+			expectedProblems: []string{
+				"(root): outPath is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"imageRef":              "ghcr.io/example/bundle:v1.0.0",
+				"insecureSkipTLSVerify": true,
+				"mediaType":             "application/vnd.example.file.v1",
+				"outPath":               "out",
+			},
+		},
+	}
+
+	r := newOCIPuller(nil)
+	runner, ok := r.(*ociPuller)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_ociPuller_run(t *testing.T) {
+	const testMediaType = "application/vnd.example.file.v1"
+
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	testRepo := fmt.Sprintf("%s/example/bundle", strings.TrimPrefix(server.URL, "http://"))
+
+	// Push an artifact with one titled and one untitled layer
+	artifact := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	artifact = mutate.ConfigMediaType(artifact, "application/vnd.example.config.v1+json")
+	titledLayer := static.NewLayer([]byte("titled"), testMediaType)
+	untitledLayer := static.NewLayer([]byte("untitled"), "application/vnd.example.other.v1")
+	artifact, err := mutate.Append(
+		artifact,
+		mutate.Addendum{
+			Layer:       titledLayer,
+			Annotations: map[string]string{ociTitleAnnotation: "values.yaml"},
+		},
+		mutate.Addendum{Layer: untitledLayer},
+	)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(testRepo + ":v1.0.0")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, artifact))
+	artifactDigest, err := artifact.Digest()
+	require.NoError(t, err)
+	untitledDigest, err := untitledLayer.Digest()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		credsDB    credentials.Database
+		maxSize    int64
+		cfg        builtin.OCIPullConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name:    "artifact not found",
+			credsDB: &credentials.FakeDB{},
+			cfg: builtin.OCIPullConfig{
+				ImageRef: testRepo + ":v2.0.0",
+				OutPath:  "out",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error retrieving artifact")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (*credentials.Credentials, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			cfg: builtin.OCIPullConfig{
+				ImageRef: testRepo + ":v1.0.0",
+				OutPath:  "out",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:    "no layers match media type",
+			credsDB: &credentials.FakeDB{},
+			cfg: builtin.OCIPullConfig{
+				ImageRef:  testRepo + ":v1.0.0",
+				OutPath:   "out",
+				MediaType: "application/vnd.example.missing.v1",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "has no layers to pull")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:    "artifact too large",
+			credsDB: &credentials.FakeDB{},
+			maxSize: 10,
+			cfg: builtin.OCIPullConfig{
+				ImageRef: testRepo + ":v1.0.0",
+				OutPath:  "out",
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "exceeding the maximum of 10 bytes")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+				require.NoDirExists(t, filepath.Join(workDir, "out"))
+			},
+		},
+		{
+			name:    "pulls all layers",
+			credsDB: &credentials.FakeDB{},
+			cfg: builtin.OCIPullConfig{
+				ImageRef: testRepo + ":v1.0.0",
+				OutPath:  "out",
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(t, map[string]any{"digest": artifactDigest.String()}, res.Output)
+
+				content, err := os.ReadFile(filepath.Join(workDir, "out", "values.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, "titled", string(content))
+
+				content, err = os.ReadFile(filepath.Join(workDir, "out", untitledDigest.Hex))
+				require.NoError(t, err)
+				require.Equal(t, "untitled", string(content))
+			},
+		},
+		{
+			name:    "pulls layers by digest and media type",
+			credsDB: &credentials.FakeDB{},
+			cfg: builtin.OCIPullConfig{
+				ImageRef:  testRepo + "@" + artifactDigest.String(),
+				OutPath:   "out",
+				MediaType: testMediaType,
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				entries, err := os.ReadDir(filepath.Join(workDir, "out"))
+				require.NoError(t, err)
+				require.Len(t, entries, 1)
+				require.Equal(t, "values.yaml", entries[0].Name())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			r := newOCIPuller(testCase.credsDB)
+			runner, ok := r.(*ociPuller)
+			require.True(t, ok)
+			if testCase.maxSize > 0 {
+				runner.cfg.MaxArtifactBytes = testCase.maxSize
+			}
+
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "fake-project",
+					WorkDir: workDir,
+				},
+				testCase.cfg,
+			)
+			testCase.assertions(t, workDir, res, err)
+		})
+	}
+}
+
+func Test_getOCIRepoURL(t *testing.T) {
+	testCases := []struct {
+		imageRef string
+		expected string
+	}{
+		{imageRef: "ghcr.io/example/bundle", expected: "ghcr.io/example/bundle"},
+		{imageRef: "ghcr.io/example/bundle:v1.0.0", expected: "ghcr.io/example/bundle"},
+		{
+			imageRef: "ghcr.io/example/bundle@" + v1.Hash{
+				Algorithm: "sha256",
+				Hex:       strings.Repeat("a", 64),
+			}.String(),
+			expected: "ghcr.io/example/bundle",
+		},
+		{imageRef: "localhost:5000/bundle:v1.0.0", expected: "localhost:5000/bundle"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.imageRef, func(t *testing.T) {
+			ref, err := name.ParseReference(testCase.imageRef)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, getOCIRepoURL(testCase.imageRef, ref))
+		})
+	}
+}
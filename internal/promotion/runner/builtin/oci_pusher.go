@@ -0,0 +1,329 @@
+package builtin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	// defaultOCIConfigMediaType and defaultOCILayerMediaType are the media
+	// types Flux uses for the config and the content of the artifacts it
+	// consumes using OCIRepository resources.
+	defaultOCIConfigMediaType = "application/vnd.cncf.flux.config.v1+json"
+	defaultOCILayerMediaType  = "application/vnd.cncf.flux.content.v1.tar+gzip"
+
+	// ociSourceAnnotation and ociRevisionAnnotation are the annotations
+	// commonly used (e.g. by Flux) to record the source repository and
+	// revision an artifact was built from.
+	ociSourceAnnotation   = "org.opencontainers.image.source"
+	ociRevisionAnnotation = "org.opencontainers.image.revision"
+
+	// ociFreightAnnotation and ociCommitsAnnotation record the Freight an
+	// artifact was pushed for and the commits that Freight references.
+	ociFreightAnnotation = "kargo.akuity.io/freight"
+	ociCommitsAnnotation = "kargo.akuity.io/commits"
+
+	stateKeyImageRef = "imageRef"
+)
+
+// ociPusher is an implementation of the promotion.StepRunner interface that
+// packages a file or directory as an OCI artifact and pushes it to a
+// repository.
+type ociPusher struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newOCIPusher returns an implementation of the promotion.StepRunner interface
+// that packages a file or directory as an OCI artifact and pushes it to a
+// repository.
+func newOCIPusher(credsDB credentials.Database) promotion.StepRunner {
+	r := &ociPusher{
+		credsDB: credsDB,
+	}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (o *ociPusher) Name() string {
+	return "oci-push"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (o *ociPusher) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := o.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.OCIPushConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", o.Name(), err)
+	}
+	return o.run(ctx, stepCtx, cfg)
+}
+
+// validate validates ociPusher configuration against a JSON schema.
+func (o *ociPusher) validate(cfg promotion.Config) error {
+	return validate(o.schemaLoader, gojsonschema.NewGoLoader(cfg), o.Name())
+}
+
+func (o *ociPusher) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPushConfig,
+) (promotion.StepResult, error) {
+	ref, err := name.ParseReference(cfg.ImageRef)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error parsing artifact reference %q: %w", cfg.ImageRef, err)
+	}
+	if _, ok := ref.(name.Tag); !ok {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("artifact reference %q must specify a tag, not a digest", cfg.ImageRef)
+	}
+
+	path, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not secure join path %q: %w", cfg.Path, err)
+	}
+	archive, err := archiveOCIContent(path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error packaging %q: %w", cfg.Path, sanitizePathError(err, stepCtx.WorkDir))
+	}
+
+	img, err := o.buildArtifact(archive, stepCtx.Freight, cfg)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error building artifact: %w", err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error computing digest of artifact: %w", err)
+	}
+
+	opts, err := getOCIRemoteOptions(
+		ctx,
+		o.credsDB,
+		stepCtx.Project,
+		cfg.ImageRef,
+		ref,
+		cfg.InsecureSkipTLSVerify,
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	if err = remote.Write(ref, img, opts...); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error pushing artifact to %q: %w", cfg.ImageRef, err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			stateKeyDigest:   digest.String(),
+			stateKeyImageRef: ref.Context().Digest(digest.String()).String(),
+		},
+	}, nil
+}
+
+// buildArtifact returns an OCI artifact with a single layer holding the given
+// archive. The manifest of the artifact is annotated with the names of the
+// given Freight and the commits they reference, followed by any annotations
+// from the configuration.
+func (o *ociPusher) buildArtifact(
+	archive []byte,
+	freight kargoapi.FreightCollection,
+	cfg builtin.OCIPushConfig,
+) (v1.Image, error) {
+	layerMediaType := cfg.MediaType
+	if layerMediaType == "" {
+		layerMediaType = defaultOCILayerMediaType
+	}
+	layer, err := tarball.LayerFromOpener(
+		func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(archive)), nil
+		},
+		tarball.WithMediaType(types.MediaType(layerMediaType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	configMediaType := cfg.ConfigMediaType
+	if configMediaType == "" {
+		configMediaType = defaultOCIConfigMediaType
+	}
+	img := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, types.MediaType(configMediaType))
+
+	annotations, err := getOCIFreightAnnotations(freight)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range cfg.Annotations {
+		annotations[k] = v
+	}
+	if len(annotations) > 0 {
+		img = mutate.Annotations(img, annotations).(v1.Image) // nolint: forcetypeassert
+	}
+
+	return mutate.Append(img, mutate.Addendum{Layer: layer})
+}
+
+// getOCIFreightAnnotations returns annotations recording the names of the
+// given Freight and the commits they reference. If the Freight references a
+// single commit, the source and revision annotations commonly used by Flux are
+// set as well.
+func getOCIFreightAnnotations(freight kargoapi.FreightCollection) (map[string]string, error) {
+	annotations := map[string]string{}
+	refs := freight.References()
+	if len(refs) == 0 {
+		return annotations, nil
+	}
+
+	names := make([]string, 0, len(refs))
+	var commits []kargoapi.GitCommit
+	for _, ref := range refs {
+		names = append(names, ref.Name)
+		for _, commit := range ref.Commits {
+			commits = append(commits, kargoapi.GitCommit{
+				RepoURL: commit.RepoURL,
+				ID:      commit.ID,
+				Branch:  commit.Branch,
+				Tag:     commit.Tag,
+			})
+		}
+	}
+	annotations[ociFreightAnnotation] = strings.Join(names, ",")
+	if len(commits) == 0 {
+		return annotations, nil
+	}
+
+	data, err := json.Marshal(commits)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling commits: %w", err)
+	}
+	annotations[ociCommitsAnnotation] = string(data)
+	if len(commits) == 1 {
+		commit := commits[0]
+		annotations[ociSourceAnnotation] = commit.RepoURL
+		revision := "sha1:" + commit.ID
+		if commit.Tag != "" {
+			revision = commit.Tag + "@" + revision
+		} else if commit.Branch != "" {
+			revision = commit.Branch + "@" + revision
+		}
+		annotations[ociRevisionAnnotation] = revision
+	}
+	return annotations, nil
+}
+
+// archiveOCIContent returns a gzipped tarball of the given file or of the
+// contents of the given directory. Only regular files, directories and
+// symlinks are included. To ensure the same content always results in the
+// same archive, modification times and ownership are not preserved.
+func archiveOCIContent(path string) ([]byte, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	root := path
+	if !info.IsDir() {
+		root = filepath.Dir(path)
+	}
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	if err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == root {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		switch {
+		case info.Mode().IsRegular(), info.IsDir():
+		case info.Mode()&fs.ModeSymlink != 0:
+			if link, err = os.Readlink(p); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(relPath)
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		hdr.ModTime = time.Unix(0, 0)
+		hdr.Uid, hdr.Gid = 0, 0
+		hdr.Uname, hdr.Gname = "", ""
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	if err = gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,330 @@
+package builtin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_ociPusher_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "imageRef and path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): imageRef is required",
+				"(root): path is required",
+			},
+		},
+		{
+			name: "imageRef and path are empty strings",
+			config: promotion.Config{
+				"imageRef": "",
+				"path":     "",
+			},
+			expectedProblems: []string{
+				"imageRef: String length must be greater than or equal to 1",
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "annotation value is not a string",
+			config: promotion.Config{
+				"imageRef": "ghcr.io/example/manifests:test",
+				"path":     "out",
+				"annotations": map[string]any{
+					"example.com/number": 42,
+				},
+			},
+			expectedProblems: []string{
+				"annotations.example.com/number: Invalid type. Expected: string, given: integer",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"imageRef":              "ghcr.io/example/manifests:test",
+				"path":                  "out",
+				"mediaType":             "application/vnd.example.content.v1.tar+gzip",
+				"configMediaType":       "application/vnd.example.config.v1+json",
+				"insecureSkipTLSVerify": true,
+				"annotations": map[string]any{
+					"example.com/stage": "test",
+				},
+			},
+		},
+	}
+
+	r := newOCIPusher(nil)
+	runner, ok := r.(*ociPusher)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_ociPusher_run(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	testRepo := fmt.Sprintf("%s/example/manifests", strings.TrimPrefix(server.URL, "http://"))
+
+	testFreight := kargoapi.FreightCollection{
+		Freight: map[string]kargoapi.FreightReference{
+			"Warehouse/manifests": {
+				Name: "fake-freight",
+				Commits: []kargoapi.GitCommit{{
+					RepoURL: "https://github.com/example/repo.git",
+					ID:      "fake-commit",
+					Branch:  "main",
+					Message: "Fake commit",
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		credsDB    credentials.Database
+		files      map[string]string
+		cfg        builtin.OCIPushConfig
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name:    "imageRef with digest",
+			credsDB: &credentials.FakeDB{},
+			cfg: builtin.OCIPushConfig{
+				ImageRef: testRepo + "@sha256:" + strings.Repeat("a", 64),
+				Path:     "out",
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "must specify a tag")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:    "path does not exist",
+			credsDB: &credentials.FakeDB{},
+			cfg: builtin.OCIPushConfig{
+				ImageRef: testRepo + ":test",
+				Path:     "out",
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error packaging "out"`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (*credentials.Credentials, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			files: map[string]string{"out/deployment.yaml": "kind: Deployment\n"},
+			cfg: builtin.OCIPushConfig{
+				ImageRef: testRepo + ":test",
+				Path:     "out",
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:    "pushes directory",
+			credsDB: &credentials.FakeDB{},
+			files: map[string]string{
+				"out/deployment.yaml":  "kind: Deployment\n",
+				"out/nested/svc.yaml":  "kind: Service\n",
+				"other/ignored.yaml":   "kind: ConfigMap\n",
+				"out/nested/empty.txt": "",
+			},
+			cfg: builtin.OCIPushConfig{
+				ImageRef: testRepo + ":test",
+				Path:     "out",
+				Annotations: map[string]string{
+					"example.com/stage":   "test",
+					ociRevisionAnnotation: "overridden",
+				},
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				digest, ok := res.Output[stateKeyDigest].(string)
+				require.True(t, ok)
+				require.Equal(t, testRepo+"@"+digest, res.Output[stateKeyImageRef])
+
+				ref, err := name.ParseReference(testRepo + ":test")
+				require.NoError(t, err)
+				img, err := remote.Image(ref)
+				require.NoError(t, err)
+				pushedDigest, err := img.Digest()
+				require.NoError(t, err)
+				require.Equal(t, digest, pushedDigest.String())
+
+				manifest, err := img.Manifest()
+				require.NoError(t, err)
+				require.Equal(t, defaultOCIConfigMediaType, string(manifest.Config.MediaType))
+				require.Equal(t, map[string]string{
+					ociFreightAnnotation:  "fake-freight",
+					ociCommitsAnnotation:  `[{"repoURL":"https://github.com/example/repo.git","id":"fake-commit","branch":"main"}]`,
+					ociSourceAnnotation:   "https://github.com/example/repo.git",
+					ociRevisionAnnotation: "overridden",
+					"example.com/stage":   "test",
+				}, manifest.Annotations)
+				require.Len(t, manifest.Layers, 1)
+				require.Equal(t, defaultOCILayerMediaType, string(manifest.Layers[0].MediaType))
+
+				layers, err := img.Layers()
+				require.NoError(t, err)
+				rc, err := layers[0].Uncompressed()
+				require.NoError(t, err)
+				defer rc.Close()
+				require.Equal(t, map[string]string{
+					"deployment.yaml":  "kind: Deployment\n",
+					"nested/":          "",
+					"nested/svc.yaml":  "kind: Service\n",
+					"nested/empty.txt": "",
+				}, readTestTar(t, rc))
+			},
+		},
+		{
+			name:    "pushes file with custom media types",
+			credsDB: &credentials.FakeDB{},
+			files:   map[string]string{"out/all.yaml": "kind: Deployment\n"},
+			cfg: builtin.OCIPushConfig{
+				ImageRef:        testRepo + ":file",
+				Path:            "out/all.yaml",
+				MediaType:       "application/vnd.example.content.v1.tar+gzip",
+				ConfigMediaType: "application/vnd.example.config.v1+json",
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				ref, err := name.ParseReference(res.Output[stateKeyImageRef].(string)) // nolint: forcetypeassert
+				require.NoError(t, err)
+				img, err := remote.Image(ref)
+				require.NoError(t, err)
+				manifest, err := img.Manifest()
+				require.NoError(t, err)
+				require.Equal(t, "application/vnd.example.config.v1+json", string(manifest.Config.MediaType))
+				require.Equal(
+					t,
+					"application/vnd.example.content.v1.tar+gzip",
+					string(manifest.Layers[0].MediaType),
+				)
+
+				layers, err := img.Layers()
+				require.NoError(t, err)
+				rc, err := layers[0].Uncompressed()
+				require.NoError(t, err)
+				defer rc.Close()
+				require.Equal(t, map[string]string{"all.yaml": "kind: Deployment\n"}, readTestTar(t, rc))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range testCase.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			r := newOCIPusher(testCase.credsDB)
+			runner, ok := r.(*ociPusher)
+			require.True(t, ok)
+
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "fake-project",
+					WorkDir: workDir,
+					Freight: testFreight,
+				},
+				testCase.cfg,
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_archiveOCIContent(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("a"), 0o600))
+
+	first, err := archiveOCIContent(dir)
+	require.NoError(t, err)
+
+	// Changing the modification time of the file must not change the archive
+	mtime := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "a.yaml"), mtime, mtime))
+	second, err := archiveOCIContent(dir)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	gr, err := gzip.NewReader(bytes.NewReader(first))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a.yaml": "a"}, readTestTar(t, gr))
+}
+
+// readTestTar returns the names and contents of the entries of the given
+// tarball. The names of directories carry a trailing slash.
+func readTestTar(t *testing.T, r io.Reader) map[string]string {
+	t.Helper()
+	entries := map[string]string{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		entries[hdr.Name] = string(content)
+	}
+	return entries
+}
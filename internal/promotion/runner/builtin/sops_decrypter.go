@@ -0,0 +1,135 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/sops"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// sopsDecrypter is an implementation of the promotion.StepRunner interface
+// that decrypts SOPS-encrypted YAML and JSON files using age or PGP keys from
+// a Secret in the Project namespace.
+type sopsDecrypter struct {
+	schemaLoader gojsonschema.JSONLoader
+	kargoClient  client.Client
+}
+
+// newSOPSDecrypter returns an implementation of the promotion.StepRunner
+// interface that decrypts SOPS-encrypted files.
+func newSOPSDecrypter(kargoClient client.Client) promotion.StepRunner {
+	r := &sopsDecrypter{kargoClient: kargoClient}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (s *sopsDecrypter) Name() string {
+	return "sops-decrypt"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *sopsDecrypter) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := s.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.SOPSDecryptConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", s.Name(), err)
+	}
+	return s.run(ctx, stepCtx, cfg)
+}
+
+// validate validates sopsDecrypter configuration against a JSON schema.
+func (s *sopsDecrypter) validate(cfg promotion.Config) error {
+	return validate(s.schemaLoader, gojsonschema.NewGoLoader(cfg), s.Name())
+}
+
+func (s *sopsDecrypter) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.SOPSDecryptConfig,
+) (promotion.StepResult, error) {
+	format, err := sops.FormatFromPath(cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	inPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not secure join path %q: %w", cfg.Path, err)
+	}
+	outPath, outName := inPath, cfg.Path
+	if cfg.OutPath != "" {
+		if outPath, err = securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("could not secure join outPath %q: %w", cfg.OutPath, err)
+		}
+		outName = cfg.OutPath
+	}
+
+	keys, err := getSOPSKeys(ctx, s.kargoClient, stepCtx.Project, cfg.KeySecret)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	encrypted, err := os.ReadFile(inPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error reading file %q: %w", cfg.Path, sanitizePathError(err, stepCtx.WorkDir))
+	}
+	plain, err := sops.Decrypt(encrypted, format, keys)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error decrypting file %q: %w", cfg.Path, err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(outPath), 0o700); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating directory for %q: %w", outName, sanitizePathError(err, stepCtx.WorkDir))
+	}
+	if err = os.WriteFile(outPath, plain, 0o600); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error writing decrypted file to %q: %w", outName, sanitizePathError(err, stepCtx.WorkDir))
+	}
+
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// getSOPSKeys returns the SOPS keys held by the specified Secret in the
+// Project namespace.
+func getSOPSKeys(
+	ctx context.Context,
+	kargoClient client.Client,
+	project string,
+	secretName string,
+) (*sops.Keys, error) {
+	secret := &corev1.Secret{}
+	if err := kargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: secretName},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf("error getting Secret %q in namespace %q: %w", secretName, project, err)
+	}
+	keys, err := sops.ParseKeys(secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing keys from Secret %q: %w", secretName, err)
+	}
+	return keys, nil
+}
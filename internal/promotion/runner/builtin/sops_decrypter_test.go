@@ -0,0 +1,245 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/sops"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const testSOPSPlainYAML = `image:
+    repository: nginx
+    tag: 1.21.0
+database:
+    password: s3cr3t
+`
+
+// newSOPSTestClient returns a fake client with a Secret holding the provided
+// age identity in the provided namespace.
+func newSOPSTestClient(t *testing.T, namespace string, identity *age.X25519Identity) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "sops-keys",
+			},
+			Data: map[string][]byte{
+				"identity.agekey": []byte(identity.String()),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "not-sops-keys",
+			},
+			Data: map[string][]byte{
+				"username": []byte("admin"),
+			},
+		},
+	).Build()
+}
+
+func Test_sopsDecrypter_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "path and keySecret not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+				"(root): keySecret is required",
+			},
+		},
+		{
+			name: "path and keySecret are empty strings",
+			config: promotion.Config{
+				"path":      "",
+				"keySecret": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+				"keySecret: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":      "src/secrets.yaml",
+				"outPath":   "secrets.yaml",
+				"keySecret": "sops-keys",
+			},
+		},
+	}
+
+	r := newSOPSDecrypter(nil)
+	runner, ok := r.(*sopsDecrypter)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_sopsDecrypter_run(t *testing.T) {
+	const testNamespace = "test-project"
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	encrypted, err := sops.Encrypt(
+		[]byte(testSOPSPlainYAML),
+		sops.FormatYAML,
+		sops.EncryptOptions{AgeRecipients: []string{identity.Recipient().String()}},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		cfg        builtin.SOPSDecryptConfig
+		files      map[string]string
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "unsupported file extension",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.env",
+				KeySecret: "sops-keys",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "only .yaml, .yml and .json files are supported")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "Secret does not exist",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.yaml",
+				KeySecret: "non-existent",
+			},
+			files: map[string]string{"secrets.yaml": string(encrypted)},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error getting Secret "non-existent"`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "Secret holds no keys",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.yaml",
+				KeySecret: "not-sops-keys",
+			},
+			files: map[string]string{"secrets.yaml": string(encrypted)},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error parsing keys from Secret "not-sops-keys"`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "file does not exist",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.yaml",
+				KeySecret: "sops-keys",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error reading file "secrets.yaml"`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "file is not encrypted",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.yaml",
+				KeySecret: "sops-keys",
+			},
+			files: map[string]string{"secrets.yaml": testSOPSPlainYAML},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error decrypting file "secrets.yaml"`)
+				require.ErrorContains(t, err, "sops metadata not found")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "decrypt in place",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.yaml",
+				KeySecret: "sops-keys",
+			},
+			files: map[string]string{"secrets.yaml": string(encrypted)},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+				content, err := os.ReadFile(filepath.Join(workDir, "secrets.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, testSOPSPlainYAML, string(content))
+			},
+		},
+		{
+			name: "decrypt to outPath",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "src/secrets.yaml",
+				OutPath:   "decrypted/secrets.yaml",
+				KeySecret: "sops-keys",
+			},
+			files: map[string]string{"src/secrets.yaml": string(encrypted)},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+				content, err := os.ReadFile(filepath.Join(workDir, "decrypted/secrets.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, testSOPSPlainYAML, string(content))
+				// The original file is left untouched.
+				content, err = os.ReadFile(filepath.Join(workDir, "src/secrets.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, string(encrypted), string(content))
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &sopsDecrypter{
+				kargoClient: newSOPSTestClient(t, testNamespace, identity),
+			}
+
+			stepCtx := &promotion.StepContext{
+				Project: testNamespace,
+				WorkDir: t.TempDir(),
+			}
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(stepCtx.WorkDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(stepCtx.WorkDir, p), []byte(c), 0o600))
+			}
+
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, stepCtx.WorkDir, result, err)
+		})
+	}
+}
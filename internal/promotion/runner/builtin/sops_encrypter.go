@@ -0,0 +1,143 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/sops"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// sopsEncrypter is an implementation of the promotion.StepRunner interface
+// that encrypts plain YAML and JSON files using SOPS.
+//
+// When the output path holds an existing SOPS-encrypted file, its data key is
+// decrypted using keys from a Secret in the Project namespace and reused along
+// with all of its master keys. This allows files to be re-encrypted without
+// access to the public keys or KMS services they were originally encrypted
+// with. Otherwise, a new data key is generated and encrypted for the
+// configured age recipients.
+type sopsEncrypter struct {
+	schemaLoader gojsonschema.JSONLoader
+	kargoClient  client.Client
+}
+
+// newSOPSEncrypter returns an implementation of the promotion.StepRunner
+// interface that encrypts files using SOPS.
+func newSOPSEncrypter(kargoClient client.Client) promotion.StepRunner {
+	r := &sopsEncrypter{kargoClient: kargoClient}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (s *sopsEncrypter) Name() string {
+	return "sops-encrypt"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *sopsEncrypter) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := s.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	cfg, err := promotion.ConfigToStruct[builtin.SOPSEncryptConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not convert config into %s config: %w", s.Name(), err)
+	}
+	return s.run(ctx, stepCtx, cfg)
+}
+
+// validate validates sopsEncrypter configuration against a JSON schema.
+func (s *sopsEncrypter) validate(cfg promotion.Config) error {
+	return validate(s.schemaLoader, gojsonschema.NewGoLoader(cfg), s.Name())
+}
+
+func (s *sopsEncrypter) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.SOPSEncryptConfig,
+) (promotion.StepResult, error) {
+	format, err := sops.FormatFromPath(cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	inPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("could not secure join path %q: %w", cfg.Path, err)
+	}
+	outPath, outName := inPath, cfg.Path
+	if cfg.OutPath != "" {
+		if outPath, err = securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("could not secure join outPath %q: %w", cfg.OutPath, err)
+		}
+		outName = cfg.OutPath
+	}
+
+	plain, err := os.ReadFile(inPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error reading file %q: %w", cfg.Path, sanitizePathError(err, stepCtx.WorkDir))
+	}
+
+	opts := sops.EncryptOptions{
+		AgeRecipients:  cfg.AgeRecipients,
+		EncryptedRegex: cfg.EncryptedRegex,
+	}
+	existing, err := os.ReadFile(outPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error reading file %q: %w", outName, sanitizePathError(err, stepCtx.WorkDir))
+	}
+	if sops.IsEncrypted(existing, format) {
+		if cfg.KeySecret == "" {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(
+					"keySecret must be specified to re-encrypt the existing SOPS-encrypted file %q",
+					outName,
+				)
+		}
+		if opts.Keys, err = getSOPSKeys(ctx, s.kargoClient, stepCtx.Project, cfg.KeySecret); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		opts.Reference = existing
+	} else if len(cfg.AgeRecipients) == 0 {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf(
+				"ageRecipients must be specified when %q is not an existing SOPS-encrypted file",
+				outName,
+			)
+	}
+
+	encrypted, err := sops.Encrypt(plain, format, opts)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error encrypting file %q: %w", cfg.Path, err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(outPath), 0o700); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating directory for %q: %w", outName, sanitizePathError(err, stepCtx.WorkDir))
+	}
+	if err = os.WriteFile(outPath, encrypted, 0o600); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error writing encrypted file to %q: %w", outName, sanitizePathError(err, stepCtx.WorkDir))
+	}
+
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
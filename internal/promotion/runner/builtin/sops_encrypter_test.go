@@ -0,0 +1,218 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/sops"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_sopsEncrypter_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "ageRecipients contains empty string",
+			config: promotion.Config{
+				"path":          "secrets.yaml",
+				"ageRecipients": []string{""},
+			},
+			expectedProblems: []string{
+				"ageRecipients.0: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":           "secrets.yaml",
+				"outPath":        "src/secrets.yaml",
+				"keySecret":      "sops-keys",
+				"ageRecipients":  []string{"age1fake"},
+				"encryptedRegex": "^(data|stringData)$",
+			},
+		},
+	}
+
+	r := newSOPSEncrypter(nil)
+	runner, ok := r.(*sopsEncrypter)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_sopsEncrypter_run(t *testing.T) {
+	const testNamespace = "test-project"
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	keys, err := sops.ParseKeys(map[string][]byte{"identity.agekey": []byte(identity.String())})
+	require.NoError(t, err)
+
+	// The existing document is encrypted for a recipient whose identity is not
+	// available to the step. Re-encrypting it must not require it.
+	otherIdentity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	existing, err := sops.Encrypt(
+		[]byte(testSOPSPlainYAML),
+		sops.FormatYAML,
+		sops.EncryptOptions{
+			AgeRecipients: []string{
+				otherIdentity.Recipient().String(),
+				identity.Recipient().String(),
+			},
+		},
+	)
+	require.NoError(t, err)
+	updatedPlainYAML := strings.Replace(testSOPSPlainYAML, "tag: 1.21.0", "tag: 1.22.0", 1)
+
+	testCases := []struct {
+		name       string
+		cfg        builtin.SOPSEncryptConfig
+		files      map[string]string
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "file does not exist",
+			cfg: builtin.SOPSEncryptConfig{
+				Path:          "secrets.yaml",
+				AgeRecipients: []string{identity.Recipient().String()},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error reading file "secrets.yaml"`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "no ageRecipients for new file",
+			cfg: builtin.SOPSEncryptConfig{
+				Path: "secrets.yaml",
+			},
+			files: map[string]string{"secrets.yaml": testSOPSPlainYAML},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "ageRecipients must be specified")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "no keySecret for existing file",
+			cfg: builtin.SOPSEncryptConfig{
+				Path:    "secrets.yaml",
+				OutPath: "src/secrets.yaml",
+			},
+			files: map[string]string{
+				"secrets.yaml":     updatedPlainYAML,
+				"src/secrets.yaml": string(existing),
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "keySecret must be specified")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "encrypt new file in place",
+			cfg: builtin.SOPSEncryptConfig{
+				Path:           "secrets.yaml",
+				AgeRecipients:  []string{identity.Recipient().String()},
+				EncryptedRegex: "^password$",
+			},
+			files: map[string]string{"secrets.yaml": testSOPSPlainYAML},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+				content, err := os.ReadFile(filepath.Join(workDir, "secrets.yaml"))
+				require.NoError(t, err)
+				assert.Contains(t, string(content), "tag: 1.21.0\n")
+				assert.NotContains(t, string(content), "s3cr3t")
+				plain, err := sops.Decrypt(content, sops.FormatYAML, keys)
+				require.NoError(t, err)
+				assert.Equal(t, testSOPSPlainYAML, string(plain))
+			},
+		},
+		{
+			name: "re-encrypt existing file",
+			cfg: builtin.SOPSEncryptConfig{
+				Path:      "secrets.yaml",
+				OutPath:   "src/secrets.yaml",
+				KeySecret: "sops-keys",
+			},
+			files: map[string]string{
+				"secrets.yaml":     updatedPlainYAML,
+				"src/secrets.yaml": string(existing),
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+				content, err := os.ReadFile(filepath.Join(workDir, "src/secrets.yaml"))
+				require.NoError(t, err)
+				// The file can still be decrypted with the identity of the other
+				// recipient.
+				otherKeys, err := sops.ParseKeys(
+					map[string][]byte{"identity.agekey": []byte(otherIdentity.String())},
+				)
+				require.NoError(t, err)
+				plain, err := sops.Decrypt(content, sops.FormatYAML, otherKeys)
+				require.NoError(t, err)
+				assert.Equal(t, updatedPlainYAML, string(plain))
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &sopsEncrypter{
+				kargoClient: newSOPSTestClient(t, testNamespace, identity),
+			}
+
+			stepCtx := &promotion.StepContext{
+				Project: testNamespace,
+				WorkDir: t.TempDir(),
+			}
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(stepCtx.WorkDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(stepCtx.WorkDir, p), []byte(c), 0o600))
+			}
+
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, stepCtx.WorkDir, result, err)
+		})
+	}
+}
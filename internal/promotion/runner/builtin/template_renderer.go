@@ -0,0 +1,166 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/expr-lang/expr"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/expressions"
+	exprfn "github.com/akuity/kargo/internal/expressions/function"
+	"github.com/akuity/kargo/internal/promotion"
+	pkgPromotion "github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// templateRenderer is an implementation of the promotion.StepRunner interface
+// that renders templates containing expr-lang expressions to files.
+//
+// Templates are rendered using the same environment that is available to the
+// expressions in the configuration of a step, which includes the context of
+// the Promotion, its variables, the outputs of previous steps and functions
+// for looking up artifacts from the Freight being promoted. Inline templates
+// are part of the configuration of the step and have therefore already been
+// rendered by the time the step runs, so they are written as is.
+type templateRenderer struct {
+	schemaLoader gojsonschema.JSONLoader
+	kargoClient  client.Client
+}
+
+// newTemplateRenderer returns an implementation of the promotion.StepRunner
+// interface that renders templates to files.
+func newTemplateRenderer(kargoClient client.Client) pkgPromotion.StepRunner {
+	r := &templateRenderer{kargoClient: kargoClient}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the promotion.StepRunner interface.
+func (t *templateRenderer) Name() string {
+	return "template"
+}
+
+// Run implements the promotion.StepRunner interface.
+func (t *templateRenderer) Run(
+	ctx context.Context,
+	stepCtx *pkgPromotion.StepContext,
+) (pkgPromotion.StepResult, error) {
+	failure := pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+
+	if err := t.validate(stepCtx.Config); err != nil {
+		return failure, err
+	}
+
+	// Convert the configuration into a typed struct
+	cfg, err := pkgPromotion.ConfigToStruct[builtin.TemplateConfig](stepCtx.Config)
+	if err != nil {
+		return failure, fmt.Errorf("could not convert config into %s config: %w", t.Name(), err)
+	}
+
+	return t.run(ctx, stepCtx, cfg)
+}
+
+// validate validates templateRenderer configuration against a JSON schema.
+func (t *templateRenderer) validate(cfg pkgPromotion.Config) error {
+	return validate(t.schemaLoader, gojsonschema.NewGoLoader(cfg), t.Name())
+}
+
+func (t *templateRenderer) run(
+	ctx context.Context,
+	stepCtx *pkgPromotion.StepContext,
+	cfg builtin.TemplateConfig,
+) (pkgPromotion.StepResult, error) {
+	env, exprOpts := t.buildEnv(ctx, stepCtx)
+
+	// Render all templates before writing any of them, so that a failure to
+	// render one of them does not leave the working directory partially updated.
+	rendered := make([]string, len(cfg.Templates))
+	for i, tmpl := range cfg.Templates {
+		if tmpl.InPath == "" {
+			// The text of inline templates has already been evaluated along with
+			// the rest of the configuration. Evaluating it again would also
+			// evaluate any expressions in the values it was rendered with.
+			rendered[i] = tmpl.Inline
+			continue
+		}
+		inPath, err := securejoin.SecureJoin(stepCtx.WorkDir, tmpl.InPath)
+		if err != nil {
+			return pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("could not secure join inPath %q: %w", tmpl.InPath, err)
+		}
+		b, err := os.ReadFile(inPath)
+		if err != nil {
+			return pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(
+					"error reading template %q: %w",
+					tmpl.InPath, sanitizePathError(err, stepCtx.WorkDir),
+				)
+		}
+		if rendered[i], err = expressions.EvaluateTextTemplate(string(b), env, cfg.Strict, exprOpts...); err != nil {
+			return pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error rendering template for %q: %w", tmpl.OutPath, err)
+		}
+	}
+
+	for i, tmpl := range cfg.Templates {
+		outPath, err := securejoin.SecureJoin(stepCtx.WorkDir, tmpl.OutPath)
+		if err != nil {
+			return pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("could not secure join outPath %q: %w", tmpl.OutPath, err)
+		}
+		if err = os.MkdirAll(filepath.Dir(outPath), 0o700); err != nil {
+			return pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(
+					"error creating directory for %q: %w",
+					tmpl.OutPath, sanitizePathError(err, stepCtx.WorkDir),
+				)
+		}
+		if err = os.WriteFile(outPath, []byte(rendered[i]), 0o600); err != nil {
+			return pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(
+					"error writing rendered template to %q: %w",
+					tmpl.OutPath, sanitizePathError(err, stepCtx.WorkDir),
+				)
+		}
+	}
+
+	return pkgPromotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// buildEnv returns the environment and the expr-lang options templates are
+// rendered with. These mirror those used for evaluating the expressions in the
+// configuration of the step.
+func (t *templateRenderer) buildEnv(
+	ctx context.Context,
+	stepCtx *pkgPromotion.StepContext,
+) (map[string]any, []expr.Option) {
+	step := promotion.Step{Alias: stepCtx.Alias}
+	env := step.BuildEnv(
+		promotion.Context{
+			Project:   stepCtx.Project,
+			Stage:     stepCtx.Stage,
+			Promotion: stepCtx.Promotion,
+			Actor:     stepCtx.Actor,
+		},
+		promotion.StepEnvWithOutputs(stepCtx.SharedState),
+		promotion.StepEnvWithTaskOutputs(stepCtx.Alias, stepCtx.SharedState),
+		promotion.StepEnvWithVars(stepCtx.Vars),
+	)
+	exprOpts := append(
+		exprfn.FreightOperations(
+			ctx,
+			t.kargoClient,
+			stepCtx.Project,
+			stepCtx.FreightRequests,
+			stepCtx.Freight.References(),
+		),
+		exprfn.DataOperations(ctx, t.kargoClient, nil, stepCtx.Project)...,
+	)
+	return env, exprOpts
+}
@@ -0,0 +1,259 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_templateRenderer_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           promotion.Config
+		expectedProblems []string
+	}{
+		{
+			name:   "templates is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): templates is required",
+			},
+		},
+		{
+			name: "templates is empty",
+			config: promotion.Config{
+				"templates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"templates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "outPath is not specified",
+			config: promotion.Config{
+				"templates": []promotion.Config{{
+					"inline": "fake-template",
+				}},
+			},
+			expectedProblems: []string{
+				"templates.0: outPath is required",
+			},
+		},
+		{
+			name: "neither inPath nor inline is specified",
+			config: promotion.Config{
+				"templates": []promotion.Config{{
+					"outPath": "fake-out-path",
+				}},
+			},
+			expectedProblems: []string{
+				"templates.0: Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "both inPath and inline are specified",
+			config: promotion.Config{
+				"templates": []promotion.Config{{
+					"inPath":  "fake-in-path",
+					"inline":  "fake-template",
+					"outPath": "fake-out-path",
+				}},
+			},
+			expectedProblems: []string{
+				"templates.0: Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"strict": true,
+				"templates": []promotion.Config{
+					{
+						"inPath":  "fake-in-path",
+						"outPath": "fake-out-path",
+					},
+					{
+						"inline":  "fake-template",
+						"outPath": "another-fake-out-path",
+					},
+				},
+			},
+		},
+	}
+
+	r := newTemplateRenderer(nil)
+	runner, ok := r.(*templateRenderer)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_templateRenderer_run(t *testing.T) {
+	const testNamespace = "test-project-run"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	tests := []struct {
+		name       string
+		stepCtx    *promotion.StepContext
+		cfg        builtin.TemplateConfig
+		files      map[string]string
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run with multiple templates",
+			stepCtx: &promotion.StepContext{
+				Project:   testNamespace,
+				Stage:     "test-stage",
+				Promotion: "test-promotion",
+				Alias:     "task::render",
+				Actor:     "admin",
+				SharedState: promotion.State{
+					"task::clone": map[string]any{"commit": "abc123"},
+				},
+				Vars: map[string]any{"replicas": float64(3)},
+				FreightRequests: []kargoapi.FreightRequest{
+					{Origin: kargoapi.FreightOrigin{Name: "warehouse1", Kind: "Warehouse"}},
+				},
+				Freight: kargoapi.FreightCollection{
+					Freight: map[string]kargoapi.FreightReference{
+						"Warehouse/warehouse1": {
+							Origin: kargoapi.FreightOrigin{Kind: "Warehouse", Name: "warehouse1"},
+							Images: []kargoapi.Image{{RepoURL: "nginx", Tag: "1.21.0"}},
+						},
+					},
+				},
+			},
+			cfg: builtin.TemplateConfig{
+				Templates: []builtin.Template{
+					{
+						InPath:  "templates/values.yaml.tpl",
+						OutPath: "out/values.yaml",
+					},
+					{
+						// Inline templates have already been evaluated by the engine, so
+						// they are written as is.
+						Inline:  "literal ${{ ctx.meta.promotion.actor }}\n",
+						OutPath: "out/README.md",
+					},
+				},
+			},
+			files: map[string]string{
+				"templates/values.yaml.tpl": `stage: ${{ ctx.stage }}
+replicas: ${{ vars.replicas }}
+image: ${{ imageFrom("nginx").RepoURL }}:${{ imageFrom("nginx").Tag }}
+commit: ${{ task.outputs.clone.commit }}
+missing: "${{ vars.missing }}"
+`,
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, result)
+				content, err := os.ReadFile(path.Join(workDir, "out/values.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `stage: test-stage
+replicas: 3
+image: nginx:1.21.0
+commit: abc123
+missing: ""
+`, string(content))
+				content, err = os.ReadFile(path.Join(workDir, "out/README.md"))
+				require.NoError(t, err)
+				assert.Equal(t, "literal ${{ ctx.meta.promotion.actor }}\n", string(content))
+			},
+		},
+		{
+			name: "missing key in strict mode",
+			stepCtx: &promotion.StepContext{
+				Project: testNamespace,
+			},
+			cfg: builtin.TemplateConfig{
+				Strict: true,
+				Templates: []builtin.Template{
+					{
+						InPath:  "stage.yaml.tpl",
+						OutPath: "stage.yaml",
+					},
+					{
+						InPath:  "tag.yaml.tpl",
+						OutPath: "tag.yaml",
+					},
+				},
+			},
+			files: map[string]string{
+				"stage.yaml.tpl": "stage: ${{ ctx.stage }}\n",
+				"tag.yaml.tpl":   "tag: ${{ vars.tag }}\n",
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error rendering template for "tag.yaml"`)
+				require.ErrorContains(t, err, `expression "vars.tag" evaluated to nil`)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+				// Nothing is written when any template fails to render.
+				assert.NoFileExists(t, path.Join(workDir, "stage.yaml"))
+				assert.NoFileExists(t, path.Join(workDir, "tag.yaml"))
+			},
+		},
+		{
+			name: "template file does not exist",
+			stepCtx: &promotion.StepContext{
+				Project: testNamespace,
+			},
+			cfg: builtin.TemplateConfig{
+				Templates: []builtin.Template{{
+					InPath:  "non-existent.tpl",
+					OutPath: "out.yaml",
+				}},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error reading template "non-existent.tpl"`)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &templateRenderer{
+				kargoClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					mockWarehouse(testNamespace, "warehouse1", kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{Image: &kargoapi.ImageSubscription{RepoURL: "nginx"}},
+						},
+					}),
+				).Build(),
+			}
+
+			stepCtx := tt.stepCtx
+			stepCtx.WorkDir = t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(path.Join(stepCtx.WorkDir, path.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(path.Join(stepCtx.WorkDir, p), []byte(c), 0o600))
+			}
+
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, stepCtx.WorkDir, result, err)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package builtin
+
+import (
+	inttoml "github.com/akuity/kargo/internal/toml"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// newTOMLUpdater returns an implementation of the promotion.StepRunner interface
+// that updates the values of specified keys in a TOML file.
+func newTOMLUpdater() promotion.StepRunner {
+	return newKeyPathUpdater("toml-update", "TOML", inttoml.SetValuesInFile)
+}
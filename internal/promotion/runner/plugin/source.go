@@ -0,0 +1,125 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/promotion"
+	pkgPromotion "github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/plugin"
+)
+
+var initialized atomic.Uint32
+
+// Initialize registers a promotion.StepRunnerSource for the steps provided by
+// StepRunnerPlugins with the promotion package. Built-in steps take precedence
+// over steps provided by plugins.
+func Initialize(kargoClient client.Reader) {
+	if !initialized.CompareAndSwap(0, 1) {
+		panic("plugin promotion step runners already initialized")
+	}
+	promotion.RegisterStepRunnerSource(newSource(kargoClient))
+}
+
+// sourceConfig represents configuration for the StepRunners provided by the
+// source that is sourced from environment variables.
+type sourceConfig struct {
+	// MaxWorkDirArchiveBytes is the maximum size, in bytes, of the gzipped
+	// tarball of a working directory streamed to or from a plugin.
+	MaxWorkDirArchiveBytes int64 `envconfig:"STEP_RUNNER_PLUGIN_MAX_WORK_DIR_ARCHIVE_BYTES" default:"536870912"`
+	// MaxWorkDirBytes is the maximum combined size, in bytes, of the files of a
+	// working directory streamed to or from a plugin.
+	MaxWorkDirBytes int64 `envconfig:"STEP_RUNNER_PLUGIN_MAX_WORK_DIR_BYTES" default:"2147483648"`
+}
+
+// sourceConfigFromEnv returns a sourceConfig populated from environment
+// variables.
+func sourceConfigFromEnv() sourceConfig {
+	cfg := sourceConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// source is an implementation of promotion.StepRunnerSource that provides
+// StepRunners for the steps provided by StepRunnerPlugins.
+type source struct {
+	client             client.Reader
+	httpClient         *http.Client
+	insecureHTTPClient *http.Client
+	workDirLimits      plugin.WorkDirLimits
+}
+
+// newSource returns an implementation of promotion.StepRunnerSource that
+// provides StepRunners for the steps provided by StepRunnerPlugins.
+func newSource(kargoClient client.Reader) *source {
+	cfg := sourceConfigFromEnv()
+	return &source{
+		client:             kargoClient,
+		httpClient:         plugin.NewHTTPClient(false),
+		insecureHTTPClient: plugin.NewHTTPClient(true),
+		workDirLimits: plugin.WorkDirLimits{
+			MaxArchiveBytes: cfg.MaxWorkDirArchiveBytes,
+			MaxBytes:        cfg.MaxWorkDirBytes,
+		},
+	}
+}
+
+// GetStepRunner implements promotion.StepRunnerSource.
+func (s *source) GetStepRunner(
+	ctx context.Context,
+	name string,
+) (pkgPromotion.StepRunner, error) {
+	plugins := &kargoapi.StepRunnerPluginList{}
+	if err := s.client.List(ctx, plugins); err != nil {
+		return nil, fmt.Errorf("error listing StepRunnerPlugins: %w", err)
+	}
+
+	var (
+		providers []string
+		provider  *kargoapi.StepRunnerPlugin
+	)
+	for i := range plugins.Items {
+		if slices.Contains(plugins.Items[i].Spec.Steps, name) {
+			provider = &plugins.Items[i]
+			providers = append(providers, provider.Name)
+		}
+	}
+	switch len(providers) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		slices.Sort(providers)
+		return nil, fmt.Errorf(
+			"promotion step %q is provided by more than one StepRunnerPlugin: %s",
+			name, strings.Join(providers, ", "),
+		)
+	}
+
+	httpClient := s.httpClient
+	if provider.Spec.InsecureSkipTLSVerify {
+		httpClient = s.insecureHTTPClient
+	}
+	var timeout *time.Duration
+	if provider.Spec.Timeout != nil {
+		timeout = &provider.Spec.Timeout.Duration
+	}
+	return pkgPromotion.NewRetryableStepRunner(
+		plugin.NewStepRunner(
+			name,
+			plugin.NewClient(httpClient, provider.Spec.URL),
+			s.workDirLimits,
+		),
+		timeout,
+		provider.Spec.ErrorThreshold,
+	), nil
+}
@@ -0,0 +1,160 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	pluginv1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	pkgPromotion "github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/plugin"
+	"github.com/akuity/kargo/pkg/promotion/plugin/reference"
+)
+
+func Test_source_GetStepRunner(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- plugin.NewServer(
+			pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_STREAMED,
+			reference.NewFileWriter(),
+		).Serve(ctx, l)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		listErr    error
+		stepName   string
+		assertions func(*testing.T, pkgPromotion.StepRunner, error)
+	}{
+		{
+			name:     "error listing plugins",
+			listErr:  context.DeadlineExceeded,
+			stepName: reference.WriteFileStepName,
+			assertions: func(t *testing.T, runner pkgPromotion.StepRunner, err error) {
+				require.ErrorContains(t, err, "error listing StepRunnerPlugins")
+				require.Nil(t, runner)
+			},
+		},
+		{
+			name: "step not provided by any plugin",
+			objects: []client.Object{
+				&kargoapi.StepRunnerPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "reference"},
+					Spec: kargoapi.StepRunnerPluginSpec{
+						URL:   "http://" + l.Addr().String(),
+						Steps: []string{reference.WriteFileStepName},
+					},
+				},
+			},
+			stepName: "nonexistent",
+			assertions: func(t *testing.T, runner pkgPromotion.StepRunner, err error) {
+				require.NoError(t, err)
+				require.Nil(t, runner)
+			},
+		},
+		{
+			name: "step provided by more than one plugin",
+			objects: []client.Object{
+				&kargoapi.StepRunnerPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "reference"},
+					Spec: kargoapi.StepRunnerPluginSpec{
+						URL:   "http://" + l.Addr().String(),
+						Steps: []string{reference.WriteFileStepName},
+					},
+				},
+				&kargoapi.StepRunnerPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "another"},
+					Spec: kargoapi.StepRunnerPluginSpec{
+						URL:   "http://another.example.com",
+						Steps: []string{reference.WriteFileStepName},
+					},
+				},
+			},
+			stepName: reference.WriteFileStepName,
+			assertions: func(t *testing.T, runner pkgPromotion.StepRunner, err error) {
+				require.ErrorContains(t, err, "provided by more than one StepRunnerPlugin: another, reference")
+				require.Nil(t, runner)
+			},
+		},
+		{
+			name: "step provided by plugin",
+			objects: []client.Object{
+				&kargoapi.StepRunnerPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "reference"},
+					Spec: kargoapi.StepRunnerPluginSpec{
+						URL:            "http://" + l.Addr().String(),
+						Steps:          []string{reference.WriteFileStepName},
+						Timeout:        &metav1.Duration{Duration: time.Minute},
+						ErrorThreshold: 3,
+					},
+				},
+			},
+			stepName: reference.WriteFileStepName,
+			assertions: func(t *testing.T, runner pkgPromotion.StepRunner, err error) {
+				require.NoError(t, err)
+				require.Equal(t, reference.WriteFileStepName, runner.Name())
+
+				retryable, ok := runner.(pkgPromotion.RetryableStepRunner)
+				require.True(t, ok)
+				require.Equal(t, time.Minute, *retryable.DefaultTimeout())
+				require.Equal(t, uint32(3), retryable.DefaultErrorThreshold())
+
+				workDir := t.TempDir()
+				result, err := runner.Run(context.Background(), &pkgPromotion.StepContext{
+					WorkDir: workDir,
+					Config:  pkgPromotion.Config{"path": "foo.txt", "content": "bar"},
+				})
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "foo.txt"))
+				require.NoError(t, err)
+				require.Equal(t, "bar", string(b))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(
+						ctx context.Context,
+						c client.WithWatch,
+						list client.ObjectList,
+						opts ...client.ListOption,
+					) error {
+						if testCase.listErr != nil {
+							return testCase.listErr
+						}
+						return c.List(ctx, list, opts...)
+					},
+				}).
+				Build()
+			runner, err := newSource(c).GetStepRunner(context.Background(), testCase.stepName)
+			testCase.assertions(t, runner, err)
+		})
+	}
+}
@@ -53,6 +53,7 @@ func DefaultExprDataCacheFn() *gocache.Cache {
 // built-in StepRunners.
 type simpleEngine struct {
 	registry    stepRunnerRegistry
+	sources     []StepRunnerSource
 	kargoClient client.Client
 	cacheFunc   ExprDataCacheFn
 }
@@ -62,6 +63,7 @@ type simpleEngine struct {
 func NewSimpleEngine(kargoClient client.Client, cacheFunc ExprDataCacheFn) Engine {
 	return &simpleEngine{
 		registry:    stepRunnerReg,
+		sources:     stepRunnerSources,
 		kargoClient: kargoClient,
 		cacheFunc:   cacheFunc,
 	}
@@ -176,7 +178,16 @@ stepLoop:
 		}
 
 		// Get the StepRunner for the step.
-		runner := e.registry.getStepRunner(step.Kind)
+		runner, err := lookupStepRunner(ctx, e.registry, e.sources, step.Kind)
+		if err != nil {
+			stepExecMeta.Status = kargoapi.PromotionStepStatusErrored
+			stepExecMeta.Message = fmt.Sprintf(
+				"error looking up promotion step runner for kind %q: %s", step.Kind, err,
+			)
+			// Continue, because despite this failure, some steps' "if" conditions may
+			// still allow them to run.
+			continue
+		}
 		if runner == nil {
 			stepExecMeta.Status = kargoapi.PromotionStepStatusErrored
 			stepExecMeta.Message = fmt.Sprintf("no promotion step runner found for kind %q", step.Kind)
@@ -424,6 +435,11 @@ func (e *simpleEngine) prepareStepContext(
 		return nil, fmt.Errorf("failed to get step config: %w", err)
 	}
 
+	vars, err := step.GetVars(ctx, e.kargoClient, cache, promoCtx, stateCopy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get step vars: %w", err)
+	}
+
 	return &promotion.StepContext{
 		UIBaseURL:       promoCtx.UIBaseURL,
 		WorkDir:         workDir,
@@ -435,6 +451,8 @@ func (e *simpleEngine) prepareStepContext(
 		Promotion:       promoCtx.Promotion,
 		FreightRequests: promoCtx.FreightRequests,
 		Freight:         promoCtx.Freight,
+		Vars:            vars,
+		Actor:           promoCtx.Actor,
 	}, nil
 }
 
@@ -889,6 +889,27 @@ func TestSimpleEngine_prepareStepContext(t *testing.T) {
 				assert.Equal(t, "http://test", ctx.UIBaseURL)
 			},
 		},
+		{
+			name: "vars and actor are included",
+			promoCtx: Context{
+				Project: "test-project",
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "foo", Value: "bar"},
+				},
+				Actor: "admin",
+			},
+			step: Step{
+				Kind: "test-step",
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "baz", Value: "${{ vars.foo }}"},
+				},
+			},
+			assertions: func(t *testing.T, ctx *promotion.StepContext, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, map[string]any{"foo": "bar", "baz": "bar"}, ctx.Vars)
+				assert.Equal(t, "admin", ctx.Actor)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
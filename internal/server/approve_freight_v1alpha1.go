@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -101,26 +104,72 @@ func (s *server) ApproveFreight(
 		return nil, err
 	}
 
-	if freight.IsApprovedFor(stageName) {
-		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
+	if freight.IsRejectedFor(stageName) {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Freight %q has been rejected for Stage %q and cannot be approved",
+				freight.Name,
+				stageName,
+			),
+		)
+	}
+
+	u, hasUser := user.InfoFromContext(ctx)
+	var actor string
+	if hasUser {
+		actor = api.FormatEventUserActor(u)
+	}
+
+	policy := stage.Spec.ApprovalPolicy
+	if policy == nil {
+		if freight.IsApprovedFor(stageName) {
+			return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
+		}
+	} else {
+		if !hasUser || actor == kargoapi.EventActorUnknown {
+			return nil, connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf(
+					"Stage %q has an approval policy; approvals must be made by an identifiable user",
+					stageName,
+				),
+			)
+		}
+		if err := validateApprover(u, project, policy, freight); err != nil {
+			return nil, connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf("user %q may not approve Freight for Stage %q: %w", actor, stageName, err),
+			)
+		}
+		if freight.IsApprovedBy(stageName, actor) {
+			return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
+		}
 	}
 
 	newStatus := *freight.Status.DeepCopy()
-	if newStatus.ApprovedFor == nil {
-		newStatus.ApprovedFor = make(map[string]kargoapi.ApprovedStage)
+	if actor != "" {
+		newStatus.AddApproval(stageName, actor, time.Now())
+	} else {
+		newStatus.AddApprovedStage(stageName, time.Now())
 	}
-	newStatus.AddApprovedStage(stageName, time.Now())
 
 	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
 		return nil, fmt.Errorf("patch status: %w", err)
 	}
 
-	var actor string
 	eventMsg := fmt.Sprintf("Freight approved for Stage %q", stageName)
-	if u, ok := user.InfoFromContext(ctx); ok {
-		actor = api.FormatEventUserActor(u)
+	if actor != "" {
 		eventMsg += fmt.Sprintf(" by %q", actor)
 	}
+	if policy != nil {
+		updated := kargoapi.Freight{Status: newStatus}
+		eventMsg += fmt.Sprintf(
+			" (%d of %d required approvals)",
+			updated.GetApprovalCount(stageName),
+			policy.GetRequiredApprovals(),
+		)
+	}
 
 	s.recorder.AnnotatedEventf(
 		freight,
@@ -132,6 +181,124 @@ func (s *server) ApproveFreight(
 	return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 }
 
+// validateApprover returns an error if the provided user is not permitted to
+// approve the provided Freight under the provided ApprovalPolicy. The policy
+// applies to every user, including the admin user.
+func validateApprover(
+	u user.Info,
+	project string,
+	policy *kargoapi.ApprovalPolicy,
+	freight *kargoapi.Freight,
+) error {
+	if policy.HasEligibilityRestrictions() && !isEligibleApprover(u, project, policy) {
+		return errors.New("user is not a member of any eligible group or role")
+	}
+	if policy.ExcludeCommitAuthors {
+		// Fail closed: if it cannot be established that the user did not author
+		// or commit any of the changes, the user may not approve the Freight.
+		emails := getUserEmails(u)
+		if len(emails) == 0 {
+			return errors.New(
+				"user has no email address to compare to the authors and " +
+					"committers of changes included in the Freight",
+			)
+		}
+		authors, err := getCommitAuthorEmails(freight)
+		if err != nil {
+			return err
+		}
+		for _, email := range emails {
+			if _, ok := authors[email]; ok {
+				return errors.New(
+					"user authored or committed changes included in the Freight",
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// isEligibleApprover returns true if the provided user belongs to one of the
+// ApprovalPolicy's eligible groups or is mapped to one of its eligible roles.
+func isEligibleApprover(
+	u user.Info,
+	project string,
+	policy *kargoapi.ApprovalPolicy,
+) bool {
+	if len(policy.EligibleGroups) > 0 {
+		groups := getUserGroups(u)
+		for _, group := range policy.EligibleGroups {
+			if slices.Contains(groups, group) {
+				return true
+			}
+		}
+	}
+	if len(policy.EligibleRoles) > 0 {
+		for sa := range u.ServiceAccountsByNamespace[project] {
+			if slices.Contains(policy.EligibleRoles, sa.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getUserGroups returns the groups the provided user belongs to according to
+// their "groups" claim.
+func getUserGroups(u user.Info) []string {
+	switch groups := u.Claims["groups"].(type) {
+	case []string:
+		return groups
+	case []any:
+		strs := make([]string, 0, len(groups))
+		for _, group := range groups {
+			if str, ok := group.(string); ok {
+				strs = append(strs, str)
+			}
+		}
+		return strs
+	case string:
+		return []string{groups}
+	}
+	return nil
+}
+
+// getUserEmails returns the lowercased email addresses associated with the
+// provided user.
+func getUserEmails(u user.Info) []string {
+	var emails []string
+	if email, ok := u.Claims["email"].(string); ok && email != "" {
+		emails = append(emails, strings.ToLower(email))
+	}
+	if strings.Contains(u.Username, "@") {
+		emails = append(emails, strings.ToLower(u.Username))
+	}
+	return emails
+}
+
+// getCommitAuthorEmails returns the set of lowercased email addresses of the
+// authors and committers of all commits referenced by the provided Freight. An
+// error is returned if the email address of any author or committer is
+// unknown.
+func getCommitAuthorEmails(freight *kargoapi.Freight) (map[string]struct{}, error) {
+	emails := make(map[string]struct{}, len(freight.Commits)*2)
+	for _, commit := range freight.Commits {
+		for _, person := range []string{commit.Author, commit.Committer} {
+			addr, err := mail.ParseAddress(person)
+			if err != nil || addr.Address == "" {
+				return nil, fmt.Errorf(
+					"email address of the author or committer of commit %q from "+
+						"repository %q included in the Freight is unknown",
+					commit.ID,
+					commit.RepoURL,
+				)
+			}
+			emails[strings.ToLower(addr.Address)] = struct{}{}
+		}
+	}
+	return emails, nil
+}
+
 func (s *server) patchFreightStatus(
 	ctx context.Context,
 	freight *kargoapi.Freight,
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -15,12 +17,14 @@ import (
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+	"github.com/akuity/kargo/internal/server/user"
 )
 
 func TestApproveFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
 		req        *svcv1alpha1.ApproveFreightRequest
+		user       *user.Info
 		server     *server
 		assertions func(
 			*testing.T,
@@ -371,13 +375,664 @@ func TestApproveFreight(t *testing.T) {
 				require.Equal(t, kargoapi.EventReasonFreightApproved, event.Reason)
 			},
 		},
+		{
+			name: "Freight rejected for Stage",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							Rejected: &kargoapi.RejectedFreight{Reason: "broken"},
+						},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+			},
+		},
+		{
+			name: "approval policy requires identifiable user",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					_ kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.ErrorContains(t, err, "identifiable user")
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "user not eligible under approval policy",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			user: &user.Info{
+				Claims: map[string]any{
+					"email":  "bob@example.com",
+					"groups": []any{"developers"},
+				},
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{
+								EligibleGroups: []string{"release-managers"},
+								EligibleRoles:  []string{"approver"},
+							},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					_ kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.ErrorContains(t, err, "not a member of any eligible group or role")
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "admin not eligible under approval policy",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			user: &user.Info{
+				IsAdmin: true,
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{
+								EligibleGroups: []string{"release-managers"},
+								EligibleRoles:  []string{"approver"},
+							},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					_ kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.ErrorContains(t, err, "not a member of any eligible group or role")
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "approval policy excludes commit authors",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			user: &user.Info{
+				Claims: map[string]any{
+					"email":  "bob@example.com",
+					"groups": []any{"release-managers"},
+				},
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Commits: []kargoapi.GitCommit{{
+							Author:    "Bob <Bob@Example.com>",
+							Committer: "Alice <alice@example.com>",
+						}},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{ExcludeCommitAuthors: true},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					_ kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.ErrorContains(t, err, "authored or committed")
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "approval policy excludes commit authors and commit author is unknown",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			user: &user.Info{
+				Claims: map[string]any{
+					"email":  "bob@example.com",
+					"groups": []any{"release-managers"},
+				},
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Commits: []kargoapi.GitCommit{{
+							RepoURL:           "https://github.com/example/repo",
+							ID:                "fake-commit",
+							PullRequestNumber: 42,
+						}},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{ExcludeCommitAuthors: true},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					_ kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.ErrorContains(t, err, "is unknown")
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "approval policy excludes commit authors and user has no email address",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			user: &user.Info{
+				Claims: map[string]any{
+					"sub":    "bob",
+					"groups": []any{"release-managers"},
+				},
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Commits: []kargoapi.GitCommit{{
+							Author:    "Bob <Bob@Example.com>",
+							Committer: "Alice <alice@example.com>",
+						}},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{ExcludeCommitAuthors: true},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					_ kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.ErrorContains(t, err, "no email address")
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "user already approved under approval policy",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			user: &user.Info{
+				Claims: map[string]any{
+					"email":  "bob@example.com",
+					"groups": []any{"release-managers"},
+				},
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							ApprovedFor: map[string]kargoapi.ApprovedStage{
+								"fake-stage": {
+									Approvals: []kargoapi.Approval{{
+										Approver: "email:bob@example.com",
+									}},
+								},
+							},
+						},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					_ kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "success with approval policy",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			user: &user.Info{
+				Claims: map[string]any{
+					"email":  "bob@example.com",
+					"groups": []any{"release-managers"},
+				},
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Commits: []kargoapi.GitCommit{{
+							Author:    "Alice <alice@example.com>",
+							Committer: "Carol <carol@example.com>",
+						}},
+						Status: kargoapi.FreightStatus{
+							ApprovedFor: map[string]kargoapi.ApprovedStage{
+								"fake-stage": {
+									ApprovedAt: &metav1.Time{Time: time.Now()},
+									Approvals: []kargoapi.Approval{{
+										Approver: "dave@example.com",
+									}},
+								},
+							},
+						},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{
+								RequiredApprovals:    2,
+								EligibleRoles:        []string{"approver"},
+								EligibleGroups:       []string{"release-managers"},
+								ExcludeCommitAuthors: true,
+							},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					status kargoapi.FreightStatus,
+				) error {
+					record := status.ApprovedFor["fake-stage"]
+					if len(record.Approvals) != 2 ||
+						record.Approvals[1].Approver != "email:bob@example.com" {
+						return errors.New("approval was not recorded")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonFreightApproved, event.Reason)
+				require.Contains(t, event.Message, "(2 of 2 required approvals)")
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			recorder := fakeevent.NewEventRecorder(1)
 			testCase.server.recorder = recorder
+			ctx := context.Background()
+			if testCase.user != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.user)
+			}
 			resp, err := testCase.server.ApproveFreight(
-				context.Background(),
+				ctx,
 				connect.NewRequest(testCase.req),
 			)
 			testCase.assertions(t, recorder, resp, err)
@@ -74,11 +74,12 @@ func (s *server) validateCredentials(creds credentials) error {
 	switch creds.credType {
 	case kargoapi.CredentialTypeLabelValueGit,
 		kargoapi.CredentialTypeLabelValueHelm,
-		kargoapi.CredentialTypeLabelValueImage:
+		kargoapi.CredentialTypeLabelValueImage,
+		kargoapi.CredentialTypeLabelValueHTTP:
 	default:
 		return connect.NewError(
 			connect.CodeInvalidArgument,
-			errors.New("type should be one of git, helm, or image"),
+			errors.New("type should be one of git, helm, image, or http"),
 		)
 	}
 	if creds.repoURL == "" {
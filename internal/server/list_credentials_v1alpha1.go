@@ -43,6 +43,7 @@ func (s *server) ListCredentials(
 			kargoapi.CredentialTypeLabelValueGit,
 			kargoapi.CredentialTypeLabelValueHelm,
 			kargoapi.CredentialTypeLabelValueImage,
+			kargoapi.CredentialTypeLabelValueHTTP,
 		})
 
 	if err != nil {
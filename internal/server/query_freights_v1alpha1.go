@@ -103,6 +103,12 @@ func (s *server) QueryFreight(
 		freight = freightList.Items
 	}
 
+	if req.Msg.GetExcludeRejected() {
+		freight = slices.DeleteFunc(freight, func(f kargoapi.Freight) bool {
+			return f.IsRejected()
+		})
+	}
+
 	// Split the Freight into groups
 	var freightGroups map[string]*svcv1alpha1.FreightList
 	switch groupBy {
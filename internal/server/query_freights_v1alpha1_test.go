@@ -243,6 +243,44 @@ func TestQueryFreight(t *testing.T) {
 			},
 		},
 
+		{
+			name: "exclude rejected Freight",
+			req: &svcv1alpha1.QueryFreightRequest{
+				Project:         "kargo-demo",
+				ExcludeRejected: true,
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{},
+						{
+							Status: kargoapi.FreightStatus{
+								Rejected: &kargoapi.RejectedFreight{Reason: "broken"},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				res *connect.Response[svcv1alpha1.QueryFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetGroups()[""].Freight, 1)
+			},
+		},
+
 		{
 			name: "group by git repo",
 			req: &svcv1alpha1.QueryFreightRequest{
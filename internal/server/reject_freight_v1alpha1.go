@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api"
+	"github.com/akuity/kargo/internal/server/user"
+)
+
+// RejectFreight marks Freight as rejected, either for a specific Stage or, if
+// no Stage is specified, for all Stages in the Project. Rejected Freight is
+// never available for promotion.
+func (s *server) RejectFreight(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RejectFreightRequest],
+) (*connect.Response[svcv1alpha1.RejectFreightResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	alias := req.Msg.GetAlias()
+	if (name == "" && alias == "") ||
+		(name != "" && alias != "") {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("exactly one of name or alias should not be empty"),
+		)
+	}
+
+	reason := req.Msg.GetReason()
+	if err := validateFieldNotEmpty("reason", reason); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	freight, err := s.getFreightByNameOrAliasFn(
+		ctx,
+		s.client,
+		project,
+		name,
+		alias,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		if name != "" {
+			err = fmt.Errorf("freight %q not found in namespace %q", name, project)
+		} else {
+			err = fmt.Errorf("freight with alias %q not found in namespace %q", alias, project)
+		}
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	// NB: An empty Stage name means the Freight is to be rejected for all
+	// Stages in the Project. In that case, the permissions required to patch the
+	// Freight's status are enforced when the status is patched.
+	stageName := req.Msg.GetStage()
+	if stageName != "" {
+		stage, err := s.getStageFn(
+			ctx,
+			s.client,
+			client.ObjectKey{
+				Namespace: project,
+				Name:      stageName,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("get stage: %w", err)
+		}
+		if stage == nil {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf("Stage %q not found in namespace %q", stageName, project),
+			)
+		}
+
+		if err := s.authorizeFn(
+			ctx,
+			"promote",
+			schema.GroupVersionResource{
+				Group:    kargoapi.GroupVersion.Group,
+				Version:  kargoapi.GroupVersion.Version,
+				Resource: "stages",
+			},
+			"",
+			types.NamespacedName{
+				Namespace: project,
+				Name:      stageName,
+			},
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if freight.IsRejected() ||
+		(stageName != "" && freight.IsRejectedFor(stageName)) {
+		return &connect.Response[svcv1alpha1.RejectFreightResponse]{}, nil
+	}
+
+	var actor string
+	if u, ok := user.InfoFromContext(ctx); ok {
+		actor = api.FormatEventUserActor(u)
+	}
+
+	newStatus := *freight.Status.DeepCopy()
+	newStatus.AddRejection(stageName, reason, actor, time.Now())
+
+	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
+		return nil, fmt.Errorf("patch status: %w", err)
+	}
+
+	eventMsg := "Freight rejected for all Stages"
+	if stageName != "" {
+		eventMsg = fmt.Sprintf("Freight rejected for Stage %q", stageName)
+	}
+	if actor != "" {
+		eventMsg += fmt.Sprintf(" by %q", actor)
+	}
+	eventMsg += fmt.Sprintf(": %s", reason)
+
+	s.recorder.AnnotatedEventf(
+		freight,
+		api.NewFreightRejectedEventAnnotations(actor, freight, stageName, reason),
+		corev1.EventTypeWarning,
+		kargoapi.EventReasonFreightRejected,
+		"%s",
+		eventMsg,
+	)
+	return &connect.Response[svcv1alpha1.RejectFreightResponse]{}, nil
+}
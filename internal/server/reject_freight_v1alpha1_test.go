@@ -0,0 +1,413 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+)
+
+func TestRejectFreight(t *testing.T) {
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.RejectFreightRequest
+		server     *server
+		assertions func(
+			*testing.T,
+			*fakeevent.EventRecorder,
+			*connect.Response[svcv1alpha1.RejectFreightResponse],
+			error,
+		)
+	}{
+		{
+			name: "input validation error",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Reason:  "broken",
+			},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "reason not specified",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+			},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.ErrorContains(t, err, "reason")
+			},
+		},
+		{
+			name: "Freight not found",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Reason:  "broken",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+				Reason:  "broken",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "rejecting for Stage not authorized",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+				Reason:  "broken",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "not authorized")
+			},
+		},
+		{
+			name: "already rejected",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+				Reason:  "broken",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							Rejected: &kargoapi.RejectedFreight{Reason: "broken"},
+						},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "error patching Freight",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Reason:  "broken",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success rejecting for all Stages",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Reason:  "broken",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					status kargoapi.FreightStatus,
+				) error {
+					if status.Rejected == nil || status.Rejected.Reason != "broken" {
+						return errors.New("rejection was not recorded")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonFreightRejected, event.Reason)
+				require.Equal(
+					t,
+					"broken",
+					event.Annotations[kargoapi.AnnotationKeyEventRejectionReason],
+				)
+			},
+		},
+		{
+			name: "success rejecting for Stage",
+			req: &svcv1alpha1.RejectFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+				Reason:  "broken",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					status kargoapi.FreightStatus,
+				) error {
+					if status.Rejected != nil {
+						return errors.New("rejected for all Stages")
+					}
+					if _, ok := status.RejectedFor["fake-stage"]; !ok {
+						return errors.New("rejection was not recorded")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RejectFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonFreightRejected, event.Reason)
+				require.Contains(t, event.Message, `Freight rejected for Stage "fake-stage"`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(1)
+			testCase.server.recorder = recorder
+			resp, err := testCase.server.RejectFreight(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(t, recorder, resp, err)
+		})
+	}
+}
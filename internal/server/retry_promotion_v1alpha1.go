@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+)
+
+// RetryPromotion creates a Promotion resource that retries the specified
+// Promotion, which must have failed or errored. Unless a rerun from the start
+// is requested, the new Promotion carries over the shared state of the
+// specified Promotion and resumes from the step at which it stopped.
+func (s *server) RetryPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RetryPromotionRequest],
+) (*connect.Response[svcv1alpha1.RetryPromotionResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.getPromotionFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      name,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get promotion: %w", err)
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Promotion %q not found in namespace %q", name, project),
+		)
+	}
+	switch promo.Status.Phase {
+	case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+	default:
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Promotion %q has not failed or errored and cannot be retried",
+				name,
+			),
+		)
+	}
+
+	stageName := promo.Spec.Stage
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stageName,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"Stage %q not found in namespace %q",
+				stageName,
+				project,
+			),
+		)
+	}
+
+	freight, err := s.getFreightByNameOrAliasFn(
+		ctx,
+		s.client,
+		project,
+		promo.Spec.Freight,
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("freight %q not found in namespace %q", promo.Spec.Freight, project),
+		)
+	}
+
+	if !s.isFreightAvailableFn(stage, freight) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf(
+				"Freight %q is not available to Stage %q",
+				freight.Name,
+				stageName,
+			),
+		)
+	}
+
+	if err = s.authorizeFn(
+		ctx,
+		"promote",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stageName,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	promotion, err := kargo.NewPromotionBuilder(s.client).BuildRetry(
+		ctx,
+		*stage,
+		*promo,
+		req.Msg.GetFromStart(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("build promotion: %w", err)
+	}
+	if err := s.createPromotionFn(ctx, promotion); err != nil {
+		return nil, fmt.Errorf("create promotion: %w", err)
+	}
+	s.recordPromotionCreatedEvent(ctx, promotion, freight)
+	return connect.NewResponse(&svcv1alpha1.RetryPromotionResponse{
+		Promotion: promotion,
+	}), nil
+}
@@ -0,0 +1,309 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+)
+
+func TestRetryPromotion(t *testing.T) {
+	testPromotion := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+			Steps: []kargoapi.PromotionStep{
+				{As: "template", Uses: "helm-template"},
+				{As: "push", Uses: "git-push"},
+			},
+		},
+		Status: kargoapi.PromotionStatus{
+			Phase: kargoapi.PromotionPhaseErrored,
+		},
+	}
+	getTestPromotionFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error) {
+		return testPromotion.DeepCopy(), nil
+	}
+	getTestStageFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-stage",
+			},
+		}, nil
+	}
+	getTestFreightFn := func(
+		_ context.Context,
+		_ client.Client,
+		project string,
+		name string,
+		_ string,
+	) (*kargoapi.Freight, error) {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: project,
+				Name:      name,
+			},
+		}, nil
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.RetryPromotionRequest
+		server     *server
+		assertions func(
+			*testing.T,
+			*fakeevent.EventRecorder,
+			*connect.Response[svcv1alpha1.RetryPromotionResponse],
+			error,
+		)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.RetryPromotionRequest{},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RetryPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.RetryPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RetryPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Promotion not found",
+			req: &svcv1alpha1.RetryPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RetryPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "Promotion not failed or errored",
+			req: &svcv1alpha1.RetryPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					promo := testPromotion.DeepCopy()
+					promo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+					return promo, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RetryPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "cannot be retried")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+			},
+		},
+		{
+			name: "Freight not available",
+			req: &svcv1alpha1.RetryPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn:            getTestPromotionFn,
+				getStageFn:                getTestStageFn,
+				getFreightByNameOrAliasFn: getTestFreightFn,
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return false
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RetryPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "not available to Stage")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "promoting not authorized",
+			req: &svcv1alpha1.RetryPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn:            getTestPromotionFn,
+				getStageFn:                getTestStageFn,
+				getFreightByNameOrAliasFn: getTestFreightFn,
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RetryPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "not authorized")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.RetryPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn:            getTestPromotionFn,
+				getStageFn:                getTestStageFn,
+				getFreightByNameOrAliasFn: getTestFreightFn,
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.RetryPromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				promo := res.Msg.GetPromotion()
+				require.NotNil(t, promo)
+				require.Equal(t, testPromotion.Spec, promo.Spec)
+				require.Equal(t, "fake-promotion", promo.Annotations[kargoapi.AnnotationKeyRetry])
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeNormal, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(1)
+			testCase.server.recorder = recorder
+			res, err := testCase.server.RetryPromotion(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(t, recorder, res, err)
+		})
+	}
+}
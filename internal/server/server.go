@@ -79,6 +79,11 @@ type server struct {
 		alias string,
 	) (*kargoapi.Freight, error)
 	isFreightAvailableFn func(*kargoapi.Stage, *kargoapi.Freight) bool
+	getPromotionFn       func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error)
 
 	// Common Promotions:
 	createPromotionFn func(
@@ -180,6 +185,7 @@ func NewServer(
 	s.getStageFn = api.GetStage
 	s.getFreightByNameOrAliasFn = api.GetFreightByNameOrAlias
 	s.isFreightAvailableFn = s.isFreightAvailable
+	s.getPromotionFn = api.GetPromotion
 	s.createPromotionFn = kubeClient.Create
 	s.findDownstreamStagesFn = s.findDownstreamStages
 	s.listFreightFn = kubeClient.List
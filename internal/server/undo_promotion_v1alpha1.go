@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+)
+
+// UndoPromotion creates a Promotion resource that reverts the commits made by
+// the specified Promotion and transitions its Stage back into the state
+// represented by the Freight that preceded the specified Promotion's Freight.
+// Only the last Promotion of a Stage can be undone.
+func (s *server) UndoPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.UndoPromotionRequest],
+) (*connect.Response[svcv1alpha1.UndoPromotionResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.getPromotionFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      name,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get promotion: %w", err)
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Promotion %q not found in namespace %q", name, project),
+		)
+	}
+	if promo.Status.Phase != kargoapi.PromotionPhaseSucceeded {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Promotion %q has not succeeded and cannot be undone",
+				name,
+			),
+		)
+	}
+
+	stageName := promo.Spec.Stage
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stageName,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"Stage %q not found in namespace %q",
+				stageName,
+				project,
+			),
+		)
+	}
+	if stage.Status.LastPromotion == nil ||
+		stage.Status.LastPromotion.Name != promo.Name {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Promotion %q is not the last Promotion of Stage %q",
+				name,
+				stageName,
+			),
+		)
+	}
+
+	freightName := getPreviousFreight(stage, promo.Spec.Freight)
+	if freightName == "" {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Stage %q has no Freight history preceding Freight %q",
+				stageName,
+				promo.Spec.Freight,
+			),
+		)
+	}
+
+	freight, err := s.getFreightByNameOrAliasFn(
+		ctx,
+		s.client,
+		project,
+		freightName,
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("freight %q not found in namespace %q", freightName, project),
+		)
+	}
+
+	if !s.isFreightAvailableFn(stage, freight) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf(
+				"Freight %q is not available to Stage %q",
+				freightName,
+				stageName,
+			),
+		)
+	}
+
+	if err = s.authorizeFn(
+		ctx,
+		"promote",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stageName,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	promotion, err := kargo.NewPromotionBuilder(s.client).BuildUndo(
+		ctx,
+		*stage,
+		*promo,
+		freight.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("build promotion: %w", err)
+	}
+	if err := s.createPromotionFn(ctx, promotion); err != nil {
+		return nil, fmt.Errorf("create promotion: %w", err)
+	}
+	s.recordPromotionCreatedEvent(ctx, promotion, freight)
+	return connect.NewResponse(&svcv1alpha1.UndoPromotionResponse{
+		Promotion: promotion,
+	}), nil
+}
+
+// getPreviousFreight returns the name of the Freight from the same origin that
+// preceded the specified Freight in the Stage's Freight history. If there is
+// no such Freight, an empty string is returned.
+func getPreviousFreight(stage *kargoapi.Stage, freightName string) string {
+	var origin string
+	for _, collection := range stage.Status.FreightHistory {
+		if collection == nil {
+			continue
+		}
+		if origin == "" {
+			for key, ref := range collection.Freight {
+				if ref.Name == freightName {
+					origin = key
+					break
+				}
+			}
+			continue
+		}
+		if ref, ok := collection.Freight[origin]; ok && ref.Name != freightName {
+			return ref.Name
+		}
+	}
+	return ""
+}
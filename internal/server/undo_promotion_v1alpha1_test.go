@@ -0,0 +1,407 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+)
+
+func TestUndoPromotion(t *testing.T) {
+	testPromotion := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight-2",
+			Steps: []kargoapi.PromotionStep{
+				{As: "clone", Uses: "git-clone"},
+				{
+					As:   "push",
+					Uses: "git-push",
+					Config: &apiextensionsv1.JSON{
+						Raw: []byte(`{"path":"./out"}`),
+					},
+				},
+			},
+		},
+		Status: kargoapi.PromotionStatus{
+			Phase: kargoapi.PromotionPhaseSucceeded,
+		},
+	}
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+		Status: kargoapi.StageStatus{
+			LastPromotion: &kargoapi.PromotionReference{
+				Name: "fake-promotion",
+			},
+			FreightHistory: kargoapi.FreightHistory{
+				{
+					Freight: map[string]kargoapi.FreightReference{
+						testOrigin.String(): {Name: "fake-freight-2", Origin: testOrigin},
+					},
+				},
+				{
+					Freight: map[string]kargoapi.FreightReference{
+						testOrigin.String(): {Name: "fake-freight-1", Origin: testOrigin},
+					},
+				},
+			},
+		},
+	}
+	getTestPromotionFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error) {
+		return testPromotion.DeepCopy(), nil
+	}
+	getTestStageFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return testStage.DeepCopy(), nil
+	}
+	getTestFreightFn := func(
+		_ context.Context,
+		_ client.Client,
+		project string,
+		name string,
+		_ string,
+	) (*kargoapi.Freight, error) {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: project,
+				Name:      name,
+			},
+		}, nil
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.UndoPromotionRequest
+		server     *server
+		assertions func(
+			*testing.T,
+			*fakeevent.EventRecorder,
+			*connect.Response[svcv1alpha1.UndoPromotionResponse],
+			error,
+		)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.UndoPromotionRequest{},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Promotion not found",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "Promotion not succeeded",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					promo := testPromotion.DeepCopy()
+					promo.Status.Phase = kargoapi.PromotionPhaseFailed
+					return promo, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "has not succeeded")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+			},
+		},
+		{
+			name: "Promotion is not the last Promotion of the Stage",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn: getTestPromotionFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					stage := testStage.DeepCopy()
+					stage.Status.LastPromotion.Name = "another-promotion"
+					return stage, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "is not the last Promotion")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+			},
+		},
+		{
+			name: "no preceding Freight",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn: getTestPromotionFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					stage := testStage.DeepCopy()
+					stage.Status.FreightHistory = stage.Status.FreightHistory[:1]
+					return stage, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "has no Freight history preceding")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+			},
+		},
+		{
+			name: "Freight not available",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn:            getTestPromotionFn,
+				getStageFn:                getTestStageFn,
+				getFreightByNameOrAliasFn: getTestFreightFn,
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return false
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "not available to Stage")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "promoting not authorized",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn:            getTestPromotionFn,
+				getStageFn:                getTestStageFn,
+				getFreightByNameOrAliasFn: getTestFreightFn,
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "not authorized")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.UndoPromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getPromotionFn:            getTestPromotionFn,
+				getStageFn:                getTestStageFn,
+				getFreightByNameOrAliasFn: getTestFreightFn,
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.UndoPromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				promo := res.Msg.GetPromotion()
+				require.NotNil(t, promo)
+				require.Equal(t, "fake-stage", promo.Spec.Stage)
+				require.Equal(t, "fake-freight-1", promo.Spec.Freight)
+				require.Equal(t, "fake-promotion", promo.Annotations[kargoapi.AnnotationKeyUndo])
+				require.Len(t, promo.Spec.Steps, 3)
+				require.Equal(t, "git-revert", promo.Spec.Steps[1].Uses)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeNormal, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(1)
+			testCase.server.recorder = recorder
+			res, err := testCase.server.UndoPromotion(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(t, recorder, res, err)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package sops
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgparmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/getsops/sops/v3"
+	sopsage "github.com/getsops/sops/v3/age"
+	"github.com/getsops/sops/v3/keyservice"
+)
+
+const (
+	// AgeKeySuffix is the suffix of the keys of Secret data that hold age
+	// identities.
+	AgeKeySuffix = ".agekey"
+	// PGPKeySuffix is the suffix of the keys of Secret data that hold ASCII
+	// armored PGP private keys.
+	PGPKeySuffix = ".asc"
+)
+
+// Keys are the private keys the data keys of SOPS documents can be decrypted
+// with.
+type Keys struct {
+	ageIdentities []age.Identity
+	pgpKeyRing    openpgp.EntityList
+}
+
+// ParseKeys parses private keys from the data of a Secret. Following the
+// convention established by Flux, values of keys with the .agekey suffix are
+// parsed as age identity files and values of keys with the .asc suffix as
+// ASCII armored PGP private keys. All other values are ignored. An error is
+// returned if no private keys are found.
+func ParseKeys(data map[string][]byte) (*Keys, error) {
+	k := &Keys{}
+	names := make([]string, 0, len(data))
+	for name := range data {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		switch {
+		case strings.HasSuffix(name, AgeKeySuffix):
+			ids, err := age.ParseIdentities(bytes.NewReader(data[name]))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing age identities from %q: %w", name, err)
+			}
+			k.ageIdentities = append(k.ageIdentities, ids...)
+		case strings.HasSuffix(name, PGPKeySuffix):
+			ring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(data[name]))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing PGP keys from %q: %w", name, err)
+			}
+			k.pgpKeyRing = append(k.pgpKeyRing, ring...)
+		}
+	}
+	if len(k.ageIdentities) == 0 && len(k.pgpKeyRing) == 0 {
+		return nil, fmt.Errorf(
+			"no keys with the %s or %s suffix found", AgeKeySuffix, PGPKeySuffix,
+		)
+	}
+	return k, nil
+}
+
+// decryptDataKey decrypts the data key of a document using the first of its
+// master keys that can be decrypted with the Keys. As is the case with the
+// sops CLI, age keys are attempted before PGP keys.
+func (k *Keys) decryptDataKey(md sops.Metadata) ([]byte, error) {
+	if k == nil {
+		return nil, errors.New("no keys were provided to decrypt the data key with")
+	}
+	dataKey, err := md.GetDataKeyWithKeyServices(
+		[]keyservice.KeyServiceClient{keyservice.NewCustomLocalClient(keyService{keys: k})},
+		sops.DefaultDecryptionOrder,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// keyService is a sops key service that decrypts data keys with in-memory
+// Keys only and encrypts data keys for age recipients.
+type keyService struct {
+	keys *Keys
+}
+
+// Encrypt implements keyservice.KeyServiceServer.
+func (s keyService) Encrypt(
+	_ context.Context,
+	req *keyservice.EncryptRequest,
+) (*keyservice.EncryptResponse, error) {
+	ageKey := req.GetKey().GetAgeKey()
+	if ageKey == nil {
+		return nil, errors.New("data keys can only be encrypted for age recipients")
+	}
+	mk, err := sopsage.MasterKeyFromRecipient(ageKey.GetRecipient())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing age recipient %q: %w", ageKey.GetRecipient(), err)
+	}
+	if err = mk.Encrypt(req.GetPlaintext()); err != nil {
+		return nil, err
+	}
+	return &keyservice.EncryptResponse{Ciphertext: mk.EncryptedDataKey()}, nil
+}
+
+// Decrypt implements keyservice.KeyServiceServer.
+func (s keyService) Decrypt(
+	_ context.Context,
+	req *keyservice.DecryptRequest,
+) (*keyservice.DecryptResponse, error) {
+	var plaintext []byte
+	var err error
+	switch key := req.GetKey(); {
+	case key.GetAgeKey() != nil:
+		if len(s.keys.ageIdentities) == 0 {
+			return nil, errors.New("no age identities were provided")
+		}
+		mk := &sopsage.MasterKey{
+			Recipient:    key.GetAgeKey().GetRecipient(),
+			EncryptedKey: string(req.GetCiphertext()),
+		}
+		sopsage.ParsedIdentities(s.keys.ageIdentities).ApplyToMasterKey(mk)
+		plaintext, err = mk.Decrypt()
+	case key.GetPgpKey() != nil:
+		if len(s.keys.pgpKeyRing) == 0 {
+			return nil, errors.New("no PGP keys were provided")
+		}
+		plaintext, err = s.keys.decryptWithPGP(req.GetCiphertext())
+	default:
+		return nil, errors.New("only age and PGP master keys are supported")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &keyservice.DecryptResponse{Plaintext: plaintext}, nil
+}
+
+// decryptWithPGP decrypts an ASCII armored PGP message with the PGP key ring.
+// The sops library only supports decryption using GnuPG or key ring files.
+func (k *Keys) decryptWithPGP(encryptedDataKey []byte) ([]byte, error) {
+	block, err := pgparmor.Decode(bytes.NewReader(encryptedDataKey))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding armored message: %w", err)
+	}
+	msg, err := openpgp.ReadMessage(block.Body, k.pgpKeyRing, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(msg.UnverifiedBody)
+}
@@ -0,0 +1,61 @@
+package sops
+
+import (
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeys(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	pgpKey := readTestFile(t, "pgp.asc")
+
+	testCases := []struct {
+		name       string
+		data       map[string][]byte
+		assertions func(*testing.T, *Keys, error)
+	}{
+		{
+			name: "no keys",
+			data: map[string][]byte{"username": []byte("admin")},
+			assertions: func(t *testing.T, _ *Keys, err error) {
+				require.ErrorContains(t, err, "no keys with the .agekey or .asc suffix found")
+			},
+		},
+		{
+			name: "invalid age identity",
+			data: map[string][]byte{"identity.agekey": []byte("not an identity")},
+			assertions: func(t *testing.T, _ *Keys, err error) {
+				require.ErrorContains(t, err, `error parsing age identities from "identity.agekey"`)
+			},
+		},
+		{
+			name: "invalid PGP key",
+			data: map[string][]byte{"private.asc": []byte("not a key")},
+			assertions: func(t *testing.T, _ *Keys, err error) {
+				require.ErrorContains(t, err, `error parsing PGP keys from "private.asc"`)
+			},
+		},
+		{
+			name: "age and PGP keys",
+			data: map[string][]byte{
+				"identity.agekey": []byte("# created: 2025-01-01T00:00:00Z\n" + identity.String() + "\n"),
+				"private.asc":     pgpKey,
+				"README":          []byte("ignored"),
+			},
+			assertions: func(t *testing.T, keys *Keys, err error) {
+				require.NoError(t, err)
+				require.Len(t, keys.ageIdentities, 1)
+				require.Len(t, keys.pgpKeyRing, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			keys, err := ParseKeys(testCase.data)
+			testCase.assertions(t, keys, err)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Package sops decrypts and encrypts YAML and JSON documents in the SOPS
+// (https://getsops.io) file format using age or PGP keys. It is a thin wrapper
+// around the stores, cipher and tree of the sops library that, unlike the sops
+// CLI, only uses keys that are held in memory and never reads keys from the
+// environment, the file system or a GnuPG keyring.
+package sops
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/getsops/sops/v3"
+	"github.com/getsops/sops/v3/aes"
+	sopsage "github.com/getsops/sops/v3/age"
+	"github.com/getsops/sops/v3/config"
+	"github.com/getsops/sops/v3/keys"
+	"github.com/getsops/sops/v3/keyservice"
+	"github.com/getsops/sops/v3/stores/json"
+	"github.com/getsops/sops/v3/stores/yaml"
+)
+
+// Format is the format of a SOPS document.
+type Format string
+
+const (
+	// FormatYAML is the format of YAML documents.
+	FormatYAML Format = "yaml"
+	// FormatJSON is the format of JSON documents.
+	FormatJSON Format = "json"
+)
+
+// FormatFromPath returns the Format of the document at the specified path,
+// based on its extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	case ".json":
+		return FormatJSON, nil
+	default:
+		return "", fmt.Errorf(
+			"unable to determine format of %q: only .yaml, .yml and .json files are supported",
+			path,
+		)
+	}
+}
+
+// formatVersion is the version of the sops library, which is recorded in the
+// metadata of new documents. It is not read from the version package of the
+// library because that package depends on the sops CLI.
+const formatVersion = "3.9.0"
+
+// now returns the current time. It is overridden in tests.
+var now = time.Now
+
+// storeFor returns the sops store for the specified Format. Like the sops
+// CLI, YAML documents are indented with four spaces and JSON documents with
+// tabs.
+func storeFor(format Format) (sops.Store, error) {
+	switch format {
+	case FormatYAML:
+		return yaml.NewStore(&config.YAMLStoreConfig{}), nil
+	case FormatJSON:
+		return json.NewStore(&config.JSONStoreConfig{Indent: -1}), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// IsEncrypted returns true if the provided bytes are a document of the
+// specified Format that contains SOPS metadata.
+func IsEncrypted(data []byte, format Format) bool {
+	s, err := storeFor(format)
+	if err != nil {
+		return false
+	}
+	branches, err := s.LoadPlainFile(data)
+	if err != nil || len(branches) == 0 {
+		return false
+	}
+	return s.HasSopsTopLevelKey(branches[0])
+}
+
+// Decrypt decrypts the provided SOPS document of the specified Format using
+// the provided Keys and returns the plain text document. An error is returned
+// if the data key of the document cannot be decrypted with any of the Keys or
+// if the MAC of the document does not match its contents.
+func Decrypt(data []byte, format Format, keys *Keys) ([]byte, error) {
+	s, err := storeFor(format)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.LoadEncryptedFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing document: %w", err)
+	}
+	dataKey, err := keys.decryptDataKey(tree.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	if err = decryptTree(&tree, dataKey, aes.NewCipher()); err != nil {
+		return nil, err
+	}
+	out, err := s.EmitPlainFile(tree.Branches)
+	if err != nil {
+		return nil, fmt.Errorf("error writing decrypted document: %w", err)
+	}
+	return out, nil
+}
+
+// EncryptOptions are the options for encrypting a plain text document.
+type EncryptOptions struct {
+	// Reference is an existing SOPS document of the same Format. When
+	// specified, its data key, master keys and encryption rules are reused and
+	// values that did not change retain their ciphertext, which keeps the
+	// differences between both documents to a minimum.
+	Reference []byte
+	// Keys are used to decrypt the data key of the Reference document.
+	Keys *Keys
+	// AgeRecipients are the age recipients a newly generated data key is
+	// encrypted for. These are only used when no Reference is specified.
+	AgeRecipients []string
+	// EncryptedRegex is an optional regular expression that limits encryption
+	// to the values of keys that match it. This is only used when no Reference
+	// is specified. By default, all values except those of keys with the
+	// _unencrypted suffix are encrypted.
+	EncryptedRegex string
+}
+
+// Encrypt encrypts the provided plain text document of the specified Format
+// according to the provided EncryptOptions and returns the SOPS document.
+func Encrypt(data []byte, format Format, opts EncryptOptions) ([]byte, error) {
+	s, err := storeFor(format)
+	if err != nil {
+		return nil, err
+	}
+	branches, err := s.LoadPlainFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing document: %w", err)
+	}
+	for _, branch := range branches {
+		if s.HasSopsTopLevelKey(branch) {
+			return nil, errors.New("document is already encrypted")
+		}
+	}
+
+	cipher := aes.NewCipher()
+	tree := sops.Tree{Branches: branches}
+	var dataKey []byte
+	if opts.Reference != nil {
+		ref, err := s.LoadEncryptedFile(opts.Reference)
+		if err != nil {
+			return nil, fmt.Errorf("error loading reference document: %w", err)
+		}
+		if dataKey, err = opts.Keys.decryptDataKey(ref.Metadata); err != nil {
+			return nil, fmt.Errorf("error decrypting data key of reference document: %w", err)
+		}
+		// Decrypting the reference document stashes the IVs of its values in the
+		// cipher, which allows values that did not change to be encrypted to the
+		// same ciphertext.
+		if err = decryptTree(&ref, dataKey, cipher); err != nil {
+			return nil, fmt.Errorf("error decrypting reference document: %w", err)
+		}
+		tree.Metadata = ref.Metadata
+	} else {
+		if tree.Metadata, err = newMetadata(opts.AgeRecipients, opts.EncryptedRegex); err != nil {
+			return nil, err
+		}
+		var errs []error
+		if dataKey, errs = tree.GenerateDataKeyWithKeyServices(
+			[]keyservice.KeyServiceClient{keyservice.NewCustomLocalClient(keyService{})},
+		); len(errs) > 0 {
+			return nil, fmt.Errorf("error encrypting data key: %w", errors.Join(errs...))
+		}
+	}
+
+	tree.Metadata.LastModified = now().UTC().Truncate(time.Second)
+	mac, err := tree.Encrypt(dataKey, cipher)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting document: %w", err)
+	}
+	if tree.Metadata.MessageAuthenticationCode, err = cipher.Encrypt(
+		mac,
+		dataKey,
+		tree.Metadata.LastModified.Format(time.RFC3339),
+	); err != nil {
+		return nil, fmt.Errorf("error encrypting MAC: %w", err)
+	}
+	out, err := s.EmitEncryptedFile(tree)
+	if err != nil {
+		return nil, fmt.Errorf("error writing encrypted document: %w", err)
+	}
+	return out, nil
+}
+
+// newMetadata returns the metadata of a new document whose data key is
+// encrypted for the provided age recipients.
+func newMetadata(ageRecipients []string, encryptedRegex string) (sops.Metadata, error) {
+	if len(ageRecipients) == 0 {
+		return sops.Metadata{}, errors.New("at least one age recipient must be specified")
+	}
+	group := make(sops.KeyGroup, 0, len(ageRecipients))
+	for _, recipient := range ageRecipients {
+		mk, err := sopsage.MasterKeyFromRecipient(recipient)
+		if err != nil {
+			return sops.Metadata{}, fmt.Errorf("error parsing age recipient %q: %w", recipient, err)
+		}
+		group = append(group, keys.MasterKey(mk))
+	}
+	md := sops.Metadata{
+		KeyGroups: []sops.KeyGroup{group},
+		Version:   formatVersion,
+	}
+	if encryptedRegex != "" {
+		md.EncryptedRegex = encryptedRegex
+	} else {
+		md.UnencryptedSuffix = sops.DefaultUnencryptedSuffix
+	}
+	return md, nil
+}
+
+// decryptTree decrypts all values of the provided tree in place using the
+// provided cipher and verifies its MAC.
+func decryptTree(tree *sops.Tree, dataKey []byte, cipher sops.Cipher) error {
+	mac, err := tree.Decrypt(dataKey, cipher)
+	if err != nil {
+		return fmt.Errorf("error decrypting document: %w", err)
+	}
+	if tree.Metadata.MessageAuthenticationCode == "" {
+		return errors.New("document has no MAC")
+	}
+	storedMAC, err := cipher.Decrypt(
+		tree.Metadata.MessageAuthenticationCode,
+		dataKey,
+		tree.Metadata.LastModified.Format(time.RFC3339),
+	)
+	if err != nil {
+		return fmt.Errorf("error decrypting MAC: %w", err)
+	}
+	if storedMAC != mac {
+		return errors.New("MAC mismatch: the document has been modified without being re-encrypted")
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+package sops
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/require"
+)
+
+// The files in testdata are taken from the sops repository. They are encrypted
+// with the PGP key in testdata/pgp.asc, which the sops project uses for its own
+// functional tests.
+
+func testPGPKeys(t *testing.T) *Keys {
+	t.Helper()
+	key, err := os.ReadFile("testdata/pgp.asc")
+	require.NoError(t, err)
+	keys, err := ParseKeys(map[string][]byte{"sops.asc": key})
+	require.NoError(t, err)
+	return keys
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	t.Helper()
+	b, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return b
+}
+
+// splitMetadata splits a YAML SOPS document with a single branch into its
+// encrypted values and its metadata.
+func splitMetadata(t *testing.T, doc []byte) (string, string) {
+	t.Helper()
+	values, metadata, ok := strings.Cut(string(doc), "\nsops:\n")
+	require.True(t, ok)
+	return values, metadata
+}
+
+// macLine returns the line of the provided metadata that holds the MAC.
+func macLine(t *testing.T, metadata string) string {
+	t.Helper()
+	for _, line := range strings.Split(metadata, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "mac: ") {
+			return line
+		}
+	}
+	require.Fail(t, "metadata has no MAC")
+	return ""
+}
+
+func TestFormatFromPath(t *testing.T) {
+	testCases := []struct {
+		path           string
+		expectedFormat Format
+		expectedErr    string
+	}{
+		{path: "secrets.yaml", expectedFormat: FormatYAML},
+		{path: "path/to/secrets.enc.YML", expectedFormat: FormatYAML},
+		{path: "secrets.json", expectedFormat: FormatJSON},
+		{path: "secrets.env", expectedErr: "only .yaml, .yml and .json files are supported"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.path, func(t *testing.T) {
+			format, err := FormatFromPath(testCase.path)
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedFormat, format)
+		})
+	}
+}
+
+func TestIsEncrypted(t *testing.T) {
+	require.True(t, IsEncrypted(readTestFile(t, "example.enc.yaml"), FormatYAML))
+	require.True(t, IsEncrypted(readTestFile(t, "example.enc.json"), FormatJSON))
+	require.False(t, IsEncrypted([]byte("foo: bar\n"), FormatYAML))
+	require.False(t, IsEncrypted([]byte("not: [valid"), FormatYAML))
+	require.False(t, IsEncrypted(readTestFile(t, "example.enc.yaml"), FormatJSON))
+}
+
+func TestDecrypt(t *testing.T) {
+	pgpKeys := testPGPKeys(t)
+	otherIdentity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	ageKeys, err := ParseKeys(map[string][]byte{"age.agekey": []byte(otherIdentity.String())})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		data       []byte
+		format     Format
+		keys       *Keys
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:   "no metadata",
+			data:   []byte("foo: bar\n"),
+			format: FormatYAML,
+			keys:   pgpKeys,
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "sops metadata not found")
+			},
+		},
+		{
+			name:   "no matching key",
+			data:   readTestFile(t, "example.enc.yaml"),
+			format: FormatYAML,
+			keys:   ageKeys,
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "error decrypting data key")
+			},
+		},
+		{
+			name: "document was tampered with",
+			data: []byte(strings.Replace(
+				string(readTestFile(t, "example.enc.yaml")),
+				"all: going to remain in clear text",
+				"all: modified without re-encrypting",
+				1,
+			)),
+			format: FormatYAML,
+			keys:   pgpKeys,
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "MAC mismatch")
+			},
+		},
+		{
+			name:   "YAML document",
+			data:   readTestFile(t, "example.enc.yaml"),
+			format: FormatYAML,
+			keys:   pgpKeys,
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				for _, expected := range []string{
+					"myapp1: t00m4nys3cr3tzupdated\n",
+					"        user: eve\n        password: c4r1b0u\n" +
+						"        # private key for secret operations in app2\n",
+					"number: 1.234568e+09\n",
+					"somebooleans:\n    - true\n    - false\n",
+					"                value: with something secret in it\n" +
+						"                # sops supports unencrypted fields\n",
+					"            all: going to remain in clear text\n",
+				} {
+					require.Contains(t, string(out), expected)
+				}
+				require.NotContains(t, string(out), "sops:")
+			},
+		},
+		{
+			name:   "JSON document",
+			data:   readTestFile(t, "example.enc.json"),
+			format: FormatJSON,
+			keys:   pgpKeys,
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`{
+	"firstName": "John",
+	"lastName": "Smith",
+	"age": 25.4,
+	"address": {
+		"city": "New York",
+		"postalCode": "10021-3100",
+		"state": "NY",
+		"streetAddress": "21 2nd Street"
+	},
+	"phoneNumbers": [
+		{
+			"number": "212 555-1234",
+			"type": "home"
+		},
+		{
+			"number": "646 555-4567",
+			"type": "office"
+		}
+	],
+	"anEmptyValue": ""
+}`,
+					string(out),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out, err := Decrypt(testCase.data, testCase.format, testCase.keys)
+			testCase.assertions(t, out, err)
+		})
+	}
+}
+
+func TestEncrypt(t *testing.T) {
+	pgpKeys := testPGPKeys(t)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	ageKeys, err := ParseKeys(map[string][]byte{"age.agekey": []byte(identity.String())})
+	require.NoError(t, err)
+
+	encryptedYAML := readTestFile(t, "example.enc.yaml")
+	plainYAML, err := Decrypt(encryptedYAML, FormatYAML, pgpKeys)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		data       []byte
+		format     Format
+		opts       EncryptOptions
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:   "document is already encrypted",
+			data:   encryptedYAML,
+			format: FormatYAML,
+			opts:   EncryptOptions{AgeRecipients: []string{identity.Recipient().String()}},
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "document is already encrypted")
+			},
+		},
+		{
+			name:   "no reference and no recipients",
+			data:   []byte("foo: bar\n"),
+			format: FormatYAML,
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "at least one age recipient must be specified")
+			},
+		},
+		{
+			name:   "reference can not be decrypted",
+			data:   plainYAML,
+			format: FormatYAML,
+			opts:   EncryptOptions{Reference: encryptedYAML, Keys: ageKeys},
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "error decrypting data key of reference document")
+			},
+		},
+		{
+			name:   "unchanged document with reference",
+			data:   plainYAML,
+			format: FormatYAML,
+			opts:   EncryptOptions{Reference: encryptedYAML, Keys: pgpKeys},
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				// The last modification time in the reference is used by the test,
+				// so all values and the MAC are reproduced exactly.
+				outValues, outMetadata := splitMetadata(t, out)
+				refValues, refMetadata := splitMetadata(t, encryptedYAML)
+				require.Equal(t, refValues, outValues)
+				require.Equal(t, macLine(t, refMetadata), macLine(t, outMetadata))
+			},
+		},
+		{
+			name:   "updated document with reference",
+			data:   []byte(strings.Replace(string(plainYAML), "user: eve", "user: mallory", 1)),
+			format: FormatYAML,
+			opts:   EncryptOptions{Reference: encryptedYAML, Keys: pgpKeys},
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				outValues, outMetadata := splitMetadata(t, out)
+				refValues, refMetadata := splitMetadata(t, encryptedYAML)
+				outLines := strings.Split(outValues, "\n")
+				refLines := strings.Split(refValues, "\n")
+				require.Len(t, outLines, len(refLines))
+				var changed []string
+				for i := range outLines {
+					if outLines[i] != refLines[i] {
+						changed = append(changed, strings.TrimSpace(strings.SplitN(outLines[i], ":", 2)[0]))
+					}
+				}
+				// Only the updated value and the MAC differ from the reference.
+				require.Equal(t, []string{"user"}, changed)
+				require.NotEqual(t, macLine(t, refMetadata), macLine(t, outMetadata))
+
+				plain, err := Decrypt(out, FormatYAML, pgpKeys)
+				require.NoError(t, err)
+				require.Contains(t, string(plain), "user: mallory\n")
+			},
+		},
+		{
+			name: "new YAML document",
+			data: []byte(`# Database credentials
+db:
+    user: admin # the admin user
+    password: s3cr3t
+    port: 5432
+    tls: true
+hosts:
+    - db1
+    # a comment in a list
+    - db2
+host_unencrypted: db.example.com
+---
+second: document
+`),
+			format: FormatYAML,
+			opts:   EncryptOptions{AgeRecipients: []string{identity.Recipient().String()}},
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				require.NotContains(t, string(out), "s3cr3t")
+				require.NotContains(t, string(out), "Database credentials")
+				require.Contains(t, string(out), "host_unencrypted: db.example.com\n")
+				require.Contains(t, string(out), "recipient: "+identity.Recipient().String()+"\n")
+				require.Contains(t, string(out), "unencrypted_suffix: _unencrypted\n")
+				require.Contains(t, string(out), "version: "+formatVersion+"\n")
+				require.Equal(t, 2, strings.Count(string(out), "\nsops:\n"))
+
+				plain, err := Decrypt(out, FormatYAML, ageKeys)
+				require.NoError(t, err)
+				// Like the sops CLI, the sops library moves inline comments to the
+				// line above the value they belong to.
+				require.Equal(
+					t,
+					`# Database credentials
+db:
+    # the admin user
+    user: admin
+    password: s3cr3t
+    port: 5432
+    tls: true
+hosts:
+    - db1
+    # a comment in a list
+    - db2
+host_unencrypted: db.example.com
+---
+second: document
+`,
+					string(plain),
+				)
+			},
+		},
+		{
+			name:   "new JSON document with encrypted regex",
+			data:   []byte(`{"name": "app", "password": "s3cr3t", "nested": {"token": 42}, "list": [1.5, false]}`),
+			format: FormatJSON,
+			opts: EncryptOptions{
+				AgeRecipients:  []string{identity.Recipient().String()},
+				EncryptedRegex: "^(password|token)$",
+			},
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				require.Contains(t, string(out), `"name": "app"`)
+				require.Contains(t, string(out), `"password": "ENC[AES256_GCM,`)
+				require.Contains(t, string(out), `"token": "ENC[AES256_GCM,`)
+				require.Contains(t, string(out), `type:float]`)
+				require.Contains(t, string(out), `"encrypted_regex": "^(password|token)$"`)
+
+				plain, err := Decrypt(out, FormatJSON, ageKeys)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"{\n\t\"name\": \"app\",\n\t\"password\": \"s3cr3t\",\n\t\"nested\": {\n\t\t\"token\": 42\n"+
+						"\t},\n\t\"list\": [\n\t\t1.5,\n\t\tfalse\n\t]\n}",
+					string(plain),
+				)
+			},
+		},
+	}
+
+	lastModified, err := time.Parse(time.RFC3339, "2025-08-06T18:55:05Z")
+	require.NoError(t, err)
+	now = func() time.Time { return lastModified }
+	t.Cleanup(func() { now = time.Now })
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out, err := Encrypt(testCase.data, testCase.format, testCase.opts)
+			testCase.assertions(t, out, err)
+		})
+	}
+}
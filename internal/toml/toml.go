@@ -0,0 +1,272 @@
+package toml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+)
+
+// Update represents a discrete update to be made to a TOML document.
+type Update struct {
+	// Key is the dot-separated path to the value to update.
+	Key string
+	// Value is the new value to set for the key.
+	Value any
+}
+
+// SetValuesInFile overwrites the specified file with the changes specified by
+// the list of Updates. Keys are of the form <key 0>.<key 1>...<key n>. Integers
+// may be used as keys in cases where a specific element needs to be selected
+// from an array or an array of tables. An error is returned for any attempted
+// update to a key that does not exist or does not address a scalar value.
+// Importantly, all comments and style choices in the file are preserved.
+func SetValuesInFile(file string, updates []Update) error {
+	inBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	outBytes, err := SetValuesInBytes(inBytes, updates)
+	if err != nil {
+		return fmt.Errorf("error mutating bytes: %w", err)
+	}
+	// This file should always exist already, so the permissions we choose here
+	// don't really matter.
+	if err = os.WriteFile(file, outBytes, 0600); err != nil {
+		return fmt.Errorf("error writing mutated bytes to file %q: %w", file, err)
+	}
+	return nil
+}
+
+// SetValuesInBytes returns a copy of the provided bytes with the changes
+// specified by Updates applied. Keys are of the form <key 0>.<key 1>...<key n>.
+// Integers may be used as keys in cases where a specific element needs to be
+// selected from an array or an array of tables. An error is returned for any
+// attempted update to a key that does not exist or does not address a scalar
+// value. Importantly, all comments and style choices in the input bytes are
+// preserved in the output.
+func SetValuesInBytes(inBytes []byte, updates []Update) ([]byte, error) {
+	if err := toml.Unmarshal(inBytes, &map[string]any{}); err != nil {
+		return nil, fmt.Errorf("error unmarshaling input: %w", err)
+	}
+	values, err := findScalarValues(inBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing input: %w", err)
+	}
+
+	changes := make(map[uint32]change, len(updates))
+	for _, update := range updates {
+		value, ok := values[update.Key]
+		if !ok {
+			return nil, fmt.Errorf(
+				"error finding key %s: key path not found or does not address a scalar value",
+				update.Key,
+			)
+		}
+		newValue, err := formatValue(update.Value, value)
+		if err != nil {
+			return nil, fmt.Errorf("error formatting value for key %s: %w", update.Key, err)
+		}
+		changes[value.raw.Offset] = change{raw: value.raw, value: newValue}
+	}
+
+	// Apply the changes from the end of the input to its beginning, so the
+	// offsets of changes that are yet to be applied remain valid.
+	offsets := make([]uint32, 0, len(changes))
+	for offset := range changes {
+		offsets = append(offsets, offset)
+	}
+	slices.Sort(offsets)
+	outBytes := bytes.Clone(inBytes)
+	for _, offset := range slices.Backward(offsets) {
+		c := changes[offset]
+		outBytes = slices.Replace(
+			outBytes,
+			int(c.raw.Offset),
+			int(c.raw.Offset+c.raw.Length),
+			[]byte(c.value)...,
+		)
+	}
+	return outBytes, nil
+}
+
+// change represents the replacement of the bytes in a range of the input.
+type change struct {
+	raw   unstable.Range
+	value string
+}
+
+// scalarValue describes a scalar value in a TOML document.
+type scalarValue struct {
+	kind unstable.Kind
+	raw  unstable.Range
+	// data is the raw representation of the value in the document.
+	data []byte
+}
+
+// findScalarValues returns all scalar values in the provided TOML document,
+// indexed by their dot-separated key paths.
+func findScalarValues(doc []byte) (map[string]scalarValue, error) {
+	values := map[string]scalarValue{}
+	// arrayTables tracks the number of tables in each array of tables, indexed
+	// by the key path of the array.
+	arrayTables := map[string]int{}
+	var tablePath []string
+
+	p := &unstable.Parser{}
+	p.Reset(doc)
+	for p.NextExpression() {
+		expr := p.Expression()
+		switch expr.Kind {
+		case unstable.Table:
+			tablePath = resolveTablePath(keyParts(expr), arrayTables)
+		case unstable.ArrayTable:
+			parts := keyParts(expr)
+			tablePath = resolveTablePath(parts[:len(parts)-1], arrayTables)
+			tablePath = append(tablePath, parts[len(parts)-1])
+			arrayPath := strings.Join(tablePath, ".")
+			tablePath = append(tablePath, strconv.Itoa(arrayTables[arrayPath]))
+			arrayTables[arrayPath]++
+		case unstable.KeyValue:
+			collectScalarValues(
+				p,
+				expr.Value(),
+				append(slices.Clone(tablePath), keyParts(expr)...),
+				values,
+			)
+		}
+	}
+	if err := p.Error(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// resolveTablePath returns the key path of the table with the provided key
+// parts, in which any array of tables is addressed by the index of its last
+// table.
+func resolveTablePath(parts []string, arrayTables map[string]int) []string {
+	path := make([]string, 0, len(parts))
+	for _, part := range parts {
+		path = append(path, part)
+		if count, ok := arrayTables[strings.Join(path, ".")]; ok {
+			path = append(path, strconv.Itoa(count-1))
+		}
+	}
+	return path
+}
+
+// collectScalarValues adds all scalar values in the provided value node to the
+// provided map, indexed by their dot-separated key paths.
+func collectScalarValues(
+	p *unstable.Parser,
+	node *unstable.Node,
+	path []string,
+	values map[string]scalarValue,
+) {
+	switch node.Kind {
+	case unstable.InlineTable:
+		it := node.Children()
+		for it.Next() {
+			kv := it.Node()
+			collectScalarValues(
+				p,
+				kv.Value(),
+				append(slices.Clone(path), keyParts(kv)...),
+				values,
+			)
+		}
+	case unstable.Array:
+		it := node.Children()
+		for i := 0; it.Next(); i++ {
+			collectScalarValues(
+				p,
+				it.Node(),
+				append(slices.Clone(path), strconv.Itoa(i)),
+				values,
+			)
+		}
+	default:
+		raw := node.Raw
+		if raw.Length == 0 {
+			// Not all scalar nodes have their raw range set, but the data of those
+			// that do not references the input.
+			raw = p.Range(node.Data)
+		}
+		values[strings.Join(path, ".")] = scalarValue{
+			kind: node.Kind,
+			raw:  raw,
+			data: p.Raw(raw),
+		}
+	}
+}
+
+// keyParts returns the parts of the key of the provided KeyValue, Table or
+// ArrayTable node.
+func keyParts(node *unstable.Node) []string {
+	var parts []string
+	it := node.Key()
+	for it.Next() {
+		parts = append(parts, string(it.Node().Data))
+	}
+	return parts
+}
+
+// formatValue returns the TOML representation of the provided value, which is
+// to replace the provided existing value. Where possible, the style of the
+// existing value is retained.
+func formatValue(value any, existing scalarValue) (string, error) {
+	switch v := value.(type) {
+	case string:
+		// Retain literal strings, unless the new value can not be represented as
+		// one.
+		if bytes.HasPrefix(existing.data, []byte("'")) &&
+			!bytes.HasPrefix(existing.data, []byte("'''")) &&
+			!strings.ContainsAny(v, "'\r\n") {
+			return "'" + v + "'", nil
+		}
+		buf := &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return formatFloat(float64(v), existing.kind), nil
+	case float64:
+		return formatFloat(v, existing.kind), nil
+	default:
+		return "", fmt.Errorf("value of type %T is not a scalar", value)
+	}
+}
+
+// formatFloat returns the TOML representation of the provided float. Floats
+// without a fractional part are represented as integers unless they replace a
+// float, as numbers decoded from JSON are always floats.
+func formatFloat(f float64, existingKind unstable.Kind) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	case math.IsNaN(f):
+		return "nan"
+	}
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if existingKind == unstable.Float && !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s
+}
@@ -0,0 +1,150 @@
+package toml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetValuesInBytes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		updates    []Update
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:    "invalid TOML",
+			inBytes: []byte(`name = `),
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error unmarshaling input")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "key not found",
+			inBytes: []byte(`
+[image]
+tag = "v1.0.0"
+`),
+			updates: []Update{{Key: "image.digest", Value: "sha256:abc"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error finding key image.digest")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "key addresses a table",
+			inBytes: []byte(`
+[image]
+tag = "v1.0.0"
+`),
+			updates: []Update{{Key: "image", Value: "v2.0.0"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "does not address a scalar value")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "value is not a scalar",
+			inBytes: []byte(`
+[image]
+tag = "v1.0.0"
+`),
+			updates: []Update{{Key: "image.tag", Value: []string{"v2.0.0"}}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "is not a scalar")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "success",
+			inBytes: []byte(`# Service configuration
+name = "api" # The name of the service
+
+[image]
+# The image to deploy
+repository = 'ghcr.io/example/api'
+tag   =   "v1.0.0"
+replicas = 1
+ratio = 0.5
+enabled = false
+resources = { cpu = "100m", memory = "128Mi" }
+
+[[sidecars]]
+name = "proxy"
+ports = [8080, 8081]
+
+[[sidecars]]
+name = "logger"
+
+[sidecars.image]
+tag = "v0.1.0"
+`),
+			updates: []Update{
+				{Key: "name", Value: "web"},
+				{Key: "image.repository", Value: "ghcr.io/example/web"},
+				{Key: "image.tag", Value: "v2.0.0"},
+				{Key: "image.replicas", Value: float64(3)},
+				{Key: "image.ratio", Value: float64(1)},
+				{Key: "image.enabled", Value: true},
+				{Key: "image.resources.memory", Value: "256Mi"},
+				{Key: "sidecars.0.ports.1", Value: 9090},
+				{Key: "sidecars.1.image.tag", Value: "v0.2.0"},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`# Service configuration
+name = "web" # The name of the service
+
+[image]
+# The image to deploy
+repository = 'ghcr.io/example/web'
+tag   =   "v2.0.0"
+replicas = 3
+ratio = 1.0
+enabled = true
+resources = { cpu = "100m", memory = "256Mi" }
+
+[[sidecars]]
+name = "proxy"
+ports = [8080, 9090]
+
+[[sidecars]]
+name = "logger"
+
+[sidecars.image]
+tag = "v0.2.0"
+`,
+					string(bytes),
+				)
+			},
+		},
+		{
+			name:    "literal string is replaced with a basic string when necessary",
+			inBytes: []byte(`message = 'hello'`),
+			updates: []Update{{Key: "message", Value: `it's "here"`}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `message = "it's \"here\""`, string(bytes))
+			},
+		},
+		{
+			name:    "dotted keys",
+			inBytes: []byte(`image.tag = "v1.0.0"`),
+			updates: []Update{{Key: "image.tag", Value: "v2.0.0"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `image.tag = "v2.0.0"`, string(bytes))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := SetValuesInBytes(testCase.inBytes, testCase.updates)
+			testCase.assertions(t, b, err)
+		})
+	}
+}
@@ -184,7 +184,9 @@ func (w *webhook) ValidateCreate(
 		)
 	}
 
-	if len(freight.Commits) == 0 && len(freight.Images) == 0 && len(freight.Charts) == 0 {
+	if len(freight.Commits) == 0 && len(freight.Images) == 0 &&
+		len(freight.Charts) == 0 && len(freight.OCIArtifacts) == 0 &&
+		len(freight.Releases) == 0 {
 		return nil, apierrors.NewInvalid(
 			freightGroupKind,
 			freight.Name,
@@ -192,7 +194,7 @@ func (w *webhook) ValidateCreate(
 				field.Invalid(
 					field.NewPath(""),
 					freight,
-					"freight must contain at least one commit, image, or chart",
+					"freight must contain at least one commit, image, chart, OCI artifact, or release",
 				),
 			},
 		)
@@ -345,6 +347,10 @@ func (a artifactType) FreightPath() string {
 		return "images"
 	case artifactTypeChart:
 		return "charts"
+	case artifactTypeOCIArtifact:
+		return "ociArtifacts"
+	case artifactTypeRelease:
+		return "releases"
 	default:
 		return ""
 	}
@@ -354,6 +360,9 @@ const (
 	artifactTypeGit   artifactType = "git"
 	artifactTypeImage artifactType = "image"
 	artifactTypeChart artifactType = "chart"
+
+	artifactTypeOCIArtifact artifactType = "ociArtifact"
+	artifactTypeRelease     artifactType = "release"
 )
 
 type artifactSubscription struct {
@@ -394,6 +403,18 @@ func validateFreightArtifacts(
 				Type: artifactTypeChart,
 			}] = false
 		}
+		if repo.OCIArtifact != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.OCIArtifact.RepoURL,
+				Type: artifactTypeOCIArtifact,
+			}] = false
+		}
+		if repo.ReleaseFeed != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.ReleaseFeed.URL,
+				Type: artifactTypeRelease,
+			}] = false
+		}
 	}
 
 	// Mark the subscription as found for each artifact in the Freight, and count
@@ -464,6 +485,50 @@ func validateFreightArtifacts(
 			},
 		)
 	}
+	for _, artifact := range freight.OCIArtifacts {
+		sub := artifactSubscription{
+			URL:  artifact.RepoURL,
+			Type: artifactTypeOCIArtifact,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("ociArtifacts"),
+					artifact,
+					fmt.Sprintf("no subscription found for OCI artifact repository in Warehouse %q", warehouse.Name),
+				),
+			},
+		)
+	}
+	for _, release := range freight.Releases {
+		sub := artifactSubscription{
+			URL:  release.URL,
+			Type: artifactTypeRelease,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("releases"),
+					release,
+					fmt.Sprintf("no subscription found for release feed in Warehouse %q", warehouse.Name),
+				),
+			},
+		)
+	}
 
 	// Check that each subscription is found exactly once.
 	for sub, found := range subscriptions {
@@ -539,5 +604,23 @@ func compareFreight(existing, updated *kargoapi.Freight) (*field.Path, any, bool
 		}
 	}
 
+	if len(existing.OCIArtifacts) != len(updated.OCIArtifacts) {
+		return field.NewPath("ociArtifacts"), updated.OCIArtifacts, false
+	}
+	for i, artifact := range existing.OCIArtifacts {
+		if !artifact.DeepEquals(&updated.OCIArtifacts[i]) {
+			return field.NewPath("ociArtifacts").Index(i), updated.OCIArtifacts[i], false
+		}
+	}
+
+	if len(existing.Releases) != len(updated.Releases) {
+		return field.NewPath("releases"), updated.Releases, false
+	}
+	for i, release := range existing.Releases {
+		if !release.DeepEquals(&updated.Releases[i]) {
+			return field.NewPath("releases").Index(i), updated.Releases[i], false
+		}
+	}
+
 	return nil, nil, true
 }
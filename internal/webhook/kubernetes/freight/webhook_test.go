@@ -273,7 +273,7 @@ func TestValidateCreate(t *testing.T) {
 			},
 			assertions: func(t *testing.T, err error) {
 				require.ErrorContains(
-					t, err, "freight must contain at least one commit, image, or chart",
+					t, err, "freight must contain at least one commit, image, chart, OCI artifact, or release",
 				)
 			},
 		},
@@ -964,6 +964,34 @@ func TestValidateFreightArtifacts(t *testing.T) {
 				require.ErrorContains(t, err, "no subscription found for Helm chart in Warehouse")
 			},
 		},
+		{
+			name: "Freight with OCI artifact not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				OCIArtifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-repo-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no subscription found for OCI artifact repository in Warehouse")
+			},
+		},
+		{
+			name: "Freight with release not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				Releases: []kargoapi.Release{
+					{
+						URL: "https://fake-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no subscription found for release feed in Warehouse")
+			},
+		},
 		{
 			name: "success",
 			freight: &kargoapi.Freight{
@@ -996,6 +1024,16 @@ func TestValidateFreightArtifacts(t *testing.T) {
 						RepoURL: "fake-another-chart-repo-url",
 					},
 				},
+				OCIArtifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-artifact-repo-url",
+					},
+				},
+				Releases: []kargoapi.Release{
+					{
+						URL: "https://fake-release-feed-url",
+					},
+				},
 			},
 			warehouse: &kargoapi.Warehouse{
 				Spec: kargoapi.WarehouseSpec{
@@ -1037,6 +1075,16 @@ func TestValidateFreightArtifacts(t *testing.T) {
 								RepoURL: "fake-another-chart-repo-url",
 							},
 						},
+						{
+							OCIArtifact: &kargoapi.OCIArtifactSubscription{
+								RepoURL: "fake-artifact-repo-url",
+							},
+						},
+						{
+							ReleaseFeed: &kargoapi.ReleaseFeedSubscription{
+								URL: "https://fake-release-feed-url",
+							},
+						},
 					},
 				},
 			},
@@ -1164,6 +1212,46 @@ func TestCompareFreight(t *testing.T) {
 				require.False(t, eq)
 			},
 		},
+		{
+			name: "different number of OCI artifacts",
+			old:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1"}}},
+			new:  &kargoapi.Freight{},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("ociArtifacts"), path)
+				require.Equal(t, freight.OCIArtifacts, val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different OCI artifact contents",
+			old:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Digest: "digest1"}}},
+			new:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Digest: "digest2"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("ociArtifacts").Index(0), path)
+				require.Equal(t, freight.OCIArtifacts[0], val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different number of releases",
+			old:  &kargoapi.Freight{Releases: []kargoapi.Release{{URL: "release1"}}},
+			new:  &kargoapi.Freight{},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("releases"), path)
+				require.Equal(t, freight.Releases, val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different release contents",
+			old:  &kargoapi.Freight{Releases: []kargoapi.Release{{URL: "release1", Version: "v1.0.0"}}},
+			new:  &kargoapi.Freight{Releases: []kargoapi.Release{{URL: "release1", Version: "v1.1.0"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("releases").Index(0), path)
+				require.Equal(t, freight.Releases[0], val)
+				require.False(t, eq)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,9 +3,11 @@ package warehouse
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/expr-lang/expr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -18,6 +20,7 @@ import (
 	"github.com/akuity/kargo/internal/git"
 	"github.com/akuity/kargo/internal/helm"
 	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/pattern"
 	libWebhook "github.com/akuity/kargo/internal/webhook/kubernetes"
 )
 
@@ -128,7 +131,29 @@ func (w *webhook) validateSpec(
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	return w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	if spec.FreightCreationCriteria != nil {
+		errs = append(
+			errs,
+			w.validateFreightCreationCriteria(
+				f.Child("freightCreationCriteria"),
+				*spec.FreightCreationCriteria,
+			)...,
+		)
+	}
+	return errs
+}
+
+func (w *webhook) validateFreightCreationCriteria(
+	f *field.Path,
+	criteria kargoapi.FreightCreationCriteria,
+) field.ErrorList {
+	if _, err := expr.Compile(criteria.Expression, expr.AsBool()); err != nil {
+		return field.ErrorList{
+			field.Invalid(f.Child("expression"), criteria.Expression, err.Error()),
+		}
+	}
+	return nil
 }
 
 func (w *webhook) validateSubs(
@@ -165,6 +190,20 @@ func (w *webhook) validateSub(
 		repoTypes++
 		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart, seen)...)
 	}
+	if sub.OCIArtifact != nil {
+		repoTypes++
+		errs = append(
+			errs,
+			w.validateOCIArtifactSub(f.Child("ociArtifact"), *sub.OCIArtifact, seen)...,
+		)
+	}
+	if sub.ReleaseFeed != nil {
+		repoTypes++
+		errs = append(
+			errs,
+			w.validateReleaseFeedSub(f.Child("releaseFeed"), *sub.ReleaseFeed, seen)...,
+		)
+	}
 	if repoTypes != 1 {
 		errs = append(
 			errs,
@@ -172,7 +211,9 @@ func (w *webhook) validateSub(
 				f,
 				sub,
 				fmt.Sprintf(
-					"exactly one of %s.git, %s.image, or %s.chart must be non-empty",
+					"exactly one of %s.git, %s.image, %s.chart, %s.ociArtifact, or %s.releaseFeed must be non-empty",
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -195,6 +236,40 @@ func (w *webhook) validateGitSub(
 	); err != nil {
 		errs = append(errs, err)
 	}
+	if sub.FreightPerBranch && sub.BranchPattern == "" {
+		errs = append(
+			errs,
+			field.Invalid(f.Child("freightPerBranch"), sub.FreightPerBranch, "must be false if branchPattern is empty"),
+		)
+	}
+	if sub.BranchPattern != "" {
+		if sub.Branch != "" {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("branchPattern"), sub.BranchPattern, "must be empty if branch is set"),
+			)
+		}
+		if sub.CommitSelectionStrategy != "" &&
+			sub.CommitSelectionStrategy != kargoapi.CommitSelectionStrategyNewestFromBranch {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("branchPattern"),
+					sub.BranchPattern,
+					fmt.Sprintf(
+						"must be empty if commitSelectionStrategy is not %s",
+						kargoapi.CommitSelectionStrategyNewestFromBranch,
+					),
+				),
+			)
+		}
+		if _, err := pattern.ParseGlobPattern(sub.BranchPattern); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("branchPattern"), sub.BranchPattern, err.Error()),
+			)
+		}
+	}
 	if err := seen.addGit(sub, f); err != nil {
 		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
 	}
@@ -218,12 +293,89 @@ func (w *webhook) validateImageSub(
 			errs = append(errs, field.Invalid(f.Child("platform"), sub.Platform, ""))
 		}
 	}
+	if sub.ExpressionFilter != "" {
+		if err := image.ValidateExpressionFilter(sub.ExpressionFilter); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("expressionFilter"), sub.ExpressionFilter, err.Error()),
+			)
+		}
+	}
 	if err := seen.addImage(sub, f); err != nil {
 		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
 	}
 	return errs
 }
 
+func (w *webhook) validateOCIArtifactSub(
+	f *field.Path,
+	sub kargoapi.OCIArtifactSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	// The Digest selection strategy uses the SemverConstraint field to specify
+	// a tag rather than a semver constraint.
+	if sub.SelectionStrategy != kargoapi.ImageSelectionStrategyDigest {
+		if err := validateSemverConstraint(
+			f.Child("semverConstraint"),
+			sub.SemverConstraint,
+		); err != nil {
+			errs = field.ErrorList{err}
+		}
+	}
+	if err := seen.addOCIArtifact(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func (w *webhook) validateReleaseFeedSub(
+	f *field.Path,
+	sub kargoapi.ReleaseFeedSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if sub.SelectionStrategy != kargoapi.ReleaseFeedSelectionStrategyLexical {
+		if err := validateSemverConstraint(
+			f.Child("semverConstraint"),
+			sub.SemverConstraint,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if sub.AllowVersions != "" {
+		if _, err := regexp.Compile(sub.AllowVersions); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("allowVersions"), sub.AllowVersions, err.Error()),
+			)
+		}
+	}
+	if _, err := expr.Compile(sub.VersionsFromExpression); err != nil {
+		errs = append(
+			errs,
+			field.Invalid(f.Child("versionsFromExpression"), sub.VersionsFromExpression, err.Error()),
+		)
+	}
+	names := make(map[string]struct{}, len(sub.Metadata))
+	for i, m := range sub.Metadata {
+		if _, exists := names[m.Name]; exists {
+			errs = append(errs, field.Duplicate(f.Child("metadata").Index(i).Child("name"), m.Name))
+		}
+		names[m.Name] = struct{}{}
+		if _, err := expr.Compile(m.FromExpression); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("metadata").Index(i).Child("fromExpression"), m.FromExpression, err.Error()),
+			)
+		}
+	}
+	if err := seen.addReleaseFeed(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.URL, err.Error()))
+	}
+	return errs
+}
+
 func (w *webhook) validateChartSub(
 	f *field.Path,
 	sub kargoapi.ChartSubscription,
@@ -236,6 +388,12 @@ func (w *webhook) validateChartSub(
 	); err != nil {
 		errs = append(errs, err)
 	}
+	if err := validateSemverConstraint(
+		f.Child("appVersionConstraint"),
+		sub.AppVersionConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
 	if strings.HasPrefix(sub.RepoURL, "oci://") && sub.Name != "" {
 		errs = append(
 			errs,
@@ -304,6 +462,24 @@ func (s uniqueSubSet) addImage(sub kargoapi.ImageSubscription, p *field.Path) er
 	return nil
 }
 
+func (s uniqueSubSet) addOCIArtifact(sub kargoapi.OCIArtifactSubscription, p *field.Path) error {
+	k := subscriptionKey{kind: "ociArtifact", id: helm.NormalizeChartRepositoryURL(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for artifact repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addReleaseFeed(sub kargoapi.ReleaseFeedSubscription, p *field.Path) error {
+	k := subscriptionKey{kind: "releaseFeed", id: sub.URL}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for release feed already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
 func (s uniqueSubSet) addChart(sub kargoapi.ChartSubscription, isHTTP bool, p *field.Path) error {
 	k := subscriptionKey{kind: "chart", id: helm.NormalizeChartRepositoryURL(sub.RepoURL)}
 	if isHTTP {
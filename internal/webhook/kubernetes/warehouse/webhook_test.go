@@ -301,8 +301,9 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.subscriptions[0]",
 							BadValue: spec.Subscriptions[0],
 							Detail: "exactly one of spec.subscriptions[0].git, " +
-								"spec.subscriptions[0].image, or spec.subscriptions[0].chart " +
-								"must be non-empty",
+								"spec.subscriptions[0].image, spec.subscriptions[0].chart, " +
+								"spec.subscriptions[0].ociArtifact, or " +
+								"spec.subscriptions[0].releaseFeed must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -315,6 +316,43 @@ func TestValidateSpec(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "invalid Freight creation criteria",
+			spec: kargoapi.WarehouseSpec{
+				FreightCreationCriteria: &kargoapi.FreightCreationCriteria{
+					Expression: `imageFrom("example.com/app").Tag ==`,
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "spec.freightCreationCriteria.expression", errs[0].Field)
+			},
+		},
+		{
+			name: "non-boolean Freight creation criteria",
+			spec: kargoapi.WarehouseSpec{
+				FreightCreationCriteria: &kargoapi.FreightCreationCriteria{
+					Expression: `"true"`,
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.freightCreationCriteria.expression", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "expected bool")
+			},
+		},
+		{
+			name: "valid Freight creation criteria",
+			spec: kargoapi.WarehouseSpec{
+				FreightCreationCriteria: &kargoapi.FreightCreationCriteria{
+					Expression: `imageFrom("example.com/app").Tag == commitFrom("https://github.com/example/app.git").Tag`,
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
 		{
 			name: "valid",
 			spec: kargoapi.WarehouseSpec{
@@ -399,8 +437,8 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0]",
 							BadValue: subs[0],
-							Detail: "exactly one of subs[0].git, subs[0].image, or " +
-								"subs[0].chart must be non-empty",
+							Detail: "exactly one of subs[0].git, subs[0].image, subs[0].chart, " +
+								"subs[0].ociArtifact, or subs[0].releaseFeed must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -492,7 +530,8 @@ func TestValidateSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub",
 							BadValue: sub,
-							Detail:   "exactly one of sub.git, sub.image, or sub.chart must be non-empty",
+							Detail: "exactly one of sub.git, sub.image, sub.chart, " +
+								"sub.ociArtifact, or sub.releaseFeed must be non-empty",
 						},
 					},
 					errs,
@@ -562,6 +601,52 @@ func TestValidateGitSub(t *testing.T) {
 			},
 		},
 
+		{
+			name: "invalid branch pattern",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "https://github.com/example/repo.git",
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				Branch:                  "main",
+				BranchPattern:           "regexp:[",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 3)
+				require.Equal(t, "git.branchPattern", errs[0].Field)
+				require.Equal(t, "must be empty if branch is set", errs[0].Detail)
+				require.Equal(t, "git.branchPattern", errs[1].Field)
+				require.Equal(t, "must be empty if commitSelectionStrategy is not NewestFromBranch", errs[1].Detail)
+				require.Equal(t, "git.branchPattern", errs[2].Field)
+				require.Contains(t, errs[2].Detail, "error parsing regexp")
+			},
+		},
+		{
+			name: "freight per branch without branch pattern",
+			sub: kargoapi.GitSubscription{
+				RepoURL:          "https://github.com/example/repo.git",
+				FreightPerBranch: true,
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "git.freightPerBranch", errs[0].Field)
+				require.Equal(t, "must be false if branchPattern is empty", errs[0].Detail)
+			},
+		},
+		{
+			name: "valid branch pattern",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "https://github.com/example/repo.git",
+				BranchPattern:           "release/*",
+				BranchSelectionStrategy: kargoapi.BranchSelectionStrategySemVer,
+				SemverConstraint:        "^1.0.0",
+				FreightPerBranch:        true,
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
 		{
 			name: "valid",
 			seen: uniqueSubSet{},
@@ -631,8 +716,26 @@ func TestValidateImageSub(t *testing.T) {
 			},
 		},
 
+		{
+			name: "invalid expression filter",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:          "fake-repo",
+				ExpressionFilter: `label("env") ==`,
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "image.expressionFilter", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "error compiling expression filter")
+			},
+		},
+
 		{
 			name: "valid",
+			sub: kargoapi.ImageSubscription{
+				ExpressionFilter: `label("env") == "release"`,
+			},
 			seen: uniqueSubSet{},
 			assertions: func(t *testing.T, errs field.ErrorList) {
 				require.Nil(t, errs)
@@ -654,6 +757,183 @@ func TestValidateImageSub(t *testing.T) {
 	}
 }
 
+func TestValidateOCIArtifactSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCIArtifactSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL:          "bogus",
+				SemverConstraint: "bogus",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "ociArtifact",
+					id:   "bogus",
+				}: field.NewPath("spec.subscriptions[0].ociArtifact"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "ociArtifact.semverConstraint",
+							BadValue: "bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "ociArtifact",
+							BadValue: "bogus",
+							Detail: "subscription for artifact repository already exists at " +
+								"\"spec.subscriptions[0].ociArtifact\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid with tag for Digest selection strategy",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL:           "fake-repo",
+				SelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				SemverConstraint:  "latest",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "valid",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL:          "fake-repo",
+				SemverConstraint: "^1.0.0",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateOCIArtifactSub(
+					field.NewPath("ociArtifact"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateReleaseFeedSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.ReleaseFeedSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.ReleaseFeedSubscription{
+				URL:                    "https://fake-url",
+				SemverConstraint:       "bogus",
+				AllowVersions:          "(",
+				VersionsFromExpression: "response.body.(",
+				Metadata: []kargoapi.ReleaseFeedMetadata{
+					{Name: "notes", FromExpression: "response.body.notes"},
+					{Name: "notes", FromExpression: "response.body.("},
+				},
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "releaseFeed",
+					id:   "https://fake-url",
+				}: field.NewPath("spec.subscriptions[0].releaseFeed"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 6)
+				require.Equal(t, "releaseFeed.semverConstraint", errs[0].Field)
+				require.Equal(t, "releaseFeed.allowVersions", errs[1].Field)
+				require.Equal(t, "releaseFeed.versionsFromExpression", errs[2].Field)
+				require.Equal(t, "releaseFeed.metadata[1].name", errs[3].Field)
+				require.Equal(t, field.ErrorTypeDuplicate, errs[3].Type)
+				require.Equal(t, "releaseFeed.metadata[1].fromExpression", errs[4].Field)
+				require.Equal(
+					t,
+					&field.Error{
+						Type:     field.ErrorTypeInvalid,
+						Field:    "releaseFeed",
+						BadValue: "https://fake-url",
+						Detail: "subscription for release feed already exists at " +
+							"\"spec.subscriptions[0].releaseFeed\"",
+					},
+					errs[5],
+				)
+			},
+		},
+
+		{
+			name: "semver constraint ignored for Lexical selection strategy",
+			sub: kargoapi.ReleaseFeedSubscription{
+				URL:                    "https://fake-url",
+				SelectionStrategy:      kargoapi.ReleaseFeedSelectionStrategyLexical,
+				SemverConstraint:       "bogus",
+				VersionsFromExpression: "response.body",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "valid",
+			sub: kargoapi.ReleaseFeedSubscription{
+				URL:                    "https://fake-url",
+				SemverConstraint:       "^1.0.0",
+				AllowVersions:          "^v",
+				VersionsFromExpression: "map(response.body.releases, .version)",
+				Metadata: []kargoapi.ReleaseFeedMetadata{
+					{
+						Name:           "notes",
+						FromExpression: "first(filter(response.body.releases, .version == version)).notes",
+					},
+				},
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateReleaseFeedSub(
+					field.NewPath("releaseFeed"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateChartSub(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -662,11 +942,12 @@ func TestValidateChartSub(t *testing.T) {
 		assertions func(*testing.T, field.ErrorList)
 	}{
 		{
-			name: "invalid semverConstraint and oci repoURL with name",
+			name: "invalid semverConstraint, appVersionConstraint, and oci repoURL with name",
 			sub: kargoapi.ChartSubscription{
-				RepoURL:          "oci://fake-url",
-				Name:             "should-not-be-here",
-				SemverConstraint: "bogus",
+				RepoURL:              "oci://fake-url",
+				Name:                 "should-not-be-here",
+				SemverConstraint:     "bogus",
+				AppVersionConstraint: "also-bogus",
 			},
 			seen: uniqueSubSet{},
 			assertions: func(t *testing.T, errs field.ErrorList) {
@@ -678,6 +959,11 @@ func TestValidateChartSub(t *testing.T) {
 							Field:    "chart.semverConstraint",
 							BadValue: "bogus",
 						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "chart.appVersionConstraint",
+							BadValue: "also-bogus",
+						},
 						{
 							Type:     field.ErrorTypeInvalid,
 							Field:    "chart.name",
@@ -5,6 +5,7 @@ go 1.24.3
 replace github.com/akuity/kargo/api => ../api
 
 require (
+	connectrpc.com/connect v1.18.1
 	github.com/akuity/kargo/api v0.0.0
 	github.com/stretchr/testify v1.10.0
 	k8s.io/apimachinery v0.33.1
@@ -22,6 +23,7 @@ require (
 	github.com/x448/float16 v0.8.4 // indirect
 	golang.org/x/net v0.38.0 // indirect
 	golang.org/x/text v0.23.0 // indirect
+	google.golang.org/protobuf v1.36.6 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	k8s.io/api v0.33.1 // indirect
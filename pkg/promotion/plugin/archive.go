@@ -0,0 +1,293 @@
+package plugin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// DefaultMaxWorkDirArchiveBytes is the default maximum size, in bytes, of
+	// the gzipped tarball of a working directory streamed to or from a plugin.
+	DefaultMaxWorkDirArchiveBytes int64 = 512 << 20
+	// DefaultMaxWorkDirBytes is the default maximum combined size, in bytes, of
+	// the files extracted from the gzipped tarball of a working directory
+	// streamed to or from a plugin.
+	DefaultMaxWorkDirBytes int64 = 2 << 30
+)
+
+// gitDirName is the name of the directories, and in the case of linked
+// worktrees files, holding the metadata of Git repositories.
+const gitDirName = ".git"
+
+// WorkDirLimits limits the size of working directories streamed to and from
+// plugins. Zero values are replaced by the corresponding defaults.
+type WorkDirLimits struct {
+	// MaxArchiveBytes is the maximum size, in bytes, of the gzipped tarball of
+	// a working directory.
+	MaxArchiveBytes int64
+	// MaxBytes is the maximum combined size, in bytes, of the files extracted
+	// from the gzipped tarball of a working directory.
+	MaxBytes int64
+}
+
+// withDefaults returns a copy of the WorkDirLimits with zero values replaced
+// by the corresponding defaults.
+func (w WorkDirLimits) withDefaults() WorkDirLimits {
+	if w.MaxArchiveBytes <= 0 {
+		w.MaxArchiveBytes = DefaultMaxWorkDirArchiveBytes
+	}
+	if w.MaxBytes <= 0 {
+		w.MaxBytes = DefaultMaxWorkDirBytes
+	}
+	return w
+}
+
+// archiveOptions are the options for archiving and extracting working
+// directories.
+type archiveOptions struct {
+	limits WorkDirLimits
+	// includeGitDirs indicates whether .git directories and files are
+	// archived and extracted. When false, they are skipped.
+	includeGitDirs bool
+}
+
+// archiveDir writes a gzipped tarball of the contents of the given directory
+// to the given writer. Regular files, directories and symlinks are included.
+// Other types of files are skipped, as are .git directories and files unless
+// the options say otherwise. An error is returned as soon as the tarball
+// exceeds the size limits of the options.
+func archiveDir(w io.Writer, dir string, opts archiveOptions) error {
+	limits := opts.limits.withDefaults()
+	lw := &limitedWriter{w: w, remaining: limits.MaxArchiveBytes}
+	gw := gzip.NewWriter(lw)
+	tw := tar.NewWriter(gw)
+	var total int64
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		if !opts.includeGitDirs && d.Name() == gitDirName {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		switch {
+		case info.Mode().IsRegular(), info.IsDir():
+		case info.Mode()&fs.ModeSymlink != 0:
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+		if info.Mode().IsRegular() {
+			if total += info.Size(); total > limits.MaxBytes {
+				return fmt.Errorf(
+					"working directory exceeds the maximum size of %d bytes",
+					limits.MaxBytes,
+				)
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(relPath)
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	}); err != nil {
+		return fmt.Errorf("error archiving directory %q: %w", dir, err)
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// extractArchive extracts the gzipped tarball read from the given reader into
+// the given directory, which must already exist. Entries that would be
+// extracted outside of the directory result in an error, as does a tarball
+// exceeding the size limits of the options. .git directories and files are
+// skipped unless the options say otherwise.
+func extractArchive(r io.Reader, dir string, opts archiveOptions) error {
+	limits := opts.limits.withDefaults()
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+	gr, err := gzip.NewReader(&limitedReader{r: r, remaining: limits.MaxArchiveBytes})
+	if err != nil {
+		return fmt.Errorf("error reading archive: %w", err)
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+	remaining := limits.MaxBytes
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading archive: %w", err)
+		}
+		name := filepath.FromSlash(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("archive entry %q is not a local path", hdr.Name)
+		}
+		if !opts.includeGitDirs && isInGitDir(name) {
+			continue
+		}
+		mode := hdr.FileInfo().Mode().Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err = root.Mkdir(name, mode); err != nil && !errors.Is(err, fs.ErrExist) {
+				return err
+			}
+		case tar.TypeReg:
+			if remaining -= hdr.Size; remaining < 0 {
+				return fmt.Errorf(
+					"extracted archive exceeds the maximum size of %d bytes",
+					limits.MaxBytes,
+				)
+			}
+			if err = extractFile(root, name, mode, tr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			// Resolving the parent directory through the root guarantees it does
+			// not lie outside of the directory being extracted to.
+			if _, err = root.Stat(filepath.Dir(name)); err != nil {
+				return err
+			}
+			if err = os.Symlink(hdr.Linkname, filepath.Join(dir, name)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// extractFile writes the contents of the given reader to the file with the
+// given name and mode relative to the given root.
+func extractFile(root *os.Root, name string, mode fs.FileMode, r io.Reader) error {
+	f, err := root.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// isInGitDir returns true if any element of the given relative path is a .git
+// directory or file.
+func isInGitDir(name string) bool {
+	for name != "." {
+		if filepath.Base(name) == gitDirName {
+			return true
+		}
+		name = filepath.Dir(name)
+	}
+	return false
+}
+
+// clearDir removes the contents of the given directory without removing the
+// directory itself. When keepGitDirs is true, .git directories and files are
+// kept along with the directories containing them.
+func clearDir(dir string, keepGitDirs bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		switch {
+		case !keepGitDirs:
+		case entry.Name() == gitDirName:
+			continue
+		case entry.IsDir():
+			if err = clearDir(path, keepGitDirs); err != nil {
+				return err
+			}
+			var remaining []os.DirEntry
+			if remaining, err = os.ReadDir(path); err != nil {
+				return err
+			}
+			if len(remaining) > 0 {
+				// The directory contains a .git directory or file.
+				continue
+			}
+		}
+		if err = os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errArchiveTooLarge is returned when the gzipped tarball of a working
+// directory exceeds its maximum size.
+var errArchiveTooLarge = errors.New("archive exceeds its maximum size")
+
+// limitedWriter is an io.Writer that returns errArchiveTooLarge once more than
+// the given number of bytes have been written to it.
+type limitedWriter struct {
+	w         io.Writer
+	remaining int64
+}
+
+// Write implements io.Writer.
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if l.remaining -= int64(len(p)); l.remaining < 0 {
+		return 0, errArchiveTooLarge
+	}
+	return l.w.Write(p)
+}
+
+// limitedReader is an io.Reader that returns errArchiveTooLarge once more than
+// the given number of bytes have been read from it.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+// Read implements io.Reader.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	if l.remaining -= int64(n); l.remaining < 0 {
+		return 0, errArchiveTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,167 @@
+package plugin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_archiveDir_extractArchive(t *testing.T) {
+	srcDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "a", "b"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "a", "b", "c.txt"), []byte("c"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "run.sh"), []byte("#!/bin/sh"), 0o700))
+	require.NoError(t, os.Symlink("a/b/c.txt", filepath.Join(srcDir, "link")))
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "repo", ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "repo", ".git", "HEAD"), []byte("ref"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "repo", "file.txt"), []byte("f"), 0o600))
+
+	t.Run("without .git directories", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, archiveDir(&buf, srcDir, archiveOptions{}))
+
+		dstDir := t.TempDir()
+		require.NoError(t, extractArchive(&buf, dstDir, archiveOptions{}))
+
+		b, err := os.ReadFile(filepath.Join(dstDir, "a", "b", "c.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "c", string(b))
+		info, err := os.Stat(filepath.Join(dstDir, "run.sh"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+		link, err := os.Readlink(filepath.Join(dstDir, "link"))
+		require.NoError(t, err)
+		require.Equal(t, "a/b/c.txt", link)
+		require.FileExists(t, filepath.Join(dstDir, "repo", "file.txt"))
+		require.NoDirExists(t, filepath.Join(dstDir, "repo", ".git"))
+	})
+
+	t.Run("with .git directories", func(t *testing.T) {
+		opts := archiveOptions{includeGitDirs: true}
+		var buf bytes.Buffer
+		require.NoError(t, archiveDir(&buf, srcDir, opts))
+
+		dstDir := t.TempDir()
+		require.NoError(t, extractArchive(&buf, dstDir, opts))
+		require.FileExists(t, filepath.Join(dstDir, "repo", ".git", "HEAD"))
+	})
+
+	t.Run("archive exceeds limit", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := archiveDir(
+			&buf,
+			srcDir,
+			archiveOptions{limits: WorkDirLimits{MaxArchiveBytes: 16}},
+		)
+		require.ErrorIs(t, err, errArchiveTooLarge)
+	})
+
+	t.Run("working directory exceeds limit", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := archiveDir(
+			&buf,
+			srcDir,
+			archiveOptions{limits: WorkDirLimits{MaxBytes: 4}},
+		)
+		require.ErrorContains(t, err, "exceeds the maximum size of 4 bytes")
+	})
+}
+
+func Test_extractArchive(t *testing.T) {
+	testCases := []struct {
+		name        string
+		headers     []*tar.Header
+		opts        archiveOptions
+		expectedErr string
+	}{
+		{
+			name: "path traversal",
+			headers: []*tar.Header{
+				{Name: "../escape.txt", Typeflag: tar.TypeReg, Mode: 0o600},
+			},
+			expectedErr: "is not a local path",
+		},
+		{
+			name: "absolute path",
+			headers: []*tar.Header{
+				{Name: "/escape.txt", Typeflag: tar.TypeReg, Mode: 0o600},
+			},
+			expectedErr: "is not a local path",
+		},
+		{
+			name: "write through symlink",
+			headers: []*tar.Header{
+				{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/tmp"},
+				{Name: "link/escape.txt", Typeflag: tar.TypeReg, Mode: 0o600},
+			},
+			expectedErr: "escapes from parent",
+		},
+		{
+			name: "symlink through symlink",
+			headers: []*tar.Header{
+				{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/tmp"},
+				{Name: "link/escape", Typeflag: tar.TypeSymlink, Linkname: "foo"},
+			},
+			expectedErr: "escapes from parent",
+		},
+		{
+			name: "extracted files exceed limit",
+			headers: []*tar.Header{
+				{Name: "big.txt", Typeflag: tar.TypeReg, Mode: 0o600, Size: 1 << 20},
+			},
+			opts:        archiveOptions{limits: WorkDirLimits{MaxBytes: 1 << 10}},
+			expectedErr: "exceeds the maximum size",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			gw := gzip.NewWriter(&buf)
+			tw := tar.NewWriter(gw)
+			for _, hdr := range testCase.headers {
+				require.NoError(t, tw.WriteHeader(hdr))
+				_, err := tw.Write(make([]byte, hdr.Size))
+				require.NoError(t, err)
+			}
+			require.NoError(t, tw.Close())
+			require.NoError(t, gw.Close())
+
+			err := extractArchive(&buf, t.TempDir(), testCase.opts)
+			require.ErrorContains(t, err, testCase.expectedErr)
+		})
+	}
+}
+
+func Test_clearDir(t *testing.T) {
+	newDir := func(t *testing.T) string {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "repo", ".git"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "repo", ".git", "HEAD"), []byte("ref"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "repo", "file.txt"), []byte("f"), 0o600))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "other"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "file.txt"), []byte("f"), 0o600))
+		return dir
+	}
+
+	t.Run("removes everything", func(t *testing.T) {
+		dir := newDir(t)
+		require.NoError(t, clearDir(dir, false))
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("keeps .git directories", func(t *testing.T) {
+		dir := newDir(t)
+		require.NoError(t, clearDir(dir, true))
+		require.FileExists(t, filepath.Join(dir, "repo", ".git", "HEAD"))
+		require.NoFileExists(t, filepath.Join(dir, "repo", "file.txt"))
+		require.NoDirExists(t, filepath.Join(dir, "other"))
+		require.NoFileExists(t, filepath.Join(dir, "file.txt"))
+	})
+}
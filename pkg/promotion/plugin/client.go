@@ -0,0 +1,245 @@
+package plugin
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	pluginv1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	"github.com/akuity/kargo/api/plugin/v1alpha1/pluginv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// NewHTTPClient returns an *http.Client suitable for communicating with a
+// plugin. Plugins are served over HTTP/2, which is negotiated using TLS for
+// https URLs and used without TLS for http URLs.
+func NewHTTPClient(insecureSkipTLSVerify bool) *http.Client {
+	protocols := &http.Protocols{}
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	return &http.Client{
+		Transport: &http.Transport{
+			Protocols: protocols,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: insecureSkipTLSVerify, // nolint: gosec
+			},
+		},
+	}
+}
+
+// NewClient returns a client for the StepRunnerService served by the plugin at
+// the given base URL. The client uses the gRPC protocol.
+func NewClient(
+	httpClient connect.HTTPClient,
+	baseURL string,
+) pluginv1alpha1connect.StepRunnerServiceClient {
+	return pluginv1alpha1connect.NewStepRunnerServiceClient(
+		httpClient,
+		baseURL,
+		connect.WithGRPC(),
+	)
+}
+
+// stepRunner is an implementation of promotion.StepRunner that runs a step
+// provided by a plugin.
+type stepRunner struct {
+	name          string
+	client        pluginv1alpha1connect.StepRunnerServiceClient
+	workDirLimits WorkDirLimits
+}
+
+// NewStepRunner returns a promotion.StepRunner that runs the step with the
+// given name using the given plugin client. The given WorkDirLimits limit the
+// size of the working directory streamed to and from plugins that do not
+// have it mounted.
+func NewStepRunner(
+	name string,
+	client pluginv1alpha1connect.StepRunnerServiceClient,
+	workDirLimits WorkDirLimits,
+) promotion.StepRunner {
+	return &stepRunner{
+		name:          name,
+		client:        client,
+		workDirLimits: workDirLimits,
+	}
+}
+
+// Name implements promotion.StepRunner.
+func (s *stepRunner) Name() string {
+	return s.name
+}
+
+// Run implements promotion.StepRunner.
+func (s *stepRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	info, err := s.client.GetInfo(
+		ctx,
+		connect.NewRequest(&pluginv1alpha1.GetInfoRequest{
+			ProtocolVersion: ProtocolVersion,
+		}),
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting plugin info: %w", err)
+	}
+	if v := info.Msg.GetProtocolVersion(); v != ProtocolVersion {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf(
+					"plugin implements protocol version %d; expected %d",
+					v, ProtocolVersion,
+				),
+			}
+	}
+	if !slices.Contains(info.Msg.GetSteps(), s.name) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("plugin does not provide step %q", s.name),
+			}
+	}
+
+	protoCtx, err := StepContextToProto(stepCtx)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{Err: err}
+	}
+	req := &pluginv1alpha1.RunStepRequest{
+		ProtocolVersion: ProtocolVersion,
+		Step:            s.name,
+		Context:         protoCtx,
+	}
+
+	if info.Msg.GetWorkDirMode() == pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_STREAMED {
+		return s.runStreamed(
+			ctx,
+			req,
+			stepCtx.WorkDir,
+			archiveOptions{
+				limits:         s.workDirLimits,
+				includeGitDirs: info.Msg.GetIncludeGitDirs(),
+			},
+		)
+	}
+
+	res, err := s.client.RunStep(ctx, connect.NewRequest(req))
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error running step %q using plugin: %w", s.name, err)
+	}
+	return StepResultFromProto(res.Msg)
+}
+
+// runStreamed runs the step using a plugin that streams the working directory.
+// The contents of the given working directory are streamed to the plugin
+// after the request and replaced by the contents the plugin streams back
+// after its response.
+func (s *stepRunner) runStreamed(
+	ctx context.Context,
+	req *pluginv1alpha1.RunStepRequest,
+	workDir string,
+	opts archiveOptions,
+) (promotion.StepResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream := s.client.RunStreamedStep(ctx)
+
+	if err := sendStreamedStep(stream, req, workDir, opts); err != nil &&
+		!errors.Is(err, io.EOF) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error streaming working directory to plugin: %w", err)
+	}
+	// An io.EOF returned while sending indicates the plugin has ended the
+	// stream. The reason is returned when receiving.
+
+	msg, err := stream.Receive()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error running step %q using plugin: %w", s.name, err)
+	}
+	res := msg.GetResponse()
+	if res == nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf(
+					"plugin did not respond before streaming the working directory after running step %q",
+					s.name,
+				),
+			}
+	}
+
+	// Failures from here on leave the working directory incomplete, so
+	// retrying the step would be pointless.
+	if err = clearDir(workDir, !opts.includeGitDirs); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("error clearing working directory: %w", err),
+			}
+	}
+	chunks := &chunkReader{
+		receive: func() ([]byte, error) {
+			msg, err := stream.Receive()
+			if err != nil {
+				return nil, err
+			}
+			chunk, ok := msg.GetMsg().(*pluginv1alpha1.RunStreamedStepResponse_WorkDirChunk)
+			if !ok {
+				return nil, errors.New("plugin sent more than one response")
+			}
+			return chunk.WorkDirChunk, nil
+		},
+	}
+	if err = extractArchive(chunks, workDir, opts); err == nil {
+		err = drainChunks(chunks)
+	}
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("error extracting working directory: %w", err),
+			}
+	}
+	if err = stream.CloseResponse(); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error closing stream: %w", err)
+	}
+	return StepResultFromProto(res)
+}
+
+// sendStreamedStep sends the given request followed by a gzipped tarball of
+// the given working directory on the given stream and closes its request
+// side.
+func sendStreamedStep(
+	stream *connect.BidiStreamForClient[
+		pluginv1alpha1.RunStreamedStepRequest,
+		pluginv1alpha1.RunStreamedStepResponse,
+	],
+	req *pluginv1alpha1.RunStepRequest,
+	workDir string,
+	opts archiveOptions,
+) error {
+	if err := stream.Send(&pluginv1alpha1.RunStreamedStepRequest{
+		Msg: &pluginv1alpha1.RunStreamedStepRequest_Request{Request: req},
+	}); err != nil {
+		return err
+	}
+	w := newChunkWriter(func(chunk []byte) error {
+		return stream.Send(&pluginv1alpha1.RunStreamedStepRequest{
+			Msg: &pluginv1alpha1.RunStreamedStepRequest_WorkDirChunk{WorkDirChunk: chunk},
+		})
+	})
+	if err := archiveDir(w, workDir, opts); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return stream.CloseRequest()
+}
@@ -0,0 +1,156 @@
+// Package conformance implements tests that verify a step runner plugin
+// correctly implements the step runner plugin protocol. Plugin authors are
+// encouraged to run them against their plugins as part of their own tests.
+package conformance
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	pluginv1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	"github.com/akuity/kargo/api/plugin/v1alpha1/pluginv1alpha1connect"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/plugin"
+)
+
+// stepNameRegex matches valid step names. It mirrors the validation of the
+// steps field of the StepRunnerPlugin resource.
+var stepNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// Case is a test case that runs a step provided by the plugin under test.
+type Case struct {
+	// Name is the name of the test case.
+	Name string
+	// Step is the name of the step to run.
+	Step string
+	// Config is the configuration of the step.
+	Config promotion.Config
+	// Files are the files, keyed by their path relative to the working
+	// directory, to write to the working directory before the step is run.
+	Files map[string]string
+	// Assertions are run against the working directory and the outcome of
+	// the step after it has run.
+	Assertions func(t *testing.T, workDir string, result promotion.StepResult, err error)
+}
+
+// Test verifies the plugin served by the given client correctly implements
+// the step runner plugin protocol and then runs the given test cases against
+// it. Plugins that expect the working directory to be mounted must share a
+// filesystem with the test.
+func Test(
+	t *testing.T,
+	client pluginv1alpha1connect.StepRunnerServiceClient,
+	cases ...Case,
+) {
+	t.Run("GetInfo", func(t *testing.T) {
+		res, err := client.GetInfo(
+			context.Background(),
+			connect.NewRequest(&pluginv1alpha1.GetInfoRequest{
+				ProtocolVersion: plugin.ProtocolVersion,
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, plugin.ProtocolVersion, res.Msg.GetProtocolVersion())
+		require.NotEmpty(t, res.Msg.GetSteps())
+		seen := make(map[string]struct{}, len(res.Msg.GetSteps()))
+		for _, step := range res.Msg.GetSteps() {
+			require.Regexp(t, stepNameRegex, step)
+			require.NotContains(t, seen, step, "step %q is listed more than once", step)
+			seen[step] = struct{}{}
+		}
+		require.Contains(
+			t,
+			[]pluginv1alpha1.WorkDirMode{
+				pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_UNSPECIFIED,
+				pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_MOUNTED,
+				pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_STREAMED,
+			},
+			res.Msg.GetWorkDirMode(),
+		)
+	})
+
+	t.Run("RunStep rejects unsupported protocol versions", func(t *testing.T) {
+		_, err := client.RunStep(
+			context.Background(),
+			connect.NewRequest(&pluginv1alpha1.RunStepRequest{
+				ProtocolVersion: plugin.ProtocolVersion + 1,
+				Step:            firstStep(t, client),
+				Context:         &pluginv1alpha1.StepContext{WorkDir: t.TempDir()},
+			}),
+		)
+		require.Error(t, err)
+		require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("RunStep rejects unknown steps", func(t *testing.T) {
+		_, err := client.RunStep(
+			context.Background(),
+			connect.NewRequest(&pluginv1alpha1.RunStepRequest{
+				ProtocolVersion: plugin.ProtocolVersion,
+				Step:            "conformance-unknown-step",
+				Context:         &pluginv1alpha1.StepContext{WorkDir: t.TempDir()},
+			}),
+		)
+		require.Error(t, err)
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for path, content := range testCase.Files {
+				path = filepath.Join(workDir, path)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			result, err := plugin.NewStepRunner(testCase.Step, client, plugin.WorkDirLimits{}).Run(
+				context.Background(),
+				&promotion.StepContext{
+					WorkDir:     workDir,
+					SharedState: promotion.State{},
+					Alias:       "conformance",
+					Config:      testCase.Config,
+					Project:     "conformance-project",
+					Stage:       "conformance-stage",
+					Promotion:   "conformance-promotion",
+				},
+			)
+			require.Contains(
+				t,
+				[]kargoapi.PromotionStepStatus{
+					kargoapi.PromotionStepStatusSucceeded,
+					kargoapi.PromotionStepStatusFailed,
+					kargoapi.PromotionStepStatusErrored,
+					kargoapi.PromotionStepStatusRunning,
+					kargoapi.PromotionStepStatusSkipped,
+				},
+				result.Status,
+			)
+			testCase.Assertions(t, workDir, result, err)
+		})
+	}
+}
+
+// firstStep returns the name of the first step provided by the plugin served
+// by the given client.
+func firstStep(
+	t *testing.T,
+	client pluginv1alpha1connect.StepRunnerServiceClient,
+) string {
+	res, err := client.GetInfo(
+		context.Background(),
+		connect.NewRequest(&pluginv1alpha1.GetInfoRequest{
+			ProtocolVersion: plugin.ProtocolVersion,
+		}),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Msg.GetSteps())
+	return res.Msg.GetSteps()[0]
+}
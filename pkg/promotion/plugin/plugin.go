@@ -0,0 +1,182 @@
+// Package plugin implements the protocol used by Kargo to run promotion steps
+// provided by out-of-process plugins. Plugins implement the StepRunnerService
+// defined in the github.com/akuity/kargo/api/plugin/v1alpha1 package, which is
+// most easily done by wrapping ordinary promotion.StepRunners in a Server.
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	pluginv1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// ProtocolVersion is the version of the step runner plugin protocol
+// implemented by this package. Kargo refuses to run steps provided by plugins
+// implementing a different version of the protocol.
+const ProtocolVersion uint32 = 1
+
+// StepContextToProto converts a promotion.StepContext to its protocol
+// representation.
+func StepContextToProto(
+	stepCtx *promotion.StepContext,
+) (*pluginv1alpha1.StepContext, error) {
+	sharedState, err := marshalJSON(stepCtx.SharedState)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling shared state: %w", err)
+	}
+	cfg, err := marshalJSON(stepCtx.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling step config: %w", err)
+	}
+	vars, err := marshalJSON(stepCtx.Vars)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling step vars: %w", err)
+	}
+	freightReqs := make([]*kargoapi.FreightRequest, len(stepCtx.FreightRequests))
+	for i := range stepCtx.FreightRequests {
+		freightReqs[i] = &stepCtx.FreightRequests[i]
+	}
+	return &pluginv1alpha1.StepContext{
+		UiBaseUrl:       stepCtx.UIBaseURL,
+		WorkDir:         stepCtx.WorkDir,
+		SharedState:     sharedState,
+		Alias:           stepCtx.Alias,
+		Config:          cfg,
+		Project:         stepCtx.Project,
+		Stage:           stepCtx.Stage,
+		Promotion:       stepCtx.Promotion,
+		FreightRequests: freightReqs,
+		Freight:         &stepCtx.Freight,
+		Vars:            vars,
+		Actor:           stepCtx.Actor,
+	}, nil
+}
+
+// StepContextFromProto converts the protocol representation of a step context
+// to a promotion.StepContext.
+func StepContextFromProto(
+	stepCtx *pluginv1alpha1.StepContext,
+) (*promotion.StepContext, error) {
+	var sharedState promotion.State
+	if err := unmarshalJSON(stepCtx.GetSharedState(), &sharedState); err != nil {
+		return nil, fmt.Errorf("error unmarshaling shared state: %w", err)
+	}
+	var cfg promotion.Config
+	if err := unmarshalJSON(stepCtx.GetConfig(), &cfg); err != nil {
+		return nil, fmt.Errorf("error unmarshaling step config: %w", err)
+	}
+	var vars map[string]any
+	if err := unmarshalJSON(stepCtx.GetVars(), &vars); err != nil {
+		return nil, fmt.Errorf("error unmarshaling step vars: %w", err)
+	}
+	var freightReqs []kargoapi.FreightRequest
+	if reqs := stepCtx.GetFreightRequests(); len(reqs) > 0 {
+		freightReqs = make([]kargoapi.FreightRequest, len(reqs))
+		for i, req := range reqs {
+			freightReqs[i] = *req
+		}
+	}
+	var freight kargoapi.FreightCollection
+	if f := stepCtx.GetFreight(); f != nil {
+		freight = *f
+	}
+	return &promotion.StepContext{
+		UIBaseURL:       stepCtx.GetUiBaseUrl(),
+		WorkDir:         stepCtx.GetWorkDir(),
+		SharedState:     sharedState,
+		Alias:           stepCtx.GetAlias(),
+		Config:          cfg,
+		Project:         stepCtx.GetProject(),
+		Stage:           stepCtx.GetStage(),
+		Promotion:       stepCtx.GetPromotion(),
+		FreightRequests: freightReqs,
+		Freight:         freight,
+		Vars:            vars,
+		Actor:           stepCtx.GetActor(),
+	}, nil
+}
+
+// StepResultToProto converts the promotion.StepResult and error returned by a
+// promotion.StepRunner to their protocol representation.
+func StepResultToProto(
+	result promotion.StepResult,
+	runErr error,
+) (*pluginv1alpha1.RunStepResponse, error) {
+	output, err := marshalJSON(result.Output)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling step output: %w", err)
+	}
+	res := &pluginv1alpha1.RunStepResponse{
+		Status:  string(result.Status),
+		Message: result.Message,
+		Output:  output,
+	}
+	if result.HealthCheck != nil {
+		input, err := marshalJSON(result.HealthCheck.Input)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling health check input: %w", err)
+		}
+		res.HealthCheck = &pluginv1alpha1.HealthCheck{
+			Kind:  result.HealthCheck.Kind,
+			Input: input,
+		}
+	}
+	if runErr != nil {
+		res.Error = runErr.Error()
+		res.Terminal = promotion.IsTerminal(runErr)
+	}
+	return res, nil
+}
+
+// StepResultFromProto converts the protocol representation of the outcome of
+// a step to the promotion.StepResult and error returned by a
+// promotion.StepRunner.
+func StepResultFromProto(
+	res *pluginv1alpha1.RunStepResponse,
+) (promotion.StepResult, error) {
+	result := promotion.StepResult{
+		Status:  kargoapi.PromotionStepStatus(res.GetStatus()),
+		Message: res.GetMessage(),
+	}
+	if err := unmarshalJSON(res.GetOutput(), &result.Output); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error unmarshaling step output: %w", err)
+	}
+	if hc := res.GetHealthCheck(); hc != nil {
+		result.HealthCheck = &health.Criteria{Kind: hc.GetKind()}
+		if err := unmarshalJSON(hc.GetInput(), &result.HealthCheck.Input); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error unmarshaling health check input: %w", err)
+		}
+	}
+	if res.GetError() == "" {
+		return result, nil
+	}
+	err := errors.New(res.GetError())
+	if res.GetTerminal() {
+		err = &promotion.TerminalError{Err: err}
+	}
+	return result, err
+}
+
+// marshalJSON marshals the given map to JSON. Empty maps are marshaled to nil.
+func marshalJSON[T ~map[string]any](m T) ([]byte, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(m)
+}
+
+// unmarshalJSON unmarshals the given JSON into the given value. Empty input
+// leaves the value untouched.
+func unmarshalJSON(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+func TestStepContextRoundTrip(t *testing.T) {
+	stepCtx := &promotion.StepContext{
+		UIBaseURL:   "https://kargo.example.com",
+		WorkDir:     "/tmp/run-1",
+		SharedState: promotion.State{"step-1": map[string]any{"commit": "abc"}},
+		Alias:       "step-2",
+		Config:      promotion.Config{"path": "foo.txt", "count": float64(2)},
+		Project:     "fake-project",
+		Stage:       "fake-stage",
+		Promotion:   "fake-promotion",
+		FreightRequests: []kargoapi.FreightRequest{{
+			Origin: kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "fake-warehouse"},
+		}},
+		Freight: kargoapi.FreightCollection{
+			ID: "fake-id",
+			Freight: map[string]kargoapi.FreightReference{
+				"Warehouse/fake-warehouse": {Name: "fake-freight"},
+			},
+		},
+		Vars:  map[string]any{"repoURL": "https://github.com/example/repo.git"},
+		Actor: "admin",
+	}
+	protoCtx, err := StepContextToProto(stepCtx)
+	require.NoError(t, err)
+	actual, err := StepContextFromProto(protoCtx)
+	require.NoError(t, err)
+	require.Equal(t, stepCtx, actual)
+}
+
+func TestStepResultRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name   string
+		result promotion.StepResult
+		err    error
+	}{
+		{
+			name: "success with output and health check",
+			result: promotion.StepResult{
+				Status:  kargoapi.PromotionStepStatusSucceeded,
+				Message: "done",
+				Output:  map[string]any{"commit": "abc"},
+				HealthCheck: &health.Criteria{
+					Kind:  "argocd",
+					Input: health.Input{"apps": []any{"fake-app"}},
+				},
+			},
+		},
+		{
+			name:   "error",
+			result: promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			err:    errors.New("something went wrong"),
+		},
+		{
+			name:   "terminal error",
+			result: promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			err:    &promotion.TerminalError{Err: errors.New("something went wrong")},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := StepResultToProto(testCase.result, testCase.err)
+			require.NoError(t, err)
+			result, err := StepResultFromProto(res)
+			require.Equal(t, testCase.result, result)
+			if testCase.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, testCase.err.Error())
+			require.Equal(t, promotion.IsTerminal(testCase.err), promotion.IsTerminal(err))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Command reference-plugin serves the reference step runner plugin.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	pluginv1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion/plugin"
+	"github.com/akuity/kargo/pkg/promotion/plugin/reference"
+)
+
+func main() {
+	addr := flag.String("addr", ":50051", "address to serve the plugin on")
+	streamWorkDir := flag.Bool(
+		"stream-work-dir",
+		false,
+		"stream the working directory of promotions instead of expecting it to be mounted",
+	)
+	includeGitDirs := flag.Bool(
+		"include-git-dirs",
+		false,
+		"stream the .git directories of Git repositories along with the working directory",
+	)
+	flag.Parse()
+
+	workDirMode := pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_MOUNTED
+	if *streamWorkDir {
+		workDirMode = pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_STREAMED
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	srv := plugin.NewServer(workDirMode, reference.NewFileWriter())
+	if *includeGitDirs {
+		srv = srv.WithGitDirs()
+	}
+	if err := srv.ListenAndServe(ctx, *addr); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
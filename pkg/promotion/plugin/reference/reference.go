@@ -0,0 +1,112 @@
+// Package reference implements the reference step runner plugin. It provides
+// a single, deliberately simple, step that writes a file to the working
+// directory of a promotion and serves as an example for plugin authors.
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// WriteFileStepName is the name of the step provided by the reference plugin.
+const WriteFileStepName = "write-file"
+
+// WriteFileConfig is the configuration of the write-file step.
+type WriteFileConfig struct {
+	// Path is the path of the file to write, relative to the working directory.
+	Path string `json:"path"`
+	// Content is the content to write to the file.
+	Content string `json:"content"`
+}
+
+// fileWriter is an implementation of promotion.StepRunner that writes a file
+// to the working directory of a promotion.
+type fileWriter struct{}
+
+// NewFileWriter returns an implementation of promotion.StepRunner that writes
+// a file to the working directory of a promotion.
+func NewFileWriter() promotion.StepRunner {
+	return &fileWriter{}
+}
+
+// Name implements promotion.StepRunner.
+func (f *fileWriter) Name() string {
+	return WriteFileStepName
+}
+
+// Run implements promotion.StepRunner.
+func (f *fileWriter) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := promotion.ConfigToStruct[WriteFileConfig](stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{Err: fmt.Errorf("could not convert config into struct: %w", err)}
+	}
+	if cfg.Path == "" {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{Err: errors.New("path is required")}
+	}
+	if !filepath.IsLocal(cfg.Path) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("path %q is not within the working directory", cfg.Path),
+			}
+	}
+
+	root, err := os.OpenRoot(stepCtx.WorkDir)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error opening working directory: %w", err)
+	}
+	defer root.Close()
+	if dir := filepath.Dir(cfg.Path); dir != "." {
+		if err = mkdirAll(root, dir); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error creating directory %q: %w", dir, err)
+		}
+	}
+	file, err := root.Create(cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating file %q: %w", cfg.Path, err)
+	}
+	defer file.Close()
+	if _, err = file.WriteString(cfg.Content); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error writing file %q: %w", cfg.Path, err)
+	}
+	if err = file.Close(); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error writing file %q: %w", cfg.Path, err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			"path":  cfg.Path,
+			"bytes": len(cfg.Content),
+		},
+	}, nil
+}
+
+// mkdirAll creates the directory with the given path relative to the given
+// root, along with any missing parents.
+func mkdirAll(root *os.Root, dir string) error {
+	if parent := filepath.Dir(dir); parent != "." {
+		if err := mkdirAll(root, parent); err != nil {
+			return err
+		}
+	}
+	if err := root.Mkdir(dir, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return err
+	}
+	return nil
+}
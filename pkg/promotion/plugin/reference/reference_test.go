@@ -0,0 +1,94 @@
+package reference
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pluginv1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/plugin"
+	"github.com/akuity/kargo/pkg/promotion/plugin/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	cases := []conformance.Case{
+		{
+			Name: "writes file",
+			Step: WriteFileStepName,
+			Config: promotion.Config{
+				"path":    "out/hello.txt",
+				"content": "hello",
+			},
+			Files: map[string]string{"existing.txt": "unchanged"},
+			Assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				require.Equal(t, map[string]any{"path": "out/hello.txt", "bytes": float64(5)}, result.Output)
+				b, err := os.ReadFile(filepath.Join(workDir, "out", "hello.txt"))
+				require.NoError(t, err)
+				require.Equal(t, "hello", string(b))
+				b, err = os.ReadFile(filepath.Join(workDir, "existing.txt"))
+				require.NoError(t, err)
+				require.Equal(t, "unchanged", string(b))
+			},
+		},
+		{
+			Name: "keeps Git repositories",
+			Step: WriteFileStepName,
+			Config: promotion.Config{
+				"path":    "repo/hello.txt",
+				"content": "hello",
+			},
+			Files: map[string]string{"repo/.git/HEAD": "ref: refs/heads/main"},
+			Assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "repo", ".git", "HEAD"))
+				require.NoError(t, err)
+				require.Equal(t, "ref: refs/heads/main", string(b))
+				require.FileExists(t, filepath.Join(workDir, "repo", "hello.txt"))
+			},
+		},
+		{
+			Name:   "path outside of working directory",
+			Step:   WriteFileStepName,
+			Config: promotion.Config{"path": "../escape.txt"},
+			Assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "is not within the working directory")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+	}
+
+	for _, workDirMode := range []pluginv1alpha1.WorkDirMode{
+		pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_MOUNTED,
+		pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_STREAMED,
+	} {
+		t.Run(workDirMode.String(), func(t *testing.T) {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			ctx, cancel := context.WithCancel(context.Background())
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- plugin.NewServer(workDirMode, NewFileWriter()).Serve(ctx, l)
+			}()
+			t.Cleanup(func() {
+				cancel()
+				require.NoError(t, <-errCh)
+			})
+
+			conformance.Test(
+				t,
+				plugin.NewClient(plugin.NewHTTPClient(false), "http://"+l.Addr().String()),
+				cases...,
+			)
+		})
+	}
+}
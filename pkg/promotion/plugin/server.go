@@ -0,0 +1,265 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+
+	pluginv1alpha1 "github.com/akuity/kargo/api/plugin/v1alpha1"
+	"github.com/akuity/kargo/api/plugin/v1alpha1/pluginv1alpha1connect"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// Server implements the StepRunnerService of the step runner plugin protocol
+// by delegating the execution of steps to promotion.StepRunners.
+type Server struct {
+	runners        map[string]promotion.StepRunner
+	workDirMode    pluginv1alpha1.WorkDirMode
+	workDirLimits  WorkDirLimits
+	includeGitDirs bool
+}
+
+// NewServer returns a Server that runs steps using the given
+// promotion.StepRunners. The given WorkDirMode determines whether the plugin
+// expects the working directory of a promotion to be mounted at the same path
+// as it is for Kargo or to be streamed to and from the plugin.
+func NewServer(
+	workDirMode pluginv1alpha1.WorkDirMode,
+	runners ...promotion.StepRunner,
+) *Server {
+	s := &Server{
+		runners:     make(map[string]promotion.StepRunner, len(runners)),
+		workDirMode: workDirMode,
+	}
+	for _, runner := range runners {
+		s.runners[runner.Name()] = runner
+	}
+	return s
+}
+
+// WithWorkDirLimits sets the limits on the size of the working directory
+// streamed to and from the Server and returns the Server. It only applies
+// when the working directory is streamed.
+func (s *Server) WithWorkDirLimits(limits WorkDirLimits) *Server {
+	s.workDirLimits = limits
+	return s
+}
+
+// WithGitDirs makes the Server ask for the .git directories and files of Git
+// repositories in the working directory to be streamed along with the rest of
+// it and returns the Server. It only applies when the working directory is
+// streamed.
+func (s *Server) WithGitDirs() *Server {
+	s.includeGitDirs = true
+	return s
+}
+
+// Handler returns an http.Handler that serves the StepRunnerService.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(pluginv1alpha1connect.NewStepRunnerServiceHandler(s))
+	return mux
+}
+
+// ListenAndServe serves the StepRunnerService over unencrypted HTTP/2 on the
+// given address until the given context is canceled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("error listening on %q: %w", addr, err)
+	}
+	return s.Serve(ctx, l)
+}
+
+// Serve serves the StepRunnerService over unencrypted HTTP/2 on the given
+// listener until the given context is canceled.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	protocols := &http.Protocols{}
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	srv := &http.Server{
+		Handler:           s.Handler(),
+		Protocols:         protocols,
+		ReadHeaderTimeout: time.Minute,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(l)
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// GetInfo implements pluginv1alpha1connect.StepRunnerServiceHandler.
+func (s *Server) GetInfo(
+	context.Context,
+	*connect.Request[pluginv1alpha1.GetInfoRequest],
+) (*connect.Response[pluginv1alpha1.GetInfoResponse], error) {
+	steps := make([]string, 0, len(s.runners))
+	for name := range s.runners {
+		steps = append(steps, name)
+	}
+	slices.Sort(steps)
+	return connect.NewResponse(&pluginv1alpha1.GetInfoResponse{
+		ProtocolVersion: ProtocolVersion,
+		Steps:           steps,
+		WorkDirMode:     s.workDirMode,
+		IncludeGitDirs:  s.includeGitDirs,
+	}), nil
+}
+
+// RunStep implements pluginv1alpha1connect.StepRunnerServiceHandler.
+func (s *Server) RunStep(
+	ctx context.Context,
+	req *connect.Request[pluginv1alpha1.RunStepRequest],
+) (*connect.Response[pluginv1alpha1.RunStepResponse], error) {
+	runner, stepCtx, err := s.prepareStep(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	if s.workDirMode == pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_STREAMED {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("this plugin streams the working directory; use RunStreamedStep"),
+		)
+	}
+	res, err := StepResultToProto(runner.Run(ctx, stepCtx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// RunStreamedStep implements pluginv1alpha1connect.StepRunnerServiceHandler.
+func (s *Server) RunStreamedStep(
+	ctx context.Context,
+	stream *connect.BidiStream[
+		pluginv1alpha1.RunStreamedStepRequest,
+		pluginv1alpha1.RunStreamedStepResponse,
+	],
+) error {
+	msg, err := stream.Receive()
+	if err != nil {
+		return err
+	}
+	req := msg.GetRequest()
+	if req == nil {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("the first message of the stream must be the request"),
+		)
+	}
+	runner, stepCtx, err := s.prepareStep(req)
+	if err != nil {
+		return err
+	}
+	if s.workDirMode != pluginv1alpha1.WorkDirMode_WORK_DIR_MODE_STREAMED {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("this plugin does not stream the working directory; use RunStep"),
+		)
+	}
+	opts := archiveOptions{
+		limits:         s.workDirLimits,
+		includeGitDirs: s.includeGitDirs,
+	}
+
+	if stepCtx.WorkDir, err = os.MkdirTemp("", "step-"); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer os.RemoveAll(stepCtx.WorkDir)
+	chunks := &chunkReader{
+		receive: func() ([]byte, error) {
+			msg, err := stream.Receive()
+			if err != nil {
+				return nil, err
+			}
+			chunk, ok := msg.GetMsg().(*pluginv1alpha1.RunStreamedStepRequest_WorkDirChunk)
+			if !ok {
+				return nil, errors.New("stream contains more than one request")
+			}
+			return chunk.WorkDirChunk, nil
+		},
+	}
+	if err = extractArchive(chunks, stepCtx.WorkDir, opts); err != nil {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("error extracting working directory: %w", err),
+		)
+	}
+	if err = drainChunks(chunks); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	res, err := StepResultToProto(runner.Run(ctx, stepCtx))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err = stream.Send(&pluginv1alpha1.RunStreamedStepResponse{
+		Msg: &pluginv1alpha1.RunStreamedStepResponse_Response{Response: res},
+	}); err != nil {
+		return err
+	}
+	w := newChunkWriter(func(chunk []byte) error {
+		return stream.Send(&pluginv1alpha1.RunStreamedStepResponse{
+			Msg: &pluginv1alpha1.RunStreamedStepResponse_WorkDirChunk{WorkDirChunk: chunk},
+		})
+	})
+	if err = archiveDir(w, stepCtx.WorkDir, opts); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err = w.Flush(); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// prepareStep returns the promotion.StepRunner and promotion.StepContext for
+// the given request or a *connect.Error if the request is invalid.
+func (s *Server) prepareStep(
+	req *pluginv1alpha1.RunStepRequest,
+) (promotion.StepRunner, *promotion.StepContext, error) {
+	if v := req.GetProtocolVersion(); v != ProtocolVersion {
+		return nil, nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("unsupported protocol version %d; expected %d", v, ProtocolVersion),
+		)
+	}
+	runner, ok := s.runners[req.GetStep()]
+	if !ok {
+		return nil, nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("step %q is not provided by this plugin", req.GetStep()),
+		)
+	}
+	if req.GetContext() == nil {
+		return nil, nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("step context is required"),
+		)
+	}
+	stepCtx, err := StepContextFromProto(req.GetContext())
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return runner, stepCtx, nil
+}
@@ -0,0 +1,79 @@
+package plugin
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+// workDirChunkSize is the size, in bytes, of the chunks in which working
+// directories are streamed to and from plugins.
+const workDirChunkSize = 64 << 10
+
+// chunkWriter is an io.Writer that sends everything written to it using the
+// given function. Callers should buffer writes to avoid sending many small
+// chunks.
+type chunkWriter struct {
+	send func([]byte) error
+}
+
+// newChunkWriter returns a buffered writer that sends chunks of at most
+// workDirChunkSize bytes using the given function. The returned writer must
+// be flushed once everything has been written to it.
+func newChunkWriter(send func([]byte) error) *bufio.Writer {
+	return bufio.NewWriterSize(&chunkWriter{send: send}, workDirChunkSize)
+}
+
+// Write implements io.Writer.
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	var written int
+	for written < len(p) {
+		n := min(len(p)-written, workDirChunkSize)
+		if err := c.send(p[written : written+n]); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// chunkReader is an io.Reader that reads from chunks received using the given
+// function. The function returns an error wrapping io.EOF once there are no
+// more chunks.
+type chunkReader struct {
+	receive func() ([]byte, error)
+	chunk   []byte
+}
+
+// Read implements io.Reader.
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.chunk) == 0 {
+		chunk, err := c.receive()
+		if errors.Is(err, io.EOF) {
+			// Readers are expected to return io.EOF itself rather than an
+			// error wrapping it.
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		c.chunk = chunk
+	}
+	n := copy(p, c.chunk)
+	c.chunk = c.chunk[n:]
+	return n, nil
+}
+
+// drainChunks reads what remains of the given reader once a gzipped tarball
+// has been extracted from it. This is at most the padding and trailer of the
+// tarball, so an error is returned if more than one chunk remains.
+func drainChunks(r io.Reader) error {
+	n, err := io.Copy(io.Discard, io.LimitReader(r, workDirChunkSize+1))
+	if err != nil {
+		return err
+	}
+	if n > workDirChunkSize {
+		return errors.New("unexpected data after the end of the archive")
+	}
+	return nil
+}
@@ -120,6 +120,12 @@ type StepContext struct {
 	// responsible for finding them and furnishing them directly to each
 	// StepRunner.
 	Freight kargoapi.FreightCollection
+	// Vars is the collection of evaluated variables available to the step that
+	// is currently being executed. This includes the variables defined by the
+	// Promotion itself as well as those defined by the step.
+	Vars map[string]any
+	// Actor is the name of the actor triggering the Promotion.
+	Actor string
 }
 
 // StepResult represents the results of single Step of a user-defined promotion
@@ -81,6 +81,31 @@ type ArgoCDKustomizeImageUpdate struct {
 	Tag string `json:"tag,omitempty"`
 }
 
+type ContainerRunConfig struct {
+	// The arguments to the entrypoint of the container. If not specified, the command of the
+	// image is used.
+	Args []string `json:"args,omitempty"`
+	// The entrypoint of the container. If not specified, the entrypoint of the image is used.
+	Command []string `json:"command,omitempty"`
+	// Environment variables to set in the container. Names starting with KARGO_ are reserved.
+	Env []ContainerRunEnvVar `json:"env,omitempty"`
+	// The container image to run.
+	Image string `json:"image"`
+	// Arbitrary input for the container. It is passed to the container as JSON in the
+	// KARGO_STEP_INPUT environment variable.
+	Input map[string]interface{} `json:"input,omitempty"`
+	// The working directory of the container, relative to the working directory of the
+	// promotion. If not specified, the working directory of the promotion is used.
+	Path string `json:"path,omitempty"`
+}
+
+type ContainerRunEnvVar struct {
+	// The name of the environment variable.
+	Name string `json:"name"`
+	// The value of the environment variable.
+	Value string `json:"value,omitempty"`
+}
+
 type CopyConfig struct {
 	// Ignore is a (multiline) string of glob patterns to ignore when copying files. It accepts
 	// the same syntax as .gitignore files.
@@ -91,6 +116,32 @@ type CopyConfig struct {
 	OutPath string `json:"outPath"`
 }
 
+type CUEExportConfig struct {
+	// Expression is a CUE expression to evaluate and export instead of the whole package. e.g.
+	// 'objects'.
+	Expression string `json:"expression,omitempty"`
+	// OutPath is the file path to write the exported output to. If it has a .json extension,
+	// the output is written as JSON. Otherwise, it is written as YAML, with each element of a
+	// top-level list written as a separate document.
+	OutPath string `json:"outPath"`
+	// Package is the name of the CUE package to export, for directories that contain more
+	// than one package.
+	Package string `json:"package,omitempty"`
+	// Path is the path to the directory containing the CUE package to export.
+	Path string `json:"path"`
+	// Tags are values to inject into fields carrying a matching @tag() attribute.
+	Tags map[string]string `json:"tags,omitempty"`
+	// Values are values to unify with the CUE package before it is exported.
+	Values []CUEValue `json:"values,omitempty"`
+}
+
+type CUEValue struct {
+	// The path of the field to set the value of. e.g. 'image.tag'.
+	Path string `json:"path"`
+	// The value to unify with the field.
+	Value interface{} `json:"value"`
+}
+
 type DeleteConfig struct {
 	// Path is the path to the file or directory to delete.
 	Path string `json:"path"`
@@ -98,6 +149,37 @@ type DeleteConfig struct {
 	Strict bool `json:"strict,omitempty"`
 }
 
+type FluxUpdateConfig struct {
+	// Resources is a list of Flux resources to update and request reconciliation of.
+	Resources []FluxUpdateResource `json:"resources"`
+}
+
+type FluxUpdateResource struct {
+	// The Git branch a GitRepository should track. Only applicable to GitRepository resources.
+	Branch string `json:"branch,omitempty"`
+	// The version of the chart a HelmRelease should install. Only applicable to HelmRelease
+	// resources that reference a chart using a chart template.
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// The Git commit a GitRepository should point to. Only applicable to GitRepository
+	// resources.
+	Commit string `json:"commit,omitempty"`
+	// The digest of the OCI artifact an OCIRepository should point to. Only applicable to
+	// OCIRepository resources.
+	Digest string `json:"digest,omitempty"`
+	// The kind of the Flux resource to update.
+	Kind Kind `json:"kind"`
+	// The name of the Flux resource to update.
+	Name string `json:"name"`
+	// The namespace of the Flux resource to update. Defaults to 'flux-system'.
+	Namespace string `json:"namespace,omitempty"`
+	// The semantic version range a GitRepository or OCIRepository should track. Only applicable
+	// to GitRepository and OCIRepository resources.
+	Semver string `json:"semver,omitempty"`
+	// The Git tag or OCI artifact tag a GitRepository or OCIRepository should point to. Only
+	// applicable to GitRepository and OCIRepository resources.
+	Tag string `json:"tag,omitempty"`
+}
+
 type GitClearConfig struct {
 	// Path to a working directory of a local repository from which to remove all files,
 	// excluding the .git/ directory.
@@ -219,6 +301,35 @@ type GitPushConfig struct {
 	TargetBranch string `json:"targetBranch,omitempty"`
 }
 
+type GitRevertConfig struct {
+	// Optional authorship information for the revert commits. If provided, this takes
+	// precedence over both system-level defaults and any optional, default authorship
+	// information configured in the `git-clone` step.
+	Author *GitRevertConfigAuthor `json:"author,omitempty"`
+	// IDs of additional commits to revert. Commits that are not reachable from the working
+	// tree's current HEAD are ignored.
+	Commits []string `json:"commits,omitempty"`
+	// The path to a working directory of a local repository.
+	Path string `json:"path"`
+	// The name of a previous Promotion in the same Project whose commits should be reverted.
+	// The commits are taken from the output of the Promotion's successful `git-wait-for-pr`
+	// steps or, if there are none, its successful `git-push` steps. Commits that are not
+	// reachable from the working tree's current HEAD are ignored.
+	Promotion string `json:"promotion,omitempty"`
+}
+
+// Optional authorship information for the revert commits. If provided, this takes
+// precedence over both system-level defaults and any optional, default authorship
+// information configured in the `git-clone` step.
+type GitRevertConfigAuthor struct {
+	// The email of the author.
+	Email string `json:"email"`
+	// The name of the author.
+	Name string `json:"name"`
+	// The GPG signing key for the author.
+	SigningKey string `json:"signingKey,omitempty"`
+}
+
 type GitWaitForPRConfig struct {
 	// Indicates whether to skip TLS verification when cloning the repository. Default is false.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
@@ -232,6 +343,32 @@ type GitWaitForPRConfig struct {
 	RepoURL string `json:"repoURL"`
 }
 
+type HCLUpdateConfig struct {
+	// The path to an HCL file.
+	Path string `json:"path"`
+	// A list of updates to apply to the HCL file.
+	Updates []HCLUpdate `json:"updates"`
+}
+
+type HCLUpdate struct {
+	// The key whose value needs to be updated. For nested values, use a dot notation path of attribute names, block types and block labels. Integers may be used to select elements of tuples.
+	Key string `json:"key"`
+	// The new value for the specified key.
+	Value interface{} `json:"value"`
+}
+
+type HelmDependencyBuildConfig struct {
+	// The path to a directory to use as a local cache of chart archives. Dependencies found in
+	// the cache are not downloaded, and downloaded dependencies are added to the cache.
+	CachePath string `json:"cachePath,omitempty"`
+	// Whether to build the dependencies without contacting any chart repository. When true,
+	// every dependency must already be present in the chart's `charts/` directory or in the
+	// cache.
+	Offline bool `json:"offline,omitempty"`
+	// The path at which the chart whose dependencies should be built can be found.
+	Path string `json:"path"`
+}
+
 type HelmTemplateConfig struct {
 	// APIVersions allows a manual set of supported API Versions to be passed when rendering the
 	// manifests.
@@ -335,6 +472,20 @@ type HTTPQueryParam struct {
 	Value string `json:"value"`
 }
 
+type INIUpdateConfig struct {
+	// The path to an INI file.
+	Path string `json:"path"`
+	// A list of updates to apply to the INI file.
+	Updates []INIUpdate `json:"updates"`
+}
+
+type INIUpdate struct {
+	// The key whose value needs to be updated, of the form <section>.<key>. Keys that precede the first section of the file are addressed by their name alone.
+	Key string `json:"key"`
+	// The new value for the specified key.
+	Value interface{} `json:"value"`
+}
+
 type JSONParseConfig struct {
 	// An array of outputs to extract from the JSON file.
 	Outputs []JSONParse `json:"outputs"`
@@ -363,7 +514,31 @@ type JSONUpdate struct {
 	Value interface{} `json:"value"`
 }
 
+type JsonnetRenderConfig struct {
+	// ExtVars are external variables made available to the Jsonnet code through std.extVar().
+	// String values are passed as strings, while any other values are passed as Jsonnet code.
+	ExtVars map[string]interface{} `json:"extVars,omitempty"`
+	// JPath is a list of library search paths for imports, relative to the working directory.
+	// These are searched in order after the directory of the importing file.
+	JPath []string `json:"jpath,omitempty"`
+	// OutPath is the file path to write the rendered output to. If it has a .json extension,
+	// the output is written as JSON. Otherwise, it is written as YAML, with each element of a
+	// top-level array written as a separate document.
+	OutPath string `json:"outPath"`
+	// Path is the path to the Jsonnet file to render.
+	Path string `json:"path"`
+	// TLAVars are top-level arguments passed to the Jsonnet code if it evaluates to a
+	// function. String values are passed as strings, while any other values are passed as
+	// Jsonnet code.
+	TLAVars map[string]interface{} `json:"tlaVars,omitempty"`
+}
+
 type KustomizeBuildConfig struct {
+	// LoadRestrictor restricts the files Kustomize may load. LoadRestrictionsRootOnly only
+	// allows files within the directory of a Kustomization file and its subdirectories to be
+	// loaded. LoadRestrictionsNone allows any file within the working directory to be loaded.
+	// Defaults to LoadRestrictionsNone.
+	LoadRestrictor *LoadRestrictor `json:"loadRestrictor,omitempty"`
 	// OutPath is the file path to write the built manifests to.
 	OutPath string `json:"outPath"`
 	// Path to the directory containing the Kustomization file.
@@ -383,6 +558,11 @@ type Helm struct {
 	// APIVersions allows a manual set of supported API versions to be passed when inflating a
 	// Helm chart.
 	APIVersions []string `json:"apiVersions,omitempty"`
+	// Enabled enables the inflation of Helm charts referenced by the helmCharts field of
+	// Kustomization files and by HelmChartInflationGenerator generators. Charts are inflated
+	// using Kargo's built-in Helm support, and pulled from their repositories using the
+	// credentials known to Kargo.
+	Enabled bool `json:"enabled,omitempty"`
 	// KubeVersion allows for passing a specific Kubernetes version to use when inflating a Helm
 	// chart.
 	KubeVersion string `json:"kubeVersion,omitempty"`
@@ -413,6 +593,107 @@ type Image struct {
 	Tag string `json:"tag,omitempty"`
 }
 
+type OCIPullConfig struct {
+	// The reference to the OCI artifact to pull, including its tag or digest. e.g.
+	// ghcr.io/example/bundle:v1.0.0
+	ImageRef string `json:"imageRef"`
+	// Whether to skip TLS verification when pulling the artifact. Default is false.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// If specified, only layers of the artifact with this media type are pulled.
+	MediaType string `json:"mediaType,omitempty"`
+	// The path to the directory the artifact's layers are written to. Each layer is written to
+	// a file named after its `org.opencontainers.image.title` annotation or, if it has none,
+	// after its digest.
+	OutPath string `json:"outPath"`
+}
+
+type OCIPushConfig struct {
+	// Annotations to add to the manifest of the artifact. These take precedence over the
+	// annotations Kargo adds by default.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// The media type of the config of the artifact. Default is
+	// 'application/vnd.cncf.flux.config.v1+json'.
+	ConfigMediaType string `json:"configMediaType,omitempty"`
+	// The reference to push the artifact to, including its tag. e.g.
+	// ghcr.io/example/manifests:test
+	ImageRef string `json:"imageRef"`
+	// Whether to skip TLS verification when pushing the artifact. Default is false.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// The media type of the layer of the artifact. Default is
+	// 'application/vnd.cncf.flux.content.v1.tar+gzip'.
+	MediaType string `json:"mediaType,omitempty"`
+	// The path to a file or directory to package as an OCI artifact. The file, or the contents
+	// of the directory, are packaged as a gzipped tarball in a single layer.
+	Path string `json:"path"`
+}
+
+type SOPSDecryptConfig struct {
+	// The name of a Secret in the Project namespace that holds the private keys to decrypt the
+	// file with. Values of keys with the '.agekey' suffix are read as age identities and values
+	// of keys with the '.asc' suffix as ASCII armored PGP private keys.
+	KeySecret string `json:"keySecret"`
+	// The path to the file the decrypted content is written to. If not specified, the file is
+	// decrypted in place.
+	OutPath string `json:"outPath,omitempty"`
+	// The path to a SOPS-encrypted YAML or JSON file.
+	Path string `json:"path"`
+}
+
+type SOPSEncryptConfig struct {
+	// The age recipients to encrypt a new file for. These are only used when there is no
+	// SOPS-encrypted file at the output path yet.
+	AgeRecipients []string `json:"ageRecipients,omitempty"`
+	// A regular expression that limits encryption of a new file to the values of keys that
+	// match it. By default, all values except those of keys with the '_unencrypted' suffix are
+	// encrypted. This is only used when there is no SOPS-encrypted file at the output path yet.
+	EncryptedRegex string `json:"encryptedRegex,omitempty"`
+	// The name of a Secret in the Project namespace that holds the private keys to decrypt the
+	// data key of the SOPS-encrypted file at the output path with. Values of keys with the
+	// '.agekey' suffix are read as age identities and values of keys with the '.asc' suffix as
+	// ASCII armored PGP private keys.
+	KeySecret string `json:"keySecret,omitempty"`
+	// The path to the file the encrypted content is written to. If this is an existing
+	// SOPS-encrypted file, its data key, master keys and encryption rules are reused and values
+	// that did not change keep their ciphertext. If not specified, the file is encrypted in
+	// place.
+	OutPath string `json:"outPath,omitempty"`
+	// The path to a plain YAML or JSON file.
+	Path string `json:"path"`
+}
+
+type TemplateConfig struct {
+	// Whether rendering a template fails when an expression evaluates to nil, as references to
+	// missing keys do. When false, such expressions are rendered as empty strings. Default is
+	// false.
+	Strict bool `json:"strict,omitempty"`
+	// A list of templates to render.
+	Templates []Template `json:"templates"`
+}
+
+type Template struct {
+	// The path to a template file. Mutually exclusive with 'inline'.
+	InPath string `json:"inPath,omitempty"`
+	// The text of the template. Mutually exclusive with 'inPath'.
+	Inline string `json:"inline,omitempty"`
+	// The path to the file the rendered template is written to. Any existing file at this path
+	// is overwritten.
+	OutPath string `json:"outPath"`
+}
+
+type TOMLUpdateConfig struct {
+	// The path to a TOML file.
+	Path string `json:"path"`
+	// A list of updates to apply to the TOML file.
+	Updates []TOMLUpdate `json:"updates"`
+}
+
+type TOMLUpdate struct {
+	// The key whose value needs to be updated. For nested values, use a dot notation path. Integers may be used to select elements of arrays and arrays of tables.
+	Key string `json:"key"`
+	// The new value for the specified key.
+	Value interface{} `json:"value"`
+}
+
 type YAMLParseConfig struct {
 	// An array of outputs to extract from the YAML file.
 	Outputs []YAMLParse `json:"outputs"`
@@ -453,3 +734,24 @@ const (
 	Github    Provider = "github"
 	Gitlab    Provider = "gitlab"
 )
+
+// The kind of the Flux resource to update.
+type Kind string
+
+const (
+	GitRepository Kind = "GitRepository"
+	HelmRelease   Kind = "HelmRelease"
+	Kustomization Kind = "Kustomization"
+	OCIRepository Kind = "OCIRepository"
+)
+
+// LoadRestrictor restricts the files Kustomize may load. LoadRestrictionsRootOnly only
+// allows files within the directory of a Kustomization file and its subdirectories to be
+// loaded. LoadRestrictionsNone allows any file within the working directory to be loaded.
+// Defaults to LoadRestrictionsNone.
+type LoadRestrictor string
+
+const (
+	LoadRestrictionsNone     LoadRestrictor = "LoadRestrictionsNone"
+	LoadRestrictionsRootOnly LoadRestrictor = "LoadRestrictionsRootOnly"
+)
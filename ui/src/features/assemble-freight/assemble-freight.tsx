@@ -60,14 +60,22 @@ const constructFreight = (
         repoURL: artifact.repoURL,
         tag: imageRef.tag,
         digest: imageRef.digest,
+        sourceRepoURL: imageRef.sourceRepoURL,
+        sourceCommit: imageRef.sourceCommit,
         // Deprecated: Use OCI annotations instead. Will be removed in version 1.7.
         gitRepoURL: imageRef.gitRepoURL
       } as Image);
     } else if ('versions' in artifact) {
+      const metadata = (artifact as ChartDiscoveryResult).metadata?.find(
+        (m) => m.version === info
+      );
       freight.charts.push({
         repoURL: artifact.repoURL,
         name: artifact.name,
-        version: info as string
+        version: info as string,
+        appVersion: metadata?.appVersion,
+        annotations: metadata?.annotations,
+        dependencies: metadata?.dependencies
       } as Chart);
     } else if ('commits' in artifact) {
       const commitRef = info as DiscoveredCommit;
@@ -78,7 +86,9 @@ const constructFreight = (
         branch: commitRef.branch,
         tag: commitRef.tag,
         author: commitRef.author,
-        committer: commitRef.committer
+        committer: commitRef.committer,
+        pullRequestNumber: commitRef.pullRequestNumber,
+        pullRequestURL: commitRef.pullRequestURL
       } as GitCommit);
     }
   }
@@ -292,6 +302,7 @@ const DiscoveryTable = ({
 
       <ChartTable
         versions={(selected as ChartDiscoveryResult).versions || []}
+        metadata={(selected as ChartDiscoveryResult).metadata}
         select={select}
         selected={selectedItem as string}
         show={'versions' in selected}
@@ -1,13 +1,17 @@
 import { Radio, Table } from 'antd';
 import { useState } from 'react';
 
+import { ChartVersionMetadata } from '@ui/gen/api/v1alpha1/generated_pb';
+
 export const ChartTable = ({
   versions,
+  metadata,
   selected,
   select,
   show
 }: {
   versions: string[];
+  metadata?: ChartVersionMetadata[];
   selected: string | undefined;
   select: (version?: string) => void;
   show?: boolean;
@@ -20,7 +24,10 @@ export const ChartTable = ({
 
   return (
     <Table
-      dataSource={versions.map((version) => ({ version }))}
+      dataSource={versions.map((version) => ({
+        version,
+        appVersion: metadata?.find((m) => m.version === version)?.appVersion
+      }))}
       pagination={{ current: page, onChange: (page) => setPage(page) }}
       columns={[
         {
@@ -32,7 +39,8 @@ export const ChartTable = ({
             />
           )
         },
-        { title: 'Version', dataIndex: 'version' }
+        { title: 'Version', dataIndex: 'version' },
+        { title: 'App Version', dataIndex: 'appVersion' }
       ]}
     />
   );
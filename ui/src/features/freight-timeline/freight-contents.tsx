@@ -60,7 +60,7 @@ export const FreightContents = (props: {
           icon={faDocker}
           href={urlForImage(i.repoURL || '')}
           fullContentVisibility={props.fullContentVisibility}
-          artifactSource={getImageSource(i?.annotations)}
+          artifactSource={getImageSource(i?.annotations, i)}
           artifactBuildDate={getImageBuiltDate(i?.annotations)}
         >
           {`${props.horizontal ? i.repoURL + ':' : ''}${i.tag}`}
@@ -13,11 +13,17 @@ export const ociAnnotationKeys = {
   revision: `${ociPrefix}.revision`
 };
 
-export const getImageSource = (annotation: Annotation) => {
-  const url = annotation?.[ociAnnotationKeys.source];
-  const revision = annotation?.[ociAnnotationKeys.revision];
+// source information Kargo recorded from the image's OCI annotations or labels
+type ImageSourceInfo = {
+  sourceRepoURL?: string;
+  sourceCommit?: string;
+};
+
+export const getImageSource = (annotation: Annotation, image?: ImageSourceInfo) => {
+  const url = annotation?.[ociAnnotationKeys.source] || image?.sourceRepoURL;
+  const revision = annotation?.[ociAnnotationKeys.revision] || image?.sourceCommit;
 
-  if (!revision) {
+  if (!url || !revision) {
     return url;
   }
 
@@ -15,7 +15,7 @@ import { TableSource } from './flatten-freight-origin-utils';
 export const ArtifactMetadata = (props: TableSource) => {
   const [showOnlyOci, setShowOnlyOci] = useState(true);
   if (props.type === 'image') {
-    const artifactSource = getImageSource(props?.annotations || {});
+    const artifactSource = getImageSource(props?.annotations || {}, props);
     const artifactBuildDate = getImageBuiltDate(props?.annotations || {});
     const { ociPrefixedAnnotations, restAnnotations } = splitOciPrefixedAnnotations(
       props?.annotations || {}
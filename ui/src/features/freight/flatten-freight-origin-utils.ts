@@ -6,6 +6,8 @@ export type TableSource =
       repoURL: string;
       tag?: string;
       annotations?: Record<string, string>;
+      sourceRepoURL?: string;
+      sourceCommit?: string;
     }
   | {
       type: 'git';
@@ -28,7 +30,9 @@ export const flattenFreightOrigin = (freight: Freight): TableSource[] => {
       type: 'image',
       repoURL: image?.repoURL,
       tag: image?.tag,
-      annotations: image?.annotations
+      annotations: image?.annotations,
+      sourceRepoURL: image?.sourceRepoURL,
+      sourceCommit: image?.sourceCommit
     })) || [];
 
   const git: TableSource[] = freight?.commits?.map((commit) => ({
@@ -1,4 +1,9 @@
-import { IconDefinition, faCertificate, faCircleCheck } from '@fortawesome/free-solid-svg-icons';
+import {
+  IconDefinition,
+  faBan,
+  faCertificate,
+  faCircleCheck
+} from '@fortawesome/free-solid-svg-icons';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
 import { useContext } from 'react';
 
@@ -45,8 +50,38 @@ const StageStatusList = ({
   );
 };
 
+const RejectionNotice = ({ freight }: { freight?: Freight }) => {
+  const rejected = freight?.status?.rejected;
+  const rejectedFor = Object.entries(freight?.status?.rejectedFor || {});
+  if (!rejected && rejectedFor.length === 0) {
+    return null;
+  }
+  return (
+    <div className='mb-6 w-full bg-red-50 px-3 py-2 text-red-700 rounded'>
+      <FontAwesomeIcon icon={faBan} className='mr-2' />
+      {rejected ? (
+        <span className='font-semibold'>
+          This freight has been rejected for all stages: {rejected.reason}
+        </span>
+      ) : (
+        <span className='font-semibold'>This freight has been rejected for some stages.</span>
+      )}
+      {rejectedFor.length > 0 && (
+        <ul className='mt-2 list-disc pl-5'>
+          {rejectedFor.map(([stageName, rejection]) => (
+            <li key={stageName}>
+              {stageName}: {rejection.reason}
+            </li>
+          ))}
+        </ul>
+      )}
+    </div>
+  );
+};
+
 export const FreightStatusList = ({ freight }: { freight?: Freight }) => (
   <div>
+    <RejectionNotice freight={freight} />
     <StageStatusList
       title='verified in'
       stageNames={Object.keys(freight?.status?.verifiedIn || {}) || []}
@@ -77,11 +77,7 @@ export const FreightArtifact = (props: FreightArtifactProps) => {
     );
   }
 
-  let imageSourceFromOci = '';
-
-  if (props.artifact.annotations) {
-    imageSourceFromOci = getImageSource(props.artifact.annotations);
-  }
+  const imageSourceFromOci = getImageSource(props.artifact.annotations, props.artifact) || '';
 
   const TagComponent = (
     <Tag
@@ -252,11 +252,10 @@ const Artifact = (props: { artifact: string | GitCommit | Chart | Image }) => {
     );
   }
 
-  let imageSourceFromOci = '';
+  const imageSourceFromOci = getImageSource(props.artifact.annotations, props.artifact) || '';
   let imageBuiltDate = '';
 
   if (props.artifact.annotations) {
-    imageSourceFromOci = getImageSource(props.artifact.annotations);
     imageBuiltDate = getImageBuiltDate(props.artifact.annotations);
   }
 
@@ -50,8 +50,8 @@ const createFormSchema = (genericCreds: boolean, editing?: boolean) => {
     });
   }
 
-  return schema.refine((data) => ['git', 'helm', 'image', 'generic'].includes(data.type), {
-    message: "Type must be one of 'git', 'helm', 'image' or 'generic'."
+  return schema.refine((data) => ['git', 'helm', 'image', 'http', 'generic'].includes(data.type), {
+    message: "Type must be one of 'git', 'helm', 'image', 'http' or 'generic'."
   });
 };
 
@@ -182,7 +182,8 @@ export const CreateCredentialsModal = ({ project, onSuccess, editing, init, ...p
                 options={[
                   { label: typeLabel('git'), value: 'git' },
                   { label: typeLabel('helm'), value: 'helm' },
-                  { label: typeLabel('image'), value: 'image' }
+                  { label: typeLabel('image'), value: 'image' },
+                  { label: typeLabel('http'), value: 'http' }
                 ]}
                 onChange={(newValue) => field.onChange(newValue)}
                 value={field.value}
@@ -1,5 +1,10 @@
 import { faDocker, faGit } from '@fortawesome/free-brands-svg-icons';
-import { faAnchor, faDharmachakra, faQuestionCircle } from '@fortawesome/free-solid-svg-icons';
+import {
+  faAnchor,
+  faDharmachakra,
+  faGlobe,
+  faQuestionCircle
+} from '@fortawesome/free-solid-svg-icons';
 
 import { SegmentLabel } from '@ui/features/common/segment-label';
 import { DESCRIPTION_ANNOTATION_KEY } from '@ui/features/common/utils';
@@ -19,6 +24,8 @@ export const iconForCredentialsType = (type: CredentialsType) => {
       return faAnchor;
     case 'image':
       return faDocker;
+    case 'http':
+      return faGlobe;
     case 'generic':
       return faDharmachakra;
     default:
@@ -2,21 +2,34 @@ import { JSONSchema7 } from 'json-schema';
 
 // IMPORTANT(Marvin9): this must be replaced with proper discovery mechanism
 import argocdUpdateConfig from '@ui/gen/directives/argocd-update-config.json';
+import containerRunConfig from '@ui/gen/directives/container-run-config.json';
 import copyConfig from '@ui/gen/directives/copy-config.json';
+import cueExportConfig from '@ui/gen/directives/cue-export-config.json';
 import deleteConfig from '@ui/gen/directives/delete-config.json';
+import fluxUpdateConfig from '@ui/gen/directives/flux-update-config.json';
 import gitOverwriteConfig from '@ui/gen/directives/git-clear-config.json';
 import gitCloneConfig from '@ui/gen/directives/git-clone-config.json';
 import gitCommitConfig from '@ui/gen/directives/git-commit-config.json';
 import gitOpenPR from '@ui/gen/directives/git-open-pr-config.json';
 import gitPushConfig from '@ui/gen/directives/git-push-config.json';
 import gitWaitForPR from '@ui/gen/directives/git-wait-for-pr-config.json';
+import hclUpdateConfig from '@ui/gen/directives/hcl-update-config.json';
+import helmDependencyBuildConfig from '@ui/gen/directives/helm-dependency-build-config.json';
 import helmTemplateConfig from '@ui/gen/directives/helm-template-config.json';
 import helmUpdateChartConfig from '@ui/gen/directives/helm-update-chart-config.json';
 import httpConfig from '@ui/gen/directives/http-config.json';
+import iniUpdateConfig from '@ui/gen/directives/ini-update-config.json';
+import jsonnetRenderConfig from '@ui/gen/directives/jsonnet-render-config.json';
 import jsonParseConfig from '@ui/gen/directives/json-parse-config.json';
 import jsonUpdateConfig from '@ui/gen/directives/json-update-config.json';
 import kustomizeBuildConfig from '@ui/gen/directives/kustomize-build-config.json';
 import kustomizeSetImageConfig from '@ui/gen/directives/kustomize-set-image-config.json';
+import ociPullConfig from '@ui/gen/directives/oci-pull-config.json';
+import ociPushConfig from '@ui/gen/directives/oci-push-config.json';
+import sopsDecryptConfig from '@ui/gen/directives/sops-decrypt-config.json';
+import sopsEncryptConfig from '@ui/gen/directives/sops-encrypt-config.json';
+import templateConfig from '@ui/gen/directives/template-config.json';
+import tomlUpdateConfig from '@ui/gen/directives/toml-update-config.json';
 import yamlParseConfig from '@ui/gen/directives/yaml-parse-config.json';
 import yamlUpdateConfig from '@ui/gen/directives/yaml-update-config.json';
 
@@ -41,6 +54,10 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
         unstable_icons: [],
         config: deleteConfig as JSONSchema7
       },
+      {
+        identifier: 'flux-update',
+        config: fluxUpdateConfig as JSONSchema7
+      },
       {
         identifier: 'git-clone',
         config: gitCloneConfig as JSONSchema7
@@ -77,6 +94,22 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
         identifier: 'json-update',
         config: jsonUpdateConfig as unknown as JSONSchema7
       },
+      {
+        identifier: 'toml-update',
+        config: tomlUpdateConfig as unknown as JSONSchema7
+      },
+      {
+        identifier: 'hcl-update',
+        config: hclUpdateConfig as unknown as JSONSchema7
+      },
+      {
+        identifier: 'ini-update',
+        config: iniUpdateConfig as unknown as JSONSchema7
+      },
+      {
+        identifier: 'template',
+        config: templateConfig as unknown as JSONSchema7
+      },
       {
         identifier: 'git-push',
         config: gitPushConfig as unknown as JSONSchema7
@@ -89,10 +122,22 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
         identifier: 'helm-update-chart',
         config: helmUpdateChartConfig as JSONSchema7
       },
+      {
+        identifier: 'helm-dependency-build',
+        config: helmDependencyBuildConfig as JSONSchema7
+      },
       {
         identifier: 'helm-template',
         config: helmTemplateConfig as JSONSchema7
       },
+      {
+        identifier: 'jsonnet-render',
+        config: jsonnetRenderConfig as JSONSchema7
+      },
+      {
+        identifier: 'cue-export',
+        config: cueExportConfig as JSONSchema7
+      },
       {
         identifier: 'kustomize-build',
         config: kustomizeBuildConfig as JSONSchema7
@@ -104,6 +149,26 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
       {
         identifier: 'http',
         config: httpConfig as JSONSchema7
+      },
+      {
+        identifier: 'oci-pull',
+        config: ociPullConfig as JSONSchema7
+      },
+      {
+        identifier: 'oci-push',
+        config: ociPushConfig as JSONSchema7
+      },
+      {
+        identifier: 'sops-decrypt',
+        config: sopsDecryptConfig as JSONSchema7
+      },
+      {
+        identifier: 'sops-encrypt',
+        config: sopsEncryptConfig as JSONSchema7
+      },
+      {
+        identifier: 'container-run',
+        config: containerRunConfig as JSONSchema7
       }
     ]
   };
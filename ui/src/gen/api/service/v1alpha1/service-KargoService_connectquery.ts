@@ -87,6 +87,16 @@ export const getPromotion = KargoService.method.getPromotion;
  */
 export const abortPromotion = KargoService.method.abortPromotion;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UndoPromotion
+ */
+export const undoPromotion = KargoService.method.undoPromotion;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RetryPromotion
+ */
+export const retryPromotion = KargoService.method.retryPromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject
  */
@@ -142,6 +152,11 @@ export const promoteDownstream = KargoService.method.promoteDownstream;
  */
 export const queryFreight = KargoService.method.queryFreight;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RejectFreight
+ */
+export const rejectFreight = KargoService.method.rejectFreight;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UpdateFreightAlias
  */
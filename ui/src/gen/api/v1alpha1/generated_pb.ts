@@ -20,7 +20,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1alpha1/generated.proto.
  */
 export const file_api_v1alpha1_generated: GenFile = /*@__PURE__*/
-  fileDesc("ChxhcGkvdjFhbHBoYTEvZ2VuZXJhdGVkLnByb3RvEiRnaXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEiMgoTQW5hbHlzaXNSdW5Bcmd1bWVudBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIrACChNBbmFseXNpc1J1bk1ldGFkYXRhElUKBmxhYmVscxgBIAMoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhLkxhYmVsc0VudHJ5El8KC2Fubm90YXRpb25zGAIgAygLMkouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuQW5ub3RhdGlvbnNFbnRyeRotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJGChRBbmFseXNpc1J1blJlZmVyZW5jZRIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRINCgVwaGFzZRgDIAEoCSI3ChlBbmFseXNpc1RlbXBsYXRlUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSJPCg1BcHByb3ZlZFN0YWdlEj4KCmFwcHJvdmVkQXQYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSI4ChVBcmdvQ0RBcHBIZWFsdGhTdGF0dXMSDgoGc3RhdHVzGAEgASgJEg8KB21lc3NhZ2UYAiABKAki1AEKD0FyZ29DREFwcFN0YXR1cxIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRJRCgxoZWFsdGhTdGF0dXMYAyABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwSGVhbHRoU3RhdHVzEk0KCnN5bmNTdGF0dXMYBCABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwU3luY1N0YXR1cyJKChNBcmdvQ0RBcHBTeW5jU3RhdHVzEg4KBnN0YXR1cxgBIAEoCRIQCghyZXZpc2lvbhgCIAEoCRIRCglyZXZpc2lvbnMYAyADKAkiNwoFQ2hhcnQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB3ZlcnNpb24YAyABKAkiYQoUQ2hhcnREaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSEAoIdmVyc2lvbnMYBCADKAkiZAoRQ2hhcnRTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSFgoOZGlzY292ZXJ5TGltaXQYBCABKAUioQEKFENsdXN0ZXJQcm9tb3Rpb25UYXNrEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrU3BlYyKnAQoYQ2x1c3RlclByb21vdGlvblRhc2tMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkkKBWl0ZW1zGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJQcm9tb3Rpb25UYXNrIkkKDEN1cnJlbnRTdGFnZRI5CgVzaW5jZRgBIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIrYCChNEaXNjb3ZlcmVkQXJ0aWZhY3RzEkAKDGRpc2NvdmVyZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEkUKA2dpdBgBIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXREaXNjb3ZlcnlSZXN1bHQSSgoGaW1hZ2VzGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlRGlzY292ZXJ5UmVzdWx0EkoKBmNoYXJ0cxgDIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydERpc2NvdmVyeVJlc3VsdCKwAQoQRGlzY292ZXJlZENvbW1pdBIKCgJpZBgBIAEoCRIOCgZicmFuY2gYAiABKAkSCwoDdGFnGAMgASgJEg8KB3N1YmplY3QYBCABKAkSDgoGYXV0aG9yGAUgASgJEhEKCWNvbW1pdHRlchgGIAEoCRI/CgtjcmVhdG9yRGF0ZRgHIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIqQCChhEaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2USCwoDdGFnGAEgASgJEg4KBmRpZ2VzdBgCIAEoCRJkCgthbm5vdGF0aW9ucxgFIAMoCzJPLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2UuQW5ub3RhdGlvbnNFbnRyeRISCgpnaXRSZXBvVVJMGAMgASgJEj0KCWNyZWF0ZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASIxChJFeHByZXNzaW9uVmFyaWFibGUSDAoEbmFtZRgBIAEoCRINCgV2YWx1ZRgCIAEoCSKiAwoHRnJlaWdodBJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEg0KBWFsaWFzGAcgASgJEkMKBm9yaWdpbhgJIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEkAKB2NvbW1pdHMYAyADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0Q29tbWl0EjsKBmltYWdlcxgEIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZRI7CgZjaGFydHMYBSADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnQSQwoGc3RhdHVzGAYgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMirQIKEUZyZWlnaHRDb2xsZWN0aW9uEgoKAmlkGAMgASgJElEKBWl0ZW1zGAEgAygLMkIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uLkl0ZW1zRW50cnkSUwoTdmVyaWZpY2F0aW9uSGlzdG9yeRgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25JbmZvGmQKCkl0ZW1zRW50cnkSCwoDa2V5GAEgASgJEkUKBXZhbHVlGAIgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZWZlcmVuY2U6AjgBIo0BCgtGcmVpZ2h0TGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI8CgVpdGVtcxgCIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0IisKDUZyZWlnaHRPcmlnaW4SDAoEa2luZBgBIAEoCRIMCgRuYW1lGAIgASgJIqECChBGcmVpZ2h0UmVmZXJlbmNlEgwKBG5hbWUYASABKAkSQwoGb3JpZ2luGAggASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SQAoHY29tbWl0cxgCIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRDb21taXQSOwoGaW1hZ2VzGAMgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlEjsKBmNoYXJ0cxgEIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydCKcAQoORnJlaWdodFJlcXVlc3QSQwoGb3JpZ2luGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SRQoHc291cmNlcxgCIAEoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U291cmNlcyKYAQoORnJlaWdodFNvdXJjZXMSDgoGZGlyZWN0GAEgASgIEg4KBnN0YWdlcxgCIAMoCRJIChByZXF1aXJlZFNvYWtUaW1lGAMgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhwKFGF2YWlsYWJpbGl0eVN0cmF0ZWd5GAQgASgJIp0GCg1GcmVpZ2h0U3RhdHVzElkKC2N1cnJlbnRseUluGAMgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQ3VycmVudGx5SW5FbnRyeRJXCgp2ZXJpZmllZEluGAEgAygLMkMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuVmVyaWZpZWRJbkVudHJ5ElkKC2FwcHJvdmVkRm9yGAIgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQXBwcm92ZWRGb3JFbnRyeRJTCghtZXRhZGF0YRgEIAMoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLk1ldGFkYXRhRW50cnkaZgoQQ3VycmVudGx5SW5FbnRyeRILCgNrZXkYASABKAkSQQoFdmFsdWUYAiABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ3VycmVudFN0YWdlOgI4ARpmCg9WZXJpZmllZEluRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWVkU3RhZ2U6AjgBGmcKEEFwcHJvdmVkRm9yRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmVkU3RhZ2U6AjgBGm8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEk0KBXZhbHVlGAIgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTjoCOAEieQoJR2l0Q29tbWl0Eg8KB3JlcG9VUkwYASABKAkSCgoCaWQYAiABKAkSDgoGYnJhbmNoGAMgASgJEgsKA3RhZxgEIAEoCRIPCgdtZXNzYWdlGAYgASgJEg4KBmF1dGhvchgHIAEoCRIRCgljb21taXR0ZXIYCCABKAkibgoSR2l0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSRwoHY29tbWl0cxgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkQ29tbWl0IlQKFUdpdEh1YldlYmhvb2tSZWNlaXZlchI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UijgIKD0dpdFN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEh8KF2NvbW1pdFNlbGVjdGlvblN0cmF0ZWd5GAIgASgJEg4KBmJyYW5jaBgDIAEoCRIVCg1zdHJpY3RTZW12ZXJzGAsgASgIEhgKEHNlbXZlckNvbnN0cmFpbnQYBCABKAkSEQoJYWxsb3dUYWdzGAUgASgJEhIKCmlnbm9yZVRhZ3MYBiADKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAcgASgIEhQKDGluY2x1ZGVQYXRocxgIIAMoCRIUCgxleGNsdWRlUGF0aHMYCSADKAkSFgoOZGlzY292ZXJ5TGltaXQYCiABKAUiyAEKBkhlYWx0aBIOCgZzdGF0dXMYASABKAkSDgoGaXNzdWVzGAIgAygJEk4KBmNvbmZpZxgEIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04STgoGb3V0cHV0GAUgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiJvCg9IZWFsdGhDaGVja1N0ZXASDAoEdXNlcxgBIAEoCRJOCgZjb25maWcYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIh4KC0hlYWx0aFN0YXRzEg8KB2hlYWx0aHkYASABKAMi0AEKBUltYWdlEg8KB3JlcG9VUkwYASABKAkSEgoKZ2l0UmVwb1VSTBgCIAEoCRILCgN0YWcYAyABKAkSDgoGZGlnZXN0GAQgASgJElEKC2Fubm90YXRpb25zGAUgAygLMjwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlLkFubm90YXRpb25zRW50cnkaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIo0BChRJbWFnZURpc2NvdmVyeVJlc3VsdBIPCgdyZXBvVVJMGAEgASgJEhAKCHBsYXRmb3JtGAIgASgJElIKCnJlZmVyZW5jZXMYAyADKAsyPi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZEltYWdlUmVmZXJlbmNlIvkBChFJbWFnZVN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEhIKCmdpdFJlcG9VUkwYAiABKAkSHgoWaW1hZ2VTZWxlY3Rpb25TdHJhdGVneRgDIAEoCRIVCg1zdHJpY3RTZW12ZXJzGAogASgIEhgKEHNlbXZlckNvbnN0cmFpbnQYBCABKAkSEQoJYWxsb3dUYWdzGAUgASgJEhIKCmlnbm9yZVRhZ3MYBiADKAkSEAoIcGxhdGZvcm0YByABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAggASgIEhYKDmRpc2NvdmVyeUxpbWl0GAkgASgFItkBCgdQcm9qZWN0EkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0Q29uZmlnU3BlYxJDCgZzdGF0dXMYAyABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdFN0YXR1cyLlAQoNUHJvamVjdENvbmZpZxJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkUKBHNwZWMYAiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZ1NwZWMSSQoGc3RhdHVzGAMgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWdTdGF0dXMimQEKEVByb2plY3RDb25maWdMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkIKBWl0ZW1zGAIgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWcitQEKEVByb2plY3RDb25maWdTcGVjElAKEXByb21vdGlvblBvbGljaWVzGAEgAygLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblBvbGljeRJOCglyZWNlaXZlcnMYAiADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnIqQBChNQcm9qZWN0Q29uZmlnU3RhdHVzEkMKCmNvbmRpdGlvbnMYASADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEkgKCXJlY2VpdmVycxgCIAMoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XZWJob29rUmVjZWl2ZXIijQEKC1Byb2plY3RMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjwKBWl0ZW1zGAIgAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3QimgEKDFByb2plY3RTdGF0cxJICgp3YXJlaG91c2VzGAEgASgLMjQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZVN0YXRzEkAKBnN0YWdlcxgCIAEoCzIwLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZVN0YXRzIpcBCg1Qcm9qZWN0U3RhdHVzEkMKCmNvbmRpdGlvbnMYAyADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEkEKBXN0YXRzGAQgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RTdGF0cyLZAQoJUHJvbW90aW9uEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESQQoEc3BlYxgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TcGVjEkUKBnN0YXR1cxgDIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGF0dXMikQEKDVByb21vdGlvbkxpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPgoFaXRlbXMYAiADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIpQBCg9Qcm9tb3Rpb25Qb2xpY3kSDQoFc3RhZ2UYASABKAkSVAoNc3RhZ2VTZWxlY3RvchgDIAEoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25Qb2xpY3lTZWxlY3RvchIcChRhdXRvUHJvbW90aW9uRW5hYmxlZBgCIAEoCCJzChdQcm9tb3Rpb25Qb2xpY3lTZWxlY3RvchIMCgRuYW1lGAEgASgJEkoKDWxhYmVsU2VsZWN0b3IYAiABKAsyMy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGFiZWxTZWxlY3RvciLyAQoSUHJvbW90aW9uUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSRwoHZnJlaWdodBgCIAEoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVmZXJlbmNlEkUKBnN0YXR1cxgDIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGF0dXMSPgoKZmluaXNoZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIrsBCg1Qcm9tb3Rpb25TcGVjEg0KBXN0YWdlGAEgASgJEg8KB2ZyZWlnaHQYAiABKAkSRgoEdmFycxgEIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYAyADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCK3BAoPUHJvbW90aW9uU3RhdHVzEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgEIAEoCRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEkcKB2ZyZWlnaHQYBSABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJSChFmcmVpZ2h0Q29sbGVjdGlvbhgHIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q29sbGVjdGlvbhJLCgxoZWFsdGhDaGVja3MYCCADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoQ2hlY2tTdGVwEj4KCmZpbmlzaGVkQXQYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRITCgtjdXJyZW50U3RlcBgJIAEoAxJaChVzdGVwRXhlY3V0aW9uTWV0YWRhdGEYCyADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEk0KBXN0YXRlGAogASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiL7AgoNUHJvbW90aW9uU3RlcBIMCgR1c2VzGAEgASgJEkoKBHRhc2sYBSABKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1JlZmVyZW5jZRIKCgJhcxgCIAEoCRIKCgJpZhgHIAEoCRIXCg9jb250aW51ZU9uRXJyb3IYCCABKAgSRwoFcmV0cnkYBCABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcFJldHJ5EkYKBHZhcnMYBiADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEk4KBmNvbmZpZxgDIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04ibQoSUHJvbW90aW9uU3RlcFJldHJ5Ej8KB3RpbWVvdXQYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SFgoOZXJyb3JUaHJlc2hvbGQYAiABKA0imgEKDVByb21vdGlvblRhc2sSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tTcGVjIpkBChFQcm9tb3Rpb25UYXNrTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRJCCgVpdGVtcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrIjQKFlByb21vdGlvblRhc2tSZWZlcmVuY2USDAoEbmFtZRgBIAEoCRIMCgRraW5kGAIgASgJIp8BChFQcm9tb3Rpb25UYXNrU3BlYxJGCgR2YXJzGAEgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJCCgVzdGVwcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIl4KEVByb21vdGlvblRlbXBsYXRlEkkKBHNwZWMYASABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGVTcGVjIqMBChVQcm9tb3Rpb25UZW1wbGF0ZVNwZWMSRgoEdmFycxgCIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYASADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCLmAQoQUmVwb1N1YnNjcmlwdGlvbhJCCgNnaXQYASABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0U3Vic2NyaXB0aW9uEkYKBWltYWdlGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlU3Vic2NyaXB0aW9uEkYKBWNoYXJ0GAMgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0U3Vic2NyaXB0aW9uIs0BCgVTdGFnZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEj0KBHNwZWMYAiABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTcGVjEkEKBnN0YXR1cxgDIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZVN0YXR1cyKJAQoJU3RhZ2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjoKBWl0ZW1zGAIgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlItACCglTdGFnZVNwZWMSDQoFc2hhcmQYBCABKAkSRgoEdmFycxgHIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSTgoQcmVxdWVzdGVkRnJlaWdodBgFIAMoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVxdWVzdBJSChFwcm9tb3Rpb25UZW1wbGF0ZRgGIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UZW1wbGF0ZRJICgx2ZXJpZmljYXRpb24YAyABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuVmVyaWZpY2F0aW9uIl4KClN0YWdlU3RhdHMSDQoFY291bnQYAiABKAMSQQoGaGVhbHRoGAEgASgLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aFN0YXRzItYDCgtTdGFnZVN0YXR1cxJDCgpjb25kaXRpb25zGA0gAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhIaChJsYXN0SGFuZGxlZFJlZnJlc2gYCyABKAkSTwoOZnJlaWdodEhpc3RvcnkYBCADKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENvbGxlY3Rpb24SFgoOZnJlaWdodFN1bW1hcnkYDCABKAkSPAoGaGVhbHRoGAggASgLMiwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aBIaChJvYnNlcnZlZEdlbmVyYXRpb24YBiABKAMSUgoQY3VycmVudFByb21vdGlvbhgHIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25SZWZlcmVuY2USTwoNbGFzdFByb21vdGlvbhgKIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25SZWZlcmVuY2Ui8wEKFVN0ZXBFeGVjdXRpb25NZXRhZGF0YRINCgVhbGlhcxgBIAEoCRI9CglzdGFydGVkQXQYAiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRI+CgpmaW5pc2hlZEF0GAMgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSEgoKZXJyb3JDb3VudBgEIAEoDRIOCgZzdGF0dXMYBSABKAkSDwoHbWVzc2FnZRgGIAEoCRIXCg9jb250aW51ZU9uRXJyb3IYByABKAgiiwIKDFZlcmlmaWNhdGlvbhJaChFhbmFseXNpc1RlbXBsYXRlcxgBIAMoCzI/LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1RlbXBsYXRlUmVmZXJlbmNlElYKE2FuYWx5c2lzUnVuTWV0YWRhdGEYAiABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5NZXRhZGF0YRJHCgRhcmdzGAMgAygLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuQXJndW1lbnQinQIKEFZlcmlmaWNhdGlvbkluZm8SCgoCaWQYBCABKAkSDQoFYWN0b3IYByABKAkSPQoJc3RhcnRUaW1lGAUgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSDQoFcGhhc2UYASABKAkSDwoHbWVzc2FnZRgCIAEoCRJPCgthbmFseXNpc1J1bhgDIAEoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1blJlZmVyZW5jZRI+CgpmaW5pc2hUaW1lGAYgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUilAEKDVZlcmlmaWVkU3RhZ2USPgoKdmVyaWZpZWRBdBgBIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEkMKC2xvbmdlc3RTb2FrGAIgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uItkBCglXYXJlaG91c2USQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJBCgRzcGVjGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZVNwZWMSRQoGc3RhdHVzGAMgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZVN0YXR1cyKRAQoNV2FyZWhvdXNlTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI+CgVpdGVtcxgCIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2UizgEKDVdhcmVob3VzZVNwZWMSDQoFc2hhcmQYAiABKAkSQAoIaW50ZXJ2YWwYBCABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SHQoVZnJlaWdodENyZWF0aW9uUG9saWN5GAMgASgJEk0KDXN1YnNjcmlwdGlvbnMYASADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUmVwb1N1YnNjcmlwdGlvbiJiCg5XYXJlaG91c2VTdGF0cxINCgVjb3VudBgCIAEoAxJBCgZoZWFsdGgYASABKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoU3RhdHMi/QEKD1dhcmVob3VzZVN0YXR1cxJDCgpjb25kaXRpb25zGAkgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBiABKAkSGgoSb2JzZXJ2ZWRHZW5lcmF0aW9uGAQgASgDEhUKDWxhc3RGcmVpZ2h0SUQYCCABKAkSVgoTZGlzY292ZXJlZEFydGlmYWN0cxgHIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkQXJ0aWZhY3RzIjoKD1dlYmhvb2tSZWNlaXZlchIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAyABKAkSCwoDdXJsGAQgASgJInIKFVdlYmhvb2tSZWNlaXZlckNvbmZpZxIMCgRuYW1lGAEgASgJEksKBmdpdGh1YhgCIAEoCzI7LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRIdWJXZWJob29rUmVjZWl2ZXJClwIKKGNvbS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTFCDkdlbmVyYXRlZFByb3RvUAFaJGdpdGh1Yi5jb20vYWt1aXR5L2thcmdvL2FwaS92MWFscGhhMaICBUdDQUtBqgIkR2l0aHViLkNvbS5Ba3VpdHkuS2FyZ28uQXBpLlYxYWxwaGExygIkR2l0aHViXENvbVxBa3VpdHlcS2FyZ29cQXBpXFYxYWxwaGEx4gIwR2l0aHViXENvbVxBa3VpdHlcS2FyZ29cQXBpXFYxYWxwaGExXEdQQk1ldGFkYXRh6gIpR2l0aHViOjpDb206OkFrdWl0eTo6S2FyZ286OkFwaTo6VjFhbHBoYTE", [file_k8s_io_api_core_v1_generated, file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
+  fileDesc("ChxhcGkvdjFhbHBoYTEvZ2VuZXJhdGVkLnByb3RvEiRnaXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEiMgoTQW5hbHlzaXNSdW5Bcmd1bWVudBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIrACChNBbmFseXNpc1J1bk1ldGFkYXRhElUKBmxhYmVscxgBIAMoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhLkxhYmVsc0VudHJ5El8KC2Fubm90YXRpb25zGAIgAygLMkouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuQW5ub3RhdGlvbnNFbnRyeRotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJGChRBbmFseXNpc1J1blJlZmVyZW5jZRIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRINCgVwaGFzZRgDIAEoCSI3ChlBbmFseXNpc1RlbXBsYXRlUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSJcCghBcHByb3ZhbBIQCghhcHByb3ZlchgBIAEoCRI+CgphcHByb3ZlZEF0GAIgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUieAoOQXBwcm92YWxQb2xpY3kSGQoRcmVxdWlyZWRBcHByb3ZhbHMYASABKAUSFgoOZWxpZ2libGVHcm91cHMYAiADKAkSFQoNZWxpZ2libGVSb2xlcxgDIAMoCRIcChRleGNsdWRlQ29tbWl0QXV0aG9ycxgEIAEoCCKSAQoNQXBwcm92ZWRTdGFnZRI+CgphcHByb3ZlZEF0GAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSQQoJYXBwcm92YWxzGAIgAygLMi4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmFsIjgKFUFyZ29DREFwcEhlYWx0aFN0YXR1cxIOCgZzdGF0dXMYASABKAkSDwoHbWVzc2FnZRgCIAEoCSLUAQoPQXJnb0NEQXBwU3RhdHVzEhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJElEKDGhlYWx0aFN0YXR1cxgDIAEoCzI7LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BcmdvQ0RBcHBIZWFsdGhTdGF0dXMSTQoKc3luY1N0YXR1cxgEIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BcmdvQ0RBcHBTeW5jU3RhdHVzIkoKE0FyZ29DREFwcFN5bmNTdGF0dXMSDgoGc3RhdHVzGAEgASgJEhAKCHJldmlzaW9uGAIgASgJEhEKCXJldmlzaW9ucxgDIAMoCSIfCgxBdXRvUm9sbGJhY2sSDwoHZW5hYmxlZBgBIAEoCCKfAgoFQ2hhcnQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB3ZlcnNpb24YAyABKAkSEgoKYXBwVmVyc2lvbhgEIAEoCRJRCgthbm5vdGF0aW9ucxgFIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydC5Bbm5vdGF0aW9uc0VudHJ5EksKDGRlcGVuZGVuY2llcxgGIAMoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydERlcGVuZGVuY3kaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkQKD0NoYXJ0RGVwZW5kZW5jeRIMCgRuYW1lGAEgASgJEg8KB3ZlcnNpb24YAiABKAkSEgoKcmVwb3NpdG9yeRgDIAEoCSLNAQoUQ2hhcnREaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSEAoIdmVyc2lvbnMYBCADKAkSHAoUYXBwVmVyc2lvbkNvbnN0cmFpbnQYBSABKAkSTAoIbWV0YWRhdGEYBiADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnRWZXJzaW9uTWV0YWRhdGEiggEKEUNoYXJ0U3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSDAoEbmFtZRgCIAEoCRIYChBzZW12ZXJDb25zdHJhaW50GAMgASgJEhwKFGFwcFZlcnNpb25Db25zdHJhaW50GAUgASgJEhYKDmRpc2NvdmVyeUxpbWl0GAQgASgFIp4CChRDaGFydFZlcnNpb25NZXRhZGF0YRIPCgd2ZXJzaW9uGAEgASgJEhIKCmFwcFZlcnNpb24YAiABKAkSYAoLYW5ub3RhdGlvbnMYAyADKAsySy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnRWZXJzaW9uTWV0YWRhdGEuQW5ub3RhdGlvbnNFbnRyeRJLCgxkZXBlbmRlbmNpZXMYBCADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnREZXBlbmRlbmN5GjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKhAQoUQ2x1c3RlclByb21vdGlvblRhc2sSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tTcGVjIqcBChhDbHVzdGVyUHJvbW90aW9uVGFza0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESSQoFaXRlbXMYAiADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlclByb21vdGlvblRhc2siSQoMQ3VycmVudFN0YWdlEjkKBXNpbmNlGAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUi4gMKE0Rpc2NvdmVyZWRBcnRpZmFjdHMSQAoMZGlzY292ZXJlZEF0GAQgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSRQoDZ2l0GAEgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdERpc2NvdmVyeVJlc3VsdBJKCgZpbWFnZXMYAiADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2VEaXNjb3ZlcnlSZXN1bHQSSgoGY2hhcnRzGAMgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0RGlzY292ZXJ5UmVzdWx0ElYKDG9jaUFydGlmYWN0cxgFIAMoCzJALmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5PQ0lBcnRpZmFjdERpc2NvdmVyeVJlc3VsdBJSCghyZWxlYXNlcxgGIAMoCzJALmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWxlYXNlRmVlZERpc2NvdmVyeVJlc3VsdCLjAQoQRGlzY292ZXJlZENvbW1pdBIKCgJpZBgBIAEoCRIOCgZicmFuY2gYAiABKAkSCwoDdGFnGAMgASgJEg8KB3N1YmplY3QYBCABKAkSDgoGYXV0aG9yGAUgASgJEhEKCWNvbW1pdHRlchgGIAEoCRI/CgtjcmVhdG9yRGF0ZRgHIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEhkKEXB1bGxSZXF1ZXN0TnVtYmVyGAggASgDEhYKDnB1bGxSZXF1ZXN0VVJMGAkgASgJItECChhEaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2USCwoDdGFnGAEgASgJEg4KBmRpZ2VzdBgCIAEoCRJkCgthbm5vdGF0aW9ucxgFIAMoCzJPLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2UuQW5ub3RhdGlvbnNFbnRyeRISCgpnaXRSZXBvVVJMGAMgASgJEj0KCWNyZWF0ZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEhUKDXNvdXJjZVJlcG9VUkwYBiABKAkSFAoMc291cmNlQ29tbWl0GAcgASgJGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKyAgoeRGlzY292ZXJlZE9DSUFydGlmYWN0UmVmZXJlbmNlEgsKA3RhZxgBIAEoCRIOCgZkaWdlc3QYAiABKAkSFAoMYXJ0aWZhY3RUeXBlGAMgASgJEmoKC2Fubm90YXRpb25zGAQgAygLMlUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRPQ0lBcnRpZmFjdFJlZmVyZW5jZS5Bbm5vdGF0aW9uc0VudHJ5Ej0KCWNyZWF0ZWRBdBgFIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKuAQoRRGlzY292ZXJlZFJlbGVhc2USDwoHdmVyc2lvbhgBIAEoCRJXCghtZXRhZGF0YRgCIAMoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkUmVsZWFzZS5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASIxChJFeHByZXNzaW9uVmFyaWFibGUSDAoEbmFtZRgBIAEoCRINCgV2YWx1ZRgCIAEoCSKsBAoHRnJlaWdodBJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEg0KBWFsaWFzGAcgASgJEkMKBm9yaWdpbhgJIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEkAKB2NvbW1pdHMYAyADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0Q29tbWl0EjsKBmltYWdlcxgEIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZRI7CgZjaGFydHMYBSADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnQSRwoMb2NpQXJ0aWZhY3RzGAogAygLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLk9DSUFydGlmYWN0Ej8KCHJlbGVhc2VzGAsgAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlbGVhc2USQwoGc3RhdHVzGAYgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMirQIKEUZyZWlnaHRDb2xsZWN0aW9uEgoKAmlkGAMgASgJElEKBWl0ZW1zGAEgAygLMkIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uLkl0ZW1zRW50cnkSUwoTdmVyaWZpY2F0aW9uSGlzdG9yeRgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25JbmZvGmQKCkl0ZW1zRW50cnkSCwoDa2V5GAEgASgJEkUKBXZhbHVlGAIgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZWZlcmVuY2U6AjgBIi0KF0ZyZWlnaHRDcmVhdGlvbkNyaXRlcmlhEhIKCmV4cHJlc3Npb24YASABKAkijQEKC0ZyZWlnaHRMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjwKBWl0ZW1zGAIgAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHQiKwoNRnJlaWdodE9yaWdpbhIMCgRraW5kGAEgASgJEgwKBG5hbWUYAiABKAkiqwMKEEZyZWlnaHRSZWZlcmVuY2USDAoEbmFtZRgBIAEoCRJDCgZvcmlnaW4YCCABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJACgdjb21taXRzGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdENvbW1pdBI7CgZpbWFnZXMYAyADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2USOwoGY2hhcnRzGAQgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0EkcKDG9jaUFydGlmYWN0cxgJIAMoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5PQ0lBcnRpZmFjdBI/CghyZWxlYXNlcxgKIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWxlYXNlIpwBCg5GcmVpZ2h0UmVxdWVzdBJDCgZvcmlnaW4YASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJFCgdzb3VyY2VzGAIgASgLMjQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTb3VyY2VzIpgBCg5GcmVpZ2h0U291cmNlcxIOCgZkaXJlY3QYASABKAgSDgoGc3RhZ2VzGAIgAygJEkgKEHJlcXVpcmVkU29ha1RpbWUYAyABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SHAoUYXZhaWxhYmlsaXR5U3RyYXRlZ3kYBCABKAkirAgKDUZyZWlnaHRTdGF0dXMSWQoLY3VycmVudGx5SW4YAyADKAsyRC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cy5DdXJyZW50bHlJbkVudHJ5ElcKCnZlcmlmaWVkSW4YASADKAsyQy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cy5WZXJpZmllZEluRW50cnkSWQoLYXBwcm92ZWRGb3IYAiADKAsyRC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cy5BcHByb3ZlZEZvckVudHJ5EkcKCHJlamVjdGVkGAUgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlamVjdGVkRnJlaWdodBJZCgtyZWplY3RlZEZvchgGIAMoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLlJlamVjdGVkRm9yRW50cnkSUwoIbWV0YWRhdGEYBCADKAsyQS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cy5NZXRhZGF0YUVudHJ5GmYKEEN1cnJlbnRseUluRW50cnkSCwoDa2V5GAEgASgJEkEKBXZhbHVlGAIgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkN1cnJlbnRTdGFnZToCOAEaZgoPVmVyaWZpZWRJbkVudHJ5EgsKA2tleRgBIAEoCRJCCgV2YWx1ZRgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmllZFN0YWdlOgI4ARpnChBBcHByb3ZlZEZvckVudHJ5EgsKA2tleRgBIAEoCRJCCgV2YWx1ZRgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BcHByb3ZlZFN0YWdlOgI4ARppChBSZWplY3RlZEZvckVudHJ5EgsKA2tleRgBIAEoCRJECgV2YWx1ZRgCIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWplY3RlZEZyZWlnaHQ6AjgBGm8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEk0KBXZhbHVlGAIgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTjoCOAEirAEKCUdpdENvbW1pdBIPCgdyZXBvVVJMGAEgASgJEgoKAmlkGAIgASgJEg4KBmJyYW5jaBgDIAEoCRILCgN0YWcYBCABKAkSDwoHbWVzc2FnZRgGIAEoCRIOCgZhdXRob3IYByABKAkSEQoJY29tbWl0dGVyGAggASgJEhkKEXB1bGxSZXF1ZXN0TnVtYmVyGAkgASgDEhYKDnB1bGxSZXF1ZXN0VVJMGAogASgJIm4KEkdpdERpc2NvdmVyeVJlc3VsdBIPCgdyZXBvVVJMGAEgASgJEkcKB2NvbW1pdHMYAiADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZENvbW1pdCJUChVHaXRIdWJXZWJob29rUmVjZWl2ZXISOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIkwKFEdpdFB1bGxSZXF1ZXN0RmlsdGVyEhAKCHByb3ZpZGVyGAEgASgJEhIKCmJhc2VCcmFuY2gYAiABKAkSDgoGbGFiZWxzGAMgAygJIrIDCg9HaXRTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIfChdjb21taXRTZWxlY3Rpb25TdHJhdGVneRgCIAEoCRIOCgZicmFuY2gYAyABKAkSFQoNYnJhbmNoUGF0dGVybhgNIAEoCRIfChdicmFuY2hTZWxlY3Rpb25TdHJhdGVneRgOIAEoCRIYChBmcmVpZ2h0UGVyQnJhbmNoGA8gASgIEhUKDXN0cmljdFNlbXZlcnMYCyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSEgoKaWdub3JlVGFncxgGIAMoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYByABKAgSFAoMaW5jbHVkZVBhdGhzGAggAygJEhQKDGV4Y2x1ZGVQYXRocxgJIAMoCRIWCg5kaXNjb3ZlcnlMaW1pdBgKIAEoBRJQCgxwdWxsUmVxdWVzdHMYDCABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0UHVsbFJlcXVlc3RGaWx0ZXIiyAEKBkhlYWx0aBIOCgZzdGF0dXMYASABKAkSDgoGaXNzdWVzGAIgAygJEk4KBmNvbmZpZxgEIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04STgoGb3V0cHV0GAUgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiJvCg9IZWFsdGhDaGVja1N0ZXASDAoEdXNlcxgBIAEoCRJOCgZjb25maWcYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIh4KC0hlYWx0aFN0YXRzEg8KB2hlYWx0aHkYASABKAMi/QEKBUltYWdlEg8KB3JlcG9VUkwYASABKAkSEgoKZ2l0UmVwb1VSTBgCIAEoCRILCgN0YWcYAyABKAkSDgoGZGlnZXN0GAQgASgJElEKC2Fubm90YXRpb25zGAUgAygLMjwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlLkFubm90YXRpb25zRW50cnkSFQoNc291cmNlUmVwb1VSTBgGIAEoCRIUCgxzb3VyY2VDb21taXQYByABKAkaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIo0BChRJbWFnZURpc2NvdmVyeVJlc3VsdBIPCgdyZXBvVVJMGAEgASgJEhAKCHBsYXRmb3JtGAIgASgJElIKCnJlZmVyZW5jZXMYAyADKAsyPi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZEltYWdlUmVmZXJlbmNlIpMCChFJbWFnZVN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEhIKCmdpdFJlcG9VUkwYAiABKAkSHgoWaW1hZ2VTZWxlY3Rpb25TdHJhdGVneRgDIAEoCRIVCg1zdHJpY3RTZW12ZXJzGAogASgIEhgKEHNlbXZlckNvbnN0cmFpbnQYBCABKAkSEQoJYWxsb3dUYWdzGAUgASgJEhIKCmlnbm9yZVRhZ3MYBiADKAkSEAoIcGxhdGZvcm0YByABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAggASgIEhYKDmRpc2NvdmVyeUxpbWl0GAkgASgFEhgKEGV4cHJlc3Npb25GaWx0ZXIYCyABKAki3gEKC09DSUFydGlmYWN0Eg8KB3JlcG9VUkwYASABKAkSCwoDdGFnGAIgASgJEg4KBmRpZ2VzdBgDIAEoCRIUCgxhcnRpZmFjdFR5cGUYBCABKAkSVwoLYW5ub3RhdGlvbnMYBSADKAsyQi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuT0NJQXJ0aWZhY3QuQW5ub3RhdGlvbnNFbnRyeRoyChBBbm5vdGF0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEinQEKGk9DSUFydGlmYWN0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSFAoMYXJ0aWZhY3RUeXBlGAIgASgJElgKCnJlZmVyZW5jZXMYAyADKAsyRC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZE9DSUFydGlmYWN0UmVmZXJlbmNlIuoBChdPQ0lBcnRpZmFjdFN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEhkKEXNlbGVjdGlvblN0cmF0ZWd5GAIgASgJEhUKDXN0cmljdFNlbXZlcnMYAyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSEgoKaWdub3JlVGFncxgGIAMoCRIUCgxhcnRpZmFjdFR5cGUYByABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAggASgIEhYKDmRpc2NvdmVyeUxpbWl0GAkgASgFItkBCgdQcm9qZWN0EkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0Q29uZmlnU3BlYxJDCgZzdGF0dXMYAyABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdFN0YXR1cyLlAQoNUHJvamVjdENvbmZpZxJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkUKBHNwZWMYAiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZ1NwZWMSSQoGc3RhdHVzGAMgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWdTdGF0dXMimQEKEVByb2plY3RDb25maWdMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkIKBWl0ZW1zGAIgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWcitQEKEVByb2plY3RDb25maWdTcGVjElAKEXByb21vdGlvblBvbGljaWVzGAEgAygLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblBvbGljeRJOCglyZWNlaXZlcnMYAiADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnIqQBChNQcm9qZWN0Q29uZmlnU3RhdHVzEkMKCmNvbmRpdGlvbnMYASADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEkgKCXJlY2VpdmVycxgCIAMoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XZWJob29rUmVjZWl2ZXIijQEKC1Byb2plY3RMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjwKBWl0ZW1zGAIgAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3QimgEKDFByb2plY3RTdGF0cxJICgp3YXJlaG91c2VzGAEgASgLMjQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZVN0YXRzEkAKBnN0YWdlcxgCIAEoCzIwLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZVN0YXRzIpcBCg1Qcm9qZWN0U3RhdHVzEkMKCmNvbmRpdGlvbnMYAyADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEkEKBXN0YXRzGAQgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RTdGF0cyLZAQoJUHJvbW90aW9uEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESQQoEc3BlYxgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TcGVjEkUKBnN0YXR1cxgDIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGF0dXMikQEKDVByb21vdGlvbkxpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPgoFaXRlbXMYAiADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIpQBCg9Qcm9tb3Rpb25Qb2xpY3kSDQoFc3RhZ2UYASABKAkSVAoNc3RhZ2VTZWxlY3RvchgDIAEoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25Qb2xpY3lTZWxlY3RvchIcChRhdXRvUHJvbW90aW9uRW5hYmxlZBgCIAEoCCJzChdQcm9tb3Rpb25Qb2xpY3lTZWxlY3RvchIMCgRuYW1lGAEgASgJEkoKDWxhYmVsU2VsZWN0b3IYAiABKAsyMy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGFiZWxTZWxlY3RvciLyAQoSUHJvbW90aW9uUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSRwoHZnJlaWdodBgCIAEoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVmZXJlbmNlEkUKBnN0YXR1cxgDIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGF0dXMSPgoKZmluaXNoZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIrsBCg1Qcm9tb3Rpb25TcGVjEg0KBXN0YWdlGAEgASgJEg8KB2ZyZWlnaHQYAiABKAkSRgoEdmFycxgEIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYAyADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCK3BAoPUHJvbW90aW9uU3RhdHVzEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgEIAEoCRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEkcKB2ZyZWlnaHQYBSABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJSChFmcmVpZ2h0Q29sbGVjdGlvbhgHIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q29sbGVjdGlvbhJLCgxoZWFsdGhDaGVja3MYCCADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoQ2hlY2tTdGVwEj4KCmZpbmlzaGVkQXQYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRITCgtjdXJyZW50U3RlcBgJIAEoAxJaChVzdGVwRXhlY3V0aW9uTWV0YWRhdGEYCyADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEk0KBXN0YXRlGAogASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiL7AgoNUHJvbW90aW9uU3RlcBIMCgR1c2VzGAEgASgJEkoKBHRhc2sYBSABKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1JlZmVyZW5jZRIKCgJhcxgCIAEoCRIKCgJpZhgHIAEoCRIXCg9jb250aW51ZU9uRXJyb3IYCCABKAgSRwoFcmV0cnkYBCABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcFJldHJ5EkYKBHZhcnMYBiADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEk4KBmNvbmZpZxgDIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04ibQoSUHJvbW90aW9uU3RlcFJldHJ5Ej8KB3RpbWVvdXQYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SFgoOZXJyb3JUaHJlc2hvbGQYAiABKA0imgEKDVByb21vdGlvblRhc2sSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tTcGVjIpkBChFQcm9tb3Rpb25UYXNrTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRJCCgVpdGVtcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrIjQKFlByb21vdGlvblRhc2tSZWZlcmVuY2USDAoEbmFtZRgBIAEoCRIMCgRraW5kGAIgASgJIp8BChFQcm9tb3Rpb25UYXNrU3BlYxJGCgR2YXJzGAEgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJCCgVzdGVwcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIl4KEVByb21vdGlvblRlbXBsYXRlEkkKBHNwZWMYASABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGVTcGVjIqMBChVQcm9tb3Rpb25UZW1wbGF0ZVNwZWMSRgoEdmFycxgCIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYASADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCJ1Cg9SZWplY3RlZEZyZWlnaHQSDgoGcmVhc29uGAEgASgJEhIKCnJlamVjdGVkQnkYAiABKAkSPgoKcmVqZWN0ZWRBdBgDIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIqcBCgdSZWxlYXNlEgsKA3VybBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEk0KCG1ldGFkYXRhGAMgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlbGVhc2UuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEidAoaUmVsZWFzZUZlZWREaXNjb3ZlcnlSZXN1bHQSCwoDdXJsGAEgASgJEkkKCHJlbGVhc2VzGAIgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRSZWxlYXNlIjAKEVJlbGVhc2VGZWVkSGVhZGVyEgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkiOwoTUmVsZWFzZUZlZWRNZXRhZGF0YRIMCgRuYW1lGAEgASgJEhYKDmZyb21FeHByZXNzaW9uGAIgASgJIvgCChdSZWxlYXNlRmVlZFN1YnNjcmlwdGlvbhILCgN1cmwYASABKAkSSAoHaGVhZGVycxgCIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWxlYXNlRmVlZEhlYWRlchIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYAyABKAgSHgoWdmVyc2lvbnNGcm9tRXhwcmVzc2lvbhgEIAEoCRJLCghtZXRhZGF0YRgFIAMoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWxlYXNlRmVlZE1ldGFkYXRhEhkKEXNlbGVjdGlvblN0cmF0ZWd5GAYgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYByABKAkSFQoNYWxsb3dWZXJzaW9ucxgIIAEoCRIWCg5pZ25vcmVWZXJzaW9ucxgJIAMoCRIWCg5kaXNjb3ZlcnlMaW1pdBgKIAEoBSKOAwoQUmVwb1N1YnNjcmlwdGlvbhJCCgNnaXQYASABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0U3Vic2NyaXB0aW9uEkYKBWltYWdlGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlU3Vic2NyaXB0aW9uEkYKBWNoYXJ0GAMgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0U3Vic2NyaXB0aW9uElIKC29jaUFydGlmYWN0GAQgASgLMj0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLk9DSUFydGlmYWN0U3Vic2NyaXB0aW9uElIKC3JlbGVhc2VGZWVkGAUgASgLMj0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlbGVhc2VGZWVkU3Vic2NyaXB0aW9uIs0BCgVTdGFnZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEj0KBHNwZWMYAiABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTcGVjEkEKBnN0YXR1cxgDIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZVN0YXR1cyKJAQoJU3RhZ2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjoKBWl0ZW1zGAIgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlIugDCglTdGFnZVNwZWMSDQoFc2hhcmQYBCABKAkSRgoEdmFycxgHIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSTgoQcmVxdWVzdGVkRnJlaWdodBgFIAMoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVxdWVzdBJSChFwcm9tb3Rpb25UZW1wbGF0ZRgGIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UZW1wbGF0ZRJICgx2ZXJpZmljYXRpb24YAyABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuVmVyaWZpY2F0aW9uEkwKDmFwcHJvdmFsUG9saWN5GAggASgLMjQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmFsUG9saWN5EkgKDGF1dG9Sb2xsYmFjaxgJIAEoCzIyLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BdXRvUm9sbGJhY2siXgoKU3RhZ2VTdGF0cxINCgVjb3VudBgCIAEoAxJBCgZoZWFsdGgYASABKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoU3RhdHMi1gMKC1N0YWdlU3RhdHVzEkMKCmNvbmRpdGlvbnMYDSADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgLIAEoCRJPCg5mcmVpZ2h0SGlzdG9yeRgEIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q29sbGVjdGlvbhIWCg5mcmVpZ2h0U3VtbWFyeRgMIAEoCRI8CgZoZWFsdGgYCCABKAsyLC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgGIAEoAxJSChBjdXJyZW50UHJvbW90aW9uGAcgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblJlZmVyZW5jZRJPCg1sYXN0UHJvbW90aW9uGAogASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblJlZmVyZW5jZSLzAQoVU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEg0KBWFsaWFzGAEgASgJEj0KCXN0YXJ0ZWRBdBgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEj4KCmZpbmlzaGVkQXQYAyABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRISCgplcnJvckNvdW50GAQgASgNEg4KBnN0YXR1cxgFIAEoCRIPCgdtZXNzYWdlGAYgASgJEhcKD2NvbnRpbnVlT25FcnJvchgHIAEoCCKgAQoQU3RlcFJ1bm5lclBsdWdpbhJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkgKBHNwZWMYAiABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RlcFJ1bm5lclBsdWdpblNwZWMinwEKFFN0ZXBSdW5uZXJQbHVnaW5MaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkUKBWl0ZW1zGAIgAygLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0ZXBSdW5uZXJQbHVnaW4iqgEKFFN0ZXBSdW5uZXJQbHVnaW5TcGVjEgsKA3VybBgBIAEoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYAiABKAgSDQoFc3RlcHMYAyADKAkSPwoHdGltZW91dBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIWCg5lcnJvclRocmVzaG9sZBgFIAEoDSKLAgoMVmVyaWZpY2F0aW9uEloKEWFuYWx5c2lzVGVtcGxhdGVzGAEgAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USVgoTYW5hbHlzaXNSdW5NZXRhZGF0YRgCIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhEkcKBGFyZ3MYAyADKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5Bcmd1bWVudCKdAgoQVmVyaWZpY2F0aW9uSW5mbxIKCgJpZBgEIAEoCRINCgVhY3RvchgHIAEoCRI9CglzdGFydFRpbWUYBSABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEk8KC2FuYWx5c2lzUnVuGAMgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuUmVmZXJlbmNlEj4KCmZpbmlzaFRpbWUYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSKUAQoNVmVyaWZpZWRTdGFnZRI+Cgp2ZXJpZmllZEF0GAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSQwoLbG9uZ2VzdFNvYWsYAiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24i2QEKCVdhcmVob3VzZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3RhdHVzIpEBCg1XYXJlaG91c2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZSKuAgoNV2FyZWhvdXNlU3BlYxINCgVzaGFyZBgCIAEoCRJACghpbnRlcnZhbBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIdChVmcmVpZ2h0Q3JlYXRpb25Qb2xpY3kYAyABKAkSXgoXZnJlaWdodENyZWF0aW9uQ3JpdGVyaWEYBSABKAsyPS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENyZWF0aW9uQ3JpdGVyaWESTQoNc3Vic2NyaXB0aW9ucxgBIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZXBvU3Vic2NyaXB0aW9uImIKDldhcmVob3VzZVN0YXRzEg0KBWNvdW50GAIgASgDEkEKBmhlYWx0aBgBIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhTdGF0cyL9AQoPV2FyZWhvdXNlU3RhdHVzEkMKCmNvbmRpdGlvbnMYCSADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgGIAEoCRIaChJvYnNlcnZlZEdlbmVyYXRpb24YBCABKAMSFQoNbGFzdEZyZWlnaHRJRBgIIAEoCRJWChNkaXNjb3ZlcmVkQXJ0aWZhY3RzGAcgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRBcnRpZmFjdHMiOgoPV2ViaG9va1JlY2VpdmVyEgwKBG5hbWUYASABKAkSDAoEcGF0aBgDIAEoCRILCgN1cmwYBCABKAkicgoVV2ViaG9va1JlY2VpdmVyQ29uZmlnEgwKBG5hbWUYASABKAkSSwoGZ2l0aHViGAIgASgLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdEh1YldlYmhvb2tSZWNlaXZlckKXAgooY29tLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMUIOR2VuZXJhdGVkUHJvdG9QAVokZ2l0aHViLmNvbS9ha3VpdHkva2FyZ28vYXBpL3YxYWxwaGExogIFR0NBS0GqAiRHaXRodWIuQ29tLkFrdWl0eS5LYXJnby5BcGkuVjFhbHBoYTHKAiRHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTHiAjBHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTFcR1BCTWV0YWRhdGHqAilHaXRodWI6OkNvbTo6QWt1aXR5OjpLYXJnbzo6QXBpOjpWMWFscGhhMQ", [file_k8s_io_api_core_v1_generated, file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
 
 /**
  * AnalysisRunArgument represents an argument to be added to an AnalysisRun.
@@ -153,6 +153,92 @@ export type AnalysisTemplateReference = Message<"github.com.akuity.kargo.api.v1a
 export const AnalysisTemplateReferenceSchema: GenMessage<AnalysisTemplateReference> = /*@__PURE__*/
   messageDesc(file_api_v1alpha1_generated, 3);
 
+/**
+ * Approval describes a single user's approval of Freight for a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.Approval
+ */
+export type Approval = Message<"github.com.akuity.kargo.api.v1alpha1.Approval"> & {
+  /**
+   * Approver identifies the user who approved the Freight.
+   *
+   * @generated from field: optional string approver = 1;
+   */
+  approver: string;
+
+  /**
+   * ApprovedAt is the time at which the user approved the Freight.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time approvedAt = 2;
+   */
+  approvedAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.Approval.
+ * Use `create(ApprovalSchema)` to create a new message.
+ */
+export const ApprovalSchema: GenMessage<Approval> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 4);
+
+/**
+ * ApprovalPolicy describes the manual approvals Freight must receive before it
+ * may be promoted to a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ApprovalPolicy
+ */
+export type ApprovalPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.ApprovalPolicy"> & {
+  /**
+   * RequiredApprovals is the number of approvals from distinct users that
+   * Freight must receive before it becomes available to the Stage. If not
+   * specified, a single approval is required.
+   *
+   * +kubebuilder:default=1
+   * +kubebuilder:validation:Minimum=1
+   *
+   * @generated from field: optional int32 requiredApprovals = 1;
+   */
+  requiredApprovals: number;
+
+  /**
+   * EligibleGroups is an optional list of OIDC groups. When non-empty (or when
+   * EligibleRoles is non-empty), only users belonging to at least one of these
+   * groups, or mapped to at least one of the EligibleRoles, may approve
+   * Freight for the Stage.
+   *
+   * @generated from field: repeated string eligibleGroups = 2;
+   */
+  eligibleGroups: string[];
+
+  /**
+   * EligibleRoles is an optional list of names of Kargo roles in the Stage's
+   * Project. When non-empty (or when EligibleGroups is non-empty), only users
+   * mapped to at least one of these roles, or belonging to at least one of the
+   * EligibleGroups, may approve Freight for the Stage.
+   *
+   * @generated from field: repeated string eligibleRoles = 3;
+   */
+  eligibleRoles: string[];
+
+  /**
+   * ExcludeCommitAuthors enforces separation of duties. When true, users whose
+   * email address matches that of the author or committer of any commit
+   * referenced by the Freight may not approve it for the Stage. Users without
+   * an email address may not approve the Freight, and neither may anyone if
+   * the email address of any commit's author or committer is unknown.
+   *
+   * @generated from field: optional bool excludeCommitAuthors = 4;
+   */
+  excludeCommitAuthors: boolean;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ApprovalPolicy.
+ * Use `create(ApprovalPolicySchema)` to create a new message.
+ */
+export const ApprovalPolicySchema: GenMessage<ApprovalPolicy> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 5);
+
 /**
  * ApprovedStage describes a Stage for which Freight has been (manually)
  * approved.
@@ -166,6 +252,15 @@ export type ApprovedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Approv
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time approvedAt = 1;
    */
   approvedAt?: Time;
+
+  /**
+   * Approvals records the individual approvals the Freight has received for
+   * the Stage. When the Stage has an ApprovalPolicy, the number of distinct
+   * approvers recorded here determines whether the policy has been satisfied.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Approval approvals = 2;
+   */
+  approvals: Approval[];
 };
 
 /**
@@ -173,7 +268,7 @@ export type ApprovedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Approv
  * Use `create(ApprovedStageSchema)` to create a new message.
  */
 export const ApprovedStageSchema: GenMessage<ApprovedStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 4);
+  messageDesc(file_api_v1alpha1_generated, 6);
 
 /**
  * ArgoCDAppHealthStatus describes the health of an ArgoCD Application.
@@ -197,7 +292,7 @@ export type ArgoCDAppHealthStatus = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(ArgoCDAppHealthStatusSchema)` to create a new message.
  */
 export const ArgoCDAppHealthStatusSchema: GenMessage<ArgoCDAppHealthStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 5);
+  messageDesc(file_api_v1alpha1_generated, 7);
 
 /**
  * ArgoCDAppStatus describes the current state of a single ArgoCD Application.
@@ -239,7 +334,7 @@ export type ArgoCDAppStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Argo
  * Use `create(ArgoCDAppStatusSchema)` to create a new message.
  */
 export const ArgoCDAppStatusSchema: GenMessage<ArgoCDAppStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 6);
+  messageDesc(file_api_v1alpha1_generated, 8);
 
 /**
  * ArgoCDAppSyncStatus describes the sync status of an ArgoCD Application.
@@ -268,7 +363,35 @@ export type ArgoCDAppSyncStatus = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(ArgoCDAppSyncStatusSchema)` to create a new message.
  */
 export const ArgoCDAppSyncStatusSchema: GenMessage<ArgoCDAppSyncStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 7);
+  messageDesc(file_api_v1alpha1_generated, 9);
+
+/**
+ * AutoRollback describes whether a Stage should automatically roll back to the
+ * most recently verified Freight when verification of its current Freight
+ * fails.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.AutoRollback
+ */
+export type AutoRollback = Message<"github.com.akuity.kargo.api.v1alpha1.AutoRollback"> & {
+  /**
+   * Enabled indicates whether automatic rollback is enabled for the Stage.
+   * When enabled and verification of the Stage's current Freight fails, a
+   * Promotion of the most recent Freight in the Stage's Freight history that
+   * was successfully verified is created automatically. To prevent rollback
+   * loops, a Stage whose current Freight was itself the result of an
+   * automatic rollback will not be rolled back again.
+   *
+   * @generated from field: optional bool enabled = 1;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.AutoRollback.
+ * Use `create(AutoRollbackSchema)` to create a new message.
+ */
+export const AutoRollbackSchema: GenMessage<AutoRollback> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 10);
 
 /**
  * Chart describes a specific version of a Helm chart.
@@ -301,6 +424,29 @@ export type Chart = Message<"github.com.akuity.kargo.api.v1alpha1.Chart"> & {
    * @generated from field: optional string version = 3;
    */
   version: string;
+
+  /**
+   * AppVersion is the version of the application packaged by the chart, as
+   * specified in the chart's Chart.yaml file.
+   *
+   * @generated from field: optional string appVersion = 4;
+   */
+  appVersion: string;
+
+  /**
+   * Annotations are the annotations specified in the chart's Chart.yaml file.
+   *
+   * @generated from field: map<string, string> annotations = 5;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * Dependencies are the dependencies specified in the chart's Chart.yaml
+   * file.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ChartDependency dependencies = 6;
+   */
+  dependencies: ChartDependency[];
 };
 
 /**
@@ -308,7 +454,43 @@ export type Chart = Message<"github.com.akuity.kargo.api.v1alpha1.Chart"> & {
  * Use `create(ChartSchema)` to create a new message.
  */
 export const ChartSchema: GenMessage<Chart> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 8);
+  messageDesc(file_api_v1alpha1_generated, 11);
+
+/**
+ * ChartDependency describes a dependency of a Helm chart, as specified in the
+ * chart's Chart.yaml file.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ChartDependency
+ */
+export type ChartDependency = Message<"github.com.akuity.kargo.api.v1alpha1.ChartDependency"> & {
+  /**
+   * Name is the name of the dependency.
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Version is the version or version range of the dependency.
+   *
+   * @generated from field: optional string version = 2;
+   */
+  version: string;
+
+  /**
+   * Repository is the URL of the repository containing the dependency.
+   *
+   * @generated from field: optional string repository = 3;
+   */
+  repository: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ChartDependency.
+ * Use `create(ChartDependencySchema)` to create a new message.
+ */
+export const ChartDependencySchema: GenMessage<ChartDependency> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 12);
 
 /**
  * ChartDiscoveryResult represents the result of a chart discovery operation for
@@ -354,6 +536,28 @@ export type ChartDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
    * @generated from field: repeated string versions = 4;
    */
   versions: string[];
+
+  /**
+   * AppVersionConstraint is the application version constraint for which
+   * versions were discovered. This field is optional, and only populated if
+   * the ChartSubscription specifies an AppVersionConstraint.
+   *
+   * @generated from field: optional string appVersionConstraint = 5;
+   */
+  appVersionConstraint: string;
+
+  /**
+   * Metadata is a list of the metadata of the discovered versions, as
+   * specified in their Chart.yaml files. Each element describes the version
+   * identified by its Version field. Unless the ChartSubscription specifies
+   * an AppVersionConstraint, only the metadata of the latest version is
+   * retrieved.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ChartVersionMetadata metadata = 6;
+   */
+  metadata: ChartVersionMetadata[];
 };
 
 /**
@@ -361,7 +565,7 @@ export type ChartDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ChartDiscoveryResultSchema)` to create a new message.
  */
 export const ChartDiscoveryResultSchema: GenMessage<ChartDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 9);
+  messageDesc(file_api_v1alpha1_generated, 13);
 
 /**
  * ChartSubscription defines a subscription to a Helm chart repository.
@@ -411,10 +615,24 @@ export type ChartSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Ch
    */
   semverConstraint: string;
 
+  /**
+   * AppVersionConstraint specifies constraints on the application versions
+   * (the appVersion field of Chart.yaml) of permissible chart versions. When
+   * specified, chart versions whose appVersion is not a semantic version are
+   * ignored. This field is optional.
+   * More info: https://github.com/masterminds/semver#checking-version-constraints
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string appVersionConstraint = 5;
+   */
+  appVersionConstraint: string;
+
   /**
    * DiscoveryLimit is an optional limit on the number of chart versions that
    * can be discovered for this subscription. The limit is applied after
-   * filtering charts based on the SemverConstraint field.
+   * filtering charts based on the SemverConstraint and AppVersionConstraint
+   * fields.
    * When left unspecified, the field is implicitly treated as if its value
    * were "20". The upper limit for this field is 100.
    *
@@ -432,7 +650,52 @@ export type ChartSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Ch
  * Use `create(ChartSubscriptionSchema)` to create a new message.
  */
 export const ChartSubscriptionSchema: GenMessage<ChartSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 10);
+  messageDesc(file_api_v1alpha1_generated, 14);
+
+/**
+ * ChartVersionMetadata represents the metadata of a specific version of a Helm
+ * chart, as specified in the chart's Chart.yaml file.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ChartVersionMetadata
+ */
+export type ChartVersionMetadata = Message<"github.com.akuity.kargo.api.v1alpha1.ChartVersionMetadata"> & {
+  /**
+   * Version is the version of the chart.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string version = 1;
+   */
+  version: string;
+
+  /**
+   * AppVersion is the version of the application packaged by the chart.
+   *
+   * @generated from field: optional string appVersion = 2;
+   */
+  appVersion: string;
+
+  /**
+   * Annotations are the annotations of the chart.
+   *
+   * @generated from field: map<string, string> annotations = 3;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * Dependencies are the dependencies of the chart.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ChartDependency dependencies = 4;
+   */
+  dependencies: ChartDependency[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ChartVersionMetadata.
+ * Use `create(ChartVersionMetadataSchema)` to create a new message.
+ */
+export const ChartVersionMetadataSchema: GenMessage<ChartVersionMetadata> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 15);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTask
@@ -459,7 +722,7 @@ export type ClusterPromotionTask = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ClusterPromotionTaskSchema)` to create a new message.
  */
 export const ClusterPromotionTaskSchema: GenMessage<ClusterPromotionTask> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 11);
+  messageDesc(file_api_v1alpha1_generated, 16);
 
 /**
  * ClusterPromotionTaskList contains a list of PromotionTasks.
@@ -483,7 +746,7 @@ export type ClusterPromotionTaskList = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(ClusterPromotionTaskListSchema)` to create a new message.
  */
 export const ClusterPromotionTaskListSchema: GenMessage<ClusterPromotionTaskList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 12);
+  messageDesc(file_api_v1alpha1_generated, 17);
 
 /**
  * CurrentStage reflects a Stage's current use of Freight.
@@ -506,7 +769,7 @@ export type CurrentStage = Message<"github.com.akuity.kargo.api.v1alpha1.Current
  * Use `create(CurrentStageSchema)` to create a new message.
  */
 export const CurrentStageSchema: GenMessage<CurrentStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 13);
+  messageDesc(file_api_v1alpha1_generated, 18);
 
 /**
  * DiscoveredArtifacts holds the artifacts discovered by the Warehouse for its
@@ -553,6 +816,26 @@ export type DiscoveredArtifacts = Message<"github.com.akuity.kargo.api.v1alpha1.
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ChartDiscoveryResult charts = 3;
    */
   charts: ChartDiscoveryResult[];
+
+  /**
+   * OCIArtifacts holds the artifact references discovered by the Warehouse
+   * for the OCI artifact subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult ociArtifacts = 5;
+   */
+  ociArtifacts: OCIArtifactDiscoveryResult[];
+
+  /**
+   * Releases holds the versions discovered by the Warehouse for the release
+   * feed subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ReleaseFeedDiscoveryResult releases = 6;
+   */
+  releases: ReleaseFeedDiscoveryResult[];
 };
 
 /**
@@ -560,7 +843,7 @@ export type DiscoveredArtifacts = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(DiscoveredArtifactsSchema)` to create a new message.
  */
 export const DiscoveredArtifactsSchema: GenMessage<DiscoveredArtifacts> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 14);
+  messageDesc(file_api_v1alpha1_generated, 19);
 
 /**
  * DiscoveredCommit represents a commit discovered by a Warehouse for a
@@ -624,6 +907,24 @@ export type DiscoveredCommit = Message<"github.com.akuity.kargo.api.v1alpha1.Dis
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time creatorDate = 7;
    */
   creatorDate?: Time;
+
+  /**
+   * PullRequestNumber is the number of the pull request whose head is this
+   * commit. This field is only populated when the CommitSelectionStrategy of
+   * the GitSubscription is PullRequest.
+   *
+   * @generated from field: optional int64 pullRequestNumber = 8;
+   */
+  pullRequestNumber: bigint;
+
+  /**
+   * PullRequestURL is the URL of the pull request whose head is this commit.
+   * This field is only populated when the CommitSelectionStrategy of the
+   * GitSubscription is PullRequest.
+   *
+   * @generated from field: optional string pullRequestURL = 9;
+   */
+  pullRequestURL: string;
 };
 
 /**
@@ -631,7 +932,7 @@ export type DiscoveredCommit = Message<"github.com.akuity.kargo.api.v1alpha1.Dis
  * Use `create(DiscoveredCommitSchema)` to create a new message.
  */
 export const DiscoveredCommitSchema: GenMessage<DiscoveredCommit> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 15);
+  messageDesc(file_api_v1alpha1_generated, 20);
 
 /**
  * DiscoveredImageReference represents an image reference discovered by a
@@ -689,6 +990,24 @@ export type DiscoveredImageReference = Message<"github.com.akuity.kargo.api.v1al
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time createdAt = 4;
    */
   createdAt?: Time;
+
+  /**
+   * SourceRepoURL is the URL of the repository containing the source code the
+   * image was built from. This field is optional, and only populated if the
+   * image has an org.opencontainers.image.source annotation or label.
+   *
+   * @generated from field: optional string sourceRepoURL = 6;
+   */
+  sourceRepoURL: string;
+
+  /**
+   * SourceCommit identifies the commit the image was built from. This field is
+   * optional, and only populated if the image has an
+   * org.opencontainers.image.revision annotation or label.
+   *
+   * @generated from field: optional string sourceCommit = 7;
+   */
+  sourceCommit: string;
 };
 
 /**
@@ -696,7 +1015,99 @@ export type DiscoveredImageReference = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(DiscoveredImageReferenceSchema)` to create a new message.
  */
 export const DiscoveredImageReferenceSchema: GenMessage<DiscoveredImageReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 16);
+  messageDesc(file_api_v1alpha1_generated, 21);
+
+/**
+ * DiscoveredOCIArtifactReference represents an artifact reference discovered
+ * by a Warehouse for an OCIArtifactSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference
+ */
+export type DiscoveredOCIArtifactReference = Message<"github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference"> & {
+  /**
+   * Tag is the tag of the artifact.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=128
+   * +kubebuilder:validation:Pattern=`^[\w.\-\_]+$`
+   * +akuity:test-kubebuilder-pattern=Tag
+   *
+   * @generated from field: optional string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * Digest is the digest of the artifact.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]+$`
+   * +akuity:test-kubebuilder-pattern=Digest
+   *
+   * @generated from field: optional string digest = 2;
+   */
+  digest: string;
+
+  /**
+   * ArtifactType is the type of the artifact.
+   *
+   * @generated from field: optional string artifactType = 3;
+   */
+  artifactType: string;
+
+  /**
+   * Annotations is a map of key-value pairs that provide additional
+   * information about the artifact.
+   *
+   * @generated from field: map<string, string> annotations = 4;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * CreatedAt is the time the artifact was created, as indicated by its
+   * org.opencontainers.image.created annotation. This field is optional.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time createdAt = 5;
+   */
+  createdAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference.
+ * Use `create(DiscoveredOCIArtifactReferenceSchema)` to create a new message.
+ */
+export const DiscoveredOCIArtifactReferenceSchema: GenMessage<DiscoveredOCIArtifactReference> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 22);
+
+/**
+ * DiscoveredRelease represents a release discovered by a Warehouse for a
+ * ReleaseFeedSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease
+ */
+export type DiscoveredRelease = Message<"github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease"> & {
+  /**
+   * Version is the version of the release.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string version = 1;
+   */
+  version: string;
+
+  /**
+   * Metadata is a map of metadata extracted for the release.
+   *
+   * @generated from field: map<string, string> metadata = 2;
+   */
+  metadata: { [key: string]: string };
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease.
+ * Use `create(DiscoveredReleaseSchema)` to create a new message.
+ */
+export const DiscoveredReleaseSchema: GenMessage<DiscoveredRelease> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 23);
 
 /**
  * ExpressionVariable describes a single variable that may be referenced by
@@ -734,7 +1145,7 @@ export type ExpressionVariable = Message<"github.com.akuity.kargo.api.v1alpha1.E
  * Use `create(ExpressionVariableSchema)` to create a new message.
  */
 export const ExpressionVariableSchema: GenMessage<ExpressionVariable> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 17);
+  messageDesc(file_api_v1alpha1_generated, 24);
 
 /**
  * Freight represents a collection of versioned artifacts.
@@ -792,6 +1203,20 @@ export type Freight = Message<"github.com.akuity.kargo.api.v1alpha1.Freight"> &
    */
   charts: Chart[];
 
+  /**
+   * OCIArtifacts describes specific versions of specific OCI artifacts.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifact ociArtifacts = 10;
+   */
+  ociArtifacts: OCIArtifact[];
+
+  /**
+   * Releases describes specific versions from release feeds.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Release releases = 11;
+   */
+  releases: Release[];
+
   /**
    * Status describes the current status of this Freight.
    *
@@ -805,7 +1230,7 @@ export type Freight = Message<"github.com.akuity.kargo.api.v1alpha1.Freight"> &
  * Use `create(FreightSchema)` to create a new message.
  */
 export const FreightSchema: GenMessage<Freight> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 18);
+  messageDesc(file_api_v1alpha1_generated, 25);
 
 /**
  * FreightCollection is a collection of FreightReferences, each of which
@@ -845,7 +1270,37 @@ export type FreightCollection = Message<"github.com.akuity.kargo.api.v1alpha1.Fr
  * Use `create(FreightCollectionSchema)` to create a new message.
  */
 export const FreightCollectionSchema: GenMessage<FreightCollection> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 19);
+  messageDesc(file_api_v1alpha1_generated, 26);
+
+/**
+ * FreightCreationCriteria defines criteria that the latest discovered artifacts
+ * must satisfy for a Warehouse to automatically create Freight from them.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightCreationCriteria
+ */
+export type FreightCreationCriteria = Message<"github.com.akuity.kargo.api.v1alpha1.FreightCreationCriteria"> & {
+  /**
+   * Expression is an expression that must evaluate to true for Freight to be
+   * created automatically from the latest discovered artifacts. The
+   * commitFrom(), imageFrom(), chartFrom(), ociArtifactFrom(), and
+   * releaseFrom() functions may be used within the expression to reference
+   * the artifacts that would be included in the Freight. For example:
+   * `imageFrom("example.com/app").Tag == commitFrom("https://github.com/example/app.git").Tag`
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=2048
+   *
+   * @generated from field: optional string expression = 1;
+   */
+  expression: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightCreationCriteria.
+ * Use `create(FreightCreationCriteriaSchema)` to create a new message.
+ */
+export const FreightCreationCriteriaSchema: GenMessage<FreightCreationCriteria> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 27);
 
 /**
  * FreightList is a list of Freight resources.
@@ -869,7 +1324,7 @@ export type FreightList = Message<"github.com.akuity.kargo.api.v1alpha1.FreightL
  * Use `create(FreightListSchema)` to create a new message.
  */
 export const FreightListSchema: GenMessage<FreightList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 20);
+  messageDesc(file_api_v1alpha1_generated, 28);
 
 /**
  * FreightOrigin describes a kind of Freight in terms of where it may have
@@ -906,7 +1361,7 @@ export type FreightOrigin = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightOriginSchema)` to create a new message.
  */
 export const FreightOriginSchema: GenMessage<FreightOrigin> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 21);
+  messageDesc(file_api_v1alpha1_generated, 29);
 
 /**
  * FreightReference is a simplified representation of a piece of Freight -- not
@@ -951,6 +1406,20 @@ export type FreightReference = Message<"github.com.akuity.kargo.api.v1alpha1.Fre
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Chart charts = 4;
    */
   charts: Chart[];
+
+  /**
+   * OCIArtifacts describes specific versions of specific OCI artifacts.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifact ociArtifacts = 9;
+   */
+  ociArtifacts: OCIArtifact[];
+
+  /**
+   * Releases describes specific versions from release feeds.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Release releases = 10;
+   */
+  releases: Release[];
 };
 
 /**
@@ -958,7 +1427,7 @@ export type FreightReference = Message<"github.com.akuity.kargo.api.v1alpha1.Fre
  * Use `create(FreightReferenceSchema)` to create a new message.
  */
 export const FreightReferenceSchema: GenMessage<FreightReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 22);
+  messageDesc(file_api_v1alpha1_generated, 30);
 
 /**
  * FreightRequest expresses a Stage's need for Freight having originated from a
@@ -991,7 +1460,7 @@ export type FreightRequest = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightRequestSchema)` to create a new message.
  */
 export const FreightRequestSchema: GenMessage<FreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 23);
+  messageDesc(file_api_v1alpha1_generated, 31);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightSources
@@ -1060,7 +1529,7 @@ export type FreightSources = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightSourcesSchema)` to create a new message.
  */
 export const FreightSourcesSchema: GenMessage<FreightSources> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 24);
+  messageDesc(file_api_v1alpha1_generated, 32);
 
 /**
  * FreightStatus describes a piece of Freight's most recently observed state.
@@ -1093,6 +1562,24 @@ export type FreightStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
    */
   approvedFor: { [key: string]: ApprovedStage };
 
+  /**
+   * Rejected, if set, indicates that this Freight has been rejected by a user
+   * for all Stages in the Project. Rejected Freight is never available for
+   * promotion.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.RejectedFreight rejected = 5;
+   */
+  rejected?: RejectedFreight;
+
+  /**
+   * RejectedFor describes the Stages for which this Freight has been rejected
+   * by a user. Freight is never available for promotion to a Stage for which
+   * it has been rejected.
+   *
+   * @generated from field: map<string, github.com.akuity.kargo.api.v1alpha1.RejectedFreight> rejectedFor = 6;
+   */
+  rejectedFor: { [key: string]: RejectedFreight };
+
   /**
    * Metadata is a map of arbitrary metadata associated with the Freight.
    * This is useful for storing additional information about the Freight
@@ -1108,7 +1595,7 @@ export type FreightStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightStatusSchema)` to create a new message.
  */
 export const FreightStatusSchema: GenMessage<FreightStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 25);
+  messageDesc(file_api_v1alpha1_generated, 33);
 
 /**
  * GitCommit describes a specific commit from a specific Git repository.
@@ -1167,6 +1654,22 @@ export type GitCommit = Message<"github.com.akuity.kargo.api.v1alpha1.GitCommit"
    * @generated from field: optional string committer = 8;
    */
   committer: string;
+
+  /**
+   * PullRequestNumber is the number of the pull request from which this
+   * commit was selected, if any.
+   *
+   * @generated from field: optional int64 pullRequestNumber = 9;
+   */
+  pullRequestNumber: bigint;
+
+  /**
+   * PullRequestURL is the URL of the pull request from which this commit was
+   * selected, if any.
+   *
+   * @generated from field: optional string pullRequestURL = 10;
+   */
+  pullRequestURL: string;
 };
 
 /**
@@ -1174,7 +1677,7 @@ export type GitCommit = Message<"github.com.akuity.kargo.api.v1alpha1.GitCommit"
  * Use `create(GitCommitSchema)` to create a new message.
  */
 export const GitCommitSchema: GenMessage<GitCommit> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 26);
+  messageDesc(file_api_v1alpha1_generated, 34);
 
 /**
  * GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -1211,7 +1714,7 @@ export type GitDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.G
  * Use `create(GitDiscoveryResultSchema)` to create a new message.
  */
 export const GitDiscoveryResultSchema: GenMessage<GitDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 27);
+  messageDesc(file_api_v1alpha1_generated, 35);
 
 /**
  * GitHubWebhookReceiver describes a webhook receiver that is compatible with
@@ -1239,7 +1742,59 @@ export type GitHubWebhookReceiver = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(GitHubWebhookReceiverSchema)` to create a new message.
  */
 export const GitHubWebhookReceiverSchema: GenMessage<GitHubWebhookReceiver> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 28);
+  messageDesc(file_api_v1alpha1_generated, 36);
+
+/**
+ * GitPullRequestFilter specifies how pull requests are discovered for a
+ * GitSubscription and which of them are eligible for selection.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.GitPullRequestFilter
+ */
+export type GitPullRequestFilter = Message<"github.com.akuity.kargo.api.v1alpha1.GitPullRequestFilter"> & {
+  /**
+   * Provider is the name of the Git hosting provider to query for pull
+   * requests. This field is optional. When left unspecified, Kargo will try
+   * to infer the provider from the repository URL.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:Enum={azure,bitbucket,gitea,github,gitlab}
+   *
+   * @generated from field: optional string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * BaseBranch limits selection to pull requests targeting the specified
+   * branch. This field is optional. When left unspecified, pull requests
+   * targeting any branch are eligible for selection.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=255
+   * +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([a-zA-Z0-9._\/-]*[a-zA-Z0-9_-])?$`
+   * +akuity:test-kubebuilder-pattern=Branch
+   *
+   * @generated from field: optional string baseBranch = 2;
+   */
+  baseBranch: string;
+
+  /**
+   * Labels limits selection to pull requests having all of the specified
+   * labels. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string labels = 3;
+   */
+  labels: string[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.GitPullRequestFilter.
+ * Use `create(GitPullRequestFilterSchema)` to create a new message.
+ */
+export const GitPullRequestFilterSchema: GenMessage<GitPullRequestFilter> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 37);
 
 /**
  * GitSubscription defines a subscription to a Git repository.
@@ -1267,8 +1822,10 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * Accepted values:
    *
    * - "NewestFromBranch": Selects the latest commit on the branch specified
-   *   by the Branch field or the default branch if none is specified. This is
-   *   the default strategy.
+   *   by the Branch field or the default branch if none is specified. When
+   *   the BranchPattern field is specified instead, the latest commit on each
+   *   matching branch is selected, with branches ordered according to the
+   *   BranchSelectionStrategy field. This is the default strategy.
    *
    * - "SemVer": Selects the commit referenced by the the semantically greatest
    *   tag. The SemverConstraint field can optionally be used to narrow the set
@@ -1283,6 +1840,12 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    *   tag. The AllowTags and IgnoreTags fields can optionally be used to
    *   narrow the set of tags eligible for selection.
    *
+   * - "PullRequest": Selects the head commits of open pull requests (or
+   *   equivalent, e.g. GitLab merge requests), most recently updated first.
+   *   Pull requests opened from forks are never selected. The PullRequests
+   *   field can optionally be used to narrow the set of pull requests eligible
+   *   for selection.
+   *
    * +kubebuilder:default=NewestFromBranch
    *
    * @generated from field: optional string commitSelectionStrategy = 2;
@@ -1306,6 +1869,65 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    */
   branch: string;
 
+  /**
+   * BranchPattern selects the branches of the repository to subscribe to by
+   * name, as an alternative to the Branch field. Glob patterns (optionally
+   * prefixed with "glob:"; ex. "release/*") and regular expressions (prefixed
+   * with "regex:" or "regexp:"; ex. "regexp:^release/v?[0-9]+$") are
+   * supported. The value in this field only has any effect when the
+   * CommitSelectionStrategy is NewestFromBranch or left unspecified. This
+   * field is optional and is mutually exclusive with the Branch field.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:MaxLength=255
+   *
+   * @generated from field: optional string branchPattern = 13;
+   */
+  branchPattern: string;
+
+  /**
+   * BranchSelectionStrategy specifies how the branches matched by the
+   * BranchPattern field are ordered. Unless the FreightPerBranch field is
+   * true, the latest commit of the first branch in this order is the one used
+   * when new Freight is created automatically. The value in this field only
+   * has any effect when the BranchPattern field is specified. When left unspecified, the field is implicitly treated as if
+   * its value were "NewestCommit".
+   *
+   * Accepted values:
+   *
+   * - "NewestCommit": Orders branches by the commit date of their latest
+   *   commit, newest first. This results in Freight being produced for the
+   *   latest commit on any matching branch.
+   *
+   * - "SemVer": Orders branches by the semantic version embedded in the last
+   *   segment of their names (ex. "1.2" for "release/1.2"), greatest first.
+   *   Branches without a semantic version are ignored. The SemverConstraint
+   *   field can optionally be used to narrow the set of branches eligible for
+   *   selection.
+   *
+   * - "Lexical": Orders branches by name, lexicographically greatest first.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string branchSelectionStrategy = 14;
+   */
+  branchSelectionStrategy: string;
+
+  /**
+   * FreightPerBranch specifies whether new Freight should be created
+   * automatically for the latest commit of every branch matched by the
+   * BranchPattern field, rather than only for that of the first branch in the
+   * order specified by the BranchSelectionStrategy field. Each such Freight
+   * otherwise contains the same artifacts as the Freight built from the latest
+   * artifacts. This field only has any effect when the BranchPattern field is
+   * specified.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional bool freightPerBranch = 15;
+   */
+  freightPerBranch: boolean;
+
   /**
    * StrictSemvers specifies whether only "strict" semver tags should be
    * considered. A "strict" semver tag is one containing ALL of major, minor,
@@ -1324,11 +1946,13 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
   /**
    * SemverConstraint specifies constraints on what new tagged commits are
    * considered in determining the newest commit of interest. The value in this
-   * field only has any effect when the CommitSelectionStrategy is SemVer. This
-   * field is optional. When left unspecified, there will be no constraints,
-   * which means the latest semantically tagged commit will always be used. Care
-   * should be taken with leaving this field unspecified, as it can lead to the
-   * unanticipated rollout of breaking changes.
+   * field only has any effect when the CommitSelectionStrategy is SemVer or
+   * the BranchSelectionStrategy is SemVer, in which case it constrains the
+   * versions embedded in branch names instead. This field is optional. When
+   * left unspecified, there will be no constraints, which means the latest
+   * semantically tagged commit will always be used. Care should be taken with
+   * leaving this field unspecified, as it can lead to the unanticipated
+   * rollout of breaking changes.
    *
    * +kubebuilder:validation:Optional
    *
@@ -1424,6 +2048,17 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * @generated from field: optional int32 discoveryLimit = 10;
    */
   discoveryLimit: number;
+
+  /**
+   * PullRequests specifies how pull requests are discovered and which of them
+   * are eligible for selection. The value in this field only has any effect
+   * when the CommitSelectionStrategy is PullRequest. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.GitPullRequestFilter pullRequests = 12;
+   */
+  pullRequests?: GitPullRequestFilter;
 };
 
 /**
@@ -1431,7 +2066,7 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
  * Use `create(GitSubscriptionSchema)` to create a new message.
  */
 export const GitSubscriptionSchema: GenMessage<GitSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 29);
+  messageDesc(file_api_v1alpha1_generated, 38);
 
 /**
  * Health describes the health of a Stage.
@@ -1475,7 +2110,7 @@ export type Health = Message<"github.com.akuity.kargo.api.v1alpha1.Health"> & {
  * Use `create(HealthSchema)` to create a new message.
  */
 export const HealthSchema: GenMessage<Health> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 30);
+  messageDesc(file_api_v1alpha1_generated, 39);
 
 /**
  * HealthCheckStep describes a health check directive which can be executed by
@@ -1506,7 +2141,7 @@ export type HealthCheckStep = Message<"github.com.akuity.kargo.api.v1alpha1.Heal
  * Use `create(HealthCheckStepSchema)` to create a new message.
  */
 export const HealthCheckStepSchema: GenMessage<HealthCheckStep> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 31);
+  messageDesc(file_api_v1alpha1_generated, 40);
 
 /**
  * HealthStats contains a summary of the collective health of some resource
@@ -1528,7 +2163,7 @@ export type HealthStats = Message<"github.com.akuity.kargo.api.v1alpha1.HealthSt
  * Use `create(HealthStatsSchema)` to create a new message.
  */
 export const HealthStatsSchema: GenMessage<HealthStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 32);
+  messageDesc(file_api_v1alpha1_generated, 41);
 
 /**
  * Image describes a specific version of a container image.
@@ -1576,6 +2211,24 @@ export type Image = Message<"github.com.akuity.kargo.api.v1alpha1.Image"> & {
    * @generated from field: map<string, string> annotations = 5;
    */
   annotations: { [key: string]: string };
+
+  /**
+   * SourceRepoURL is the URL of the repository containing the source code the
+   * image was built from, as indicated by the image's
+   * org.opencontainers.image.source annotation or label.
+   *
+   * @generated from field: optional string sourceRepoURL = 6;
+   */
+  sourceRepoURL: string;
+
+  /**
+   * SourceCommit identifies the commit in the repository specified by
+   * SourceRepoURL that the image was built from, as indicated by the image's
+   * org.opencontainers.image.revision annotation or label.
+   *
+   * @generated from field: optional string sourceCommit = 7;
+   */
+  sourceCommit: string;
 };
 
 /**
@@ -1583,7 +2236,7 @@ export type Image = Message<"github.com.akuity.kargo.api.v1alpha1.Image"> & {
  * Use `create(ImageSchema)` to create a new message.
  */
 export const ImageSchema: GenMessage<Image> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 33);
+  messageDesc(file_api_v1alpha1_generated, 42);
 
 /**
  * ImageDiscoveryResult represents the result of an image discovery operation
@@ -1629,7 +2282,7 @@ export type ImageDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ImageDiscoveryResultSchema)` to create a new message.
  */
 export const ImageDiscoveryResultSchema: GenMessage<ImageDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 34);
+  messageDesc(file_api_v1alpha1_generated, 43);
 
 /**
  * ImageSubscription defines a subscription to an image repository.
@@ -1791,14 +2444,259 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
    * @generated from field: optional int32 discoveryLimit = 9;
    */
   discoveryLimit: number;
+
+  /**
+   * ExpressionFilter is an optional expression that each image must satisfy to
+   * be considered when determining the newest version of an image. It is
+   * evaluated after filtering images based on the AllowTags and IgnoreTags
+   * fields and must evaluate to a boolean. Within the expression, the tag and
+   * digest variables hold the image's tag and digest, and the annotation(key)
+   * and label(key) functions return the value of one of the image's manifest
+   * annotations or config labels, respectively, or an empty string if it has
+   * no such annotation or label. The createdAt() and age() functions return
+   * the time at which the image was built and the time that has elapsed since
+   * then. Labels of images with manifests for multiple platforms are only
+   * available when Platform is specified.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string expressionFilter = 11;
+   */
+  expressionFilter: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ImageSubscription.
+ * Use `create(ImageSubscriptionSchema)` to create a new message.
+ */
+export const ImageSubscriptionSchema: GenMessage<ImageSubscription> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 44);
+
+/**
+ * OCIArtifact describes a specific version of an OCI artifact other than a
+ * container image or Helm chart.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifact
+ */
+export type OCIArtifact = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifact"> & {
+  /**
+   * RepoURL describes the repository in which the artifact can be found.
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * Tag identifies a specific version of the artifact in the repository
+   * specified by RepoURL.
+   *
+   * @generated from field: optional string tag = 2;
+   */
+  tag: string;
+
+  /**
+   * Digest identifies a specific version of the artifact in the repository
+   * specified by RepoURL. This is a more precise identifier than Tag.
+   *
+   * @generated from field: optional string digest = 3;
+   */
+  digest: string;
+
+  /**
+   * ArtifactType is the type of the artifact.
+   *
+   * @generated from field: optional string artifactType = 4;
+   */
+  artifactType: string;
+
+  /**
+   * Annotations is a map of arbitrary metadata for the artifact.
+   *
+   * @generated from field: map<string, string> annotations = 5;
+   */
+  annotations: { [key: string]: string };
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifact.
+ * Use `create(OCIArtifactSchema)` to create a new message.
+ */
+export const OCIArtifactSchema: GenMessage<OCIArtifact> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 45);
+
+/**
+ * OCIArtifactDiscoveryResult represents the result of an artifact discovery
+ * operation for an OCIArtifactSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult
+ */
+export type OCIArtifactDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult"> & {
+  /**
+   * RepoURL is the repository URL of the artifact, as specified in the
+   * OCIArtifactSubscription.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * ArtifactType is the artifact type constraint of the
+   * OCIArtifactSubscription for which references were discovered. This field
+   * is optional, and only populated if the OCIArtifactSubscription specifies
+   * an ArtifactType.
+   *
+   * @generated from field: optional string artifactType = 2;
+   */
+  artifactType: string;
+
+  /**
+   * References is a list of artifact references discovered by the Warehouse
+   * for the OCIArtifactSubscription. An empty list indicates that the
+   * discovery operation was successful, but no artifacts matching the
+   * OCIArtifactSubscription criteria were found.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference references = 3;
+   */
+  references: DiscoveredOCIArtifactReference[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult.
+ * Use `create(OCIArtifactDiscoveryResultSchema)` to create a new message.
+ */
+export const OCIArtifactDiscoveryResultSchema: GenMessage<OCIArtifactDiscoveryResult> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 46);
+
+/**
+ * OCIArtifactSubscription defines a subscription to a repository of OCI
+ * artifacts within an OCI registry.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription
+ */
+export type OCIArtifactSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription"> & {
+  /**
+   * RepoURL specifies the URL of the artifact repository to subscribe to. The
+   * value in this field MUST NOT include a tag or digest. This field is
+   * required.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+   * +akuity:test-kubebuilder-pattern=ImageRepoURL
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * SelectionStrategy specifies the rules for how to identify the newest
+   * version of the artifact specified by the RepoURL field. This field is
+   * optional. When left unspecified, the field is implicitly treated as if its
+   * value were "SemVer". The accepted values and their meaning are the same
+   * as for the ImageSelectionStrategy field of an ImageSubscription, except
+   * that "NewestBuild" relies upon the org.opencontainers.image.created
+   * annotation of each artifact's manifest.
+   *
+   * +kubebuilder:default=SemVer
+   *
+   * @generated from field: optional string selectionStrategy = 2;
+   */
+  selectionStrategy: string;
+
+  /**
+   * StrictSemvers specifies whether only "strict" semver tags should be
+   * considered. A "strict" semver tag is one containing ALL of major, minor,
+   * and patch version components. This is enabled by default, but only has any
+   * effect when the SelectionStrategy is SemVer.
+   *
+   * +kubebuilder:default=true
+   *
+   * @generated from field: optional bool strictSemvers = 3;
+   */
+  strictSemvers: boolean;
+
+  /**
+   * SemverConstraint specifies constraints on what new artifact versions are
+   * permissible. The value in this field only has any effect when the
+   * SelectionStrategy is SemVer or left unspecified, except when the
+   * SelectionStrategy is Digest, in which case it specifies the (mutable) tag
+   * whose digest should be selected. This field is optional.
+   * More info: https://github.com/masterminds/semver#checking-version-constraints
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string semverConstraint = 4;
+   */
+  semverConstraint: string;
+
+  /**
+   * AllowTags is a regular expression that can optionally be used to limit the
+   * tags that are considered in determining the newest version of an artifact.
+   * This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string allowTags = 5;
+   */
+  allowTags: string;
+
+  /**
+   * IgnoreTags is a list of tags that must be ignored when determining the
+   * newest version of an artifact. No regular expressions or glob patterns are
+   * supported yet. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string ignoreTags = 6;
+   */
+  ignoreTags: string[];
+
+  /**
+   * ArtifactType optionally limits the artifacts that are considered in
+   * determining the newest version of an artifact to those of the specified
+   * type. An artifact's type is the artifactType of its manifest or, if that
+   * is not set, the media type of its config.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string artifactType = 7;
+   */
+  artifactType: string;
+
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when connecting to the repository. This should be enabled
+   * only with great caution.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 8;
+   */
+  insecureSkipTLSVerify: boolean;
+
+  /**
+   * DiscoveryLimit is an optional limit on the number of artifact references
+   * that can be discovered for this subscription. The limit is applied after
+   * filtering artifacts based on the AllowTags, IgnoreTags, and ArtifactType
+   * fields. When left unspecified, the field is implicitly treated as if its
+   * value were "20". The upper limit for this field is 100.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   * +kubebuilder:default=20
+   *
+   * @generated from field: optional int32 discoveryLimit = 9;
+   */
+  discoveryLimit: number;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.ImageSubscription.
- * Use `create(ImageSubscriptionSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription.
+ * Use `create(OCIArtifactSubscriptionSchema)` to create a new message.
  */
-export const ImageSubscriptionSchema: GenMessage<ImageSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 35);
+export const OCIArtifactSubscriptionSchema: GenMessage<OCIArtifactSubscription> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 47);
 
 /**
  * Project is a resource type that reconciles to a specially labeled namespace
@@ -1836,7 +2734,7 @@ export type Project = Message<"github.com.akuity.kargo.api.v1alpha1.Project"> &
  * Use `create(ProjectSchema)` to create a new message.
  */
 export const ProjectSchema: GenMessage<Project> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 36);
+  messageDesc(file_api_v1alpha1_generated, 48);
 
 /**
  * ProjectConfig is a resource type that describes the configuration of a
@@ -1870,7 +2768,7 @@ export type ProjectConfig = Message<"github.com.akuity.kargo.api.v1alpha1.Projec
  * Use `create(ProjectConfigSchema)` to create a new message.
  */
 export const ProjectConfigSchema: GenMessage<ProjectConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 37);
+  messageDesc(file_api_v1alpha1_generated, 49);
 
 /**
  * ProjectConfigList is a list of ProjectConfig resources.
@@ -1894,7 +2792,7 @@ export type ProjectConfigList = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(ProjectConfigListSchema)` to create a new message.
  */
 export const ProjectConfigListSchema: GenMessage<ProjectConfigList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 38);
+  messageDesc(file_api_v1alpha1_generated, 50);
 
 /**
  * ProjectSpec is a deprecated alias for ProjectConfigSpec. It is retained for
@@ -1927,7 +2825,7 @@ export type ProjectConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(ProjectConfigSpecSchema)` to create a new message.
  */
 export const ProjectConfigSpecSchema: GenMessage<ProjectConfigSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 39);
+  messageDesc(file_api_v1alpha1_generated, 51);
 
 /**
  * ProjectConfigStatus describes the current status of a ProjectConfig.
@@ -1959,7 +2857,7 @@ export type ProjectConfigStatus = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(ProjectConfigStatusSchema)` to create a new message.
  */
 export const ProjectConfigStatusSchema: GenMessage<ProjectConfigStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 40);
+  messageDesc(file_api_v1alpha1_generated, 52);
 
 /**
  * ProjectList is a list of Project resources.
@@ -1983,7 +2881,7 @@ export type ProjectList = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectL
  * Use `create(ProjectListSchema)` to create a new message.
  */
 export const ProjectListSchema: GenMessage<ProjectList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 41);
+  messageDesc(file_api_v1alpha1_generated, 53);
 
 /**
  * ProjectStats contains a summary of the collective state of a Project's
@@ -2013,7 +2911,7 @@ export type ProjectStats = Message<"github.com.akuity.kargo.api.v1alpha1.Project
  * Use `create(ProjectStatsSchema)` to create a new message.
  */
 export const ProjectStatsSchema: GenMessage<ProjectStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 42);
+  messageDesc(file_api_v1alpha1_generated, 54);
 
 /**
  * ProjectStatus describes a Project's current status.
@@ -2047,7 +2945,7 @@ export type ProjectStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Projec
  * Use `create(ProjectStatusSchema)` to create a new message.
  */
 export const ProjectStatusSchema: GenMessage<ProjectStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 43);
+  messageDesc(file_api_v1alpha1_generated, 55);
 
 /**
  * Promotion represents a request to transition a particular Stage into a
@@ -2085,7 +2983,7 @@ export type Promotion = Message<"github.com.akuity.kargo.api.v1alpha1.Promotion"
  * Use `create(PromotionSchema)` to create a new message.
  */
 export const PromotionSchema: GenMessage<Promotion> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 44);
+  messageDesc(file_api_v1alpha1_generated, 56);
 
 /**
  * PromotionList contains a list of Promotion
@@ -2109,7 +3007,7 @@ export type PromotionList = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionListSchema)` to create a new message.
  */
 export const PromotionListSchema: GenMessage<PromotionList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 45);
+  messageDesc(file_api_v1alpha1_generated, 57);
 
 /**
  * PromotionPolicy defines policies governing the promotion of Freight to a
@@ -2158,7 +3056,7 @@ export type PromotionPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionPolicySchema)` to create a new message.
  */
 export const PromotionPolicySchema: GenMessage<PromotionPolicy> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 46);
+  messageDesc(file_api_v1alpha1_generated, 58);
 
 /**
  * PromotionPolicySelector is a selector that matches the resource to which
@@ -2214,7 +3112,7 @@ export type PromotionPolicySelector = Message<"github.com.akuity.kargo.api.v1alp
  * Use `create(PromotionPolicySelectorSchema)` to create a new message.
  */
 export const PromotionPolicySelectorSchema: GenMessage<PromotionPolicySelector> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 47);
+  messageDesc(file_api_v1alpha1_generated, 59);
 
 /**
  * PromotionReference contains the relevant information about a Promotion
@@ -2257,7 +3155,7 @@ export type PromotionReference = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionReferenceSchema)` to create a new message.
  */
 export const PromotionReferenceSchema: GenMessage<PromotionReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 48);
+  messageDesc(file_api_v1alpha1_generated, 60);
 
 /**
  * PromotionSpec describes the desired transition of a specific Stage into a
@@ -2322,7 +3220,7 @@ export type PromotionSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionSpecSchema)` to create a new message.
  */
 export const PromotionSpecSchema: GenMessage<PromotionSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 49);
+  messageDesc(file_api_v1alpha1_generated, 61);
 
 /**
  * PromotionStatus describes the current state of the transition represented by
@@ -2420,7 +3318,7 @@ export type PromotionStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionStatusSchema)` to create a new message.
  */
 export const PromotionStatusSchema: GenMessage<PromotionStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 50);
+  messageDesc(file_api_v1alpha1_generated, 62);
 
 /**
  * PromotionStep describes a directive to be executed as part of a Promotion.
@@ -2505,7 +3403,7 @@ export type PromotionStep = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionStepSchema)` to create a new message.
  */
 export const PromotionStepSchema: GenMessage<PromotionStep> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 51);
+  messageDesc(file_api_v1alpha1_generated, 63);
 
 /**
  * PromotionStepRetry describes the retry policy for a PromotionStep.
@@ -2564,7 +3462,7 @@ export type PromotionStepRetry = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionStepRetrySchema)` to create a new message.
  */
 export const PromotionStepRetrySchema: GenMessage<PromotionStepRetry> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 52);
+  messageDesc(file_api_v1alpha1_generated, 64);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTask
@@ -2591,7 +3489,7 @@ export type PromotionTask = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionTaskSchema)` to create a new message.
  */
 export const PromotionTaskSchema: GenMessage<PromotionTask> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 53);
+  messageDesc(file_api_v1alpha1_generated, 65);
 
 /**
  * PromotionTaskList contains a list of PromotionTasks.
@@ -2607,147 +3505,459 @@ export type PromotionTaskList = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
   /**
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionTask items = 2;
    */
-  items: PromotionTask[];
+  items: PromotionTask[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTaskList.
+ * Use `create(PromotionTaskListSchema)` to create a new message.
+ */
+export const PromotionTaskListSchema: GenMessage<PromotionTaskList> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 66);
+
+/**
+ * PromotionTaskReference describes a reference to a PromotionTask.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTaskReference
+ */
+export type PromotionTaskReference = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTaskReference"> & {
+  /**
+   * Name is the name of the (Cluster)PromotionTask.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=253
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+   * +akuity:test-kubebuilder-pattern=KubernetesName
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Kind is the type of the PromotionTask. Can be either PromotionTask or
+   * ClusterPromotionTask, default is PromotionTask.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:Enum=PromotionTask;ClusterPromotionTask
+   *
+   * @generated from field: optional string kind = 2;
+   */
+  kind: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTaskReference.
+ * Use `create(PromotionTaskReferenceSchema)` to create a new message.
+ */
+export const PromotionTaskReferenceSchema: GenMessage<PromotionTaskReference> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 67);
+
+/**
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec
+ */
+export type PromotionTaskSpec = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec"> & {
+  /**
+   * Vars specifies the variables available to the PromotionTask. The
+   * values of these variables are the default values that can be
+   * overridden by the step referencing the task.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ExpressionVariable vars = 1;
+   */
+  vars: ExpressionVariable[];
+
+  /**
+   * Steps specifies the directives to be executed as part of this
+   * PromotionTask. The steps as defined here are inflated into a
+   * Promotion when it is built from a PromotionTemplate.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinItems=1
+   * +kubebuilder:validation:items:XValidation:message="PromotionTask step must have uses set and must not reference another task",rule="has(self.uses) && !has(self.task)"
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 2;
+   */
+  steps: PromotionStep[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec.
+ * Use `create(PromotionTaskSpecSchema)` to create a new message.
+ */
+export const PromotionTaskSpecSchema: GenMessage<PromotionTaskSpec> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 68);
+
+/**
+ * PromotionTemplate defines a template for a Promotion that can be used to
+ * incorporate Freight into a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTemplate
+ */
+export type PromotionTemplate = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTemplate"> & {
+  /**
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec spec = 1;
+   */
+  spec?: PromotionTemplateSpec;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTemplate.
+ * Use `create(PromotionTemplateSchema)` to create a new message.
+ */
+export const PromotionTemplateSchema: GenMessage<PromotionTemplate> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 69);
+
+/**
+ * PromotionTemplateSpec describes the (partial) specification of a Promotion
+ * for a Stage. This is a template that can be used to create a Promotion for a
+ * Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec
+ */
+export type PromotionTemplateSpec = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec"> & {
+  /**
+   * Vars is a list of variables that can be referenced by expressions in
+   * promotion steps.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ExpressionVariable vars = 2;
+   */
+  vars: ExpressionVariable[];
+
+  /**
+   * Steps specifies the directives to be executed as part of a Promotion.
+   * The order in which the directives are executed is the order in which they
+   * are listed in this field.
+   *
+   * +kubebuilder:validation:MinItems=1
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses or task set",rule="(has(self.uses) ? !has(self.task) : has(self.task))"
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 1;
+   */
+  steps: PromotionStep[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec.
+ * Use `create(PromotionTemplateSpecSchema)` to create a new message.
+ */
+export const PromotionTemplateSpecSchema: GenMessage<PromotionTemplateSpec> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 70);
+
+/**
+ * RejectedFreight describes a user's rejection of Freight.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.RejectedFreight
+ */
+export type RejectedFreight = Message<"github.com.akuity.kargo.api.v1alpha1.RejectedFreight"> & {
+  /**
+   * Reason is a human-readable explanation of why the Freight was rejected.
+   *
+   * @generated from field: optional string reason = 1;
+   */
+  reason: string;
+
+  /**
+   * RejectedBy identifies the user who rejected the Freight.
+   *
+   * @generated from field: optional string rejectedBy = 2;
+   */
+  rejectedBy: string;
+
+  /**
+   * RejectedAt is the time at which the Freight was rejected.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time rejectedAt = 3;
+   */
+  rejectedAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.RejectedFreight.
+ * Use `create(RejectedFreightSchema)` to create a new message.
+ */
+export const RejectedFreightSchema: GenMessage<RejectedFreight> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 71);
+
+/**
+ * Release describes a specific version obtained from a release feed.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.Release
+ */
+export type Release = Message<"github.com.akuity.kargo.api.v1alpha1.Release"> & {
+  /**
+   * URL is the URL of the release feed the version was obtained from.
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url: string;
+
+  /**
+   * Version is the version of the release.
+   *
+   * @generated from field: optional string version = 2;
+   */
+  version: string;
+
+  /**
+   * Metadata is a map of metadata extracted for the release.
+   *
+   * @generated from field: map<string, string> metadata = 3;
+   */
+  metadata: { [key: string]: string };
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.Release.
+ * Use `create(ReleaseSchema)` to create a new message.
+ */
+export const ReleaseSchema: GenMessage<Release> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 72);
+
+/**
+ * ReleaseFeedDiscoveryResult represents the result of a version discovery
+ * operation for a ReleaseFeedSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedDiscoveryResult
+ */
+export type ReleaseFeedDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.ReleaseFeedDiscoveryResult"> & {
+  /**
+   * URL is the URL of the release feed, as specified in the
+   * ReleaseFeedSubscription.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url: string;
+
+  /**
+   * Releases is a list of releases discovered by the Warehouse for the
+   * ReleaseFeedSubscription. An empty list indicates that the discovery
+   * operation was successful, but no versions matching the
+   * ReleaseFeedSubscription criteria were found.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease releases = 2;
+   */
+  releases: DiscoveredRelease[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedDiscoveryResult.
+ * Use `create(ReleaseFeedDiscoveryResultSchema)` to create a new message.
+ */
+export const ReleaseFeedDiscoveryResultSchema: GenMessage<ReleaseFeedDiscoveryResult> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 73);
+
+/**
+ * ReleaseFeedHeader represents a header to include in requests to a release
+ * feed.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedHeader
+ */
+export type ReleaseFeedHeader = Message<"github.com.akuity.kargo.api.v1alpha1.ReleaseFeedHeader"> & {
+  /**
+   * Name is the name of the header.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Value is the value of the header.
+   *
+   * @generated from field: optional string value = 2;
+   */
+  value: string;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTaskList.
- * Use `create(PromotionTaskListSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedHeader.
+ * Use `create(ReleaseFeedHeaderSchema)` to create a new message.
  */
-export const PromotionTaskListSchema: GenMessage<PromotionTaskList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 54);
+export const ReleaseFeedHeaderSchema: GenMessage<ReleaseFeedHeader> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 74);
 
 /**
- * PromotionTaskReference describes a reference to a PromotionTask.
+ * ReleaseFeedMetadata describes a rule for extracting metadata about a version
+ * from a release feed's response.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTaskReference
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedMetadata
  */
-export type PromotionTaskReference = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTaskReference"> & {
+export type ReleaseFeedMetadata = Message<"github.com.akuity.kargo.api.v1alpha1.ReleaseFeedMetadata"> & {
   /**
-   * Name is the name of the (Cluster)PromotionTask.
+   * Name is the key under which the metadata is recorded.
    *
-   * +kubebuilder:validation:Required
    * +kubebuilder:validation:MinLength=1
-   * +kubebuilder:validation:MaxLength=253
-   * +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
-   * +akuity:test-kubebuilder-pattern=KubernetesName
    *
    * @generated from field: optional string name = 1;
    */
   name: string;
 
   /**
-   * Kind is the type of the PromotionTask. Can be either PromotionTask or
-   * ClusterPromotionTask, default is PromotionTask.
+   * FromExpression is an expr-lang expression that extracts the metadata
+   * value. In addition to the response object, the expression has access to
+   * the version the metadata is being extracted for. Results that are not
+   * strings are converted to JSON.
    *
-   * +kubebuilder:validation:Optional
-   * +kubebuilder:validation:Enum=PromotionTask;ClusterPromotionTask
+   * +kubebuilder:validation:MinLength=1
    *
-   * @generated from field: optional string kind = 2;
+   * @generated from field: optional string fromExpression = 2;
    */
-  kind: string;
+  fromExpression: string;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTaskReference.
- * Use `create(PromotionTaskReferenceSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedMetadata.
+ * Use `create(ReleaseFeedMetadataSchema)` to create a new message.
  */
-export const PromotionTaskReferenceSchema: GenMessage<PromotionTaskReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 55);
+export const ReleaseFeedMetadataSchema: GenMessage<ReleaseFeedMetadata> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 75);
 
 /**
- * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec
+ * ReleaseFeedSubscription defines a subscription to an HTTP endpoint that
+ * lists versions of some artifact.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedSubscription
  */
-export type PromotionTaskSpec = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec"> & {
+export type ReleaseFeedSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.ReleaseFeedSubscription"> & {
   /**
-   * Vars specifies the variables available to the PromotionTask. The
-   * values of these variables are the default values that can be
-   * overridden by the step referencing the task.
+   * URL is the URL of the HTTP endpoint to poll. Credentials of type "http"
+   * whose repoURL matches this URL are used, if any are found. This field is
+   * required.
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ExpressionVariable vars = 1;
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^https?://(\w+([\.-]\w+)*@)?\w+([\.-]\w+)*(:[\d]+)?(/.*)?$`
+   *
+   * @generated from field: optional string url = 1;
    */
-  vars: ExpressionVariable[];
+  url: string;
 
   /**
-   * Steps specifies the directives to be executed as part of this
-   * PromotionTask. The steps as defined here are inflated into a
-   * Promotion when it is built from a PromotionTemplate.
+   * Headers is a list of additional headers to include in requests to the
+   * endpoint. This field is optional.
    *
-   * +kubebuilder:validation:Required
-   * +kubebuilder:validation:MinItems=1
-   * +kubebuilder:validation:items:XValidation:message="PromotionTask step must have uses set and must not reference another task",rule="has(self.uses) && !has(self.task)"
+   * +kubebuilder:validation:Optional
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 2;
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ReleaseFeedHeader headers = 2;
    */
-  steps: PromotionStep[];
-};
+  headers: ReleaseFeedHeader[];
 
-/**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec.
- * Use `create(PromotionTaskSpecSchema)` to create a new message.
- */
-export const PromotionTaskSpecSchema: GenMessage<PromotionTaskSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 56);
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when connecting to the endpoint. This should be enabled
+   * only with great caution.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 3;
+   */
+  insecureSkipTLSVerify: boolean;
 
-/**
- * PromotionTemplate defines a template for a Promotion that can be used to
- * incorporate Freight into a Stage.
- *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTemplate
- */
-export type PromotionTemplate = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTemplate"> & {
   /**
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec spec = 1;
+   * VersionsFromExpression is an expr-lang expression that extracts a list of
+   * versions from the endpoint's response. The expression has access to a
+   * response object with status, headers, header(), and body fields. If the
+   * response has a JSON content type, its body is parsed. The expression must
+   * evaluate to a list of strings. This field is required.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string versionsFromExpression = 4;
    */
-  spec?: PromotionTemplateSpec;
-};
+  versionsFromExpression: string;
 
-/**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTemplate.
- * Use `create(PromotionTemplateSchema)` to create a new message.
- */
-export const PromotionTemplateSchema: GenMessage<PromotionTemplate> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 57);
+  /**
+   * Metadata is a list of rules for extracting additional metadata about each
+   * version from the endpoint's response. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ReleaseFeedMetadata metadata = 5;
+   */
+  metadata: ReleaseFeedMetadata[];
 
-/**
- * PromotionTemplateSpec describes the (partial) specification of a Promotion
- * for a Stage. This is a template that can be used to create a Promotion for a
- * Stage.
- *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec
- */
-export type PromotionTemplateSpec = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec"> & {
   /**
-   * Vars is a list of variables that can be referenced by expressions in
-   * promotion steps.
+   * SelectionStrategy specifies the rules for how to identify the newest
+   * version from the list of versions. This field is optional. When left
+   * unspecified, the field is implicitly treated as if its value were
+   * "SemVer".
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ExpressionVariable vars = 2;
+   * Accepted values:
+   *
+   * - "SemVer": Selects the version with the greatest semantic version,
+   *   optionally constrained by the SemverConstraint field. Versions that are
+   *   not valid semantic versions are ignored.
+   * - "Lexical": Selects the lexically greatest version. This is useful when
+   *   versions are, for instance, date-based.
+   *
+   * +kubebuilder:default=SemVer
+   *
+   * @generated from field: optional string selectionStrategy = 6;
    */
-  vars: ExpressionVariable[];
+  selectionStrategy: string;
 
   /**
-   * Steps specifies the directives to be executed as part of a Promotion.
-   * The order in which the directives are executed is the order in which they
-   * are listed in this field.
+   * SemverConstraint specifies constraints on what new versions are
+   * permissible. The value in this field only has any effect when the
+   * SelectionStrategy is SemVer or left unspecified. This field is optional.
+   * More info: https://github.com/masterminds/semver#checking-version-constraints
    *
-   * +kubebuilder:validation:MinItems=1
-   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses or task set",rule="(has(self.uses) ? !has(self.task) : has(self.task))"
+   * +kubebuilder:validation:Optional
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 1;
+   * @generated from field: optional string semverConstraint = 7;
    */
-  steps: PromotionStep[];
+  semverConstraint: string;
+
+  /**
+   * AllowVersions is a regular expression that can optionally be used to
+   * limit the versions that are considered in determining the newest version.
+   * This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string allowVersions = 8;
+   */
+  allowVersions: string;
+
+  /**
+   * IgnoreVersions is a list of versions that must be ignored when
+   * determining the newest version. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string ignoreVersions = 9;
+   */
+  ignoreVersions: string[];
+
+  /**
+   * DiscoveryLimit is an optional limit on the number of versions that can be
+   * discovered for this subscription. The limit is applied after filtering
+   * versions based on the AllowVersions and IgnoreVersions fields. When left
+   * unspecified, the field is implicitly treated as if its value were "20".
+   * The upper limit for this field is 100.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   * +kubebuilder:default=20
+   *
+   * @generated from field: optional int32 discoveryLimit = 10;
+   */
+  discoveryLimit: number;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec.
- * Use `create(PromotionTemplateSpecSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ReleaseFeedSubscription.
+ * Use `create(ReleaseFeedSubscriptionSchema)` to create a new message.
  */
-export const PromotionTemplateSpecSchema: GenMessage<PromotionTemplateSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 58);
+export const ReleaseFeedSubscriptionSchema: GenMessage<ReleaseFeedSubscription> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 76);
 
 /**
  * RepoSubscription describes a subscription to ONE OF a Git repository, a
- * container image repository, or a Helm chart repository.
+ * container image repository, a Helm chart repository, a repository of other
+ * OCI artifacts, or an HTTP release feed.
  *
  * @generated from message github.com.akuity.kargo.api.v1alpha1.RepoSubscription
  */
@@ -2772,6 +3982,23 @@ export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Rep
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ChartSubscription chart = 3;
    */
   chart?: ChartSubscription;
+
+  /**
+   * OCIArtifact describes a subscription to a repository of OCI artifacts,
+   * such as Terraform modules, policy bundles, or WASM plugins, within an OCI
+   * registry.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription ociArtifact = 4;
+   */
+  ociArtifact?: OCIArtifactSubscription;
+
+  /**
+   * ReleaseFeed describes a subscription to an HTTP endpoint that lists
+   * versions of some artifact, such as an internal release API.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ReleaseFeedSubscription releaseFeed = 5;
+   */
+  releaseFeed?: ReleaseFeedSubscription;
 };
 
 /**
@@ -2779,7 +4006,7 @@ export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Rep
  * Use `create(RepoSubscriptionSchema)` to create a new message.
  */
 export const RepoSubscriptionSchema: GenMessage<RepoSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 59);
+  messageDesc(file_api_v1alpha1_generated, 77);
 
 /**
  * Stage is the Kargo API's main type.
@@ -2815,7 +4042,7 @@ export type Stage = Message<"github.com.akuity.kargo.api.v1alpha1.Stage"> & {
  * Use `create(StageSchema)` to create a new message.
  */
 export const StageSchema: GenMessage<Stage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 60);
+  messageDesc(file_api_v1alpha1_generated, 78);
 
 /**
  * StageList is a list of Stage resources.
@@ -2839,7 +4066,7 @@ export type StageList = Message<"github.com.akuity.kargo.api.v1alpha1.StageList"
  * Use `create(StageListSchema)` to create a new message.
  */
 export const StageListSchema: GenMessage<StageList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 61);
+  messageDesc(file_api_v1alpha1_generated, 79);
 
 /**
  * StageSpec describes the sources of Freight used by a Stage and how to
@@ -2899,6 +4126,25 @@ export type StageSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StageSpec"
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.Verification verification = 3;
    */
   verification?: Verification;
+
+  /**
+   * ApprovalPolicy optionally describes the manual approvals Freight must
+   * receive before it may be promoted to this Stage. When specified, Freight
+   * becomes available to the Stage only once the policy has been satisfied,
+   * regardless of whether it has been verified in any upstream Stage.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ApprovalPolicy approvalPolicy = 8;
+   */
+  approvalPolicy?: ApprovalPolicy;
+
+  /**
+   * AutoRollback optionally describes whether the Stage should automatically
+   * roll back to the most recently verified Freight when verification of its
+   * current Freight fails.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.AutoRollback autoRollback = 9;
+   */
+  autoRollback?: AutoRollback;
 };
 
 /**
@@ -2906,7 +4152,7 @@ export type StageSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StageSpec"
  * Use `create(StageSpecSchema)` to create a new message.
  */
 export const StageSpecSchema: GenMessage<StageSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 62);
+  messageDesc(file_api_v1alpha1_generated, 80);
 
 /**
  * StageStats contains a summary of the collective state of a Project's
@@ -2935,7 +4181,7 @@ export type StageStats = Message<"github.com.akuity.kargo.api.v1alpha1.StageStat
  * Use `create(StageStatsSchema)` to create a new message.
  */
 export const StageStatsSchema: GenMessage<StageStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 63);
+  messageDesc(file_api_v1alpha1_generated, 81);
 
 /**
  * StageStatus describes a Stages's current and recent Freight, health, and
@@ -3027,7 +4273,7 @@ export type StageStatus = Message<"github.com.akuity.kargo.api.v1alpha1.StageSta
  * Use `create(StageStatusSchema)` to create a new message.
  */
 export const StageStatusSchema: GenMessage<StageStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 64);
+  messageDesc(file_api_v1alpha1_generated, 82);
 
 /**
  * StepExecutionMetadata tracks metadata pertaining to the execution of
@@ -3096,7 +4342,137 @@ export type StepExecutionMetadata = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(StepExecutionMetadataSchema)` to create a new message.
  */
 export const StepExecutionMetadataSchema: GenMessage<StepExecutionMetadata> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 65);
+  messageDesc(file_api_v1alpha1_generated, 83);
+
+/**
+ * StepRunnerPlugin registers an out-of-process plugin that runs one or more
+ * custom promotion steps. Kargo invokes the plugin using the step runner
+ * plugin protocol defined in the api/plugin package whenever a promotion step
+ * uses one of the steps the plugin provides.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StepRunnerPlugin
+ */
+export type StepRunnerPlugin = Message<"github.com.akuity.kargo.api.v1alpha1.StepRunnerPlugin"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * Spec describes the plugin and the promotion steps it provides.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginSpec spec = 2;
+   */
+  spec?: StepRunnerPluginSpec;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StepRunnerPlugin.
+ * Use `create(StepRunnerPluginSchema)` to create a new message.
+ */
+export const StepRunnerPluginSchema: GenMessage<StepRunnerPlugin> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 84);
+
+/**
+ * StepRunnerPluginList contains a list of StepRunnerPlugins.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginList
+ */
+export type StepRunnerPluginList = Message<"github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginList"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.StepRunnerPlugin items = 2;
+   */
+  items: StepRunnerPlugin[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginList.
+ * Use `create(StepRunnerPluginListSchema)` to create a new message.
+ */
+export const StepRunnerPluginListSchema: GenMessage<StepRunnerPluginList> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 85);
+
+/**
+ * StepRunnerPluginSpec describes a step runner plugin and the promotion steps
+ * it provides.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginSpec
+ */
+export type StepRunnerPluginSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginSpec"> & {
+  /**
+   * URL is the base URL at which the plugin serves the step runner plugin
+   * protocol. URLs using the http scheme are served over unencrypted HTTP/2.
+   * URLs using the https scheme are served over TLS.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:Pattern=`^https?://`
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url: string;
+
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when connecting to the plugin over TLS.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 2;
+   */
+  insecureSkipTLSVerify: boolean;
+
+  /**
+   * Steps is the list of names of the promotion steps provided by the plugin.
+   * These are the names that can be referenced by the uses field of a
+   * promotion step. The names of built-in steps are reserved and can not be
+   * provided by a plugin.
+   *
+   * +kubebuilder:validation:MinItems=1
+   * +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+   * +kubebuilder:validation:items:MaxLength=63
+   *
+   * @generated from field: repeated string steps = 3;
+   */
+  steps: string[];
+
+  /**
+   * Timeout is the default soft maximum interval in which a step provided by
+   * the plugin that returns a Running status (which will be retried) may try
+   * to succeed. It can be overridden per step using the retry field of the
+   * step. When left unspecified, such steps are retried indefinitely.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration timeout = 4;
+   */
+  timeout?: Duration;
+
+  /**
+   * ErrorThreshold is the default number of consecutive times a step
+   * provided by the plugin must fail (for any reason) before retries are
+   * abandoned and the entire Promotion is marked as failed. It can be
+   * overridden per step using the retry field of the step. When left
+   * unspecified, a step provided by the plugin is not retried after it
+   * fails.
+   *
+   * @generated from field: optional uint32 errorThreshold = 5;
+   */
+  errorThreshold: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StepRunnerPluginSpec.
+ * Use `create(StepRunnerPluginSpecSchema)` to create a new message.
+ */
+export const StepRunnerPluginSpecSchema: GenMessage<StepRunnerPluginSpec> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 86);
 
 /**
  * Verification describes how to verify that a Promotion has been successful
@@ -3135,7 +4511,7 @@ export type Verification = Message<"github.com.akuity.kargo.api.v1alpha1.Verific
  * Use `create(VerificationSchema)` to create a new message.
  */
 export const VerificationSchema: GenMessage<Verification> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 66);
+  messageDesc(file_api_v1alpha1_generated, 87);
 
 /**
  * VerificationInfo contains the details of an instance of a Verification
@@ -3211,7 +4587,7 @@ export type VerificationInfo = Message<"github.com.akuity.kargo.api.v1alpha1.Ver
  * Use `create(VerificationInfoSchema)` to create a new message.
  */
 export const VerificationInfoSchema: GenMessage<VerificationInfo> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 67);
+  messageDesc(file_api_v1alpha1_generated, 88);
 
 /**
  * VerifiedStage describes a Stage in which Freight has been verified.
@@ -3243,7 +4619,7 @@ export type VerifiedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Verifi
  * Use `create(VerifiedStageSchema)` to create a new message.
  */
 export const VerifiedStageSchema: GenMessage<VerifiedStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 68);
+  messageDesc(file_api_v1alpha1_generated, 89);
 
 /**
  * Warehouse is a source of Freight.
@@ -3278,7 +4654,7 @@ export type Warehouse = Message<"github.com.akuity.kargo.api.v1alpha1.Warehouse"
  * Use `create(WarehouseSchema)` to create a new message.
  */
 export const WarehouseSchema: GenMessage<Warehouse> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 69);
+  messageDesc(file_api_v1alpha1_generated, 90);
 
 /**
  * WarehouseList is a list of Warehouse resources.
@@ -3302,7 +4678,7 @@ export type WarehouseList = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseListSchema)` to create a new message.
  */
 export const WarehouseListSchema: GenMessage<WarehouseList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 70);
+  messageDesc(file_api_v1alpha1_generated, 91);
 
 /**
  * WarehouseSpec describes sources of versioned artifacts to be included in
@@ -3358,6 +4734,19 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
    */
   freightCreationPolicy: string;
 
+  /**
+   * FreightCreationCriteria defines criteria that the latest discovered
+   * artifacts must satisfy for Freight to be created from them automatically.
+   * This field is optional and has no effect unless FreightCreationPolicy is
+   * "Automatic". When left unspecified, Freight is created from any new
+   * combination of artifacts.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightCreationCriteria freightCreationCriteria = 5;
+   */
+  freightCreationCriteria?: FreightCreationCriteria;
+
   /**
    * Subscriptions describes sources of artifacts to be included in Freight
    * produced by this Warehouse.
@@ -3374,7 +4763,7 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseSpecSchema)` to create a new message.
  */
 export const WarehouseSpecSchema: GenMessage<WarehouseSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 71);
+  messageDesc(file_api_v1alpha1_generated, 92);
 
 /**
  * WarehouseStats contains a summary of the collective state of a Project's
@@ -3404,7 +4793,7 @@ export type WarehouseStats = Message<"github.com.akuity.kargo.api.v1alpha1.Wareh
  * Use `create(WarehouseStatsSchema)` to create a new message.
  */
 export const WarehouseStatsSchema: GenMessage<WarehouseStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 72);
+  messageDesc(file_api_v1alpha1_generated, 93);
 
 /**
  * WarehouseStatus describes a Warehouse's most recently observed state.
@@ -3463,7 +4852,7 @@ export type WarehouseStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Ware
  * Use `create(WarehouseStatusSchema)` to create a new message.
  */
 export const WarehouseStatusSchema: GenMessage<WarehouseStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 73);
+  messageDesc(file_api_v1alpha1_generated, 94);
 
 /**
  * WebhookReceiver describes a path used to receive webhook events.
@@ -3498,7 +4887,7 @@ export type WebhookReceiver = Message<"github.com.akuity.kargo.api.v1alpha1.Webh
  * Use `create(WebhookReceiverSchema)` to create a new message.
  */
 export const WebhookReceiverSchema: GenMessage<WebhookReceiver> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 74);
+  messageDesc(file_api_v1alpha1_generated, 95);
 
 /**
  * WebhookReceiverConfig describes the configuration for a single webhook
@@ -3532,5 +4921,5 @@ export type WebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(WebhookReceiverConfigSchema)` to create a new message.
  */
 export const WebhookReceiverConfigSchema: GenMessage<WebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 75);
+  messageDesc(file_api_v1alpha1_generated, 96);
 